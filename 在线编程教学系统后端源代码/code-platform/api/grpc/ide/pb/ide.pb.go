@@ -76,6 +76,110 @@ func (OrderType) EnumDescriptor() ([]byte, []int) {
 	return file_ide_proto_rawDescGZIP(), []int{0}
 }
 
+type DiffWorkspacesResponse_FileStatus int32
+
+const (
+	DiffWorkspacesResponse_UNCHANGED DiffWorkspacesResponse_FileStatus = 0
+	DiffWorkspacesResponse_MODIFIED  DiffWorkspacesResponse_FileStatus = 1
+	DiffWorkspacesResponse_ADDED     DiffWorkspacesResponse_FileStatus = 2
+	DiffWorkspacesResponse_DELETED   DiffWorkspacesResponse_FileStatus = 3
+	DiffWorkspacesResponse_RENAMED   DiffWorkspacesResponse_FileStatus = 4
+)
+
+// Enum value maps for DiffWorkspacesResponse_FileStatus.
+var (
+	DiffWorkspacesResponse_FileStatus_name = map[int32]string{
+		0: "UNCHANGED",
+		1: "MODIFIED",
+		2: "ADDED",
+		3: "DELETED",
+		4: "RENAMED",
+	}
+	DiffWorkspacesResponse_FileStatus_value = map[string]int32{
+		"UNCHANGED": 0,
+		"MODIFIED":  1,
+		"ADDED":     2,
+		"DELETED":   3,
+		"RENAMED":   4,
+	}
+)
+
+func (x DiffWorkspacesResponse_FileStatus) Enum() *DiffWorkspacesResponse_FileStatus {
+	p := new(DiffWorkspacesResponse_FileStatus)
+	*p = x
+	return p
+}
+
+func (x DiffWorkspacesResponse_FileStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffWorkspacesResponse_FileStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ide_proto_enumTypes[1].Descriptor()
+}
+
+func (DiffWorkspacesResponse_FileStatus) Type() protoreflect.EnumType {
+	return &file_ide_proto_enumTypes[1]
+}
+
+func (x DiffWorkspacesResponse_FileStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffWorkspacesResponse_FileStatus.Descriptor instead.
+func (DiffWorkspacesResponse_FileStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type DiffWorkspacesResponse_LineKind int32
+
+const (
+	DiffWorkspacesResponse_EQUAL  DiffWorkspacesResponse_LineKind = 0
+	DiffWorkspacesResponse_INSERT DiffWorkspacesResponse_LineKind = 1
+	DiffWorkspacesResponse_DELETE DiffWorkspacesResponse_LineKind = 2
+)
+
+// Enum value maps for DiffWorkspacesResponse_LineKind.
+var (
+	DiffWorkspacesResponse_LineKind_name = map[int32]string{
+		0: "EQUAL",
+		1: "INSERT",
+		2: "DELETE",
+	}
+	DiffWorkspacesResponse_LineKind_value = map[string]int32{
+		"EQUAL":  0,
+		"INSERT": 1,
+		"DELETE": 2,
+	}
+)
+
+func (x DiffWorkspacesResponse_LineKind) Enum() *DiffWorkspacesResponse_LineKind {
+	p := new(DiffWorkspacesResponse_LineKind)
+	*p = x
+	return p
+}
+
+func (x DiffWorkspacesResponse_LineKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffWorkspacesResponse_LineKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ide_proto_enumTypes[2].Descriptor()
+}
+
+func (DiffWorkspacesResponse_LineKind) Type() protoreflect.EnumType {
+	return &file_ide_proto_enumTypes[2]
+}
+
+func (x DiffWorkspacesResponse_LineKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffWorkspacesResponse_LineKind.Descriptor instead.
+func (DiffWorkspacesResponse_LineKind) EnumDescriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{15, 1}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -123,6 +227,10 @@ type GetIDEForStudentRequest struct {
 	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	Language  uint32 `protobuf:"varint,3,opt,name=language,proto3" json:"language,omitempty"`
 	CanEdit   bool   `protobuf:"varint,4,opt,name=can_edit,json=canEdit,proto3" json:"can_edit,omitempty"`
+	// 0: Theia, 2: Jupyter，终端模式通过 AttachTerminal 打开
+	IdeKind int32 `protobuf:"varint,5,opt,name=ide_kind,json=ideKind,proto3" json:"ide_kind,omitempty"`
+	// 课程已构建的自定义镜像，为空时按语言使用默认镜像
+	Image string `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
 }
 
 func (x *GetIDEForStudentRequest) Reset() {
@@ -185,6 +293,20 @@ func (x *GetIDEForStudentRequest) GetCanEdit() bool {
 	return false
 }
 
+func (x *GetIDEForStudentRequest) GetIdeKind() int32 {
+	if x != nil {
+		return x.IdeKind
+	}
+	return 0
+}
+
+func (x *GetIDEForStudentRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
 type GetIDEForTeacherRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -194,6 +316,7 @@ type GetIDEForTeacherRequest struct {
 	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
 	TeacherId uint64 `protobuf:"varint,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
 	Language  uint32 `protobuf:"varint,4,opt,name=language,proto3" json:"language,omitempty"`
+	Image     string `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
 }
 
 func (x *GetIDEForTeacherRequest) Reset() {
@@ -256,6 +379,13 @@ func (x *GetIDEForTeacherRequest) GetLanguage() uint32 {
 	return 0
 }
 
+func (x *GetIDEForTeacherRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
 type GetIDEResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -264,6 +394,10 @@ type GetIDEResponse struct {
 	Port     uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
 	IsReused bool   `protobuf:"varint,2,opt,name=is_reused,json=isReused,proto3" json:"is_reused,omitempty"`
 	Token    string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// 由休眠状态恢复
+	IsResumed bool `protobuf:"varint,4,opt,name=is_resumed,json=isResumed,proto3" json:"is_resumed,omitempty"`
+	// 浏览器打开 IDE 的入口路径，Jupyter 需在其中携带 token
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (x *GetIDEResponse) Reset() {
@@ -319,6 +453,20 @@ func (x *GetIDEResponse) GetToken() string {
 	return ""
 }
 
+func (x *GetIDEResponse) GetIsResumed() bool {
+	if x != nil {
+		return x.IsResumed
+	}
+	return false
+}
+
+func (x *GetIDEResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type GetContainersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -539,6 +687,7 @@ func (x *StopContainerRequest) GetContainerId() string {
 	return ""
 }
 
+// QuickViewCodeRequest 目录树始终按 quick_view 的条目数与大小上限截断
 type QuickViewCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -546,6 +695,8 @@ type QuickViewCodeRequest struct {
 
 	LabId  uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
 	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 为 true 时一并返回文件内容，否则仅返回目录树
+	WithContent bool `protobuf:"varint,3,opt,name=with_content,json=withContent,proto3" json:"with_content,omitempty"`
 }
 
 func (x *QuickViewCodeRequest) Reset() {
@@ -594,16 +745,25 @@ func (x *QuickViewCodeRequest) GetUserId() uint64 {
 	return 0
 }
 
-type QuickViewCodeResponse struct {
+func (x *QuickViewCodeRequest) GetWithContent() bool {
+	if x != nil {
+		return x.WithContent
+	}
+	return false
+}
+
+// Notebook 从 .ipynb 中提取的单元格源码与输出
+type Notebook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RootNode *QuickViewCodeResponse_FileNode `protobuf:"bytes,1,opt,name=root_node,json=rootNode,proto3" json:"root_node,omitempty"`
+	Language string           `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Cells    []*Notebook_Cell `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
 }
 
-func (x *QuickViewCodeResponse) Reset() {
-	*x = QuickViewCodeResponse{}
+func (x *Notebook) Reset() {
+	*x = Notebook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ide_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,13 +771,13 @@ func (x *QuickViewCodeResponse) Reset() {
 	}
 }
 
-func (x *QuickViewCodeResponse) String() string {
+func (x *Notebook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuickViewCodeResponse) ProtoMessage() {}
+func (*Notebook) ProtoMessage() {}
 
-func (x *QuickViewCodeResponse) ProtoReflect() protoreflect.Message {
+func (x *Notebook) ProtoReflect() protoreflect.Message {
 	mi := &file_ide_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,28 +789,37 @@ func (x *QuickViewCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuickViewCodeResponse.ProtoReflect.Descriptor instead.
-func (*QuickViewCodeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Notebook.ProtoReflect.Descriptor instead.
+func (*Notebook) Descriptor() ([]byte, []int) {
 	return file_ide_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QuickViewCodeResponse) GetRootNode() *QuickViewCodeResponse_FileNode {
+func (x *Notebook) GetLanguage() string {
 	if x != nil {
-		return x.RootNode
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Notebook) GetCells() []*Notebook_Cell {
+	if x != nil {
+		return x.Cells
 	}
 	return nil
 }
 
-type GetContainerNamesResponse struct {
+type QuickViewCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Infos []*GetContainerNamesResponse_ContainerNameInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+	RootNode *QuickViewCodeResponse_FileNode `protobuf:"bytes,1,opt,name=root_node,json=rootNode,proto3" json:"root_node,omitempty"`
+	// 目录树因条目数或总大小限制不完整
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func (x *GetContainerNamesResponse) Reset() {
-	*x = GetContainerNamesResponse{}
+func (x *QuickViewCodeResponse) Reset() {
+	*x = QuickViewCodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ide_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -658,13 +827,13 @@ func (x *GetContainerNamesResponse) Reset() {
 	}
 }
 
-func (x *GetContainerNamesResponse) String() string {
+func (x *QuickViewCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerNamesResponse) ProtoMessage() {}
+func (*QuickViewCodeResponse) ProtoMessage() {}
 
-func (x *GetContainerNamesResponse) ProtoReflect() protoreflect.Message {
+func (x *QuickViewCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ide_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,28 +845,37 @@ func (x *GetContainerNamesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerNamesResponse.ProtoReflect.Descriptor instead.
-func (*GetContainerNamesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuickViewCodeResponse.ProtoReflect.Descriptor instead.
+func (*QuickViewCodeResponse) Descriptor() ([]byte, []int) {
 	return file_ide_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetContainerNamesResponse) GetInfos() []*GetContainerNamesResponse_ContainerNameInfo {
+func (x *QuickViewCodeResponse) GetRootNode() *QuickViewCodeResponse_FileNode {
 	if x != nil {
-		return x.Infos
+		return x.RootNode
 	}
 	return nil
 }
 
-type RemoveContainerRequest struct {
+func (x *QuickViewCodeResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type QuickViewFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerNames []string `protobuf:"bytes,1,rep,name=container_names,json=containerNames,proto3" json:"container_names,omitempty"`
+	LabId  uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *RemoveContainerRequest) Reset() {
-	*x = RemoveContainerRequest{}
+func (x *QuickViewFileRequest) Reset() {
+	*x = QuickViewFileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ide_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -705,13 +883,13 @@ func (x *RemoveContainerRequest) Reset() {
 	}
 }
 
-func (x *RemoveContainerRequest) String() string {
+func (x *QuickViewFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContainerRequest) ProtoMessage() {}
+func (*QuickViewFileRequest) ProtoMessage() {}
 
-func (x *RemoveContainerRequest) ProtoReflect() protoreflect.Message {
+func (x *QuickViewFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ide_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -723,29 +901,48 @@ func (x *RemoveContainerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContainerRequest.ProtoReflect.Descriptor instead.
-func (*RemoveContainerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuickViewFileRequest.ProtoReflect.Descriptor instead.
+func (*QuickViewFileRequest) Descriptor() ([]byte, []int) {
 	return file_ide_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveContainerRequest) GetContainerNames() []string {
+func (x *QuickViewFileRequest) GetLabId() uint64 {
 	if x != nil {
-		return x.ContainerNames
+		return x.LabId
 	}
-	return nil
+	return 0
 }
 
-type HeartBeatStat struct {
+func (x *QuickViewFileRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *QuickViewFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type QuickViewFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt     int64 `protobuf:"varint,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastVisitedAt int64 `protobuf:"varint,2,opt,name=last_visited_at,json=lastVisitedAt,proto3" json:"last_visited_at,omitempty"`
+	Content   string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Encoding  string `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	IsBinary  bool   `protobuf:"varint,3,opt,name=is_binary,json=isBinary,proto3" json:"is_binary,omitempty"`
+	Truncated bool   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Size      int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// .ipynb 文件解析成功时代替 content 返回
+	Notebook *Notebook `protobuf:"bytes,6,opt,name=notebook,proto3" json:"notebook,omitempty"`
 }
 
-func (x *HeartBeatStat) Reset() {
-	*x = HeartBeatStat{}
+func (x *QuickViewFileResponse) Reset() {
+	*x = QuickViewFileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ide_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,13 +950,13 @@ func (x *HeartBeatStat) Reset() {
 	}
 }
 
-func (x *HeartBeatStat) String() string {
+func (x *QuickViewFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartBeatStat) ProtoMessage() {}
+func (*QuickViewFileResponse) ProtoMessage() {}
 
-func (x *HeartBeatStat) ProtoReflect() protoreflect.Message {
+func (x *QuickViewFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ide_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,43 +968,67 @@ func (x *HeartBeatStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartBeatStat.ProtoReflect.Descriptor instead.
-func (*HeartBeatStat) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuickViewFileResponse.ProtoReflect.Descriptor instead.
+func (*QuickViewFileResponse) Descriptor() ([]byte, []int) {
 	return file_ide_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *HeartBeatStat) GetCreatedAt() int64 {
+func (x *QuickViewFileResponse) GetContent() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *HeartBeatStat) GetLastVisitedAt() int64 {
+func (x *QuickViewFileResponse) GetEncoding() string {
 	if x != nil {
-		return x.LastVisitedAt
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *QuickViewFileResponse) GetIsBinary() bool {
+	if x != nil {
+		return x.IsBinary
+	}
+	return false
+}
+
+func (x *QuickViewFileResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *QuickViewFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-type GetContainersResponse_ContainerInfo struct {
+func (x *QuickViewFileResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+// WorkspaceRef 指向学生工作区的当前内容或某个快照
+type WorkspaceRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string       `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	LabId       uint64       `protobuf:"varint,2,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
-	StudentId   uint64       `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	CreatedAt   int64        `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Size        string       `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
-	TeacherInfo *TeacherInfo `protobuf:"bytes,6,opt,name=teacher_info,json=teacherInfo,proto3" json:"teacher_info,omitempty"`
-	Port        uint32       `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
-	CpuPerc     string       `protobuf:"bytes,8,opt,name=cpu_perc,json=cpuPerc,proto3" json:"cpu_perc,omitempty"`
-	MemoryUsage string       `protobuf:"bytes,9,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 为 0 时表示当前工作区
+	SnapshotId int64 `protobuf:"varint,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
 }
 
-func (x *GetContainersResponse_ContainerInfo) Reset() {
-	*x = GetContainersResponse_ContainerInfo{}
+func (x *WorkspaceRef) Reset() {
+	*x = WorkspaceRef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ide_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,13 +1036,13 @@ func (x *GetContainersResponse_ContainerInfo) Reset() {
 	}
 }
 
-func (x *GetContainersResponse_ContainerInfo) String() string {
+func (x *WorkspaceRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainersResponse_ContainerInfo) ProtoMessage() {}
+func (*WorkspaceRef) ProtoMessage() {}
 
-func (x *GetContainersResponse_ContainerInfo) ProtoReflect() protoreflect.Message {
+func (x *WorkspaceRef) ProtoReflect() protoreflect.Message {
 	mi := &file_ide_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,102 +1054,176 @@ func (x *GetContainersResponse_ContainerInfo) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainersResponse_ContainerInfo.ProtoReflect.Descriptor instead.
-func (*GetContainersResponse_ContainerInfo) Descriptor() ([]byte, []int) {
-	return file_ide_proto_rawDescGZIP(), []int{6, 0}
-}
-
-func (x *GetContainersResponse_ContainerInfo) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+// Deprecated: Use WorkspaceRef.ProtoReflect.Descriptor instead.
+func (*WorkspaceRef) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetLabId() uint64 {
+func (x *WorkspaceRef) GetLabId() uint64 {
 	if x != nil {
 		return x.LabId
 	}
 	return 0
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetStudentId() uint64 {
+func (x *WorkspaceRef) GetStudentId() uint64 {
 	if x != nil {
 		return x.StudentId
 	}
 	return 0
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetCreatedAt() int64 {
+func (x *WorkspaceRef) GetSnapshotId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.SnapshotId
 	}
 	return 0
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetSize() string {
-	if x != nil {
-		return x.Size
+type DiffWorkspacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Old          *WorkspaceRef `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New          *WorkspaceRef `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	ContextLines int32         `protobuf:"varint,3,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"`
+}
+
+func (x *DiffWorkspacesRequest) Reset() {
+	*x = DiffWorkspacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetTeacherInfo() *TeacherInfo {
+func (x *DiffWorkspacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkspacesRequest) ProtoMessage() {}
+
+func (x *DiffWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*DiffWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiffWorkspacesRequest) GetOld() *WorkspaceRef {
 	if x != nil {
-		return x.TeacherInfo
+		return x.Old
 	}
 	return nil
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetPort() uint32 {
+func (x *DiffWorkspacesRequest) GetNew() *WorkspaceRef {
 	if x != nil {
-		return x.Port
+		return x.New
 	}
-	return 0
+	return nil
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetCpuPerc() string {
+func (x *DiffWorkspacesRequest) GetContextLines() int32 {
 	if x != nil {
-		return x.CpuPerc
+		return x.ContextLines
 	}
-	return ""
+	return 0
 }
 
-func (x *GetContainersResponse_ContainerInfo) GetMemoryUsage() string {
+type DiffWorkspacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*DiffWorkspacesResponse_FileDiff `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// 文件数超出限制
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *DiffWorkspacesResponse) Reset() {
+	*x = DiffWorkspacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffWorkspacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkspacesResponse) ProtoMessage() {}
+
+func (x *DiffWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*DiffWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiffWorkspacesResponse) GetFiles() []*DiffWorkspacesResponse_FileDiff {
 	if x != nil {
-		return x.MemoryUsage
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-type QuickViewCodeResponse_FileNode struct {
+func (x *DiffWorkspacesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type GetContainerNamesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Content    string                            `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	ChildNodes []*QuickViewCodeResponse_FileNode `protobuf:"bytes,3,rep,name=child_nodes,json=childNodes,proto3" json:"child_nodes,omitempty"`
-	IsDir      bool                              `protobuf:"varint,4,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	Infos []*GetContainerNamesResponse_ContainerNameInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
 }
 
-func (x *QuickViewCodeResponse_FileNode) Reset() {
-	*x = QuickViewCodeResponse_FileNode{}
+func (x *GetContainerNamesResponse) Reset() {
+	*x = GetContainerNamesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ide_proto_msgTypes[14]
+		mi := &file_ide_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuickViewCodeResponse_FileNode) String() string {
+func (x *GetContainerNamesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuickViewCodeResponse_FileNode) ProtoMessage() {}
+func (*GetContainerNamesResponse) ProtoMessage() {}
 
-func (x *QuickViewCodeResponse_FileNode) ProtoReflect() protoreflect.Message {
-	mi := &file_ide_proto_msgTypes[14]
+func (x *GetContainerNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,66 +1234,142 @@ func (x *QuickViewCodeResponse_FileNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuickViewCodeResponse_FileNode.ProtoReflect.Descriptor instead.
-func (*QuickViewCodeResponse_FileNode) Descriptor() ([]byte, []int) {
-	return file_ide_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use GetContainerNamesResponse.ProtoReflect.Descriptor instead.
+func (*GetContainerNamesResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QuickViewCodeResponse_FileNode) GetName() string {
+func (x *GetContainerNamesResponse) GetInfos() []*GetContainerNamesResponse_ContainerNameInfo {
 	if x != nil {
-		return x.Name
+		return x.Infos
 	}
-	return ""
+	return nil
 }
 
-func (x *QuickViewCodeResponse_FileNode) GetContent() string {
-	if x != nil {
-		return x.Content
+type RemoveContainerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerNames []string `protobuf:"bytes,1,rep,name=container_names,json=containerNames,proto3" json:"container_names,omitempty"`
+}
+
+func (x *RemoveContainerRequest) Reset() {
+	*x = RemoveContainerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *QuickViewCodeResponse_FileNode) GetChildNodes() []*QuickViewCodeResponse_FileNode {
+func (x *RemoveContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContainerRequest) ProtoMessage() {}
+
+func (x *RemoveContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContainerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContainerRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveContainerRequest) GetContainerNames() []string {
 	if x != nil {
-		return x.ChildNodes
+		return x.ContainerNames
 	}
 	return nil
 }
 
-func (x *QuickViewCodeResponse_FileNode) GetIsDir() bool {
+type HibernateContainerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerNames []string `protobuf:"bytes,1,rep,name=container_names,json=containerNames,proto3" json:"container_names,omitempty"`
+}
+
+func (x *HibernateContainerRequest) Reset() {
+	*x = HibernateContainerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HibernateContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HibernateContainerRequest) ProtoMessage() {}
+
+func (x *HibernateContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HibernateContainerRequest.ProtoReflect.Descriptor instead.
+func (*HibernateContainerRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HibernateContainerRequest) GetContainerNames() []string {
 	if x != nil {
-		return x.IsDir
+		return x.ContainerNames
 	}
-	return false
+	return nil
 }
 
-type GetContainerNamesResponse_ContainerNameInfo struct {
+// path 为相对工作区根目录的路径
+type WorkspaceFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LabId       uint64       `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
-	StudentId   uint64       `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
-	TeacherInfo *TeacherInfo `protobuf:"bytes,3,opt,name=teacher_info,json=teacherInfo,proto3" json:"teacher_info,omitempty"`
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// 不为 0 时只读访问该快照
+	SnapshotId int64 `protobuf:"varint,4,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
 }
 
-func (x *GetContainerNamesResponse_ContainerNameInfo) Reset() {
-	*x = GetContainerNamesResponse_ContainerNameInfo{}
+func (x *WorkspaceFileRequest) Reset() {
+	*x = WorkspaceFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ide_proto_msgTypes[15]
+		mi := &file_ide_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetContainerNamesResponse_ContainerNameInfo) String() string {
+func (x *WorkspaceFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerNamesResponse_ContainerNameInfo) ProtoMessage() {}
+func (*WorkspaceFileRequest) ProtoMessage() {}
 
-func (x *GetContainerNamesResponse_ContainerNameInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ide_proto_msgTypes[15]
+func (x *WorkspaceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,474 +1380,4139 @@ func (x *GetContainerNamesResponse_ContainerNameInfo) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerNamesResponse_ContainerNameInfo.ProtoReflect.Descriptor instead.
-func (*GetContainerNamesResponse_ContainerNameInfo) Descriptor() ([]byte, []int) {
-	return file_ide_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use WorkspaceFileRequest.ProtoReflect.Descriptor instead.
+func (*WorkspaceFileRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetContainerNamesResponse_ContainerNameInfo) GetLabId() uint64 {
+func (x *WorkspaceFileRequest) GetLabId() uint64 {
 	if x != nil {
 		return x.LabId
 	}
 	return 0
 }
 
-func (x *GetContainerNamesResponse_ContainerNameInfo) GetStudentId() uint64 {
+func (x *WorkspaceFileRequest) GetStudentId() uint64 {
 	if x != nil {
 		return x.StudentId
 	}
 	return 0
 }
 
-func (x *GetContainerNamesResponse_ContainerNameInfo) GetTeacherInfo() *TeacherInfo {
+func (x *WorkspaceFileRequest) GetPath() string {
 	if x != nil {
-		return x.TeacherInfo
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-var File_ide_proto protoreflect.FileDescriptor
+func (x *WorkspaceFileRequest) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
 
-var file_ide_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x69, 0x64, 0x65,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c,
-	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x65,
-	0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x45, 0x64,
-	0x69, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72,
-	0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
-	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22,
-	0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x75, 0x73,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x65, 0x75, 0x73,
-	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x24, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x0b, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0xa5, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0xa2, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x64, 0x65, 0x2e,
-	0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x39, 0x0a, 0x14, 0x53, 0x74,
-	0x6f, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69,
-	0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf1, 0x01,
-	0x0a, 0x15, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
-	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x64, 0x65,
-	0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x08, 0x72, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x1a, 0x95, 0x01, 0x0a, 0x08, 0x46, 0x69,
-	0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x64, 0x65, 0x2e,
-	0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73,
-	0x5f, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x69,
-	0x72, 0x22, 0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x46, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
-	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x7e, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06,
-	0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61,
-	0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x54,
-	0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x65, 0x61, 0x63,
-	0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x41, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x0d, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x2a, 0x40, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0a, 0x0a, 0x06, 0x62, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x62,
-	0x79, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x79, 0x43,
-	0x50, 0x55, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x79, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69,
-	0x7a, 0x65, 0x10, 0x03, 0x32, 0xff, 0x04, 0x0a, 0x10, 0x49, 0x44, 0x45, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
-	0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44,
-	0x45, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x41,
-	0x6c, 0x6c, 0x49, 0x44, 0x45, 0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x19,
-	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x6f,
-	0x70, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a,
-	0x0d, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19,
-	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e,
-	0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x21,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0a, 0x2e,
-	0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x0a,
-	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x69, 0x64, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e,
-	0x69, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x05, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+type WorkspaceEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDir      bool   `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	Size       int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ModifiedAt int64  `protobuf:"varint,4,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
 }
 
-var (
-	file_ide_proto_rawDescOnce sync.Once
-	file_ide_proto_rawDescData = file_ide_proto_rawDesc
-)
+func (x *WorkspaceEntry) Reset() {
+	*x = WorkspaceEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_ide_proto_rawDescGZIP() []byte {
-	file_ide_proto_rawDescOnce.Do(func() {
-		file_ide_proto_rawDescData = protoimpl.X.CompressGZIP(file_ide_proto_rawDescData)
-	})
-	return file_ide_proto_rawDescData
+func (x *WorkspaceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_ide_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ide_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_ide_proto_goTypes = []interface{}{
-	(OrderType)(0),                                      // 0: ide.OrderType
-	(*Empty)(nil),                                       // 1: ide.Empty
-	(*GetIDEForStudentRequest)(nil),                     // 2: ide.GetIDEForStudentRequest
-	(*GetIDEForTeacherRequest)(nil),                     // 3: ide.GetIDEForTeacherRequest
-	(*GetIDEResponse)(nil),                              // 4: ide.GetIDEResponse
-	(*GetContainersRequest)(nil),                        // 5: ide.GetContainersRequest
-	(*TeacherInfo)(nil),                                 // 6: ide.TeacherInfo
-	(*GetContainersResponse)(nil),                       // 7: ide.GetContainersResponse
-	(*StopContainerRequest)(nil),                        // 8: ide.StopContainerRequest
-	(*QuickViewCodeRequest)(nil),                        // 9: ide.QuickViewCodeRequest
-	(*QuickViewCodeResponse)(nil),                       // 10: ide.QuickViewCodeResponse
-	(*GetContainerNamesResponse)(nil),                   // 11: ide.GetContainerNamesResponse
-	(*RemoveContainerRequest)(nil),                      // 12: ide.RemoveContainerRequest
-	(*HeartBeatStat)(nil),                               // 13: ide.HeartBeatStat
-	(*GetContainersResponse_ContainerInfo)(nil),         // 14: ide.GetContainersResponse.ContainerInfo
-	(*QuickViewCodeResponse_FileNode)(nil),              // 15: ide.QuickViewCodeResponse.FileNode
-	(*GetContainerNamesResponse_ContainerNameInfo)(nil), // 16: ide.GetContainerNamesResponse.ContainerNameInfo
+func (*WorkspaceEntry) ProtoMessage() {}
+
+func (x *WorkspaceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_ide_proto_depIdxs = []int32{
-	0,  // 0: ide.GetContainersRequest.order:type_name -> ide.OrderType
-	14, // 1: ide.GetContainersResponse.container_infos:type_name -> ide.GetContainersResponse.ContainerInfo
-	15, // 2: ide.QuickViewCodeResponse.root_node:type_name -> ide.QuickViewCodeResponse.FileNode
-	16, // 3: ide.GetContainerNamesResponse.infos:type_name -> ide.GetContainerNamesResponse.ContainerNameInfo
-	6,  // 4: ide.GetContainersResponse.ContainerInfo.teacher_info:type_name -> ide.TeacherInfo
-	15, // 5: ide.QuickViewCodeResponse.FileNode.child_nodes:type_name -> ide.QuickViewCodeResponse.FileNode
-	6,  // 6: ide.GetContainerNamesResponse.ContainerNameInfo.teacher_info:type_name -> ide.TeacherInfo
-	2,  // 7: ide.IDEServerService.GetIDEForStudent:input_type -> ide.GetIDEForStudentRequest
-	3,  // 8: ide.IDEServerService.GetIDEForTeacher:input_type -> ide.GetIDEForTeacherRequest
-	1,  // 9: ide.IDEServerService.StopAllIDE:input_type -> ide.Empty
-	5,  // 10: ide.IDEServerService.GetContainers:input_type -> ide.GetContainersRequest
-	8,  // 11: ide.IDEServerService.StopContainer:input_type -> ide.StopContainerRequest
-	9,  // 12: ide.IDEServerService.QuickViewCode:input_type -> ide.QuickViewCodeRequest
-	1,  // 13: ide.IDEServerService.GenerateTestFileForViewCode:input_type -> ide.Empty
-	1,  // 14: ide.IDEServerService.RemoveGenerateTestFileForViewCode:input_type -> ide.Empty
-	1,  // 15: ide.IDEServerService.GetContainerNames:input_type -> ide.Empty
-	12, // 16: ide.IDEServerService.RemoveContainer:input_type -> ide.RemoveContainerRequest
-	4,  // 17: ide.IDEServerService.GetIDEForStudent:output_type -> ide.GetIDEResponse
-	4,  // 18: ide.IDEServerService.GetIDEForTeacher:output_type -> ide.GetIDEResponse
-	1,  // 19: ide.IDEServerService.StopAllIDE:output_type -> ide.Empty
-	7,  // 20: ide.IDEServerService.GetContainers:output_type -> ide.GetContainersResponse
-	1,  // 21: ide.IDEServerService.StopContainer:output_type -> ide.Empty
-	10, // 22: ide.IDEServerService.QuickViewCode:output_type -> ide.QuickViewCodeResponse
-	1,  // 23: ide.IDEServerService.GenerateTestFileForViewCode:output_type -> ide.Empty
-	1,  // 24: ide.IDEServerService.RemoveGenerateTestFileForViewCode:output_type -> ide.Empty
-	11, // 25: ide.IDEServerService.GetContainerNames:output_type -> ide.GetContainerNamesResponse
-	1,  // 26: ide.IDEServerService.RemoveContainer:output_type -> ide.Empty
-	17, // [17:27] is the sub-list for method output_type
-	7,  // [7:17] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+// Deprecated: Use WorkspaceEntry.ProtoReflect.Descriptor instead.
+func (*WorkspaceEntry) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{20}
 }
 
-func init() { file_ide_proto_init() }
-func file_ide_proto_init() {
-	if File_ide_proto != nil {
-		return
+func (x *WorkspaceEntry) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ide_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIDEForStudentRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIDEForTeacherRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIDEResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainersRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TeacherInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *WorkspaceEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *WorkspaceEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *WorkspaceEntry) GetModifiedAt() int64 {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return 0
+}
+
+type ListWorkspaceDirResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*WorkspaceEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListWorkspaceDirResponse) Reset() {
+	*x = ListWorkspaceDirResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceDirResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceDirResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceDirResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ide_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainersResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceDirResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceDirResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListWorkspaceDirResponse) GetEntries() []*WorkspaceEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ReadWorkspaceFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content    []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Size       int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ModifiedAt int64  `protobuf:"varint,3,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+	IsBinary   bool   `protobuf:"varint,4,opt,name=is_binary,json=isBinary,proto3" json:"is_binary,omitempty"`
+}
+
+func (x *ReadWorkspaceFileResponse) Reset() {
+	*x = ReadWorkspaceFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWorkspaceFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWorkspaceFileResponse) ProtoMessage() {}
+
+func (x *ReadWorkspaceFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ide_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopContainerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadWorkspaceFileResponse.ProtoReflect.Descriptor instead.
+func (*ReadWorkspaceFileResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReadWorkspaceFileResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReadWorkspaceFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ReadWorkspaceFileResponse) GetModifiedAt() int64 {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return 0
+}
+
+func (x *ReadWorkspaceFileResponse) GetIsBinary() bool {
+	if x != nil {
+		return x.IsBinary
+	}
+	return false
+}
+
+type WriteWorkspaceFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Content   []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *WriteWorkspaceFileRequest) Reset() {
+	*x = WriteWorkspaceFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteWorkspaceFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteWorkspaceFileRequest) ProtoMessage() {}
+
+func (x *WriteWorkspaceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ide_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuickViewCodeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteWorkspaceFileRequest.ProtoReflect.Descriptor instead.
+func (*WriteWorkspaceFileRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WriteWorkspaceFileRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *WriteWorkspaceFileRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *WriteWorkspaceFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WriteWorkspaceFileRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreateWorkspaceFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir     bool   `protobuf:"varint,4,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+}
+
+func (x *CreateWorkspaceFileRequest) Reset() {
+	*x = CreateWorkspaceFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceFileRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ide_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuickViewCodeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainerNamesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContainerRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartBeatStat); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ide_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainersResponse_ContainerInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceFileRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateWorkspaceFileRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *CreateWorkspaceFileRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *CreateWorkspaceFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceFileRequest) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+type RenameWorkspaceFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *RenameWorkspaceFileRequest) Reset() {
+	*x = RenameWorkspaceFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameWorkspaceFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWorkspaceFileRequest) ProtoMessage() {}
+
+func (x *RenameWorkspaceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ide_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuickViewCodeResponse_FileNode); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWorkspaceFileRequest.ProtoReflect.Descriptor instead.
+func (*RenameWorkspaceFileRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RenameWorkspaceFileRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *RenameWorkspaceFileRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *RenameWorkspaceFileRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RenameWorkspaceFileRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type HeartBeatStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt     int64 `protobuf:"varint,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastVisitedAt int64 `protobuf:"varint,2,opt,name=last_visited_at,json=lastVisitedAt,proto3" json:"last_visited_at,omitempty"`
+	// 进行中的活跃区间的开始时间与其中最后一次活跃心跳的时间，无进行中的区间时为 0
+	ActiveStartedAt int64 `protobuf:"varint,3,opt,name=active_started_at,json=activeStartedAt,proto3" json:"active_started_at,omitempty"`
+	LastActiveAt    int64 `protobuf:"varint,4,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+}
+
+func (x *HeartBeatStat) Reset() {
+	*x = HeartBeatStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartBeatStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartBeatStat) ProtoMessage() {}
+
+func (x *HeartBeatStat) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ide_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContainerNamesResponse_ContainerNameInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartBeatStat.ProtoReflect.Descriptor instead.
+func (*HeartBeatStat) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HeartBeatStat) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *HeartBeatStat) GetLastVisitedAt() int64 {
+	if x != nil {
+		return x.LastVisitedAt
+	}
+	return 0
+}
+
+func (x *HeartBeatStat) GetActiveStartedAt() int64 {
+	if x != nil {
+		return x.ActiveStartedAt
+	}
+	return 0
+}
+
+func (x *HeartBeatStat) GetLastActiveAt() int64 {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return 0
+}
+
+// SnapshotManifest 工作区快照清单，文件内容按 sha256 存储在快照目录的 objects 下
+type SnapshotManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 创建时间，Unix 毫秒
+	Id     int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string                   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Files  []*SnapshotManifest_File `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	// 因过大或数量超限未纳入快照的文件数
+	Skipped int32 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *SnapshotManifest) Reset() {
+	*x = SnapshotManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotManifest) ProtoMessage() {}
+
+func (x *SnapshotManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_ide_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_ide_proto_goTypes,
-		DependencyIndexes: file_ide_proto_depIdxs,
-		EnumInfos:         file_ide_proto_enumTypes,
-		MessageInfos:      file_ide_proto_msgTypes,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotManifest.ProtoReflect.Descriptor instead.
+func (*SnapshotManifest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SnapshotManifest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SnapshotManifest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SnapshotManifest) GetFiles() []*SnapshotManifest_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *SnapshotManifest) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type WorkspaceSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	FileCount int32  `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	TotalSize int64  `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Skipped   int32  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *WorkspaceSnapshot) Reset() {
+	*x = WorkspaceSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSnapshot) ProtoMessage() {}
+
+func (x *WorkspaceSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSnapshot.ProtoReflect.Descriptor instead.
+func (*WorkspaceSnapshot) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WorkspaceSnapshot) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkspaceSnapshot) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WorkspaceSnapshot) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *WorkspaceSnapshot) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *WorkspaceSnapshot) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type SnapshotWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SnapshotWorkspaceRequest) Reset() {
+	*x = SnapshotWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotWorkspaceRequest) ProtoMessage() {}
+
+func (x *SnapshotWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SnapshotWorkspaceRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *SnapshotWorkspaceRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *SnapshotWorkspaceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListWorkspaceSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *ListWorkspaceSnapshotsRequest) Reset() {
+	*x = ListWorkspaceSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListWorkspaceSnapshotsRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *ListWorkspaceSnapshotsRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type ListWorkspaceSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*WorkspaceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListWorkspaceSnapshotsResponse) Reset() {
+	*x = ListWorkspaceSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListWorkspaceSnapshotsResponse) GetSnapshots() []*WorkspaceSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type RestoreWorkspaceSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId      uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId  uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	SnapshotId int64  `protobuf:"varint,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+}
+
+func (x *RestoreWorkspaceSnapshotRequest) Reset() {
+	*x = RestoreWorkspaceSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkspaceSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkspaceSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreWorkspaceSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkspaceSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreWorkspaceSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestoreWorkspaceSnapshotRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *RestoreWorkspaceSnapshotRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *RestoreWorkspaceSnapshotRequest) GetSnapshotId() int64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+type RestoreWorkspaceSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 恢复前为当前工作区创建的快照，工作区不存在时为 0
+	SafetySnapshotId int64 `protobuf:"varint,1,opt,name=safety_snapshot_id,json=safetySnapshotId,proto3" json:"safety_snapshot_id,omitempty"`
+}
+
+func (x *RestoreWorkspaceSnapshotResponse) Reset() {
+	*x = RestoreWorkspaceSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWorkspaceSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWorkspaceSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreWorkspaceSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWorkspaceSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreWorkspaceSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreWorkspaceSnapshotResponse) GetSafetySnapshotId() int64 {
+	if x != nil {
+		return x.SafetySnapshotId
+	}
+	return 0
+}
+
+type ArchiveWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *ArchiveWorkspaceRequest) Reset() {
+	*x = ArchiveWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWorkspaceRequest) ProtoMessage() {}
+
+func (x *ArchiveWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ArchiveWorkspaceRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *ArchiveWorkspaceRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+// ArchiveWorkspaceChunk 工作区的 tar.gz 归档分块，最后一块携带归档的 sha256、大小与文件数
+type ArchiveWorkspaceChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Sha256    string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Size      int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	FileCount int32  `protobuf:"varint,4,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+}
+
+func (x *ArchiveWorkspaceChunk) Reset() {
+	*x = ArchiveWorkspaceChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveWorkspaceChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWorkspaceChunk) ProtoMessage() {}
+
+func (x *ArchiveWorkspaceChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWorkspaceChunk.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkspaceChunk) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ArchiveWorkspaceChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ArchiveWorkspaceChunk) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ArchiveWorkspaceChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ArchiveWorkspaceChunk) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+// LabTemplateChunk 实验初始代码模板或隐藏测试文件 zip 的分块，lab_id 仅第一块需要携带
+type LabTemplateChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *LabTemplateChunk) Reset() {
+	*x = LabTemplateChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabTemplateChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabTemplateChunk) ProtoMessage() {}
+
+func (x *LabTemplateChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabTemplateChunk.ProtoReflect.Descriptor instead.
+func (*LabTemplateChunk) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LabTemplateChunk) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *LabTemplateChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PutLabTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sha256    string `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	FileCount int32  `protobuf:"varint,2,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// 已打开过的工作区的合并结果
+	WorkspaceCount int32 `protobuf:"varint,3,opt,name=workspace_count,json=workspaceCount,proto3" json:"workspace_count,omitempty"`
+	// 新增或直接更新的文件数
+	Updated int32 `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	// 学生已修改而另存为 .template-new 的文件数
+	Conflicts int32 `protobuf:"varint,5,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// 合并失败、留待下次打开或更新时重试的工作区数
+	Failed int32 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *PutLabTemplateResponse) Reset() {
+	*x = PutLabTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutLabTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutLabTemplateResponse) ProtoMessage() {}
+
+func (x *PutLabTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutLabTemplateResponse.ProtoReflect.Descriptor instead.
+func (*PutLabTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PutLabTemplateResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *PutLabTemplateResponse) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *PutLabTemplateResponse) GetWorkspaceCount() int32 {
+	if x != nil {
+		return x.WorkspaceCount
+	}
+	return 0
+}
+
+func (x *PutLabTemplateResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *PutLabTemplateResponse) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *PutLabTemplateResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type PutLabTestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sha256    string `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	FileCount int32  `protobuf:"varint,2,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+}
+
+func (x *PutLabTestsResponse) Reset() {
+	*x = PutLabTestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutLabTestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutLabTestsResponse) ProtoMessage() {}
+
+func (x *PutLabTestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutLabTestsResponse.ProtoReflect.Descriptor instead.
+func (*PutLabTestsResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PutLabTestsResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *PutLabTestsResponse) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+type RunWorkspaceTestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// 在工作区副本根目录以 sh -c 执行，JUnit XML 需写入环境变量 JUNIT_DIR 指向的目录
+	Command        string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Language       int32  `protobuf:"varint,4,opt,name=language,proto3" json:"language,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// 覆盖到工作区副本上的隐藏测试文件版本，为空时不覆盖
+	TestsSha256 string `protobuf:"bytes,6,opt,name=tests_sha256,json=testsSha256,proto3" json:"tests_sha256,omitempty"`
+	// 课程已构建的自定义 Theia 镜像，为空时按语言使用默认镜像
+	Image string `protobuf:"bytes,7,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *RunWorkspaceTestsRequest) Reset() {
+	*x = RunWorkspaceTestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunWorkspaceTestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunWorkspaceTestsRequest) ProtoMessage() {}
+
+func (x *RunWorkspaceTestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunWorkspaceTestsRequest.ProtoReflect.Descriptor instead.
+func (*RunWorkspaceTestsRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RunWorkspaceTestsRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *RunWorkspaceTestsRequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *RunWorkspaceTestsRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *RunWorkspaceTestsRequest) GetLanguage() int32 {
+	if x != nil {
+		return x.Language
+	}
+	return 0
+}
+
+func (x *RunWorkspaceTestsRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *RunWorkspaceTestsRequest) GetTestsSha256() string {
+	if x != nil {
+		return x.TestsSha256
+	}
+	return ""
+}
+
+func (x *RunWorkspaceTestsRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type JUnitReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *JUnitReport) Reset() {
+	*x = JUnitReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JUnitReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JUnitReport) ProtoMessage() {}
+
+func (x *JUnitReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JUnitReport.ProtoReflect.Descriptor instead.
+func (*JUnitReport) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *JUnitReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JUnitReport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RunWorkspaceTestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode int32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	TimedOut bool  `protobuf:"varint,2,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// 标准输出与标准错误，超出上限时截断
+	Output          string         `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	OutputTruncated bool           `protobuf:"varint,4,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+	Reports         []*JUnitReport `protobuf:"bytes,5,rep,name=reports,proto3" json:"reports,omitempty"`
+	DurationMs      int64          `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *RunWorkspaceTestsResponse) Reset() {
+	*x = RunWorkspaceTestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunWorkspaceTestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunWorkspaceTestsResponse) ProtoMessage() {}
+
+func (x *RunWorkspaceTestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunWorkspaceTestsResponse.ProtoReflect.Descriptor instead.
+func (*RunWorkspaceTestsResponse) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RunWorkspaceTestsResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *RunWorkspaceTestsResponse) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *RunWorkspaceTestsResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *RunWorkspaceTestsResponse) GetOutputTruncated() bool {
+	if x != nil {
+		return x.OutputTruncated
+	}
+	return false
+}
+
+func (x *RunWorkspaceTestsResponse) GetReports() []*JUnitReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *RunWorkspaceTestsResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type StudentIDERequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId     uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId uint64 `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *StudentIDERequest) Reset() {
+	*x = StudentIDERequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StudentIDERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentIDERequest) ProtoMessage() {}
+
+func (x *StudentIDERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentIDERequest.ProtoReflect.Descriptor instead.
+func (*StudentIDERequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StudentIDERequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *StudentIDERequest) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+type TerminalSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cols uint32 `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows uint32 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+// 终端模式 IDE 的输入，流中第一条消息须携带 open，之后为键盘输入或窗口大小变化
+type TerminalInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Open   *GetIDEForStudentRequest `protobuf:"bytes,1,opt,name=open,proto3" json:"open,omitempty"`
+	Data   []byte                   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Resize *TerminalSize            `protobuf:"bytes,3,opt,name=resize,proto3" json:"resize,omitempty"`
+}
+
+func (x *TerminalInput) Reset() {
+	*x = TerminalInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalInput) ProtoMessage() {}
+
+func (x *TerminalInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalInput.ProtoReflect.Descriptor instead.
+func (*TerminalInput) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TerminalInput) GetOpen() *GetIDEForStudentRequest {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *TerminalInput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TerminalInput) GetResize() *TerminalSize {
+	if x != nil {
+		return x.Resize
+	}
+	return nil
+}
+
+type TerminalOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TerminalOutput) Reset() {
+	*x = TerminalOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalOutput) ProtoMessage() {}
+
+func (x *TerminalOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalOutput.ProtoReflect.Descriptor instead.
+func (*TerminalOutput) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TerminalOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BuildImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// theia 或 monaco
+	Base        string   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Language    uint32   `protobuf:"varint,2,opt,name=language,proto3" json:"language,omitempty"`
+	AptPackages []string `protobuf:"bytes,3,rep,name=apt_packages,json=aptPackages,proto3" json:"apt_packages,omitempty"`
+	PipPackages []string `protobuf:"bytes,4,rep,name=pip_packages,json=pipPackages,proto3" json:"pip_packages,omitempty"`
+	// 追加在软件包安装之后的 Dockerfile 片段，仅允许 RUN、ENV、ARG、LABEL
+	Dockerfile string `protobuf:"bytes,5,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+}
+
+func (x *BuildImageRequest) Reset() {
+	*x = BuildImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildImageRequest) ProtoMessage() {}
+
+func (x *BuildImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildImageRequest.ProtoReflect.Descriptor instead.
+func (*BuildImageRequest) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BuildImageRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *BuildImageRequest) GetLanguage() uint32 {
+	if x != nil {
+		return x.Language
+	}
+	return 0
+}
+
+func (x *BuildImageRequest) GetAptPackages() []string {
+	if x != nil {
+		return x.AptPackages
+	}
+	return nil
+}
+
+func (x *BuildImageRequest) GetPipPackages() []string {
+	if x != nil {
+		return x.PipPackages
+	}
+	return nil
+}
+
+func (x *BuildImageRequest) GetDockerfile() string {
+	if x != nil {
+		return x.Dockerfile
+	}
+	return ""
+}
+
+// BuildImageOutput 构建过程中依次返回日志，最后一条携带镜像标签
+type BuildImageOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log string `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// 相同内容的镜像已存在，未重新构建
+	Cached bool `protobuf:"varint,3,opt,name=cached,proto3" json:"cached,omitempty"`
+}
+
+func (x *BuildImageOutput) Reset() {
+	*x = BuildImageOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildImageOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildImageOutput) ProtoMessage() {}
+
+func (x *BuildImageOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildImageOutput.ProtoReflect.Descriptor instead.
+func (*BuildImageOutput) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BuildImageOutput) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *BuildImageOutput) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *BuildImageOutput) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+type GetContainersResponse_ContainerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string       `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	LabId       uint64       `protobuf:"varint,2,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId   uint64       `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CreatedAt   int64        `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Size        string       `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
+	TeacherInfo *TeacherInfo `protobuf:"bytes,6,opt,name=teacher_info,json=teacherInfo,proto3" json:"teacher_info,omitempty"`
+	Port        uint32       `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	CpuPerc     string       `protobuf:"bytes,8,opt,name=cpu_perc,json=cpuPerc,proto3" json:"cpu_perc,omitempty"`
+	MemoryUsage string       `protobuf:"bytes,9,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+}
+
+func (x *GetContainersResponse_ContainerInfo) Reset() {
+	*x = GetContainersResponse_ContainerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContainersResponse_ContainerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContainersResponse_ContainerInfo) ProtoMessage() {}
+
+func (x *GetContainersResponse_ContainerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContainersResponse_ContainerInfo.ProtoReflect.Descriptor instead.
+func (*GetContainersResponse_ContainerInfo) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetTeacherInfo() *TeacherInfo {
+	if x != nil {
+		return x.TeacherInfo
+	}
+	return nil
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetCpuPerc() string {
+	if x != nil {
+		return x.CpuPerc
+	}
+	return ""
+}
+
+func (x *GetContainersResponse_ContainerInfo) GetMemoryUsage() string {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return ""
+}
+
+type Notebook_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stream / execute_result / display_data / error
+	OutputType string `protobuf:"bytes,1,opt,name=output_type,json=outputType,proto3" json:"output_type,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// base64 编码的图片，超出大小上限时省略
+	Image     string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ImageMime string `protobuf:"bytes,4,opt,name=image_mime,json=imageMime,proto3" json:"image_mime,omitempty"`
+	Truncated bool   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *Notebook_Output) Reset() {
+	*x = Notebook_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notebook_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notebook_Output) ProtoMessage() {}
+
+func (x *Notebook_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notebook_Output.ProtoReflect.Descriptor instead.
+func (*Notebook_Output) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *Notebook_Output) GetOutputType() string {
+	if x != nil {
+		return x.OutputType
+	}
+	return ""
+}
+
+func (x *Notebook_Output) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Notebook_Output) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Notebook_Output) GetImageMime() string {
+	if x != nil {
+		return x.ImageMime
+	}
+	return ""
+}
+
+func (x *Notebook_Output) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type Notebook_Cell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code / markdown / raw
+	CellType       string             `protobuf:"bytes,1,opt,name=cell_type,json=cellType,proto3" json:"cell_type,omitempty"`
+	Source         string             `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	ExecutionCount int32              `protobuf:"varint,3,opt,name=execution_count,json=executionCount,proto3" json:"execution_count,omitempty"`
+	Outputs        []*Notebook_Output `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *Notebook_Cell) Reset() {
+	*x = Notebook_Cell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notebook_Cell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notebook_Cell) ProtoMessage() {}
+
+func (x *Notebook_Cell) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notebook_Cell.ProtoReflect.Descriptor instead.
+func (*Notebook_Cell) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *Notebook_Cell) GetCellType() string {
+	if x != nil {
+		return x.CellType
+	}
+	return ""
+}
+
+func (x *Notebook_Cell) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Notebook_Cell) GetExecutionCount() int32 {
+	if x != nil {
+		return x.ExecutionCount
+	}
+	return 0
+}
+
+func (x *Notebook_Cell) GetOutputs() []*Notebook_Output {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type QuickViewCodeResponse_FileNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content    string                            `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ChildNodes []*QuickViewCodeResponse_FileNode `protobuf:"bytes,3,rep,name=child_nodes,json=childNodes,proto3" json:"child_nodes,omitempty"`
+	IsDir      bool                              `protobuf:"varint,4,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	// 相对工作区根目录的路径
+	Path     string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	Size     int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	IsBinary bool   `protobuf:"varint,7,opt,name=is_binary,json=isBinary,proto3" json:"is_binary,omitempty"`
+	// 文件内容或目录子节点不完整
+	Truncated bool   `protobuf:"varint,8,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Encoding  string `protobuf:"bytes,9,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// .ipynb 文件解析成功时代替 content 返回
+	Notebook *Notebook `protobuf:"bytes,10,opt,name=notebook,proto3" json:"notebook,omitempty"`
+}
+
+func (x *QuickViewCodeResponse_FileNode) Reset() {
+	*x = QuickViewCodeResponse_FileNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuickViewCodeResponse_FileNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickViewCodeResponse_FileNode) ProtoMessage() {}
+
+func (x *QuickViewCodeResponse_FileNode) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickViewCodeResponse_FileNode.ProtoReflect.Descriptor instead.
+func (*QuickViewCodeResponse_FileNode) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetChildNodes() []*QuickViewCodeResponse_FileNode {
+	if x != nil {
+		return x.ChildNodes
+	}
+	return nil
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetIsBinary() bool {
+	if x != nil {
+		return x.IsBinary
+	}
+	return false
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *QuickViewCodeResponse_FileNode) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+type DiffWorkspacesResponse_Line struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind DiffWorkspacesResponse_LineKind `protobuf:"varint,1,opt,name=kind,proto3,enum=ide.DiffWorkspacesResponse_LineKind" json:"kind,omitempty"`
+	Text string                          `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// 从 1 开始，该侧不存在时为 0
+	OldLine int32 `protobuf:"varint,3,opt,name=old_line,json=oldLine,proto3" json:"old_line,omitempty"`
+	NewLine int32 `protobuf:"varint,4,opt,name=new_line,json=newLine,proto3" json:"new_line,omitempty"`
+}
+
+func (x *DiffWorkspacesResponse_Line) Reset() {
+	*x = DiffWorkspacesResponse_Line{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffWorkspacesResponse_Line) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkspacesResponse_Line) ProtoMessage() {}
+
+func (x *DiffWorkspacesResponse_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkspacesResponse_Line.ProtoReflect.Descriptor instead.
+func (*DiffWorkspacesResponse_Line) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *DiffWorkspacesResponse_Line) GetKind() DiffWorkspacesResponse_LineKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DiffWorkspacesResponse_EQUAL
+}
+
+func (x *DiffWorkspacesResponse_Line) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DiffWorkspacesResponse_Line) GetOldLine() int32 {
+	if x != nil {
+		return x.OldLine
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_Line) GetNewLine() int32 {
+	if x != nil {
+		return x.NewLine
+	}
+	return 0
+}
+
+type DiffWorkspacesResponse_Hunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldStart int32                          `protobuf:"varint,1,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"`
+	OldLines int32                          `protobuf:"varint,2,opt,name=old_lines,json=oldLines,proto3" json:"old_lines,omitempty"`
+	NewStart int32                          `protobuf:"varint,3,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
+	NewLines int32                          `protobuf:"varint,4,opt,name=new_lines,json=newLines,proto3" json:"new_lines,omitempty"`
+	Lines    []*DiffWorkspacesResponse_Line `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *DiffWorkspacesResponse_Hunk) Reset() {
+	*x = DiffWorkspacesResponse_Hunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffWorkspacesResponse_Hunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkspacesResponse_Hunk) ProtoMessage() {}
+
+func (x *DiffWorkspacesResponse_Hunk) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkspacesResponse_Hunk.ProtoReflect.Descriptor instead.
+func (*DiffWorkspacesResponse_Hunk) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *DiffWorkspacesResponse_Hunk) GetOldStart() int32 {
+	if x != nil {
+		return x.OldStart
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_Hunk) GetOldLines() int32 {
+	if x != nil {
+		return x.OldLines
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_Hunk) GetNewStart() int32 {
+	if x != nil {
+		return x.NewStart
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_Hunk) GetNewLines() int32 {
+	if x != nil {
+		return x.NewLines
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_Hunk) GetLines() []*DiffWorkspacesResponse_Line {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type DiffWorkspacesResponse_FileDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPath  string                            `protobuf:"bytes,1,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	NewPath  string                            `protobuf:"bytes,2,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	Status   DiffWorkspacesResponse_FileStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ide.DiffWorkspacesResponse_FileStatus" json:"status,omitempty"`
+	IsBinary bool                              `protobuf:"varint,4,opt,name=is_binary,json=isBinary,proto3" json:"is_binary,omitempty"`
+	// 文件过大或超出总行数限制，未给出逐行差异
+	Truncated bool                           `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	Additions int32                          `protobuf:"varint,6,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions int32                          `protobuf:"varint,7,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Hunks     []*DiffWorkspacesResponse_Hunk `protobuf:"bytes,8,rep,name=hunks,proto3" json:"hunks,omitempty"`
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) Reset() {
+	*x = DiffWorkspacesResponse_FileDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkspacesResponse_FileDiff) ProtoMessage() {}
+
+func (x *DiffWorkspacesResponse_FileDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkspacesResponse_FileDiff.ProtoReflect.Descriptor instead.
+func (*DiffWorkspacesResponse_FileDiff) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{15, 2}
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetStatus() DiffWorkspacesResponse_FileStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DiffWorkspacesResponse_UNCHANGED
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetIsBinary() bool {
+	if x != nil {
+		return x.IsBinary
+	}
+	return false
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetAdditions() int32 {
+	if x != nil {
+		return x.Additions
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetDeletions() int32 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
+}
+
+func (x *DiffWorkspacesResponse_FileDiff) GetHunks() []*DiffWorkspacesResponse_Hunk {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+type GetContainerNamesResponse_ContainerNameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId       uint64       `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	StudentId   uint64       `protobuf:"varint,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	TeacherInfo *TeacherInfo `protobuf:"bytes,3,opt,name=teacher_info,json=teacherInfo,proto3" json:"teacher_info,omitempty"`
+}
+
+func (x *GetContainerNamesResponse_ContainerNameInfo) Reset() {
+	*x = GetContainerNamesResponse_ContainerNameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContainerNamesResponse_ContainerNameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContainerNamesResponse_ContainerNameInfo) ProtoMessage() {}
+
+func (x *GetContainerNamesResponse_ContainerNameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContainerNamesResponse_ContainerNameInfo.ProtoReflect.Descriptor instead.
+func (*GetContainerNamesResponse_ContainerNameInfo) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *GetContainerNamesResponse_ContainerNameInfo) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *GetContainerNamesResponse_ContainerNameInfo) GetStudentId() uint64 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+func (x *GetContainerNamesResponse_ContainerNameInfo) GetTeacherInfo() *TeacherInfo {
+	if x != nil {
+		return x.TeacherInfo
+	}
+	return nil
+}
+
+type SnapshotManifest_File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Size int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// UnixNano，用于跳过未修改文件的哈希计算
+	ModifiedAt int64 `protobuf:"varint,4,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+}
+
+func (x *SnapshotManifest_File) Reset() {
+	*x = SnapshotManifest_File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ide_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotManifest_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotManifest_File) ProtoMessage() {}
+
+func (x *SnapshotManifest_File) ProtoReflect() protoreflect.Message {
+	mi := &file_ide_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotManifest_File.ProtoReflect.Descriptor instead.
+func (*SnapshotManifest_File) Descriptor() ([]byte, []int) {
+	return file_ide_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *SnapshotManifest_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SnapshotManifest_File) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SnapshotManifest_File) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SnapshotManifest_File) GetModifiedAt() int64 {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return 0
+}
+
+var File_ide_proto protoreflect.FileDescriptor
+
+var file_ide_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x69, 0x64, 0x65,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xb7, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x5f, 0x65,
+	0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x45, 0x64,
+	0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x64, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f,
+	0x72, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x44,
+	0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x65, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22,
+	0x2c, 0x0a, 0x0b, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa5, 0x03,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x1a, 0xa2, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x33,
+	0x0a, 0x0c, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x70, 0x75, 0x50, 0x65,
+	0x72, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x39, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x6f, 0x0a, 0x14, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10,
+	0x05, 0x22, 0xfa, 0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x64, 0x65, 0x2e,
+	0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x1a, 0x90, 0x01, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x94, 0x01, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0xb9,
+	0x03, 0x0a, 0x15, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x64,
+	0x65, 0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x1a, 0xbf, 0x02, 0x0a, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x51,
+	0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f,
+	0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x52, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x22, 0x5a, 0x0a, 0x14, 0x51, 0x75,
+	0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x69, 0x63, 0x6b,
+	0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x4e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x22, 0x65, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x66,
+	0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x15, 0x44, 0x69, 0x66, 0x66,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x22, 0xe5, 0x06, 0x0a, 0x16, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x8a, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x38,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4c, 0x69,
+	0x6e, 0x65, 0x1a, 0xb2, 0x01, 0x0a, 0x04, 0x48, 0x75, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x6c, 0x64,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x1a, 0xaf, 0x02, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x05, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x75,
+	0x6e, 0x6b, 0x52, 0x05, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22, 0x4e, 0x0a, 0x0a, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x22, 0x2d, 0x0a, 0x08, 0x4c, 0x69, 0x6e,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x22, 0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x7e,
+	0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x65, 0x61,
+	0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x41,
+	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x44, 0x0a, 0x19, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x0e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0x49, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x61,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x22, 0x7f, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x69,
+	0x73, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44,
+	0x69, 0x72, 0x22, 0x76, 0x0a, 0x1a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x1a, 0x63,
+	0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x18, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x22, 0x78, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x20,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x4f,
+	0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x76, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x10, 0x4c, 0x61, 0x62, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc8, 0x01, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x4c, 0x61,
+	0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x22, 0x4c, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x4c, 0x61, 0x62, 0x54, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xe8, 0x01, 0x0a, 0x18, 0x52, 0x75, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x0b, 0x4a, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xe5, 0x01, 0x0a, 0x19, 0x52, 0x75, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x69, 0x64, 0x65, 0x2e, 0x4a, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x49, 0x0a, 0x11, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x80, 0x01, 0x0a,
+	0x0d, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30,
+	0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x24, 0x0a, 0x0e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x70, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x69, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c,
+	0x65, 0x22, 0x4e, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x2a, 0x40, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x62, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x62, 0x79,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x79, 0x43, 0x50,
+	0x55, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x62, 0x79, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x10, 0x03, 0x32, 0x82, 0x11, 0x0a, 0x10, 0x49, 0x44, 0x45, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49,
+	0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45,
+	0x46, 0x6f, 0x72, 0x54, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x6c,
+	0x6c, 0x49, 0x44, 0x45, 0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e,
+	0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0d,
+	0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e,
+	0x69, 0x64, 0x65, 0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x51,
+	0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65,
+	0x77, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x51, 0x75, 0x69, 0x63,
+	0x6b, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x56, 0x69, 0x65, 0x77,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e,
+	0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1a,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b,
+	0x0a, 0x21, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0f,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x1b, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x12, 0x48, 0x69, 0x62, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1e,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x12, 0x19,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e,
+	0x69, 0x64, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42,
+	0x0a, 0x13, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x4a, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x61, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x24, 0x2e, 0x69, 0x64,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x69, 0x64,
+	0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x2e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x4c, 0x61,
+	0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x2e,
+	0x4c, 0x61, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x1a, 0x1b, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4c, 0x61, 0x62, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x40, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x4c, 0x61, 0x62, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x15,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x18, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x4c,
+	0x61, 0x62, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x52, 0x75, 0x6e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x45, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a,
+	0x2e, 0x69, 0x64, 0x65, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x2e, 0x69,
+	0x64, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x13, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x69, 0x64, 0x65, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x30, 0x01, 0x42, 0x05, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ide_proto_rawDescOnce sync.Once
+	file_ide_proto_rawDescData = file_ide_proto_rawDesc
+)
+
+func file_ide_proto_rawDescGZIP() []byte {
+	file_ide_proto_rawDescOnce.Do(func() {
+		file_ide_proto_rawDescData = protoimpl.X.CompressGZIP(file_ide_proto_rawDescData)
+	})
+	return file_ide_proto_rawDescData
+}
+
+var file_ide_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ide_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_ide_proto_goTypes = []interface{}{
+	(OrderType)(0),                                      // 0: ide.OrderType
+	(DiffWorkspacesResponse_FileStatus)(0),              // 1: ide.DiffWorkspacesResponse.FileStatus
+	(DiffWorkspacesResponse_LineKind)(0),                // 2: ide.DiffWorkspacesResponse.LineKind
+	(*Empty)(nil),                                       // 3: ide.Empty
+	(*GetIDEForStudentRequest)(nil),                     // 4: ide.GetIDEForStudentRequest
+	(*GetIDEForTeacherRequest)(nil),                     // 5: ide.GetIDEForTeacherRequest
+	(*GetIDEResponse)(nil),                              // 6: ide.GetIDEResponse
+	(*GetContainersRequest)(nil),                        // 7: ide.GetContainersRequest
+	(*TeacherInfo)(nil),                                 // 8: ide.TeacherInfo
+	(*GetContainersResponse)(nil),                       // 9: ide.GetContainersResponse
+	(*StopContainerRequest)(nil),                        // 10: ide.StopContainerRequest
+	(*QuickViewCodeRequest)(nil),                        // 11: ide.QuickViewCodeRequest
+	(*Notebook)(nil),                                    // 12: ide.Notebook
+	(*QuickViewCodeResponse)(nil),                       // 13: ide.QuickViewCodeResponse
+	(*QuickViewFileRequest)(nil),                        // 14: ide.QuickViewFileRequest
+	(*QuickViewFileResponse)(nil),                       // 15: ide.QuickViewFileResponse
+	(*WorkspaceRef)(nil),                                // 16: ide.WorkspaceRef
+	(*DiffWorkspacesRequest)(nil),                       // 17: ide.DiffWorkspacesRequest
+	(*DiffWorkspacesResponse)(nil),                      // 18: ide.DiffWorkspacesResponse
+	(*GetContainerNamesResponse)(nil),                   // 19: ide.GetContainerNamesResponse
+	(*RemoveContainerRequest)(nil),                      // 20: ide.RemoveContainerRequest
+	(*HibernateContainerRequest)(nil),                   // 21: ide.HibernateContainerRequest
+	(*WorkspaceFileRequest)(nil),                        // 22: ide.WorkspaceFileRequest
+	(*WorkspaceEntry)(nil),                              // 23: ide.WorkspaceEntry
+	(*ListWorkspaceDirResponse)(nil),                    // 24: ide.ListWorkspaceDirResponse
+	(*ReadWorkspaceFileResponse)(nil),                   // 25: ide.ReadWorkspaceFileResponse
+	(*WriteWorkspaceFileRequest)(nil),                   // 26: ide.WriteWorkspaceFileRequest
+	(*CreateWorkspaceFileRequest)(nil),                  // 27: ide.CreateWorkspaceFileRequest
+	(*RenameWorkspaceFileRequest)(nil),                  // 28: ide.RenameWorkspaceFileRequest
+	(*HeartBeatStat)(nil),                               // 29: ide.HeartBeatStat
+	(*SnapshotManifest)(nil),                            // 30: ide.SnapshotManifest
+	(*WorkspaceSnapshot)(nil),                           // 31: ide.WorkspaceSnapshot
+	(*SnapshotWorkspaceRequest)(nil),                    // 32: ide.SnapshotWorkspaceRequest
+	(*ListWorkspaceSnapshotsRequest)(nil),               // 33: ide.ListWorkspaceSnapshotsRequest
+	(*ListWorkspaceSnapshotsResponse)(nil),              // 34: ide.ListWorkspaceSnapshotsResponse
+	(*RestoreWorkspaceSnapshotRequest)(nil),             // 35: ide.RestoreWorkspaceSnapshotRequest
+	(*RestoreWorkspaceSnapshotResponse)(nil),            // 36: ide.RestoreWorkspaceSnapshotResponse
+	(*ArchiveWorkspaceRequest)(nil),                     // 37: ide.ArchiveWorkspaceRequest
+	(*ArchiveWorkspaceChunk)(nil),                       // 38: ide.ArchiveWorkspaceChunk
+	(*LabTemplateChunk)(nil),                            // 39: ide.LabTemplateChunk
+	(*PutLabTemplateResponse)(nil),                      // 40: ide.PutLabTemplateResponse
+	(*PutLabTestsResponse)(nil),                         // 41: ide.PutLabTestsResponse
+	(*RunWorkspaceTestsRequest)(nil),                    // 42: ide.RunWorkspaceTestsRequest
+	(*JUnitReport)(nil),                                 // 43: ide.JUnitReport
+	(*RunWorkspaceTestsResponse)(nil),                   // 44: ide.RunWorkspaceTestsResponse
+	(*StudentIDERequest)(nil),                           // 45: ide.StudentIDERequest
+	(*TerminalSize)(nil),                                // 46: ide.TerminalSize
+	(*TerminalInput)(nil),                               // 47: ide.TerminalInput
+	(*TerminalOutput)(nil),                              // 48: ide.TerminalOutput
+	(*BuildImageRequest)(nil),                           // 49: ide.BuildImageRequest
+	(*BuildImageOutput)(nil),                            // 50: ide.BuildImageOutput
+	(*GetContainersResponse_ContainerInfo)(nil),         // 51: ide.GetContainersResponse.ContainerInfo
+	(*Notebook_Output)(nil),                             // 52: ide.Notebook.Output
+	(*Notebook_Cell)(nil),                               // 53: ide.Notebook.Cell
+	(*QuickViewCodeResponse_FileNode)(nil),              // 54: ide.QuickViewCodeResponse.FileNode
+	(*DiffWorkspacesResponse_Line)(nil),                 // 55: ide.DiffWorkspacesResponse.Line
+	(*DiffWorkspacesResponse_Hunk)(nil),                 // 56: ide.DiffWorkspacesResponse.Hunk
+	(*DiffWorkspacesResponse_FileDiff)(nil),             // 57: ide.DiffWorkspacesResponse.FileDiff
+	(*GetContainerNamesResponse_ContainerNameInfo)(nil), // 58: ide.GetContainerNamesResponse.ContainerNameInfo
+	(*SnapshotManifest_File)(nil),                       // 59: ide.SnapshotManifest.File
+}
+var file_ide_proto_depIdxs = []int32{
+	0,  // 0: ide.GetContainersRequest.order:type_name -> ide.OrderType
+	51, // 1: ide.GetContainersResponse.container_infos:type_name -> ide.GetContainersResponse.ContainerInfo
+	53, // 2: ide.Notebook.cells:type_name -> ide.Notebook.Cell
+	54, // 3: ide.QuickViewCodeResponse.root_node:type_name -> ide.QuickViewCodeResponse.FileNode
+	12, // 4: ide.QuickViewFileResponse.notebook:type_name -> ide.Notebook
+	16, // 5: ide.DiffWorkspacesRequest.old:type_name -> ide.WorkspaceRef
+	16, // 6: ide.DiffWorkspacesRequest.new:type_name -> ide.WorkspaceRef
+	57, // 7: ide.DiffWorkspacesResponse.files:type_name -> ide.DiffWorkspacesResponse.FileDiff
+	58, // 8: ide.GetContainerNamesResponse.infos:type_name -> ide.GetContainerNamesResponse.ContainerNameInfo
+	23, // 9: ide.ListWorkspaceDirResponse.entries:type_name -> ide.WorkspaceEntry
+	59, // 10: ide.SnapshotManifest.files:type_name -> ide.SnapshotManifest.File
+	31, // 11: ide.ListWorkspaceSnapshotsResponse.snapshots:type_name -> ide.WorkspaceSnapshot
+	43, // 12: ide.RunWorkspaceTestsResponse.reports:type_name -> ide.JUnitReport
+	4,  // 13: ide.TerminalInput.open:type_name -> ide.GetIDEForStudentRequest
+	46, // 14: ide.TerminalInput.resize:type_name -> ide.TerminalSize
+	8,  // 15: ide.GetContainersResponse.ContainerInfo.teacher_info:type_name -> ide.TeacherInfo
+	52, // 16: ide.Notebook.Cell.outputs:type_name -> ide.Notebook.Output
+	54, // 17: ide.QuickViewCodeResponse.FileNode.child_nodes:type_name -> ide.QuickViewCodeResponse.FileNode
+	12, // 18: ide.QuickViewCodeResponse.FileNode.notebook:type_name -> ide.Notebook
+	2,  // 19: ide.DiffWorkspacesResponse.Line.kind:type_name -> ide.DiffWorkspacesResponse.LineKind
+	55, // 20: ide.DiffWorkspacesResponse.Hunk.lines:type_name -> ide.DiffWorkspacesResponse.Line
+	1,  // 21: ide.DiffWorkspacesResponse.FileDiff.status:type_name -> ide.DiffWorkspacesResponse.FileStatus
+	56, // 22: ide.DiffWorkspacesResponse.FileDiff.hunks:type_name -> ide.DiffWorkspacesResponse.Hunk
+	8,  // 23: ide.GetContainerNamesResponse.ContainerNameInfo.teacher_info:type_name -> ide.TeacherInfo
+	4,  // 24: ide.IDEServerService.GetIDEForStudent:input_type -> ide.GetIDEForStudentRequest
+	5,  // 25: ide.IDEServerService.GetIDEForTeacher:input_type -> ide.GetIDEForTeacherRequest
+	3,  // 26: ide.IDEServerService.StopAllIDE:input_type -> ide.Empty
+	7,  // 27: ide.IDEServerService.GetContainers:input_type -> ide.GetContainersRequest
+	10, // 28: ide.IDEServerService.StopContainer:input_type -> ide.StopContainerRequest
+	11, // 29: ide.IDEServerService.QuickViewCode:input_type -> ide.QuickViewCodeRequest
+	14, // 30: ide.IDEServerService.QuickViewFile:input_type -> ide.QuickViewFileRequest
+	17, // 31: ide.IDEServerService.DiffWorkspaces:input_type -> ide.DiffWorkspacesRequest
+	3,  // 32: ide.IDEServerService.GenerateTestFileForViewCode:input_type -> ide.Empty
+	3,  // 33: ide.IDEServerService.RemoveGenerateTestFileForViewCode:input_type -> ide.Empty
+	3,  // 34: ide.IDEServerService.GetContainerNames:input_type -> ide.Empty
+	20, // 35: ide.IDEServerService.RemoveContainer:input_type -> ide.RemoveContainerRequest
+	21, // 36: ide.IDEServerService.HibernateContainer:input_type -> ide.HibernateContainerRequest
+	22, // 37: ide.IDEServerService.ListWorkspaceDir:input_type -> ide.WorkspaceFileRequest
+	22, // 38: ide.IDEServerService.ReadWorkspaceFile:input_type -> ide.WorkspaceFileRequest
+	26, // 39: ide.IDEServerService.WriteWorkspaceFile:input_type -> ide.WriteWorkspaceFileRequest
+	27, // 40: ide.IDEServerService.CreateWorkspaceFile:input_type -> ide.CreateWorkspaceFileRequest
+	28, // 41: ide.IDEServerService.RenameWorkspaceFile:input_type -> ide.RenameWorkspaceFileRequest
+	22, // 42: ide.IDEServerService.DeleteWorkspaceFile:input_type -> ide.WorkspaceFileRequest
+	32, // 43: ide.IDEServerService.SnapshotWorkspace:input_type -> ide.SnapshotWorkspaceRequest
+	33, // 44: ide.IDEServerService.ListWorkspaceSnapshots:input_type -> ide.ListWorkspaceSnapshotsRequest
+	35, // 45: ide.IDEServerService.RestoreWorkspaceSnapshot:input_type -> ide.RestoreWorkspaceSnapshotRequest
+	37, // 46: ide.IDEServerService.ArchiveWorkspace:input_type -> ide.ArchiveWorkspaceRequest
+	37, // 47: ide.IDEServerService.ExportWorkspace:input_type -> ide.ArchiveWorkspaceRequest
+	39, // 48: ide.IDEServerService.PutLabTemplate:input_type -> ide.LabTemplateChunk
+	39, // 49: ide.IDEServerService.PutLabTests:input_type -> ide.LabTemplateChunk
+	42, // 50: ide.IDEServerService.RunWorkspaceTests:input_type -> ide.RunWorkspaceTestsRequest
+	45, // 51: ide.IDEServerService.JoinStudentIDE:input_type -> ide.StudentIDERequest
+	45, // 52: ide.IDEServerService.RotateIDEToken:input_type -> ide.StudentIDERequest
+	47, // 53: ide.IDEServerService.AttachTerminal:input_type -> ide.TerminalInput
+	49, // 54: ide.IDEServerService.BuildImage:input_type -> ide.BuildImageRequest
+	6,  // 55: ide.IDEServerService.GetIDEForStudent:output_type -> ide.GetIDEResponse
+	6,  // 56: ide.IDEServerService.GetIDEForTeacher:output_type -> ide.GetIDEResponse
+	3,  // 57: ide.IDEServerService.StopAllIDE:output_type -> ide.Empty
+	9,  // 58: ide.IDEServerService.GetContainers:output_type -> ide.GetContainersResponse
+	3,  // 59: ide.IDEServerService.StopContainer:output_type -> ide.Empty
+	13, // 60: ide.IDEServerService.QuickViewCode:output_type -> ide.QuickViewCodeResponse
+	15, // 61: ide.IDEServerService.QuickViewFile:output_type -> ide.QuickViewFileResponse
+	18, // 62: ide.IDEServerService.DiffWorkspaces:output_type -> ide.DiffWorkspacesResponse
+	3,  // 63: ide.IDEServerService.GenerateTestFileForViewCode:output_type -> ide.Empty
+	3,  // 64: ide.IDEServerService.RemoveGenerateTestFileForViewCode:output_type -> ide.Empty
+	19, // 65: ide.IDEServerService.GetContainerNames:output_type -> ide.GetContainerNamesResponse
+	3,  // 66: ide.IDEServerService.RemoveContainer:output_type -> ide.Empty
+	3,  // 67: ide.IDEServerService.HibernateContainer:output_type -> ide.Empty
+	24, // 68: ide.IDEServerService.ListWorkspaceDir:output_type -> ide.ListWorkspaceDirResponse
+	25, // 69: ide.IDEServerService.ReadWorkspaceFile:output_type -> ide.ReadWorkspaceFileResponse
+	3,  // 70: ide.IDEServerService.WriteWorkspaceFile:output_type -> ide.Empty
+	3,  // 71: ide.IDEServerService.CreateWorkspaceFile:output_type -> ide.Empty
+	3,  // 72: ide.IDEServerService.RenameWorkspaceFile:output_type -> ide.Empty
+	3,  // 73: ide.IDEServerService.DeleteWorkspaceFile:output_type -> ide.Empty
+	31, // 74: ide.IDEServerService.SnapshotWorkspace:output_type -> ide.WorkspaceSnapshot
+	34, // 75: ide.IDEServerService.ListWorkspaceSnapshots:output_type -> ide.ListWorkspaceSnapshotsResponse
+	36, // 76: ide.IDEServerService.RestoreWorkspaceSnapshot:output_type -> ide.RestoreWorkspaceSnapshotResponse
+	38, // 77: ide.IDEServerService.ArchiveWorkspace:output_type -> ide.ArchiveWorkspaceChunk
+	38, // 78: ide.IDEServerService.ExportWorkspace:output_type -> ide.ArchiveWorkspaceChunk
+	40, // 79: ide.IDEServerService.PutLabTemplate:output_type -> ide.PutLabTemplateResponse
+	41, // 80: ide.IDEServerService.PutLabTests:output_type -> ide.PutLabTestsResponse
+	44, // 81: ide.IDEServerService.RunWorkspaceTests:output_type -> ide.RunWorkspaceTestsResponse
+	6,  // 82: ide.IDEServerService.JoinStudentIDE:output_type -> ide.GetIDEResponse
+	3,  // 83: ide.IDEServerService.RotateIDEToken:output_type -> ide.Empty
+	48, // 84: ide.IDEServerService.AttachTerminal:output_type -> ide.TerminalOutput
+	50, // 85: ide.IDEServerService.BuildImage:output_type -> ide.BuildImageOutput
+	55, // [55:86] is the sub-list for method output_type
+	24, // [24:55] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_ide_proto_init() }
+func file_ide_proto_init() {
+	if File_ide_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ide_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIDEForStudentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIDEForTeacherRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIDEResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeacherInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopContainerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickViewCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notebook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickViewCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickViewFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickViewFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkspacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkspacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainerNamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContainerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HibernateContainerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceDirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWorkspaceFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteWorkspaceFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameWorkspaceFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartBeatStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkspaceSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreWorkspaceSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWorkspaceChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabTemplateChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutLabTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutLabTestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunWorkspaceTestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JUnitReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunWorkspaceTestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StudentIDERequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminalOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildImageOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainersResponse_ContainerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notebook_Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notebook_Cell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuickViewCodeResponse_FileNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkspacesResponse_Line); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkspacesResponse_Hunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkspacesResponse_FileDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContainerNamesResponse_ContainerNameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ide_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotManifest_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ide_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   57,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ide_proto_goTypes,
+		DependencyIndexes: file_ide_proto_depIdxs,
+		EnumInfos:         file_ide_proto_enumTypes,
+		MessageInfos:      file_ide_proto_msgTypes,
 	}.Build()
 	File_ide_proto = out.File
 	file_ide_proto_rawDesc = nil
@@ -1484,361 +5520,1207 @@ func file_ide_proto_init() {
 	file_ide_proto_depIdxs = nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// IDEServerServiceClient is the client API for IDEServerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IDEServerServiceClient interface {
+	GetIDEForStudent(ctx context.Context, in *GetIDEForStudentRequest, opts ...grpc.CallOption) (*GetIDEResponse, error)
+	GetIDEForTeacher(ctx context.Context, in *GetIDEForTeacherRequest, opts ...grpc.CallOption) (*GetIDEResponse, error)
+	StopAllIDE(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error)
+	StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*Empty, error)
+	QuickViewCode(ctx context.Context, in *QuickViewCodeRequest, opts ...grpc.CallOption) (*QuickViewCodeResponse, error)
+	QuickViewFile(ctx context.Context, in *QuickViewFileRequest, opts ...grpc.CallOption) (*QuickViewFileResponse, error)
+	DiffWorkspaces(ctx context.Context, in *DiffWorkspacesRequest, opts ...grpc.CallOption) (*DiffWorkspacesResponse, error)
+	GenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	RemoveGenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	GetContainerNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetContainerNamesResponse, error)
+	RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*Empty, error)
+	HibernateContainer(ctx context.Context, in *HibernateContainerRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListWorkspaceDir(ctx context.Context, in *WorkspaceFileRequest, opts ...grpc.CallOption) (*ListWorkspaceDirResponse, error)
+	ReadWorkspaceFile(ctx context.Context, in *WorkspaceFileRequest, opts ...grpc.CallOption) (*ReadWorkspaceFileResponse, error)
+	WriteWorkspaceFile(ctx context.Context, in *WriteWorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateWorkspaceFile(ctx context.Context, in *CreateWorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error)
+	RenameWorkspaceFile(ctx context.Context, in *RenameWorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteWorkspaceFile(ctx context.Context, in *WorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error)
+	SnapshotWorkspace(ctx context.Context, in *SnapshotWorkspaceRequest, opts ...grpc.CallOption) (*WorkspaceSnapshot, error)
+	ListWorkspaceSnapshots(ctx context.Context, in *ListWorkspaceSnapshotsRequest, opts ...grpc.CallOption) (*ListWorkspaceSnapshotsResponse, error)
+	RestoreWorkspaceSnapshot(ctx context.Context, in *RestoreWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*RestoreWorkspaceSnapshotResponse, error)
+	ArchiveWorkspace(ctx context.Context, in *ArchiveWorkspaceRequest, opts ...grpc.CallOption) (IDEServerService_ArchiveWorkspaceClient, error)
+	ExportWorkspace(ctx context.Context, in *ArchiveWorkspaceRequest, opts ...grpc.CallOption) (IDEServerService_ExportWorkspaceClient, error)
+	PutLabTemplate(ctx context.Context, opts ...grpc.CallOption) (IDEServerService_PutLabTemplateClient, error)
+	PutLabTests(ctx context.Context, opts ...grpc.CallOption) (IDEServerService_PutLabTestsClient, error)
+	RunWorkspaceTests(ctx context.Context, in *RunWorkspaceTestsRequest, opts ...grpc.CallOption) (*RunWorkspaceTestsResponse, error)
+	JoinStudentIDE(ctx context.Context, in *StudentIDERequest, opts ...grpc.CallOption) (*GetIDEResponse, error)
+	RotateIDEToken(ctx context.Context, in *StudentIDERequest, opts ...grpc.CallOption) (*Empty, error)
+	AttachTerminal(ctx context.Context, opts ...grpc.CallOption) (IDEServerService_AttachTerminalClient, error)
+	BuildImage(ctx context.Context, in *BuildImageRequest, opts ...grpc.CallOption) (IDEServerService_BuildImageClient, error)
+}
+
+type iDEServerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIDEServerServiceClient(cc grpc.ClientConnInterface) IDEServerServiceClient {
+	return &iDEServerServiceClient{cc}
+}
+
+func (c *iDEServerServiceClient) GetIDEForStudent(ctx context.Context, in *GetIDEForStudentRequest, opts ...grpc.CallOption) (*GetIDEResponse, error) {
+	out := new(GetIDEResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetIDEForStudent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) GetIDEForTeacher(ctx context.Context, in *GetIDEForTeacherRequest, opts ...grpc.CallOption) (*GetIDEResponse, error) {
+	out := new(GetIDEResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetIDEForTeacher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) StopAllIDE(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/StopAllIDE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error) {
+	out := new(GetContainersResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetContainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/StopContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) QuickViewCode(ctx context.Context, in *QuickViewCodeRequest, opts ...grpc.CallOption) (*QuickViewCodeResponse, error) {
+	out := new(QuickViewCodeResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/QuickViewCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) QuickViewFile(ctx context.Context, in *QuickViewFileRequest, opts ...grpc.CallOption) (*QuickViewFileResponse, error) {
+	out := new(QuickViewFileResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/QuickViewFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) DiffWorkspaces(ctx context.Context, in *DiffWorkspacesRequest, opts ...grpc.CallOption) (*DiffWorkspacesResponse, error) {
+	out := new(DiffWorkspacesResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/DiffWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) GenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GenerateTestFileForViewCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) RemoveGenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RemoveGenerateTestFileForViewCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) GetContainerNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetContainerNamesResponse, error) {
+	out := new(GetContainerNamesResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetContainerNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RemoveContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) HibernateContainer(ctx context.Context, in *HibernateContainerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/HibernateContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) ListWorkspaceDir(ctx context.Context, in *WorkspaceFileRequest, opts ...grpc.CallOption) (*ListWorkspaceDirResponse, error) {
+	out := new(ListWorkspaceDirResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/ListWorkspaceDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) ReadWorkspaceFile(ctx context.Context, in *WorkspaceFileRequest, opts ...grpc.CallOption) (*ReadWorkspaceFileResponse, error) {
+	out := new(ReadWorkspaceFileResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/ReadWorkspaceFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) WriteWorkspaceFile(ctx context.Context, in *WriteWorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/WriteWorkspaceFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) CreateWorkspaceFile(ctx context.Context, in *CreateWorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/CreateWorkspaceFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) RenameWorkspaceFile(ctx context.Context, in *RenameWorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RenameWorkspaceFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) DeleteWorkspaceFile(ctx context.Context, in *WorkspaceFileRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/DeleteWorkspaceFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) SnapshotWorkspace(ctx context.Context, in *SnapshotWorkspaceRequest, opts ...grpc.CallOption) (*WorkspaceSnapshot, error) {
+	out := new(WorkspaceSnapshot)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/SnapshotWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) ListWorkspaceSnapshots(ctx context.Context, in *ListWorkspaceSnapshotsRequest, opts ...grpc.CallOption) (*ListWorkspaceSnapshotsResponse, error) {
+	out := new(ListWorkspaceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/ListWorkspaceSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) RestoreWorkspaceSnapshot(ctx context.Context, in *RestoreWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*RestoreWorkspaceSnapshotResponse, error) {
+	out := new(RestoreWorkspaceSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RestoreWorkspaceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) ArchiveWorkspace(ctx context.Context, in *ArchiveWorkspaceRequest, opts ...grpc.CallOption) (IDEServerService_ArchiveWorkspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IDEServerService_serviceDesc.Streams[0], "/ide.IDEServerService/ArchiveWorkspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iDEServerServiceArchiveWorkspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IDEServerService_ArchiveWorkspaceClient interface {
+	Recv() (*ArchiveWorkspaceChunk, error)
+	grpc.ClientStream
+}
+
+type iDEServerServiceArchiveWorkspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *iDEServerServiceArchiveWorkspaceClient) Recv() (*ArchiveWorkspaceChunk, error) {
+	m := new(ArchiveWorkspaceChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *iDEServerServiceClient) ExportWorkspace(ctx context.Context, in *ArchiveWorkspaceRequest, opts ...grpc.CallOption) (IDEServerService_ExportWorkspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IDEServerService_serviceDesc.Streams[1], "/ide.IDEServerService/ExportWorkspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iDEServerServiceExportWorkspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IDEServerService_ExportWorkspaceClient interface {
+	Recv() (*ArchiveWorkspaceChunk, error)
+	grpc.ClientStream
+}
+
+type iDEServerServiceExportWorkspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *iDEServerServiceExportWorkspaceClient) Recv() (*ArchiveWorkspaceChunk, error) {
+	m := new(ArchiveWorkspaceChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *iDEServerServiceClient) PutLabTemplate(ctx context.Context, opts ...grpc.CallOption) (IDEServerService_PutLabTemplateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IDEServerService_serviceDesc.Streams[2], "/ide.IDEServerService/PutLabTemplate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iDEServerServicePutLabTemplateClient{stream}
+	return x, nil
+}
+
+type IDEServerService_PutLabTemplateClient interface {
+	Send(*LabTemplateChunk) error
+	CloseAndRecv() (*PutLabTemplateResponse, error)
+	grpc.ClientStream
+}
+
+type iDEServerServicePutLabTemplateClient struct {
+	grpc.ClientStream
+}
+
+func (x *iDEServerServicePutLabTemplateClient) Send(m *LabTemplateChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *iDEServerServicePutLabTemplateClient) CloseAndRecv() (*PutLabTemplateResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutLabTemplateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *iDEServerServiceClient) PutLabTests(ctx context.Context, opts ...grpc.CallOption) (IDEServerService_PutLabTestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IDEServerService_serviceDesc.Streams[3], "/ide.IDEServerService/PutLabTests", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iDEServerServicePutLabTestsClient{stream}
+	return x, nil
+}
+
+type IDEServerService_PutLabTestsClient interface {
+	Send(*LabTemplateChunk) error
+	CloseAndRecv() (*PutLabTestsResponse, error)
+	grpc.ClientStream
+}
+
+type iDEServerServicePutLabTestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *iDEServerServicePutLabTestsClient) Send(m *LabTemplateChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *iDEServerServicePutLabTestsClient) CloseAndRecv() (*PutLabTestsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutLabTestsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *iDEServerServiceClient) RunWorkspaceTests(ctx context.Context, in *RunWorkspaceTestsRequest, opts ...grpc.CallOption) (*RunWorkspaceTestsResponse, error) {
+	out := new(RunWorkspaceTestsResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RunWorkspaceTests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) JoinStudentIDE(ctx context.Context, in *StudentIDERequest, opts ...grpc.CallOption) (*GetIDEResponse, error) {
+	out := new(GetIDEResponse)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/JoinStudentIDE", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) RotateIDEToken(ctx context.Context, in *StudentIDERequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RotateIDEToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServerServiceClient) AttachTerminal(ctx context.Context, opts ...grpc.CallOption) (IDEServerService_AttachTerminalClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IDEServerService_serviceDesc.Streams[4], "/ide.IDEServerService/AttachTerminal", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iDEServerServiceAttachTerminalClient{stream}
+	return x, nil
+}
+
+type IDEServerService_AttachTerminalClient interface {
+	Send(*TerminalInput) error
+	Recv() (*TerminalOutput, error)
+	grpc.ClientStream
+}
+
+type iDEServerServiceAttachTerminalClient struct {
+	grpc.ClientStream
+}
+
+func (x *iDEServerServiceAttachTerminalClient) Send(m *TerminalInput) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *iDEServerServiceAttachTerminalClient) Recv() (*TerminalOutput, error) {
+	m := new(TerminalOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *iDEServerServiceClient) BuildImage(ctx context.Context, in *BuildImageRequest, opts ...grpc.CallOption) (IDEServerService_BuildImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_IDEServerService_serviceDesc.Streams[5], "/ide.IDEServerService/BuildImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &iDEServerServiceBuildImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IDEServerService_BuildImageClient interface {
+	Recv() (*BuildImageOutput, error)
+	grpc.ClientStream
+}
+
+type iDEServerServiceBuildImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *iDEServerServiceBuildImageClient) Recv() (*BuildImageOutput, error) {
+	m := new(BuildImageOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IDEServerServiceServer is the server API for IDEServerService service.
+type IDEServerServiceServer interface {
+	GetIDEForStudent(context.Context, *GetIDEForStudentRequest) (*GetIDEResponse, error)
+	GetIDEForTeacher(context.Context, *GetIDEForTeacherRequest) (*GetIDEResponse, error)
+	StopAllIDE(context.Context, *Empty) (*Empty, error)
+	GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error)
+	StopContainer(context.Context, *StopContainerRequest) (*Empty, error)
+	QuickViewCode(context.Context, *QuickViewCodeRequest) (*QuickViewCodeResponse, error)
+	QuickViewFile(context.Context, *QuickViewFileRequest) (*QuickViewFileResponse, error)
+	DiffWorkspaces(context.Context, *DiffWorkspacesRequest) (*DiffWorkspacesResponse, error)
+	GenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error)
+	RemoveGenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error)
+	GetContainerNames(context.Context, *Empty) (*GetContainerNamesResponse, error)
+	RemoveContainer(context.Context, *RemoveContainerRequest) (*Empty, error)
+	HibernateContainer(context.Context, *HibernateContainerRequest) (*Empty, error)
+	ListWorkspaceDir(context.Context, *WorkspaceFileRequest) (*ListWorkspaceDirResponse, error)
+	ReadWorkspaceFile(context.Context, *WorkspaceFileRequest) (*ReadWorkspaceFileResponse, error)
+	WriteWorkspaceFile(context.Context, *WriteWorkspaceFileRequest) (*Empty, error)
+	CreateWorkspaceFile(context.Context, *CreateWorkspaceFileRequest) (*Empty, error)
+	RenameWorkspaceFile(context.Context, *RenameWorkspaceFileRequest) (*Empty, error)
+	DeleteWorkspaceFile(context.Context, *WorkspaceFileRequest) (*Empty, error)
+	SnapshotWorkspace(context.Context, *SnapshotWorkspaceRequest) (*WorkspaceSnapshot, error)
+	ListWorkspaceSnapshots(context.Context, *ListWorkspaceSnapshotsRequest) (*ListWorkspaceSnapshotsResponse, error)
+	RestoreWorkspaceSnapshot(context.Context, *RestoreWorkspaceSnapshotRequest) (*RestoreWorkspaceSnapshotResponse, error)
+	ArchiveWorkspace(*ArchiveWorkspaceRequest, IDEServerService_ArchiveWorkspaceServer) error
+	ExportWorkspace(*ArchiveWorkspaceRequest, IDEServerService_ExportWorkspaceServer) error
+	PutLabTemplate(IDEServerService_PutLabTemplateServer) error
+	PutLabTests(IDEServerService_PutLabTestsServer) error
+	RunWorkspaceTests(context.Context, *RunWorkspaceTestsRequest) (*RunWorkspaceTestsResponse, error)
+	JoinStudentIDE(context.Context, *StudentIDERequest) (*GetIDEResponse, error)
+	RotateIDEToken(context.Context, *StudentIDERequest) (*Empty, error)
+	AttachTerminal(IDEServerService_AttachTerminalServer) error
+	BuildImage(*BuildImageRequest, IDEServerService_BuildImageServer) error
+}
+
+// UnimplementedIDEServerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedIDEServerServiceServer struct {
+}
+
+func (*UnimplementedIDEServerServiceServer) GetIDEForStudent(context.Context, *GetIDEForStudentRequest) (*GetIDEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIDEForStudent not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) GetIDEForTeacher(context.Context, *GetIDEForTeacherRequest) (*GetIDEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIDEForTeacher not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) StopAllIDE(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopAllIDE not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContainers not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) StopContainer(context.Context, *StopContainerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopContainer not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) QuickViewCode(context.Context, *QuickViewCodeRequest) (*QuickViewCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuickViewCode not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) QuickViewFile(context.Context, *QuickViewFileRequest) (*QuickViewFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuickViewFile not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) DiffWorkspaces(context.Context, *DiffWorkspacesRequest) (*DiffWorkspacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkspaces not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) GenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTestFileForViewCode not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) RemoveGenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGenerateTestFileForViewCode not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) GetContainerNames(context.Context, *Empty) (*GetContainerNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContainerNames not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) RemoveContainer(context.Context, *RemoveContainerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContainer not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) HibernateContainer(context.Context, *HibernateContainerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HibernateContainer not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) ListWorkspaceDir(context.Context, *WorkspaceFileRequest) (*ListWorkspaceDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceDir not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) ReadWorkspaceFile(context.Context, *WorkspaceFileRequest) (*ReadWorkspaceFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWorkspaceFile not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) WriteWorkspaceFile(context.Context, *WriteWorkspaceFileRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteWorkspaceFile not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) CreateWorkspaceFile(context.Context, *CreateWorkspaceFileRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceFile not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) RenameWorkspaceFile(context.Context, *RenameWorkspaceFileRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameWorkspaceFile not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) DeleteWorkspaceFile(context.Context, *WorkspaceFileRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspaceFile not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) SnapshotWorkspace(context.Context, *SnapshotWorkspaceRequest) (*WorkspaceSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotWorkspace not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) ListWorkspaceSnapshots(context.Context, *ListWorkspaceSnapshotsRequest) (*ListWorkspaceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceSnapshots not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) RestoreWorkspaceSnapshot(context.Context, *RestoreWorkspaceSnapshotRequest) (*RestoreWorkspaceSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkspaceSnapshot not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) ArchiveWorkspace(*ArchiveWorkspaceRequest, IDEServerService_ArchiveWorkspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ArchiveWorkspace not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) ExportWorkspace(*ArchiveWorkspaceRequest, IDEServerService_ExportWorkspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportWorkspace not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) PutLabTemplate(IDEServerService_PutLabTemplateServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutLabTemplate not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) PutLabTests(IDEServerService_PutLabTestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutLabTests not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) RunWorkspaceTests(context.Context, *RunWorkspaceTestsRequest) (*RunWorkspaceTestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunWorkspaceTests not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) JoinStudentIDE(context.Context, *StudentIDERequest) (*GetIDEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinStudentIDE not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) RotateIDEToken(context.Context, *StudentIDERequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateIDEToken not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) AttachTerminal(IDEServerService_AttachTerminalServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttachTerminal not implemented")
+}
+func (*UnimplementedIDEServerServiceServer) BuildImage(*BuildImageRequest, IDEServerService_BuildImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildImage not implemented")
+}
+
+func RegisterIDEServerServiceServer(s *grpc.Server, srv IDEServerServiceServer) {
+	s.RegisterService(&_IDEServerService_serviceDesc, srv)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _IDEServerService_GetIDEForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIDEForStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).GetIDEForStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/GetIDEForStudent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).GetIDEForStudent(ctx, req.(*GetIDEForStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// IDEServerServiceClient is the client API for IDEServerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type IDEServerServiceClient interface {
-	GetIDEForStudent(ctx context.Context, in *GetIDEForStudentRequest, opts ...grpc.CallOption) (*GetIDEResponse, error)
-	GetIDEForTeacher(ctx context.Context, in *GetIDEForTeacherRequest, opts ...grpc.CallOption) (*GetIDEResponse, error)
-	StopAllIDE(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
-	GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error)
-	StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*Empty, error)
-	QuickViewCode(ctx context.Context, in *QuickViewCodeRequest, opts ...grpc.CallOption) (*QuickViewCodeResponse, error)
-	GenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
-	RemoveGenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
-	GetContainerNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetContainerNamesResponse, error)
-	RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*Empty, error)
+func _IDEServerService_GetIDEForTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIDEForTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).GetIDEForTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/GetIDEForTeacher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).GetIDEForTeacher(ctx, req.(*GetIDEForTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type iDEServerServiceClient struct {
-	cc grpc.ClientConnInterface
+func _IDEServerService_StopAllIDE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).StopAllIDE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/StopAllIDE",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).StopAllIDE(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewIDEServerServiceClient(cc grpc.ClientConnInterface) IDEServerServiceClient {
-	return &iDEServerServiceClient{cc}
+func _IDEServerService_GetContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).GetContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/GetContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).GetContainers(ctx, req.(*GetContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) GetIDEForStudent(ctx context.Context, in *GetIDEForStudentRequest, opts ...grpc.CallOption) (*GetIDEResponse, error) {
-	out := new(GetIDEResponse)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetIDEForStudent", in, out, opts...)
-	if err != nil {
+func _IDEServerService_StopContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).StopContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/StopContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).StopContainer(ctx, req.(*StopContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) GetIDEForTeacher(ctx context.Context, in *GetIDEForTeacherRequest, opts ...grpc.CallOption) (*GetIDEResponse, error) {
-	out := new(GetIDEResponse)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetIDEForTeacher", in, out, opts...)
-	if err != nil {
+func _IDEServerService_QuickViewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickViewCodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).QuickViewCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/QuickViewCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).QuickViewCode(ctx, req.(*QuickViewCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) StopAllIDE(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/StopAllIDE", in, out, opts...)
-	if err != nil {
+func _IDEServerService_QuickViewFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickViewFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).QuickViewFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/QuickViewFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).QuickViewFile(ctx, req.(*QuickViewFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) GetContainers(ctx context.Context, in *GetContainersRequest, opts ...grpc.CallOption) (*GetContainersResponse, error) {
-	out := new(GetContainersResponse)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetContainers", in, out, opts...)
-	if err != nil {
+func _IDEServerService_DiffWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorkspacesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).DiffWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/DiffWorkspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).DiffWorkspaces(ctx, req.(*DiffWorkspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/StopContainer", in, out, opts...)
-	if err != nil {
+func _IDEServerService_GenerateTestFileForViewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).GenerateTestFileForViewCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/GenerateTestFileForViewCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).GenerateTestFileForViewCode(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) QuickViewCode(ctx context.Context, in *QuickViewCodeRequest, opts ...grpc.CallOption) (*QuickViewCodeResponse, error) {
-	out := new(QuickViewCodeResponse)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/QuickViewCode", in, out, opts...)
-	if err != nil {
+func _IDEServerService_RemoveGenerateTestFileForViewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).RemoveGenerateTestFileForViewCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/RemoveGenerateTestFileForViewCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).RemoveGenerateTestFileForViewCode(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) GenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GenerateTestFileForViewCode", in, out, opts...)
-	if err != nil {
+func _IDEServerService_GetContainerNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).GetContainerNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/GetContainerNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).GetContainerNames(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IDEServerService_RemoveContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).RemoveContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/RemoveContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).RemoveContainer(ctx, req.(*RemoveContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) RemoveGenerateTestFileForViewCode(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RemoveGenerateTestFileForViewCode", in, out, opts...)
-	if err != nil {
+func _IDEServerService_HibernateContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HibernateContainerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).HibernateContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/HibernateContainer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).HibernateContainer(ctx, req.(*HibernateContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) GetContainerNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetContainerNamesResponse, error) {
-	out := new(GetContainerNamesResponse)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/GetContainerNames", in, out, opts...)
-	if err != nil {
+func _IDEServerService_ListWorkspaceDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkspaceFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).ListWorkspaceDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/ListWorkspaceDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).ListWorkspaceDir(ctx, req.(*WorkspaceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *iDEServerServiceClient) RemoveContainer(ctx context.Context, in *RemoveContainerRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ide.IDEServerService/RemoveContainer", in, out, opts...)
-	if err != nil {
+func _IDEServerService_ReadWorkspaceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkspaceFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// IDEServerServiceServer is the server API for IDEServerService service.
-type IDEServerServiceServer interface {
-	GetIDEForStudent(context.Context, *GetIDEForStudentRequest) (*GetIDEResponse, error)
-	GetIDEForTeacher(context.Context, *GetIDEForTeacherRequest) (*GetIDEResponse, error)
-	StopAllIDE(context.Context, *Empty) (*Empty, error)
-	GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error)
-	StopContainer(context.Context, *StopContainerRequest) (*Empty, error)
-	QuickViewCode(context.Context, *QuickViewCodeRequest) (*QuickViewCodeResponse, error)
-	GenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error)
-	RemoveGenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error)
-	GetContainerNames(context.Context, *Empty) (*GetContainerNamesResponse, error)
-	RemoveContainer(context.Context, *RemoveContainerRequest) (*Empty, error)
-}
-
-// UnimplementedIDEServerServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedIDEServerServiceServer struct {
-}
-
-func (*UnimplementedIDEServerServiceServer) GetIDEForStudent(context.Context, *GetIDEForStudentRequest) (*GetIDEResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIDEForStudent not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) GetIDEForTeacher(context.Context, *GetIDEForTeacherRequest) (*GetIDEResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIDEForTeacher not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) StopAllIDE(context.Context, *Empty) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopAllIDE not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) GetContainers(context.Context, *GetContainersRequest) (*GetContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContainers not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) StopContainer(context.Context, *StopContainerRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopContainer not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) QuickViewCode(context.Context, *QuickViewCodeRequest) (*QuickViewCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuickViewCode not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) GenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateTestFileForViewCode not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) RemoveGenerateTestFileForViewCode(context.Context, *Empty) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveGenerateTestFileForViewCode not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) GetContainerNames(context.Context, *Empty) (*GetContainerNamesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContainerNames not implemented")
-}
-func (*UnimplementedIDEServerServiceServer) RemoveContainer(context.Context, *RemoveContainerRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveContainer not implemented")
-}
-
-func RegisterIDEServerServiceServer(s *grpc.Server, srv IDEServerServiceServer) {
-	s.RegisterService(&_IDEServerService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(IDEServerServiceServer).ReadWorkspaceFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide.IDEServerService/ReadWorkspaceFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServerServiceServer).ReadWorkspaceFile(ctx, req.(*WorkspaceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_GetIDEForStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIDEForStudentRequest)
+func _IDEServerService_WriteWorkspaceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteWorkspaceFileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).GetIDEForStudent(ctx, in)
+		return srv.(IDEServerServiceServer).WriteWorkspaceFile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/GetIDEForStudent",
+		FullMethod: "/ide.IDEServerService/WriteWorkspaceFile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).GetIDEForStudent(ctx, req.(*GetIDEForStudentRequest))
+		return srv.(IDEServerServiceServer).WriteWorkspaceFile(ctx, req.(*WriteWorkspaceFileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_GetIDEForTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIDEForTeacherRequest)
+func _IDEServerService_CreateWorkspaceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceFileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).GetIDEForTeacher(ctx, in)
+		return srv.(IDEServerServiceServer).CreateWorkspaceFile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/GetIDEForTeacher",
+		FullMethod: "/ide.IDEServerService/CreateWorkspaceFile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).GetIDEForTeacher(ctx, req.(*GetIDEForTeacherRequest))
+		return srv.(IDEServerServiceServer).CreateWorkspaceFile(ctx, req.(*CreateWorkspaceFileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_StopAllIDE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _IDEServerService_RenameWorkspaceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWorkspaceFileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).StopAllIDE(ctx, in)
+		return srv.(IDEServerServiceServer).RenameWorkspaceFile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/StopAllIDE",
+		FullMethod: "/ide.IDEServerService/RenameWorkspaceFile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).StopAllIDE(ctx, req.(*Empty))
+		return srv.(IDEServerServiceServer).RenameWorkspaceFile(ctx, req.(*RenameWorkspaceFileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_GetContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContainersRequest)
+func _IDEServerService_DeleteWorkspaceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkspaceFileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).GetContainers(ctx, in)
+		return srv.(IDEServerServiceServer).DeleteWorkspaceFile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/GetContainers",
+		FullMethod: "/ide.IDEServerService/DeleteWorkspaceFile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).GetContainers(ctx, req.(*GetContainersRequest))
+		return srv.(IDEServerServiceServer).DeleteWorkspaceFile(ctx, req.(*WorkspaceFileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_StopContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopContainerRequest)
+func _IDEServerService_SnapshotWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).StopContainer(ctx, in)
+		return srv.(IDEServerServiceServer).SnapshotWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/StopContainer",
+		FullMethod: "/ide.IDEServerService/SnapshotWorkspace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).StopContainer(ctx, req.(*StopContainerRequest))
+		return srv.(IDEServerServiceServer).SnapshotWorkspace(ctx, req.(*SnapshotWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_QuickViewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuickViewCodeRequest)
+func _IDEServerService_ListWorkspaceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).QuickViewCode(ctx, in)
+		return srv.(IDEServerServiceServer).ListWorkspaceSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/QuickViewCode",
+		FullMethod: "/ide.IDEServerService/ListWorkspaceSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).QuickViewCode(ctx, req.(*QuickViewCodeRequest))
+		return srv.(IDEServerServiceServer).ListWorkspaceSnapshots(ctx, req.(*ListWorkspaceSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_GenerateTestFileForViewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _IDEServerService_RestoreWorkspaceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkspaceSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).GenerateTestFileForViewCode(ctx, in)
+		return srv.(IDEServerServiceServer).RestoreWorkspaceSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/GenerateTestFileForViewCode",
+		FullMethod: "/ide.IDEServerService/RestoreWorkspaceSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).GenerateTestFileForViewCode(ctx, req.(*Empty))
+		return srv.(IDEServerServiceServer).RestoreWorkspaceSnapshot(ctx, req.(*RestoreWorkspaceSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_RemoveGenerateTestFileForViewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _IDEServerService_ArchiveWorkspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveWorkspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IDEServerServiceServer).ArchiveWorkspace(m, &iDEServerServiceArchiveWorkspaceServer{stream})
+}
+
+type IDEServerService_ArchiveWorkspaceServer interface {
+	Send(*ArchiveWorkspaceChunk) error
+	grpc.ServerStream
+}
+
+type iDEServerServiceArchiveWorkspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *iDEServerServiceArchiveWorkspaceServer) Send(m *ArchiveWorkspaceChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IDEServerService_ExportWorkspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveWorkspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IDEServerServiceServer).ExportWorkspace(m, &iDEServerServiceExportWorkspaceServer{stream})
+}
+
+type IDEServerService_ExportWorkspaceServer interface {
+	Send(*ArchiveWorkspaceChunk) error
+	grpc.ServerStream
+}
+
+type iDEServerServiceExportWorkspaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *iDEServerServiceExportWorkspaceServer) Send(m *ArchiveWorkspaceChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IDEServerService_PutLabTemplate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IDEServerServiceServer).PutLabTemplate(&iDEServerServicePutLabTemplateServer{stream})
+}
+
+type IDEServerService_PutLabTemplateServer interface {
+	SendAndClose(*PutLabTemplateResponse) error
+	Recv() (*LabTemplateChunk, error)
+	grpc.ServerStream
+}
+
+type iDEServerServicePutLabTemplateServer struct {
+	grpc.ServerStream
+}
+
+func (x *iDEServerServicePutLabTemplateServer) SendAndClose(m *PutLabTemplateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *iDEServerServicePutLabTemplateServer) Recv() (*LabTemplateChunk, error) {
+	m := new(LabTemplateChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _IDEServerService_PutLabTests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IDEServerServiceServer).PutLabTests(&iDEServerServicePutLabTestsServer{stream})
+}
+
+type IDEServerService_PutLabTestsServer interface {
+	SendAndClose(*PutLabTestsResponse) error
+	Recv() (*LabTemplateChunk, error)
+	grpc.ServerStream
+}
+
+type iDEServerServicePutLabTestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *iDEServerServicePutLabTestsServer) SendAndClose(m *PutLabTestsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *iDEServerServicePutLabTestsServer) Recv() (*LabTemplateChunk, error) {
+	m := new(LabTemplateChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _IDEServerService_RunWorkspaceTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunWorkspaceTestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).RemoveGenerateTestFileForViewCode(ctx, in)
+		return srv.(IDEServerServiceServer).RunWorkspaceTests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/RemoveGenerateTestFileForViewCode",
+		FullMethod: "/ide.IDEServerService/RunWorkspaceTests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).RemoveGenerateTestFileForViewCode(ctx, req.(*Empty))
+		return srv.(IDEServerServiceServer).RunWorkspaceTests(ctx, req.(*RunWorkspaceTestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_GetContainerNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _IDEServerService_JoinStudentIDE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIDERequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).GetContainerNames(ctx, in)
+		return srv.(IDEServerServiceServer).JoinStudentIDE(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/GetContainerNames",
+		FullMethod: "/ide.IDEServerService/JoinStudentIDE",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).GetContainerNames(ctx, req.(*Empty))
+		return srv.(IDEServerServiceServer).JoinStudentIDE(ctx, req.(*StudentIDERequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IDEServerService_RemoveContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveContainerRequest)
+func _IDEServerService_RotateIDEToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIDERequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IDEServerServiceServer).RemoveContainer(ctx, in)
+		return srv.(IDEServerServiceServer).RotateIDEToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ide.IDEServerService/RemoveContainer",
+		FullMethod: "/ide.IDEServerService/RotateIDEToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IDEServerServiceServer).RemoveContainer(ctx, req.(*RemoveContainerRequest))
+		return srv.(IDEServerServiceServer).RotateIDEToken(ctx, req.(*StudentIDERequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IDEServerService_AttachTerminal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IDEServerServiceServer).AttachTerminal(&iDEServerServiceAttachTerminalServer{stream})
+}
+
+type IDEServerService_AttachTerminalServer interface {
+	Send(*TerminalOutput) error
+	Recv() (*TerminalInput, error)
+	grpc.ServerStream
+}
+
+type iDEServerServiceAttachTerminalServer struct {
+	grpc.ServerStream
+}
+
+func (x *iDEServerServiceAttachTerminalServer) Send(m *TerminalOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *iDEServerServiceAttachTerminalServer) Recv() (*TerminalInput, error) {
+	m := new(TerminalInput)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _IDEServerService_BuildImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BuildImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IDEServerServiceServer).BuildImage(m, &iDEServerServiceBuildImageServer{stream})
+}
+
+type IDEServerService_BuildImageServer interface {
+	Send(*BuildImageOutput) error
+	grpc.ServerStream
+}
+
+type iDEServerServiceBuildImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *iDEServerServiceBuildImageServer) Send(m *BuildImageOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _IDEServerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ide.IDEServerService",
 	HandlerType: (*IDEServerServiceServer)(nil),
@@ -1867,6 +6749,14 @@ var _IDEServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QuickViewCode",
 			Handler:    _IDEServerService_QuickViewCode_Handler,
 		},
+		{
+			MethodName: "QuickViewFile",
+			Handler:    _IDEServerService_QuickViewFile_Handler,
+		},
+		{
+			MethodName: "DiffWorkspaces",
+			Handler:    _IDEServerService_DiffWorkspaces_Handler,
+		},
 		{
 			MethodName: "GenerateTestFileForViewCode",
 			Handler:    _IDEServerService_GenerateTestFileForViewCode_Handler,
@@ -1883,7 +6773,91 @@ var _IDEServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveContainer",
 			Handler:    _IDEServerService_RemoveContainer_Handler,
 		},
+		{
+			MethodName: "HibernateContainer",
+			Handler:    _IDEServerService_HibernateContainer_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceDir",
+			Handler:    _IDEServerService_ListWorkspaceDir_Handler,
+		},
+		{
+			MethodName: "ReadWorkspaceFile",
+			Handler:    _IDEServerService_ReadWorkspaceFile_Handler,
+		},
+		{
+			MethodName: "WriteWorkspaceFile",
+			Handler:    _IDEServerService_WriteWorkspaceFile_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceFile",
+			Handler:    _IDEServerService_CreateWorkspaceFile_Handler,
+		},
+		{
+			MethodName: "RenameWorkspaceFile",
+			Handler:    _IDEServerService_RenameWorkspaceFile_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspaceFile",
+			Handler:    _IDEServerService_DeleteWorkspaceFile_Handler,
+		},
+		{
+			MethodName: "SnapshotWorkspace",
+			Handler:    _IDEServerService_SnapshotWorkspace_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceSnapshots",
+			Handler:    _IDEServerService_ListWorkspaceSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreWorkspaceSnapshot",
+			Handler:    _IDEServerService_RestoreWorkspaceSnapshot_Handler,
+		},
+		{
+			MethodName: "RunWorkspaceTests",
+			Handler:    _IDEServerService_RunWorkspaceTests_Handler,
+		},
+		{
+			MethodName: "JoinStudentIDE",
+			Handler:    _IDEServerService_JoinStudentIDE_Handler,
+		},
+		{
+			MethodName: "RotateIDEToken",
+			Handler:    _IDEServerService_RotateIDEToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ArchiveWorkspace",
+			Handler:       _IDEServerService_ArchiveWorkspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportWorkspace",
+			Handler:       _IDEServerService_ExportWorkspace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PutLabTemplate",
+			Handler:       _IDEServerService_PutLabTemplate_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PutLabTests",
+			Handler:       _IDEServerService_PutLabTests_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AttachTerminal",
+			Handler:       _IDEServerService_AttachTerminal_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BuildImage",
+			Handler:       _IDEServerService_BuildImage_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ide.proto",
 }
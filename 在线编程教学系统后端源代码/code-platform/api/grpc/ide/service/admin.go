@@ -116,7 +116,7 @@ func (i *IDEServer) GetContainers(ctx context.Context, req *pb.GetContainersRequ
 }
 
 func (i *IDEServer) getContainersByLS(ctx context.Context, req *pb.GetContainersRequest) (*pb.GetContainersResponse, error) {
-	cmd := exec.CommandContext(ctx, "docker", "ps", "--format", listContainersFormat)
+	cmd := exec.CommandContext(ctx, "docker", "ps", "--filter", "name=^"+define.ContainerNamePrefix, "--format", listContainersFormat)
 	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
 	if err != nil {
 		i.Logger.Errorf(err, "run command %q failed for %s", cmd.String(), string(stderr))
@@ -163,8 +163,22 @@ func (i *IDEServer) getContainersByLS(ctx context.Context, req *pb.GetContainers
 }
 
 func (i *IDEServer) getContainersByStats(ctx context.Context, req *pb.GetContainersRequest) (*pb.GetContainersResponse, error) {
-	cmd := exec.CommandContext(ctx, "docker", "stats", "--no-stream", "--format", `"{{.ID}}\t{{.CPUPerc}}\t{{.MemPerc}}\t{{.MemUsage}}"`)
-	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
+	// docker stats 不支持过滤，先筛出 IDE 容器以排除预热池中的空闲容器
+	idCmd := exec.CommandContext(ctx, "docker", "ps", "-q", "--filter", "name=^"+define.ContainerNamePrefix)
+	stdout, stderr, err := osx.CommandOutput(ctx, idCmd)
+	if err != nil {
+		i.Logger.Errorf(err, "run command %q failed for %s", idCmd.String(), string(stderr))
+		return nil, status.Errorf(codes.Internal, "err %v for %q", err, string(stderr))
+	}
+
+	ids := strings.Fields(strconvx.BytesToString(stdout))
+	if len(ids) == 0 {
+		return &pb.GetContainersResponse{}, nil
+	}
+
+	args := append([]string{"stats", "--no-stream", "--format", `"{{.ID}}\t{{.CPUPerc}}\t{{.MemPerc}}\t{{.MemUsage}}"`}, ids...)
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	stdout, stderr, err = osx.CommandOutput(ctx, cmd)
 	if err != nil {
 		i.Logger.Errorf(err, "run command %q failed for %s", cmd.String(), string(stderr))
 		return nil, status.Errorf(codes.Internal, "err %v for %q", err, string(stderr))
@@ -180,7 +194,7 @@ func (i *IDEServer) getContainersByStats(ctx context.Context, req *pb.GetContain
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	args := make([]string, 0, 2*len(containerInfos)+3)
+	args = make([]string, 0, 2*len(containerInfos)+3)
 	args = append(args, "ps", "--format", listContainersFormat)
 
 	for _, containerInfo := range containerInfos {
@@ -212,16 +226,9 @@ func (i *IDEServer) getContainersByStats(ctx context.Context, req *pb.GetContain
 				i.Logger.Errorf(err, "containerName %q to ids failed", bs.containerName)
 				return nil, status.Error(codes.Internal, err.Error())
 			}
-			portSlice := portCompile.FindStringSubmatch(bs.rawPort)
-			if portSlice == nil || len(portSlice) != 2 {
-				err := fmt.Errorf("%q is not a valid port", bs.rawPort)
-				i.Logger.Error(err, "")
-				return nil, status.Error(codes.Internal, err.Error())
-			}
-
-			port, err := strconv.Atoi(portSlice[1])
+			port, err := parseContainerPort(bs.rawPort)
 			if err != nil {
-				i.Logger.Errorf(err, "%q is not a valid port", portSlice[1])
+				i.Logger.Error(err, "")
 				return nil, status.Error(codes.Internal, err.Error())
 			}
 
@@ -466,14 +473,9 @@ func getContainerInfosByLS(stdout []byte, offset, limit int, order pb.OrderType,
 		if err != nil {
 			return nil, 0, err
 		}
-		portSlice := portCompile.FindStringSubmatch(row.rawPort)
-		if portSlice == nil || len(portSlice) != 2 {
-			return nil, 0, fmt.Errorf("%q is not a valid port", row.rawPort)
-		}
-
-		port, err := strconv.Atoi(portSlice[1])
+		port, err := parseContainerPort(row.rawPort)
 		if err != nil {
-			return nil, 0, fmt.Errorf("%q is not a valid port", portSlice[1])
+			return nil, 0, err
 		}
 
 		sizeSlice := strings.Fields(row.rawsize)
@@ -495,3 +497,19 @@ func getContainerInfosByLS(stdout []byte, offset, limit int, order pb.OrderType,
 
 	return containersInfos[:len(containersInfos):len(containersInfos)], uint32(len(rows)), nil
 }
+
+// parseContainerPort 解析 docker ps 输出的端口映射，终端模式容器不映射端口，返回 0
+func parseContainerPort(rawPort string) (int, error) {
+	if strings.TrimSpace(rawPort) == "" {
+		return 0, nil
+	}
+	portSlice := portCompile.FindStringSubmatch(rawPort)
+	if len(portSlice) != 2 {
+		return 0, fmt.Errorf("%q is not a valid port", rawPort)
+	}
+	port, err := strconv.Atoi(portSlice[1])
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid port", portSlice[1])
+	}
+	return port, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JoinStudentIDE 返回学生正在运行的 IDE 容器，以编辑权限协助的教师与学生共享同一 Theia 会话（终端、打开的编辑器），
+// 容器未运行、为终端模式或工作区已冻结时返回 FailedPrecondition
+func (i *IDEServer) JoinStudentIDE(ctx context.Context, req *pb.StudentIDERequest) (*pb.GetIDEResponse, error) {
+	if isWorkspaceFrozen(req.LabId, req.StudentId) {
+		return nil, status.Error(codes.FailedPrecondition, "workspace is frozen")
+	}
+	containerName := define.GetContainerNameForStudent(req.LabId, req.StudentId)
+	if !isContainerAlive(ctx, containerName) {
+		return nil, status.Errorf(codes.FailedPrecondition, "container %q is not running", containerName)
+	}
+	label, _ := getContainerIDEKind(ctx, containerName)
+	if label == ideKindLabelTerminal {
+		return nil, status.Errorf(codes.FailedPrecondition, "container %q is a terminal", containerName)
+	}
+
+	port, err := getContainerPort(ctx, containerName)
+	if err != nil {
+		i.Logger.Errorf(err, "getContainerPort failed")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	token, err := getContainerToken(ctx, containerName)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.GetIDEResponse{Port: uint32(port), IsReused: true, Token: token, Path: ideEntryPath(label, token)}, nil
+}
+
+// RotateIDEToken 协助会话结束后轮换学生容器的 token，使协助者持有的旧 token 失效，学生需重新打开 IDE 获取新 token；
+// 休眠的容器恢复时代理会读取旧 token，Jupyter 的 token 在启动时固定，两者均直接删除，下次打开时重新创建
+func (i *IDEServer) RotateIDEToken(ctx context.Context, req *pb.StudentIDERequest) (*pb.Empty, error) {
+	containerName := define.GetContainerNameForStudent(req.LabId, req.StudentId)
+	alive := isContainerAlive(ctx, containerName)
+	switch {
+	case alive && !isJupyterContainer(ctx, containerName):
+		port, err := getContainerPort(ctx, containerName)
+		if err != nil {
+			i.Logger.Errorf(err, "getContainerPort failed")
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if _, err := rotateContainerToken(ctx, containerName, uint16(port)); err != nil {
+			i.Logger.Errorf(err, "rotate token of container %q failed", containerName)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case alive || isContainerStop(ctx, containerName):
+		if err := removeContainer(ctx, containerName); err != nil {
+			i.Logger.Errorf(err, "remove container %q failed", containerName)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/osx"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// courseImageLabelKey 标记课程自定义镜像，便于按标签清理
+const courseImageLabelKey = "code-platform.course-image"
+
+const imageBuildChunkSize = 16 << 10
+
+// imageBuildLocks 相同标签的构建串行执行，后到者直接复用先完成的镜像
+var imageBuildLocks sync.Map
+
+func lockImageBuild(tag string) func() {
+	value, _ := imageBuildLocks.LoadOrStore(tag, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// BuildImage 在 Theia 或 monaco 的默认镜像上安装软件包并执行 Dockerfile 片段，构建日志按块流式返回，
+// 最后一条消息携带镜像标签；标签由生成的 Dockerfile 决定，已存在时不再重新构建
+func (i *IDEServer) BuildImage(req *pb.BuildImageRequest, stream pb.IDEServerService_BuildImageServer) error {
+	aptPackages := define.NormalizeImagePackages(req.AptPackages)
+	pipPackages := define.NormalizeImagePackages(req.PipPackages)
+	if err := checkBuildImageRequest(aptPackages, pipPackages, req.Dockerfile); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var baseImage string
+	switch req.Base {
+	case define.ImageBaseTheia:
+		baseImage = define.GetImageName(int8(req.Language), "")
+	case define.ImageBaseMonaco:
+		baseImage = define.GetMonacoImageName(int8(req.Language))
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown base %q", req.Base)
+	}
+
+	ctx := stream.Context()
+	select {
+	case i.buildSlots <- struct{}{}:
+		defer func() { <-i.buildSlots }()
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	baseUser, err := getImageUser(ctx, baseImage)
+	if err != nil {
+		i.Logger.Errorf(err, "inspect base image %q failed", baseImage)
+		return status.Error(codes.Internal, err.Error())
+	}
+	dockerfile := define.RenderCourseDockerfile(baseImage, baseUser, aptPackages, pipPackages, req.Dockerfile)
+	tag := define.GetCourseImageTag(dockerfile)
+
+	unlock := lockImageBuild(tag)
+	defer unlock()
+
+	if isImageExist(ctx, tag) {
+		return stream.Send(&pb.BuildImageOutput{Log: fmt.Sprintf("image %s already exists, skip building\n", tag), Tag: tag, Cached: true})
+	}
+	if err := stream.Send(&pb.BuildImageOutput{Log: fmt.Sprintf("building %s with Dockerfile:\n%s\n", tag, dockerfile)}); err != nil {
+		return err
+	}
+
+	if err := i.buildImage(ctx, stream, tag, dockerfile); err != nil {
+		return err
+	}
+	return stream.Send(&pb.BuildImageOutput{Tag: tag})
+}
+
+func checkBuildImageRequest(aptPackages, pipPackages []string, fragment string) error {
+	if len(aptPackages)+len(pipPackages) > define.MaxImagePackages {
+		return fmt.Errorf("too many packages")
+	}
+	for _, packages := range [][]string{aptPackages, pipPackages} {
+		for _, p := range packages {
+			if !define.IsValidImagePackage(p) {
+				return fmt.Errorf("invalid package %q", p)
+			}
+		}
+	}
+	return define.CheckDockerfileFragment(fragment)
+}
+
+// buildImage 以 stdin 传入 Dockerfile，不发送构建上下文；客户端断开或超时时结束 docker 客户端，BuildKit 随之取消构建
+func (i *IDEServer) buildImage(ctx context.Context, stream pb.IDEServerService_BuildImageServer, tag, dockerfile string) error {
+	buildCtx, cancel := context.WithTimeout(ctx, config.IDEServer.GetDuration("image_build.timeout"))
+	defer cancel()
+
+	cmd := exec.CommandContext(buildCtx, "docker", "build", "--label", courseImageLabelKey+"=1", "-t", tag, "-")
+	cmd.Env = append(os.Environ(), "DOCKER_BUILDKIT=1", "BUILDKIT_PROGRESS=plain")
+	cmd.Stdin = strings.NewReader(dockerfile)
+	reader, writer := io.Pipe()
+	cmd.Stdout, cmd.Stderr = writer, writer
+	if err := cmd.Start(); err != nil {
+		i.Logger.Errorf(err, "start building image %q failed", tag)
+		return status.Error(codes.Internal, err.Error())
+	}
+	go func() { writer.CloseWithError(cmd.Wait()) }()
+
+	buf := make([]byte, imageBuildChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&pb.BuildImageOutput{Log: string(buf[:n])}); sendErr != nil {
+				cancel()
+				io.Copy(io.Discard, reader)
+				return sendErr
+			}
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(buildCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return status.Errorf(codes.DeadlineExceeded, "build image %s timed out", tag)
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		// 构建失败多由片段或软件包有误导致，原因已在日志中
+		return status.Errorf(codes.Aborted, "build image %s failed: %v", tag, err)
+	}
+}
+
+func isImageExist(ctx context.Context, imageName string) bool {
+	cmd := exec.CommandContext(ctx, "docker", "image", "inspect", "-f", "{{.Id}}", imageName)
+	_, _, err := osx.CommandOutput(ctx, cmd)
+	return err == nil
+}
+
+// getImageUser 返回镜像默认的运行用户，本地不存在时先拉取
+func getImageUser(ctx context.Context, imageName string) (string, error) {
+	if !isImageExist(ctx, imageName) {
+		cmd := exec.CommandContext(ctx, "docker", "pull", imageName)
+		if _, stderr, err := osx.CommandOutput(ctx, cmd); err != nil {
+			return "", fmt.Errorf("pull image %q failed: %w: %s", imageName, err, bytes.TrimSpace(stderr))
+		}
+	}
+	cmd := exec.CommandContext(ctx, "docker", "image", "inspect", "-f", "{{.Config.User}}", imageName)
+	stdout, _, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(stdout)), nil
+}
+
+// getContainerImage 返回创建容器时指定的镜像名，容器不存在时 ok 为 false
+func getContainerImage(ctx context.Context, containerName string) (imageName string, ok bool) {
+	cmd := exec.CommandContext(ctx, "docker", "inspect", "-f", "{{.Config.Image}}", containerName)
+	stdout, _, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return "", false
+	}
+	return string(bytes.TrimSpace(stdout)), true
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/diffx"
+	"code-platform/pkg/ignorex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxDiffContextLines = 100
+
+var errStopWalk = errors.New("stop walk")
+
+// DiffWorkspaces 按路径匹配两个工作区的文件并给出逐行差异，路径不同但文件名唯一相同的文件视为重命名
+func (i *IDEServer) DiffWorkspaces(ctx context.Context, req *pb.DiffWorkspacesRequest) (*pb.DiffWorkspacesResponse, error) {
+	if req.GetOld() == nil || req.GetNew() == nil {
+		return nil, status.Error(codes.InvalidArgument, "workspace ref is required")
+	}
+
+	oldFS, err := openWorkspaceRef(req.GetOld())
+	if err != nil {
+		return nil, err
+	}
+	newFS, err := openWorkspaceRef(req.GetNew())
+	if err != nil {
+		return nil, err
+	}
+
+	maxEntries := config.IDEServer.GetInt("quick_view.max_entries")
+	oldFiles, oldTruncated, err := listWorkspaceFiles(ctx, oldFS, maxEntries)
+	if err != nil {
+		i.Logger.Errorf(err, "list files of workspace %+v failed", req.GetOld())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	newFiles, newTruncated, err := listWorkspaceFiles(ctx, newFS, maxEntries)
+	if err != nil {
+		i.Logger.Errorf(err, "list files of workspace %+v failed", req.GetNew())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	contextLines := int(req.GetContextLines())
+	switch {
+	case contextLines <= 0:
+		contextLines = config.IDEServer.GetInt("diff.context_lines")
+	case contextLines > maxDiffContextLines:
+		contextLines = maxDiffContextLines
+	}
+
+	d := &workspaceDiffer{
+		oldFS:        oldFS,
+		newFS:        newFS,
+		maxFileSize:  config.IDEServer.GetInt64("quick_view.max_file_size"),
+		remaining:    config.IDEServer.GetInt("diff.max_lines"),
+		contextLines: contextLines,
+	}
+	resp := &pb.DiffWorkspacesResponse{Truncated: oldTruncated || newTruncated}
+	for _, pair := range matchWorkspaceFiles(oldFiles, newFiles) {
+		fileDiff, err := d.diffFile(ctx, pair)
+		if err != nil {
+			i.Logger.Errorf(err, "diff %q and %q failed", pair.oldPath, pair.newPath)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Files = append(resp.Files, fileDiff)
+	}
+	return resp, nil
+}
+
+// openWorkspaceRef 打开工作区或其快照，工作区不存在时返回 nil
+func openWorkspaceRef(ref *pb.WorkspaceRef) (fs.FS, error) {
+	if ref.GetSnapshotId() != 0 {
+		sfs, err := openSnapshotFS(ref.GetLabId(), ref.GetStudentId(), ref.GetSnapshotId())
+		if err != nil {
+			return nil, workspaceStatusError(err)
+		}
+		return sfs, nil
+	}
+
+	root := getViewWorkSpace(ref.GetLabId(), ref.GetStudentId())
+	if _, err := os.Stat(root); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return os.DirFS(root), nil
+}
+
+// listWorkspaceFiles 返回未被忽略的普通文件，文件数超过 maxEntries 时截断
+func listWorkspaceFiles(ctx context.Context, fsys fs.FS, maxEntries int) (map[string]fs.FileInfo, bool, error) {
+	files := make(map[string]fs.FileInfo)
+	if fsys == nil {
+		return files, false, nil
+	}
+
+	matcher := ignorex.New(config.IDEServer.GetStringSlice("quick_view.ignore")...)
+	var truncated bool
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if p == "." {
+			return addGitignore(matcher, fsys, "")
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+		if matcher.Match(p, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return addGitignore(matcher, fsys, p)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if len(files) >= maxEntries {
+			truncated = true
+			return errStopWalk
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		files[p] = info
+		return nil
+	})
+	if err != nil && err != errStopWalk {
+		return nil, false, err
+	}
+	return files, truncated, nil
+}
+
+type filePair struct {
+	oldPath string
+	newPath string
+	oldSize int64
+	newSize int64
+}
+
+// matchWorkspaceFiles 先按路径匹配，剩余文件中文件名在两侧均唯一的视为重命名
+func matchWorkspaceFiles(oldFiles, newFiles map[string]fs.FileInfo) []*filePair {
+	pairs := make([]*filePair, 0, len(oldFiles)+len(newFiles))
+	var deleted, added []string
+	for p, info := range oldFiles {
+		if newInfo, ok := newFiles[p]; ok {
+			pairs = append(pairs, &filePair{oldPath: p, newPath: p, oldSize: info.Size(), newSize: newInfo.Size()})
+			continue
+		}
+		deleted = append(deleted, p)
+	}
+	for p := range newFiles {
+		if _, ok := oldFiles[p]; !ok {
+			added = append(added, p)
+		}
+	}
+
+	uniqueBase := func(paths []string) map[string]string {
+		counter := make(map[string]int, len(paths))
+		for _, p := range paths {
+			counter[path.Base(p)]++
+		}
+		index := make(map[string]string, len(paths))
+		for _, p := range paths {
+			if base := path.Base(p); counter[base] == 1 {
+				index[base] = p
+			}
+		}
+		return index
+	}
+	addedByBase := uniqueBase(added)
+	renamed := make(map[string]struct{})
+	for base, oldPath := range uniqueBase(deleted) {
+		newPath, ok := addedByBase[base]
+		if !ok {
+			continue
+		}
+		pairs = append(pairs, &filePair{oldPath: oldPath, newPath: newPath, oldSize: oldFiles[oldPath].Size(), newSize: newFiles[newPath].Size()})
+		renamed[oldPath], renamed[newPath] = struct{}{}, struct{}{}
+	}
+
+	for _, p := range deleted {
+		if _, ok := renamed[p]; !ok {
+			pairs = append(pairs, &filePair{oldPath: p, oldSize: oldFiles[p].Size()})
+		}
+	}
+	for _, p := range added {
+		if _, ok := renamed[p]; !ok {
+			pairs = append(pairs, &filePair{newPath: p, newSize: newFiles[p].Size()})
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].sortKey() < pairs[j].sortKey()
+	})
+	return pairs
+}
+
+func (p *filePair) sortKey() string {
+	if p.newPath != "" {
+		return p.newPath
+	}
+	return p.oldPath
+}
+
+type workspaceDiffer struct {
+	oldFS        fs.FS
+	newFS        fs.FS
+	maxFileSize  int64
+	contextLines int
+	// remaining 剩余可返回的差异行数
+	remaining int
+}
+
+func (d *workspaceDiffer) diffFile(ctx context.Context, pair *filePair) (*pb.DiffWorkspacesResponse_FileDiff, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	fileDiff := &pb.DiffWorkspacesResponse_FileDiff{OldPath: pair.oldPath, NewPath: pair.newPath}
+	switch {
+	case pair.oldPath == "":
+		fileDiff.Status = pb.DiffWorkspacesResponse_ADDED
+	case pair.newPath == "":
+		fileDiff.Status = pb.DiffWorkspacesResponse_DELETED
+	case pair.oldPath != pair.newPath:
+		fileDiff.Status = pb.DiffWorkspacesResponse_RENAMED
+	}
+
+	oldView, oldHash, err := d.readSide(d.oldFS, pair.oldPath, pair.oldSize)
+	if err != nil {
+		return nil, err
+	}
+	newView, newHash, err := d.readSide(d.newFS, pair.newPath, pair.newSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileDiff.Status == pb.DiffWorkspacesResponse_UNCHANGED && !bytes.Equal(oldHash, newHash) {
+		fileDiff.Status = pb.DiffWorkspacesResponse_MODIFIED
+	}
+	if bytes.Equal(oldHash, newHash) {
+		return fileDiff, nil
+	}
+
+	if oldView.IsBinary || newView.IsBinary {
+		fileDiff.IsBinary = true
+		return fileDiff, nil
+	}
+	if oldView.Truncated || newView.Truncated {
+		fileDiff.Truncated = true
+		return fileDiff, nil
+	}
+
+	lines := diffx.Diff(diffx.SplitLines(oldView.Content), diffx.SplitLines(newView.Content))
+	hunks := diffx.Hunks(lines, d.contextLines)
+
+	var total int
+	for _, line := range lines {
+		switch line.Kind {
+		case diffx.Insert:
+			fileDiff.Additions++
+		case diffx.Delete:
+			fileDiff.Deletions++
+		}
+	}
+	for _, hunk := range hunks {
+		total += len(hunk.Lines)
+	}
+	if total > d.remaining {
+		fileDiff.Truncated = true
+		return fileDiff, nil
+	}
+	d.remaining -= total
+
+	fileDiff.Hunks = make([]*pb.DiffWorkspacesResponse_Hunk, len(hunks))
+	for index, hunk := range hunks {
+		pbHunk := &pb.DiffWorkspacesResponse_Hunk{
+			OldStart: int32(hunk.OldStart),
+			OldLines: int32(hunk.OldLines),
+			NewStart: int32(hunk.NewStart),
+			NewLines: int32(hunk.NewLines),
+			Lines:    make([]*pb.DiffWorkspacesResponse_Line, len(hunk.Lines)),
+		}
+		for lineIndex, line := range hunk.Lines {
+			pbHunk.Lines[lineIndex] = &pb.DiffWorkspacesResponse_Line{
+				Kind:    diffLineKinds[line.Kind],
+				Text:    line.Text,
+				OldLine: int32(line.OldLine),
+				NewLine: int32(line.NewLine),
+			}
+		}
+		fileDiff.Hunks[index] = pbHunk
+	}
+	return fileDiff, nil
+}
+
+var diffLineKinds = map[diffx.Kind]pb.DiffWorkspacesResponse_LineKind{
+	diffx.Equal:  pb.DiffWorkspacesResponse_EQUAL,
+	diffx.Insert: pb.DiffWorkspacesResponse_INSERT,
+	diffx.Delete: pb.DiffWorkspacesResponse_DELETE,
+}
+
+// readSide 读取一侧文件用于展示，并计算完整内容的摘要用于判断是否相同；文件不存在时内容为空
+func (d *workspaceDiffer) readSide(fsys fs.FS, p string, size int64) (*pb.QuickViewFileResponse, []byte, error) {
+	if p == "" {
+		return &pb.QuickViewFileResponse{}, nil, nil
+	}
+
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	view, err := viewContent(io.TeeReader(f, hash), size, d.maxFileSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, nil, err
+	}
+	return view, hash.Sum(nil), nil
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/osx"
+	"code-platform/pkg/strconvx"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// frozenBasePath 截止后冻结的工作区副本，教师查看、对比与查重均读取冻结副本
+var frozenBasePath = filepath.Join(define.InitBasePath, "frozen")
+
+var errWorkspaceFrozen = errors.New("workspace has been frozen")
+
+func getFrozenWorkSpace(labID, studentID uint64) string {
+	return filepath.Join(frozenBasePath, fmt.Sprintf("workspace-%d", labID), strconv.FormatUint(studentID, 10))
+}
+
+func isWorkspaceFrozen(labID, studentID uint64) bool {
+	info, err := os.Stat(getFrozenWorkSpace(labID, studentID))
+	return err == nil && info.IsDir()
+}
+
+// getViewWorkSpace 工作区已冻结时返回冻结副本，否则返回工作区本身
+func getViewWorkSpace(labID, studentID uint64) string {
+	if isWorkspaceFrozen(labID, studentID) {
+		return getFrozenWorkSpace(labID, studentID)
+	}
+	return getMountWorkSpace(labID, studentID)
+}
+
+// ArchiveWorkspace 冻结工作区并以 tar.gz 流式返回冻结副本，已冻结的工作区直接归档已有副本
+func (i *IDEServer) ArchiveWorkspace(req *pb.ArchiveWorkspaceRequest, stream pb.IDEServerService_ArchiveWorkspaceServer) error {
+	ctx := stream.Context()
+	unlock := lockWorkspace(req.LabId, req.StudentId)
+	defer unlock()
+
+	if !isWorkspaceFrozen(req.LabId, req.StudentId) {
+		// 先移除容器，避免冻结期间工作区仍被修改
+		if err := removeWorkspaceContainers(ctx, req.LabId, req.StudentId); err != nil {
+			i.Logger.Errorf(err, "remove containers of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+			return status.Error(codes.Internal, err.Error())
+		}
+		skipped, err := freezeWorkspace(ctx, req.LabId, req.StudentId)
+		switch {
+		case err == nil:
+		case errors.Is(err, os.ErrNotExist):
+			return workspaceStatusError(err)
+		default:
+			i.Logger.Errorf(err, "freeze workspace of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+			return status.Error(codes.Internal, err.Error())
+		}
+		if skipped != 0 {
+			i.Logger.Warnf("%d files of labID[%d] and studentID[%d] are too large or too many to freeze", skipped, req.LabId, req.StudentId)
+		}
+	}
+
+	if err := streamArchive(ctx, getFrozenWorkSpace(req.LabId, req.StudentId), stream); err != nil {
+		i.Logger.Errorf(err, "archive workspace of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// ExportWorkspace 以 tar.gz 流式返回工作区当前内容，已冻结时返回冻结副本，不会冻结工作区
+func (i *IDEServer) ExportWorkspace(req *pb.ArchiveWorkspaceRequest, stream pb.IDEServerService_ExportWorkspaceServer) error {
+	root := getViewWorkSpace(req.LabId, req.StudentId)
+	if _, err := os.Stat(root); err != nil {
+		return workspaceStatusError(err)
+	}
+	if err := streamArchive(stream.Context(), root, stream); err != nil {
+		i.Logger.Errorf(err, "export workspace of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+type archiveChunkStream interface {
+	Send(*pb.ArchiveWorkspaceChunk) error
+}
+
+// streamArchive 将 root 归档为 tar.gz 分块发送，最后一块携带归档的 sha256、大小与文件数
+func streamArchive(ctx context.Context, root string, stream archiveChunkStream) error {
+	sender := &chunkSender{stream: stream, buf: make([]byte, 0, archiveChunkSize())}
+	hash := sha256.New()
+	counter := &countWriter{}
+	fileCount, err := writeArchive(ctx, root, io.MultiWriter(hash, counter, sender))
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.ArchiveWorkspaceChunk{
+		Data:      sender.buf,
+		Sha256:    hex.EncodeToString(hash.Sum(nil)),
+		Size:      counter.n,
+		FileCount: fileCount,
+	})
+}
+
+// removeWorkspaceContainers 移除挂载该工作区的学生容器与教师容器，包括已休眠的容器
+func removeWorkspaceContainers(ctx context.Context, labID, studentID uint64) error {
+	cmd := exec.CommandContext(ctx, "docker", "ps", "-a",
+		"--filter", "name=^"+define.GetContainerNameForStudent(labID, studentID),
+		"--format", "{{.Names}}")
+	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("run command %q failed: %v\n%s", cmd.String(), err, stderr)
+	}
+
+	for _, name := range strings.Fields(strconvx.BytesToString(stdout)) {
+		// 前缀同样会匹配 studentID 更长的容器
+		containerLabID, containerStudentID, _, err := containerNameToIDs(name)
+		if err != nil || containerLabID != labID || containerStudentID != studentID {
+			continue
+		}
+		if err := removeContainer(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// freezeWorkspace 将工作区中未被忽略的文件复制为冻结副本，先写入临时目录再重命名，保证冻结副本完整
+func freezeWorkspace(ctx context.Context, labID, studentID uint64) (skipped int, err error) {
+	root := getMountWorkSpace(labID, studentID)
+	if _, err := os.Stat(root); err != nil {
+		return 0, err
+	}
+
+	frozen := getFrozenWorkSpace(labID, studentID)
+	if err := os.MkdirAll(filepath.Dir(frozen), os.ModePerm); err != nil {
+		return 0, err
+	}
+	// 临时目录不放在实验目录下，避免查重时被当作学生提交
+	tmp, err := os.MkdirTemp(frozenBasePath, ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if skipped, err = copyWorkspaceFiles(ctx, root, tmp); err != nil {
+		return 0, err
+	}
+	return skipped, os.Rename(tmp, frozen)
+}
+
+// copyWorkspaceFiles 将 root 中未被忽略的文件复制到 dst，返回因过大或过多而跳过的文件数
+func copyWorkspaceFiles(ctx context.Context, root, dst string) (skipped int, err error) {
+	files, truncated, err := listWorkspaceFiles(ctx, os.DirFS(root), config.IDEServer.GetInt("freeze.max_files"))
+	if err != nil {
+		return 0, err
+	}
+	if truncated {
+		skipped++
+	}
+
+	maxFileSize := config.IDEServer.GetInt64("freeze.max_file_size")
+	for p, info := range files {
+		if info.Size() > maxFileSize {
+			skipped++
+			continue
+		}
+		err := copyFile(filepath.Join(root, filepath.FromSlash(p)), filepath.Join(dst, filepath.FromSlash(p)), info.ModTime())
+		switch {
+		case err == nil:
+		case errors.Is(err, os.ErrNotExist):
+			// 复制期间被删除
+		default:
+			return 0, err
+		}
+	}
+	return skipped, nil
+}
+
+func copyFile(src, dst string, modTime time.Time) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, modTime, modTime)
+}
+
+// writeArchive 将 root 下未被忽略的文件按路径顺序写为 tar.gz，头部只保留路径、大小与修改时间，相同内容总是得到相同的归档
+func writeArchive(ctx context.Context, root string, w io.Writer) (fileCount int32, err error) {
+	files, _, err := listWorkspaceFiles(ctx, os.DirFS(root), config.IDEServer.GetInt("freeze.max_files"))
+	if err != nil {
+		return 0, err
+	}
+	paths := make([]string, 0, len(files))
+	maxFileSize := config.IDEServer.GetInt64("freeze.max_file_size")
+	for p, info := range files {
+		if info.Size() <= maxFileSize {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, p := range paths {
+		if err := writeArchiveFile(tw, filepath.Join(root, filepath.FromSlash(p)), p); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return 0, err
+		}
+		fileCount++
+	}
+	if err := tw.Close(); err != nil {
+		return 0, err
+	}
+	return fileCount, gw.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, filePath, name string) error {
+	f, info, err := openFile(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     info.Size(),
+		ModTime:  info.ModTime().Truncate(time.Second),
+		Format:   tar.FormatPAX,
+	}); err != nil {
+		return err
+	}
+	// 文件在归档期间变长时只写入头部声明的大小
+	_, err = io.CopyN(tw, f, info.Size())
+	return err
+}
+
+// 分块大小的范围，上限低于 gRPC 默认 4MB 的消息大小
+const (
+	minArchiveChunkSize = 32 << 10
+	maxArchiveChunkSize = 2 << 20
+)
+
+// archiveChunkSize 配置的分块大小，未配置或超出范围时取最近的边界，为 0 时 chunkSender 无法发送
+func archiveChunkSize() int {
+	size := config.IDEServer.GetInt("freeze.chunk_size")
+	switch {
+	case size < minArchiveChunkSize:
+		return minArchiveChunkSize
+	case size > maxArchiveChunkSize:
+		return maxArchiveChunkSize
+	}
+	return size
+}
+
+// chunkSender 将写入的数据按缓冲区大小分块发送，剩余数据由调用方随最后一块发送
+type chunkSender struct {
+	stream archiveChunkStream
+	buf    []byte
+}
+
+func (c *chunkSender) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) != 0 {
+		m := copy(c.buf[len(c.buf):cap(c.buf)], p)
+		c.buf = c.buf[:len(c.buf)+m]
+		p = p[m:]
+		if len(c.buf) == cap(c.buf) {
+			// Send 返回前已完成序列化，缓冲区可以复用
+			if err := c.stream.Send(&pb.ArchiveWorkspaceChunk{Data: c.buf}); err != nil {
+				return 0, err
+			}
+			c.buf = c.buf[:0]
+		}
+	}
+	return n, nil
+}
+
+type countWriter struct {
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
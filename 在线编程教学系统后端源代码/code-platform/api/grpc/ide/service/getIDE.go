@@ -14,17 +14,43 @@ import (
 
 func (i *IDEServer) GetIDEForStudent(ctx context.Context, req *pb.GetIDEForStudentRequest) (*pb.GetIDEResponse, error) {
 	containerName := define.GetContainerNameForStudent(req.LabId, req.StudentId)
-	mountWorkSpace := getMountWorkSpace(req.LabId, req.StudentId)
-	return i.getIDE(ctx, containerName, mountWorkSpace, req.CanEdit, int8(req.Language))
+	// 已冻结的工作区只能只读打开冻结副本
+	mountWorkSpace := getViewWorkSpace(req.LabId, req.StudentId)
+	canEdit := req.CanEdit && !isWorkspaceFrozen(req.LabId, req.StudentId)
+	if canEdit {
+		// 模板初始化失败不影响打开 IDE，下次打开时重试
+		if err := seedWorkspace(ctx, req.LabId, req.StudentId); err != nil {
+			i.Logger.Errorf(err, "seed workspace of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		}
+	}
+	return i.getIDE(ctx, containerName, mountWorkSpace, canEdit, int8(req.Language), int8(req.IdeKind), req.Image)
 }
 
 func (i *IDEServer) GetIDEForTeacher(ctx context.Context, req *pb.GetIDEForTeacherRequest) (*pb.GetIDEResponse, error) {
 	containerName := define.GetContainerNameForTeacher(req.LabId, req.StudentId, req.TeacherId)
-	mountWorkSpace := getMountWorkSpace(req.LabId, req.StudentId)
-	return i.getIDE(ctx, containerName, mountWorkSpace, false, int8(req.Language))
+	mountWorkSpace := getViewWorkSpace(req.LabId, req.StudentId)
+	return i.getIDE(ctx, containerName, mountWorkSpace, false, int8(req.Language), define.IDEKindTheia, req.Image)
 }
 
-func (i *IDEServer) getIDE(ctx context.Context, containerName, mountWorkSpace string, canEdit bool, language int8) (*pb.GetIDEResponse, error) {
+func (i *IDEServer) getIDE(ctx context.Context, containerName, mountWorkSpace string, canEdit bool, language, ideKind int8, courseImage string) (*pb.GetIDEResponse, error) {
+	// 课程切换 IDE 类型后，同名的其他类型容器不再可用
+	label := ideKindLabel(ideKind)
+	if existing, ok := getContainerIDEKind(ctx, containerName); ok && existing != label {
+		if err := removeContainer(ctx, containerName); err != nil {
+			i.Logger.Errorf(err, "remove %q container failed for %q", existing, containerName)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	imageName := define.GetImageName(language, courseImage)
+	// 课程的自定义镜像构建完成或被替换后，以旧镜像创建的 Theia 容器不再复用
+	if label == "" {
+		if existing, ok := getContainerImage(ctx, containerName); ok && existing != imageName {
+			if err := removeContainer(ctx, containerName); err != nil {
+				i.Logger.Errorf(err, "remove container of image %q failed for %q", existing, containerName)
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
 	if isContainerAlive(ctx, containerName) {
 		port, err := getContainerPort(ctx, containerName)
 		if err != nil {
@@ -36,9 +62,9 @@ func (i *IDEServer) getIDE(ctx context.Context, containerName, mountWorkSpace st
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		i.Logger.Debugf("return port[%d] directly for active container name %q", port, containerName)
-		return &pb.GetIDEResponse{Port: uint32(port), IsReused: true, Token: token}, nil
+		return &pb.GetIDEResponse{Port: uint32(port), IsReused: true, Token: token, Path: ideEntryPath(label, token)}, nil
 	} else if isContainerStop(ctx, containerName) {
-		// 容器已停止
+		// 容器已停止（休眠）
 		port, err := getContainerPort(ctx, containerName)
 		if err != nil {
 			i.Logger.Errorf(err, "getContainerPort failed")
@@ -49,13 +75,17 @@ func (i *IDEServer) getIDE(ctx context.Context, containerName, mountWorkSpace st
 		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 		if err == nil {
 			listener.Close()
-			if err = startContainer(ctx, containerName); err == nil {
-				i.Logger.Debugf("return port[%d] directly for active container name %q", port, containerName)
+			if err = resumeContainer(ctx, containerName); err == nil {
+				if err := waitForIDE(ctx, label, containerName, uint16(port)); err != nil {
+					i.Logger.Errorf(err, "wait for resumed container %q failed", containerName)
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+				i.Logger.Debugf("return port[%d] for resumed container name %q", port, containerName)
 				token, err := getContainerToken(ctx, containerName)
 				if err != nil {
 					return nil, status.Error(codes.Internal, err.Error())
 				}
-				return &pb.GetIDEResponse{Port: uint32(port), Token: token}, nil
+				return &pb.GetIDEResponse{Port: uint32(port), Token: token, IsResumed: true, Path: ideEntryPath(label, token)}, nil
 			}
 			i.Logger.Errorf(err, "start container failed for %q", containerName)
 		}
@@ -65,7 +95,21 @@ func (i *IDEServer) getIDE(ctx context.Context, containerName, mountWorkSpace st
 		}
 	}
 
-	imageName := define.GetImageName(language)
+	if ideKind == define.IDEKindJupyter {
+		port, token, err := i.runJupyterContainer(ctx, containerName, mountWorkSpace, canEdit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &pb.GetIDEResponse{Port: uint32(port), Token: token, Path: ideEntryPath(label, token)}, nil
+	}
+
+	// 只读容器需以 ro 方式挂载，仅可编辑的容器从预热池中认领
+	if canEdit {
+		if port, token, ok := i.pool.Claim(ctx, imageName, containerName, mountWorkSpace); ok {
+			return &pb.GetIDEResponse{Port: uint32(port), Token: token}, nil
+		}
+	}
+
 	port, token, err := i.runTheiaContainer(ctx, imageName, containerName, mountWorkSpace, canEdit)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
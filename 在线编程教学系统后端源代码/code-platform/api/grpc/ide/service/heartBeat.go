@@ -9,6 +9,7 @@ import (
 	"code-platform/pkg/errorx"
 	"code-platform/pkg/osx"
 	"code-platform/pkg/strconvx"
+	"code-platform/service/ide/define"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,7 +18,7 @@ import (
 const listContainersNamesFormat = `{{.Names}}`
 
 func (i *IDEServer) GetContainerNames(ctx context.Context, _ *pb.Empty) (*pb.GetContainerNamesResponse, error) {
-	cmd := exec.CommandContext(ctx, "docker", "ps", "--format", listContainersNamesFormat)
+	cmd := exec.CommandContext(ctx, "docker", "ps", "--filter", "name=^"+define.ContainerNamePrefix, "--format", listContainersNamesFormat)
 	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
 	if err != nil {
 		i.Logger.Errorf(err, "docker ps --format by name failed with stderr %s", string(stderr))
@@ -50,11 +51,18 @@ func (i *IDEServer) RemoveContainer(ctx context.Context, req *pb.RemoveContainer
 	if len(req.ContainerNames) == 0 {
 		return &pb.Empty{}, nil
 	}
+	// 槽位只能从容器标签中读取，列出失败时仍移除容器，遗留的槽位由启动时的 reconcile 清理
+	slots, err := pooledSlots(ctx)
+	if err != nil {
+		i.Logger.Error(err, "list pooled containers failed")
+	}
+
 	args := append(append(make([]string, 0, len(req.ContainerNames)+2), "rm", "-f"), req.ContainerNames...)
 	cmd := exec.CommandContext(ctx, "docker", args...)
-	_, _, err := osx.CommandOutput(ctx, cmd)
+	_, _, err = osx.CommandOutput(ctx, cmd)
 	switch err {
 	case nil:
+		releasePoolSlots(ctx, slots, req.ContainerNames...)
 	case errorx.ErrWrongCode:
 		// 容器不存在？应该不太可能出现
 		i.Logger.Debugf("sweater remove containers %v but received exit status 1", req.ContainerNames)
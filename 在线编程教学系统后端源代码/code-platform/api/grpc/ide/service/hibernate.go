@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/osx"
+	"code-platform/pkg/strconvx"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const listHibernatedFormat = `{{.Names}}\t{{.Size}}`
+
+// HibernateContainer 停止但保留容器，容器内安装的依赖与终端历史得以保留，下次打开时直接恢复
+func (i *IDEServer) HibernateContainer(ctx context.Context, req *pb.HibernateContainerRequest) (*pb.Empty, error) {
+	if len(req.ContainerNames) == 0 {
+		return &pb.Empty{}, nil
+	}
+
+	// 休眠前为工作区保存一次快照
+	infos := make([]*pb.GetContainerNamesResponse_ContainerNameInfo, 0, len(req.ContainerNames))
+	for _, name := range req.ContainerNames {
+		labID, studentID, _, err := containerNameToIDs(name)
+		if err != nil {
+			i.Logger.Errorf(err, "convert container name %q failed", name)
+			continue
+		}
+		infos = append(infos, &pb.GetContainerNamesResponse_ContainerNameInfo{LabId: labID, StudentId: studentID})
+	}
+	i.snapshotContainers(ctx, infos, define.SnapshotReasonHibernate)
+
+	// --restart=always 的容器在 docker daemon 重启时会被拉起，休眠期间需关闭自动重启
+	args := append([]string{"update", "--restart=no"}, req.ContainerNames...)
+	if err := runCommand(ctx, "docker", args...); err != nil {
+		i.Logger.Errorf(err, "disable restart policy for containers %v failed", req.ContainerNames)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	args = append([]string{"stop", "-t", "3"}, req.ContainerNames...)
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	_, stderr, err := osx.CommandOutput(ctx, cmd)
+	switch err {
+	case nil:
+	case errorx.ErrWrongCode:
+		i.Logger.Debugf("sweater hibernate containers %v but received exit status 1", req.ContainerNames)
+		return nil, status.Errorf(codes.NotFound, "container %v is not found", req.ContainerNames)
+	default:
+		i.Logger.Errorf(err, "sweater hibernate containers %v failed for %s", req.ContainerNames, string(stderr))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+// resumeContainer 恢复休眠容器并重新开启自动重启
+func resumeContainer(ctx context.Context, containerName string) error {
+	if err := startContainer(ctx, containerName); err != nil {
+		return err
+	}
+	return runCommand(ctx, "docker", "update", "--restart=always", containerName)
+}
+
+type hibernatedContainer struct {
+	stoppedAt time.Time
+	name      string
+	size      int64
+}
+
+// CollectHibernatedContainers 周期性回收休眠容器
+func (i *IDEServer) CollectHibernatedContainers(ctx context.Context) {
+	ticker := time.NewTicker(config.IDEServer.GetDuration("hibernate.gc_interval"))
+	defer ticker.Stop()
+	for {
+		if err := i.collectHibernatedContainers(ctx); err != nil {
+			i.Logger.Error(err, "collect hibernated containers failed")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (i *IDEServer) collectHibernatedContainers(ctx context.Context) error {
+	budget, err := parseDockerSize(config.IDEServer.GetString("hibernate.disk_budget"))
+	if err != nil {
+		return err
+	}
+
+	containers, err := listHibernatedContainers(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := selectHibernatedToCollect(containers, time.Now(), config.IDEServer.GetDuration("hibernate.max_age"), budget)
+	if len(names) == 0 {
+		return nil
+	}
+
+	slots, err := pooledSlots(ctx)
+	if err != nil {
+		return err
+	}
+	if err := runCommand(ctx, "docker", append([]string{"rm", "-f"}, names...)...); err != nil {
+		return err
+	}
+	releasePoolSlots(ctx, slots, names...)
+	i.Logger.Debugf("collect hibernated containers %v", names)
+	return nil
+}
+
+func listHibernatedContainers(ctx context.Context) ([]*hibernatedContainer, error) {
+	cmd := exec.CommandContext(ctx, "docker", "ps", "-a", "--size",
+		"--filter", "status=exited", "--filter", "name=^"+define.ContainerNamePrefix,
+		"--format", listHibernatedFormat)
+	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("run command %q failed: %v\n%s", cmd.String(), err, stderr)
+	}
+
+	rows := strings.Split(strconvx.BytesToString(stdout), "\n")
+	containers := make([]*hibernatedContainer, 0, len(rows))
+	for _, row := range rows {
+		cols := strings.Split(row, "\t")
+		if len(cols) != 2 {
+			continue
+		}
+		// 形如 "12.3MB (virtual 1.2GB)"，仅统计可写层大小
+		sizeSlice := strings.Fields(cols[1])
+		if len(sizeSlice) == 0 {
+			return nil, fmt.Errorf("%q is not a valid size", cols[1])
+		}
+		size, err := parseDockerSize(sizeSlice[0])
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, &hibernatedContainer{name: cols[0], size: size})
+	}
+	if len(containers) == 0 {
+		return nil, nil
+	}
+
+	args := make([]string, 0, len(containers)+3)
+	args = append(args, "inspect", "-f", `{{.Name}}{{"\t"}}{{.State.FinishedAt}}`)
+	index := make(map[string]*hibernatedContainer, len(containers))
+	for _, c := range containers {
+		args = append(args, c.name)
+		index[c.name] = c
+	}
+	cmd = exec.CommandContext(ctx, "docker", args...)
+	stdout, stderr, err = osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("run command %q failed: %v\n%s", cmd.String(), err, stderr)
+	}
+	for _, row := range strings.Split(strconvx.BytesToString(stdout), "\n") {
+		cols := strings.Split(row, "\t")
+		if len(cols) != 2 {
+			continue
+		}
+		c, ok := index[strings.TrimPrefix(cols[0], "/")]
+		if !ok {
+			continue
+		}
+		stoppedAt, err := time.Parse(time.RFC3339Nano, cols[1])
+		if err != nil {
+			return nil, fmt.Errorf("time parse %q failed for err %v", cols[1], err)
+		}
+		c.stoppedAt = stoppedAt
+	}
+	return containers, nil
+}
+
+// selectHibernatedToCollect 先回收超过 maxAge 的容器，剩余容器按停止时间由旧到新回收，直至总占用不超过 budget
+func selectHibernatedToCollect(containers []*hibernatedContainer, now time.Time, maxAge time.Duration, budget int64) []string {
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].stoppedAt.Before(containers[j].stoppedAt)
+	})
+
+	var total int64
+	for _, c := range containers {
+		total += c.size
+	}
+
+	names := make([]string, 0, len(containers))
+	for _, c := range containers {
+		if now.Sub(c.stoppedAt) <= maxAge && total <= budget {
+			break
+		}
+		names = append(names, c.name)
+		total -= c.size
+	}
+	return names
+}
+
+var dockerSizeUnits = map[string]int64{
+	"B":  1,
+	"kB": 1e3,
+	"KB": 1e3,
+	"MB": 1e6,
+	"GB": 1e9,
+	"TB": 1e12,
+}
+
+// parseDockerSize 解析 docker 输出的十进制容量，如 "0B"、"12.3MB"
+func parseDockerSize(s string) (int64, error) {
+	index := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if index <= 0 {
+		return 0, fmt.Errorf("%q is not a valid size", s)
+	}
+	unit, ok := dockerSizeUnits[s[index:]]
+	if !ok {
+		return 0, fmt.Errorf("%q is not a valid size", s)
+	}
+	value, err := strconv.ParseFloat(s[:index], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid size", s)
+	}
+	return int64(value * float64(unit)), nil
+}
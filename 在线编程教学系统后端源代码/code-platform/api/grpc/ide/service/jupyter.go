@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+
+	"code-platform/config"
+	"code-platform/pkg/osx"
+	"code-platform/pkg/randx"
+)
+
+const jupyterTokenEnv = "JUPYTER_TOKEN"
+
+// runJupyterContainer 启动 JupyterLab，工作区与 Theia 相同挂载在 /home/project，token 通过 JUPYTER_TOKEN 设置
+func (i *IDEServer) runJupyterContainer(ctx context.Context, containerName, mountWorkSpace string, canEdit bool) (uint16, string, error) {
+	port := getAvailablePort()
+
+	readOnlyOpt := "rw"
+	if !canEdit {
+		readOnlyOpt = "ro"
+	}
+
+	token, err := randx.NewRandCode(8)
+	if err != nil {
+		return 0, "", err
+	}
+
+	dockerRunCommand := fmt.Sprintf(
+		`run -d -u root --restart=always --label=%s=%s --cpus=%s --memory=%s --memory-swap=%s -e %s=%s -p %d:8888 -v %s:/home/project:%s -w /home/project --name=%s %s `+
+			`jupyter lab --allow-root --no-browser --ip=0.0.0.0 --port=8888 --ServerApp.root_dir=/home/project`,
+		ideKindLabelKey,
+		ideKindLabelJupyter,
+		config.IDEServer.GetString("jupyter.cpus"),
+		config.IDEServer.GetString("jupyter.memory"),
+		config.IDEServer.GetString("jupyter.memory_swap"),
+		jupyterTokenEnv,
+		token,
+		port,
+		mountWorkSpace,
+		readOnlyOpt,
+		containerName,
+		config.IDEServer.GetString("jupyter.image_name"),
+	)
+
+	cmd := exec.CommandContext(ctx, "docker", strings.Fields(dockerRunCommand)...)
+	if _, stderr, err := osx.CommandOutput(ctx, cmd); err != nil {
+		i.Logger.Errorf(err, "Failed to exec command %q for %s", cmd.String(), string(stderr))
+		return 0, "", err
+	}
+
+	if err := waitForJupyter(ctx, containerName, port); err != nil {
+		i.Logger.Errorf(err, "wait for container %q failed", containerName)
+		return 0, "", err
+	}
+	return port, token, nil
+}
+
+func waitForJupyter(ctx context.Context, containerName string, port uint16) error {
+	return waitForReady(ctx, containerName, port, isJupyterReady)
+}
+
+// isJupyterReady Jupyter 直接监听映射端口，以 /api 返回任意 HTTP 响应为准
+func isJupyterReady(ctx context.Context, _ string, port uint16) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://127.0.0.1:%d/api", port), nil)
+	if err != nil {
+		return false
+	}
+	resp, err := probeClient.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
+
+func getJupyterToken(ctx context.Context, containerName string) (string, error) {
+	cmd := exec.CommandContext(ctx, "docker", "inspect", "-f", `{{range .Config.Env}}{{println .}}{{end}}`, containerName)
+	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect env of container %q because of error %s", containerName, err.Error()+"\n"+string(stderr))
+	}
+	prefix := []byte(jupyterTokenEnv + "=")
+	for _, line := range bytes.Split(stdout, []byte{'\n'}) {
+		if bytes.HasPrefix(line, prefix) {
+			return string(bytes.TrimPrefix(line, prefix)), nil
+		}
+	}
+	return "", fmt.Errorf("token not found in env of container %q", containerName)
+}
+
+func isJupyterContainer(ctx context.Context, containerName string) bool {
+	label, ok := getContainerIDEKind(ctx, containerName)
+	return ok && label == ideKindLabelJupyter
+}
+
+// ideEntryPath 浏览器打开 IDE 的入口路径，Theia 的 token 由 cookie 携带，Jupyter 需放在查询参数中
+func ideEntryPath(label, token string) string {
+	if label == ideKindLabelJupyter {
+		return "/lab?token=" + token
+	}
+	return ""
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"net"
 
 	"code-platform/api/grpc/ide/pb"
@@ -13,23 +14,39 @@ import (
 
 type IDEServer struct {
 	Logger *log.Logger
+	pool   *containerPool
+	// testSlots 限制同时运行的测试沙箱数
+	testSlots chan struct{}
+	// buildSlots 限制同时构建的课程镜像数
+	buildSlots chan struct{}
 }
 
 func NewIDEServer(logger *log.Logger) *IDEServer {
 	return &IDEServer{
-		Logger: logger,
+		Logger:     logger,
+		pool:       newContainerPool(logger.Sub("pool")),
+		testSlots:  make(chan struct{}, config.IDEServer.GetInt("test.max_concurrent")),
+		buildSlots: make(chan struct{}, config.IDEServer.GetInt("image_build.max_concurrent")),
 	}
 }
 
 var _ pb.IDEServerServiceServer = (*IDEServer)(nil)
 
 func main() {
-	server := grpc.NewServer(grpc.UnaryInterceptor(
-		grpc_recovery.UnaryServerInterceptor(),
-	))
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(grpc_recovery.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(grpc_recovery.StreamServerInterceptor()),
+	)
 	ideServer := NewIDEServer(log.Sub("ide_server"))
 	pb.RegisterIDEServerServiceServer(server, ideServer)
 
+	// 启动预热容器池
+	go ideServer.pool.Run(context.Background())
+	// 回收休眠容器
+	go ideServer.CollectHibernatedContainers(context.Background())
+	// 周期性保存工作区快照
+	go ideServer.SnapshotWorkspaces(context.Background())
+
 	port := config.IDEServer.GetString("port")
 	address := "localhost:" + port
 	conn, err := net.Listen("tcp", address)
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"io"
+	"unicode/utf8"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/notebookx"
+)
+
+// viewNotebook 将 .ipynb 解析为单元格返回，源码、文本输出与图片共用 limit 字节，超出部分截断或省略；
+// 文件过大或解析失败时 ok 为 false，由调用方按普通文件返回
+func viewNotebook(r io.Reader, size, limit int64) (resp *pb.QuickViewFileResponse, ok bool, err error) {
+	if size > config.IDEServer.GetInt64("quick_view.max_notebook_file_size") {
+		return nil, false, nil
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, false, err
+	}
+	nb, err := notebookx.Parse(data)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	v := &notebookViewer{
+		remaining: limit,
+		maxOutput: config.IDEServer.GetInt("quick_view.max_notebook_output"),
+	}
+	notebook := &pb.Notebook{
+		Language: nb.Language,
+		Cells:    make([]*pb.Notebook_Cell, 0, len(nb.Cells)),
+	}
+	for _, cell := range nb.Cells {
+		pbCell := &pb.Notebook_Cell{
+			CellType:       cell.CellType,
+			Source:         v.take(cell.Source, len(cell.Source)),
+			ExecutionCount: int32(cell.ExecutionCount),
+			Outputs:        make([]*pb.Notebook_Output, 0, len(cell.Outputs)),
+		}
+		for _, output := range cell.Outputs {
+			pbOutput := &pb.Notebook_Output{
+				OutputType: output.OutputType,
+				Text:       v.take(output.Text, v.maxOutput),
+			}
+			pbOutput.Truncated = len(pbOutput.Text) < len(output.Text)
+			if output.Image != "" {
+				// 图片无法部分返回，放不下时整张省略
+				if int64(len(output.Image)) <= v.remaining {
+					pbOutput.Image, pbOutput.ImageMime = output.Image, output.ImageMIME
+					v.remaining -= int64(len(output.Image))
+				} else {
+					pbOutput.Truncated = true
+					v.truncated = true
+				}
+			}
+			pbCell.Outputs = append(pbCell.Outputs, pbOutput)
+		}
+		notebook.Cells = append(notebook.Cells, pbCell)
+	}
+
+	return &pb.QuickViewFileResponse{
+		Size:      size,
+		Encoding:  "utf-8",
+		Truncated: v.truncated,
+		Notebook:  notebook,
+	}, true, nil
+}
+
+type notebookViewer struct {
+	remaining int64
+	maxOutput int
+	truncated bool
+}
+
+// take 返回 s 在 max 与剩余额度内的前缀，不截断多字节字符
+func (v *notebookViewer) take(s string, max int) string {
+	n := len(s)
+	if n > max {
+		n = max
+	}
+	if int64(n) > v.remaining {
+		n = int(v.remaining)
+	}
+	if n < len(s) {
+		v.truncated = true
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+	}
+	v.remaining -= int64(n)
+	return s[:n]
+}
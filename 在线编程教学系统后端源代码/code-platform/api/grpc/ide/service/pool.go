@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"code-platform/config"
+	"code-platform/log"
+	"code-platform/pkg/osx"
+	"code-platform/pkg/randx"
+	"code-platform/service/ide/define"
+)
+
+const (
+	poolContainerNamePrefix = "theiapool-"
+	// poolSlotLabel 记录预热容器挂载的宿主机槽位目录，容器被认领、重命名后仍可据此释放挂载
+	poolSlotLabel = "code-platform.pool.slot"
+)
+
+var poolSlotBasePath = filepath.Join(define.InitBasePath, "pool")
+
+type pooledContainer struct {
+	name string
+	slot string
+	port uint16
+}
+
+// containerPool 预热容器池
+// docker 无法为运行中的容器追加挂载，因此预热容器以 rslave 方式挂载一个共享的槽位目录到 /home/project，
+// 认领时将学生工作区 bind mount 到槽位目录上，挂载会传播进容器；随后重命名容器并轮换 token
+type containerPool struct {
+	logger *log.Logger
+	mu     sync.Mutex
+	// idle imageName -> 空闲容器
+	idle map[string][]*pooledContainer
+}
+
+func newContainerPool(logger *log.Logger) *containerPool {
+	return &containerPool{
+		logger: logger,
+		idle:   make(map[string][]*pooledContainer),
+	}
+}
+
+// Run 清理上次运行遗留的预热容器，之后按周期补充各语言的空闲容器
+func (p *containerPool) Run(ctx context.Context) {
+	if err := p.reconcile(ctx); err != nil {
+		p.logger.Errorf(err, "reconcile pooled containers failed")
+	}
+
+	ticker := time.NewTicker(config.IDEServer.GetDuration("pool.refill_interval"))
+	defer ticker.Stop()
+	for {
+		p.refill(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Claim 取出一个空闲容器交给 containerName 使用，池为空或认领失败时返回 false
+func (p *containerPool) Claim(ctx context.Context, imageName, containerName, mountWorkSpace string) (uint16, string, bool) {
+	c := p.take(imageName)
+	if c == nil {
+		return 0, "", false
+	}
+
+	token, err := p.attach(ctx, c, containerName, mountWorkSpace)
+	if err != nil {
+		p.logger.Errorf(err, "claim pooled container %q for %q failed", c.name, containerName)
+		if err := destroyPooledContainer(ctx, c.name, c.slot); err != nil {
+			p.logger.Errorf(err, "destroy pooled container %q failed", c.name)
+		}
+		return 0, "", false
+	}
+
+	p.logger.Debugf("claim pooled container %q as %q on port[%d]", c.name, containerName, c.port)
+	return c.port, token, true
+}
+
+func (p *containerPool) take(imageName string) *pooledContainer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	containers := p.idle[imageName]
+	if len(containers) == 0 {
+		return nil
+	}
+	c := containers[0]
+	p.idle[imageName] = containers[1:]
+	return c
+}
+
+func (p *containerPool) put(imageName string, c *pooledContainer) {
+	p.mu.Lock()
+	p.idle[imageName] = append(p.idle[imageName], c)
+	p.mu.Unlock()
+}
+
+func (p *containerPool) idleCount(imageName string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.idle[imageName])
+}
+
+func (p *containerPool) refill(ctx context.Context) {
+	batch := config.IDEServer.GetInt("pool.refill_batch")
+	for language, imageName := range config.Theia.GetStringMapString("imageName") {
+		deficit := config.IDEServer.GetInt("pool.size."+language) - p.idleCount(imageName)
+		for ; deficit > 0 && batch > 0; deficit, batch = deficit-1, batch-1 {
+			c, err := p.start(ctx, imageName)
+			if err != nil {
+				p.logger.Errorf(err, "start pooled container for image %q failed", imageName)
+				break
+			}
+			p.put(imageName, c)
+		}
+	}
+}
+
+func (p *containerPool) start(ctx context.Context, imageName string) (*pooledContainer, error) {
+	code, err := randx.NewRandCode(12)
+	if err != nil {
+		return nil, err
+	}
+	name := poolContainerNamePrefix + strings.ToLower(code)
+	slot := filepath.Join(poolSlotBasePath, name)
+
+	if err := os.MkdirAll(slot, os.ModePerm); err != nil {
+		return nil, err
+	}
+	// 槽位目录自绑定并设为 shared，之后在其上的挂载才会传播到容器内
+	if err := runCommand(ctx, "mount", "--bind", slot, slot); err != nil {
+		return nil, err
+	}
+	if err := runCommand(ctx, "mount", "--make-shared", slot); err != nil {
+		unmountSlot(ctx, slot)
+		return nil, err
+	}
+
+	token, err := randx.NewRandCode(8)
+	if err != nil {
+		unmountSlot(ctx, slot)
+		return nil, err
+	}
+	port := getAvailablePort()
+
+	dockerRunCommand := fmt.Sprintf(
+		`run -d -u root --restart=always %s -e token=%s -p %d:10443 --label %s=%s -v %s:/home/project:rw,rslave --name=%s %s`,
+		theiaResourceOpts,
+		token,
+		port,
+		poolSlotLabel,
+		slot,
+		slot,
+		name,
+		imageName,
+	)
+	if err := runCommand(ctx, "docker", strings.Fields(dockerRunCommand)...); err != nil {
+		unmountSlot(ctx, slot)
+		return nil, err
+	}
+
+	if err := waitForTheia(ctx, name, port); err != nil {
+		if err := destroyPooledContainer(ctx, name, slot); err != nil {
+			p.logger.Errorf(err, "destroy pooled container %q failed", name)
+		}
+		return nil, err
+	}
+
+	p.logger.Debugf("pooled container %q for image %q is ready on port[%d]", name, imageName, port)
+	return &pooledContainer{name: name, slot: slot, port: port}, nil
+}
+
+// attach 挂载工作区、重命名容器并轮换 token
+func (p *containerPool) attach(ctx context.Context, c *pooledContainer, containerName, mountWorkSpace string) (string, error) {
+	if err := os.MkdirAll(mountWorkSpace, os.ModePerm); err != nil {
+		return "", err
+	}
+	if err := runCommand(ctx, "mount", "--bind", mountWorkSpace, c.slot); err != nil {
+		return "", err
+	}
+	if err := runCommand(ctx, "docker", "rename", c.name, containerName); err != nil {
+		return "", err
+	}
+	c.name = containerName
+
+	// 预热期间的 token 可能已被他人获取
+	return rotateContainerToken(ctx, containerName, c.port)
+}
+
+// rotateContainerToken 写入新 token 并结束认证代理，代理读取新 token 后重启
+func rotateContainerToken(ctx context.Context, containerName string, port uint16) (string, error) {
+	token, err := randx.NewRandCode(8)
+	if err != nil {
+		return "", err
+	}
+	if err := runCommand(ctx, "docker", "exec", containerName, "sh", "-c",
+		`printf %s "$0" > /home/theia/ssl/token && kill $(cat /home/theia/ssl/proxy.pid)`, token); err != nil {
+		return "", err
+	}
+	if err := waitForTheia(ctx, containerName, port); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// reconcile 池状态仅保存在内存中，启动时移除上次遗留的空闲容器，以及挂载已丢失的已认领容器
+func (p *containerPool) reconcile(ctx context.Context) error {
+	slots, err := pooledSlots(ctx)
+	if err != nil {
+		return err
+	}
+
+	inUse := make(map[string]struct{}, len(slots))
+	for name, slot := range slots {
+		if strings.HasPrefix(name, poolContainerNamePrefix) || mountCount(slot) < 2 {
+			if err := destroyPooledContainer(ctx, name, slot); err != nil {
+				p.logger.Errorf(err, "destroy pooled container %q failed", name)
+			}
+			continue
+		}
+		inUse[slot] = struct{}{}
+	}
+
+	entries, err := os.ReadDir(poolSlotBasePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		slot := filepath.Join(poolSlotBasePath, entry.Name())
+		if _, ok := inUse[slot]; !ok {
+			unmountSlot(ctx, slot)
+		}
+	}
+	return nil
+}
+
+// pooledSlots 返回所有来自预热池的容器（含已被认领的）名称与槽位目录
+func pooledSlots(ctx context.Context) (map[string]string, error) {
+	cmd := exec.CommandContext(ctx, "docker", "ps", "-a", "--filter", "label="+poolSlotLabel, "--format", `{{.Names}}\t{{.Label "`+poolSlotLabel+`"}}`)
+	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return nil, errors.New(err.Error() + "\n" + string(stderr))
+	}
+
+	slots := make(map[string]string)
+	for _, row := range strings.Split(string(stdout), "\n") {
+		cols := strings.Split(row, "\t")
+		if len(cols) != 2 || cols[1] == "" {
+			continue
+		}
+		slots[cols[0]] = cols[1]
+	}
+	return slots, nil
+}
+
+// releasePoolSlots 在移除容器后释放其槽位挂载，非预热池容器忽略
+func releasePoolSlots(ctx context.Context, slots map[string]string, containerNames ...string) {
+	for _, name := range containerNames {
+		if slot, ok := slots[name]; ok {
+			unmountSlot(ctx, slot)
+		}
+	}
+}
+
+func destroyPooledContainer(ctx context.Context, name, slot string) error {
+	err := runCommand(ctx, "docker", "rm", "-f", name)
+	unmountSlot(ctx, slot)
+	return err
+}
+
+// unmountSlot 依次卸载工作区挂载与槽位自绑定，然后删除槽位目录
+func unmountSlot(ctx context.Context, slot string) {
+	for counter := mountCount(slot); counter > 0; counter-- {
+		if err := runCommand(ctx, "umount", slot); err != nil {
+			log.Errorf(err, "umount slot %q failed", slot)
+			return
+		}
+	}
+	if err := os.Remove(slot); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Errorf(err, "remove slot %q failed", slot)
+	}
+}
+
+// mountCount 返回以 path 为挂载点的挂载层数
+func mountCount(path string) int {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	var counter int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 4 && fields[4] == path {
+			counter++
+		}
+	}
+	return counter
+}
+
+func runCommand(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	if _, stderr, err := osx.CommandOutput(ctx, cmd); err != nil {
+		return fmt.Errorf("run command %q failed: %v\n%s", cmd.String(), err, stderr)
+	}
+	return nil
+}
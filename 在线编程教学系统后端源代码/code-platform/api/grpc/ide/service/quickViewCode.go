@@ -3,104 +3,286 @@ package main
 import (
 	"bytes"
 	"context"
-	"fmt"
+	"errors"
 	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"code-platform/api/grpc/ide/pb"
-	"code-platform/pkg/filex"
+	"code-platform/config"
+	"code-platform/pkg/charsetx"
+	"code-platform/pkg/ignorex"
+	"code-platform/pkg/notebookx"
 	"code-platform/pkg/osx"
+	"code-platform/pkg/strconvx"
 	"code-platform/service/ide/define"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// binarySniffLen 与 git 一致，前 8000 字节内出现 NUL 即视为二进制文件
+const binarySniffLen = 8000
+
+// QuickViewCode 返回按配置截断的学生工作区目录树；with_content 为 false 时仅含元数据，文件内容通过 QuickViewFile 按需获取
 func (i *IDEServer) QuickViewCode(ctx context.Context, req *pb.QuickViewCodeRequest) (*pb.QuickViewCodeResponse, error) {
-	codePath := filepath.Join(
-		define.InitBasePath, "codespaces",
-		fmt.Sprintf("workspace-%d", req.GetLabId()),
-		strconv.FormatUint(req.GetUserId(), 10),
-	)
+	codePath := getViewWorkSpace(req.GetLabId(), req.GetUserId())
 
 	fileInfo, err := os.Stat(codePath)
 	switch {
 	case err == nil:
 	case os.IsNotExist(err):
-		return &pb.QuickViewCodeResponse{RootNode: &pb.QuickViewCodeResponse_FileNode{}}, nil
+		return &pb.QuickViewCodeResponse{RootNode: &pb.QuickViewCodeResponse_FileNode{IsDir: true}}, nil
 	default:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	root, err := buildTreeNodeForDir(codePath, fileInfo.Name())
+	builder := &treeBuilder{
+		fsys:        os.DirFS(codePath),
+		matcher:     ignorex.New(config.IDEServer.GetStringSlice("quick_view.ignore")...),
+		withContent: req.GetWithContent(),
+		maxFileSize: config.IDEServer.GetInt64("quick_view.max_file_size"),
+		remaining:   config.IDEServer.GetInt64("quick_view.max_total_size"),
+		maxEntries:  config.IDEServer.GetInt("quick_view.max_entries"),
+	}
+	root, err := builder.buildDir(ctx, codePath, "", fileInfo.Name())
 	if err != nil {
+		i.Logger.Errorf(err, "build tree node for %q failed", codePath)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &pb.QuickViewCodeResponse{RootNode: root}, nil
+	return &pb.QuickViewCodeResponse{RootNode: root, Truncated: builder.truncated}, nil
 }
 
-func buildTreeNodeForDir(codePath string, name string) (*pb.QuickViewCodeResponse_FileNode, error) {
-	childs, err := os.ReadDir(codePath)
+// QuickViewFile 读取工作区内单个文件，超过大小上限的部分被截断
+func (i *IDEServer) QuickViewFile(ctx context.Context, req *pb.QuickViewFileRequest) (*pb.QuickViewFileResponse, error) {
+	filePath, err := resolveWorkspacePath(getViewWorkSpace(req.GetLabId(), req.GetUserId()), req.GetPath())
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	limit := config.IDEServer.GetInt64("quick_view.max_file_size")
+	// notebook 的图片输出远大于源码，单独查看时使用更高的上限
+	if notebookx.IsNotebook(filePath) {
+		limit = config.IDEServer.GetInt64("quick_view.max_notebook_size")
+	}
+	resp, err := readFileForView(filePath, limit)
+	switch {
+	case err == nil:
+		return resp, nil
+	case errors.Is(err, errIsDir):
+		return nil, status.Errorf(codes.InvalidArgument, "%q is a directory", req.GetPath())
+	case errors.Is(err, os.ErrNotExist):
+		return nil, workspaceStatusError(err)
+	default:
+		i.Logger.Errorf(err, "read file %q for view failed", filePath)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+}
+
+type treeBuilder struct {
+	// fsys 以工作区为根，用于读取各级 .gitignore
+	fsys        fs.FS
+	matcher     *ignorex.Matcher
+	withContent bool
+	maxFileSize int64
+	// remaining 剩余可返回的文件内容字节数
+	remaining  int64
+	entries    int
+	maxEntries int
+	truncated  bool
+}
+
+// buildDir 构建 dir 的子树，rel 为 dir 相对工作区根目录的路径
+func (b *treeBuilder) buildDir(ctx context.Context, dir, rel, name string) (*pb.QuickViewCodeResponse_FileNode, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := addGitignore(b.matcher, b.fsys, rel); err != nil {
+		return nil, err
+	}
+
+	children, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, err
 	}
 
 	root := &pb.QuickViewCodeResponse_FileNode{
 		Name:       name,
+		Path:       rel,
 		IsDir:      true,
-		ChildNodes: make([]*pb.QuickViewCodeResponse_FileNode, 0, len(childs)),
+		ChildNodes: make([]*pb.QuickViewCodeResponse_FileNode, 0, len(children)),
 	}
 
-	for _, child := range childs {
+	for _, child := range children {
+		// 不跟随符号链接，避免读取工作区之外的文件
+		if child.Type()&os.ModeSymlink != 0 {
+			continue
+		}
 		childName := child.Name()
+		childRel := path.Join(rel, childName)
+		if b.matcher.Match(childRel, child.IsDir()) {
+			continue
+		}
+
+		if b.entries >= b.maxEntries {
+			root.Truncated = true
+			b.truncated = true
+			break
+		}
+		b.entries++
+
+		childPath := filepath.Join(dir, childName)
 		if child.IsDir() {
-			childNode, err := buildTreeNodeForDir(filepath.Join(codePath, childName), childName)
+			childNode, err := b.buildDir(ctx, childPath, childRel, childName)
 			if err != nil {
 				return nil, err
 			}
 			root.ChildNodes = append(root.ChildNodes, childNode)
-		} else {
-			// 判断是否有后缀名
-			childNameParts := strings.Split(childName, ".")
-			if len(childNameParts) >= 2 {
-				childFile, err := os.Open(filepath.Join(codePath, childName))
-				if err != nil {
-					return nil, err
-				}
-
-				// 避免直接读取二进制文件
-				isBinary, err := filex.IsBinary(childFile)
-				if err != nil {
-					childFile.Close()
-					return nil, err
-				}
-
-				var content string = "二进制文件"
-				if !isBinary {
-					data, err := io.ReadAll(childFile)
-					if err != nil {
-						childFile.Close()
-						return nil, err
-					}
-					content = string(data)
-				}
-				childFile.Close()
-
-				root.ChildNodes = append(root.ChildNodes, &pb.QuickViewCodeResponse_FileNode{
-					Name:    childName,
-					IsDir:   false,
-					Content: content,
-				})
+			continue
+		}
 
-			}
+		childNode, err := b.buildFile(childPath, childRel, childName)
+		switch {
+		case err == nil:
+			root.ChildNodes = append(root.ChildNodes, childNode)
+		case errors.Is(err, os.ErrNotExist):
+			// 读取期间被删除
+		default:
+			return nil, err
 		}
 	}
 	return root, nil
 }
 
+func (b *treeBuilder) buildFile(filePath, rel, name string) (*pb.QuickViewCodeResponse_FileNode, error) {
+	if !b.withContent {
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.QuickViewCodeResponse_FileNode{Name: name, Path: rel, Size: info.Size()}, nil
+	}
+
+	limit := b.maxFileSize
+	if b.remaining < limit {
+		limit = b.remaining
+	}
+	view, err := readFileForView(filePath, limit)
+	if err != nil {
+		return nil, err
+	}
+	if view.Notebook != nil {
+		b.remaining -= int64(proto.Size(view.Notebook))
+	} else {
+		b.remaining -= int64(len(view.Content))
+	}
+	// 单文件超限仅标记该文件，总量耗尽时才标记整棵树不完整
+	if view.Truncated && limit < b.maxFileSize {
+		b.truncated = true
+	}
+
+	return &pb.QuickViewCodeResponse_FileNode{
+		Name:      name,
+		Path:      rel,
+		Content:   view.Content,
+		Size:      view.Size,
+		IsBinary:  view.IsBinary,
+		Truncated: view.Truncated,
+		Encoding:  view.Encoding,
+		Notebook:  view.Notebook,
+	}, nil
+}
+
+// addGitignore 读取 fsys 中 rel 目录下的 .gitignore，规则仅作用于该目录
+func addGitignore(matcher *ignorex.Matcher, fsys fs.FS, rel string) error {
+	data, err := fs.ReadFile(fsys, path.Join(rel, ".gitignore"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	matcher.Add(rel, strings.Split(strconvx.BytesToString(data), "\n")...)
+	return nil
+}
+
+var errIsDir = errors.New("is a directory")
+
+// readFileForView 读取文件前 limit 字节，识别二进制文件与 UTF-8、GBK 编码，统一以 UTF-8 返回；
+// .ipynb 文件解析为单元格返回
+func readFileForView(filePath string, limit int64) (*pb.QuickViewFileResponse, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, errIsDir
+	}
+
+	if notebookx.IsNotebook(info.Name()) {
+		resp, ok, err := viewNotebook(f, info.Size(), limit)
+		if err != nil || ok {
+			return resp, err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+	return viewContent(f, info.Size(), limit)
+}
+
+// viewContent 见 readFileForView，size 为 r 的总长度
+func viewContent(r io.Reader, size, limit int64) (*pb.QuickViewFileResponse, error) {
+	if limit < 0 {
+		limit = 0
+	}
+	data, err := io.ReadAll(io.LimitReader(r, limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.QuickViewFileResponse{
+		Size:      size,
+		Truncated: int64(len(data)) < size,
+	}
+	sniff := data
+	if len(sniff) > binarySniffLen {
+		sniff = sniff[:binarySniffLen]
+	}
+	if bytes.IndexByte(sniff, 0) >= 0 {
+		resp.IsBinary = true
+		return resp, nil
+	}
+
+	// 截断处可能落在多字节字符中间，逐字节回退直至能够解码
+	maxCut := 0
+	if resp.Truncated {
+		maxCut = utf8.UTFMax - 1
+	}
+	for cut := 0; cut <= maxCut && cut <= len(data); cut++ {
+		content, encoding, ok := charsetx.ToUTF8(data[:len(data)-cut])
+		if ok {
+			resp.Content, resp.Encoding = content, encoding
+			return resp, nil
+		}
+	}
+
+	resp.IsBinary = true
+	return resp, nil
+}
+
 func (i *IDEServer) GenerateTestFileForViewCode(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
 	pythonBuf := bytes.NewBufferString(`
 	print("hello world")
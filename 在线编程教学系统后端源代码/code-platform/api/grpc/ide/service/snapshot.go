@@ -0,0 +1,648 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const manifestExt = ".pb"
+
+// snapshotBasePath 快照不放在 codespaces 下，避免随工作区挂载进容器
+var snapshotBasePath = filepath.Join(define.InitBasePath, "snapshots")
+
+// workspaceLocks 同一工作区的快照与恢复串行执行
+var workspaceLocks sync.Map
+
+func lockWorkspace(labID, studentID uint64) func() {
+	value, _ := workspaceLocks.LoadOrStore(fmt.Sprintf("%d-%d", labID, studentID), &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// snapshotStore 单个工作区的快照仓库，objects 下按 sha256 存储文件内容，manifests 下按快照 ID 存储清单
+type snapshotStore struct {
+	root string
+}
+
+func getSnapshotStore(labID, studentID uint64) *snapshotStore {
+	return &snapshotStore{root: filepath.Join(snapshotBasePath, fmt.Sprintf("workspace-%d", labID), strconv.FormatUint(studentID, 10))}
+}
+
+func (s *snapshotStore) objectsDir() string {
+	return filepath.Join(s.root, "objects")
+}
+
+func (s *snapshotStore) objectPath(hash string) string {
+	return filepath.Join(s.objectsDir(), hash[:2], hash)
+}
+
+func (s *snapshotStore) manifestsDir() string {
+	return filepath.Join(s.root, "manifests")
+}
+
+// manifestIDs 返回全部快照 ID，由新到旧
+func (s *snapshotStore) manifestIDs() ([]int64, error) {
+	entries, err := os.ReadDir(s.manifestsDir())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		id, err := strconv.ParseInt(strings.TrimSuffix(entry.Name(), manifestExt), 10, 64)
+		if err != nil || !strings.HasSuffix(entry.Name(), manifestExt) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	return ids, nil
+}
+
+func (s *snapshotStore) manifest(id int64) (*pb.SnapshotManifest, error) {
+	data, err := os.ReadFile(filepath.Join(s.manifestsDir(), strconv.FormatInt(id, 10)+manifestExt))
+	if err != nil {
+		return nil, err
+	}
+	var manifest pb.SnapshotManifest
+	if err := proto.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func (s *snapshotStore) latest() (*pb.SnapshotManifest, error) {
+	ids, err := s.manifestIDs()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	return s.manifest(ids[0])
+}
+
+func (s *snapshotStore) saveManifest(manifest *pb.SnapshotManifest) error {
+	data, err := proto.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(s.manifestsDir(), strconv.FormatInt(manifest.Id, 10)+manifestExt), data)
+}
+
+// putObject 将文件复制进仓库并返回其 sha256，边复制边计算摘要，避免复制期间文件被修改导致内容与摘要不一致
+func (s *snapshotStore) putObject(filePath string) (string, error) {
+	src, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(s.objectsDir(), os.ModePerm); err != nil {
+		return "", err
+	}
+	tmp, err := os.CreateTemp(s.objectsDir(), ".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), src); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	objectPath := s.objectPath(sum)
+	if _, err := os.Stat(objectPath); err == nil {
+		return sum, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(objectPath), os.ModePerm); err != nil {
+		return "", err
+	}
+	return sum, os.Rename(tmp.Name(), objectPath)
+}
+
+// snapshotWorkspace 为工作区创建快照，与最近一次快照内容相同时直接返回最近一次快照，调用方需持有工作区锁并在之后清理旧快照
+func snapshotWorkspace(ctx context.Context, labID, studentID uint64, reason string) (*pb.SnapshotManifest, error) {
+	root := getMountWorkSpace(labID, studentID)
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+
+	store := getSnapshotStore(labID, studentID)
+	latest, err := store.latest()
+	if err != nil {
+		return nil, err
+	}
+	previous := make(map[string]*pb.SnapshotManifest_File)
+	if latest != nil {
+		for _, file := range latest.Files {
+			previous[file.Path] = file
+		}
+	}
+
+	files, truncated, err := listWorkspaceFiles(ctx, os.DirFS(root), config.IDEServer.GetInt("snapshot.max_files"))
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	manifest := &pb.SnapshotManifest{
+		Reason: reason,
+		Files:  make([]*pb.SnapshotManifest_File, 0, len(paths)),
+	}
+	if truncated {
+		manifest.Skipped++
+	}
+	maxFileSize := config.IDEServer.GetInt64("snapshot.max_file_size")
+	for _, p := range paths {
+		info := files[p]
+		if info.Size() > maxFileSize {
+			manifest.Skipped++
+			continue
+		}
+
+		file := &pb.SnapshotManifest_File{Path: p, Size: info.Size(), ModifiedAt: info.ModTime().UnixNano()}
+		if prev, ok := previous[p]; ok && prev.Size == file.Size && prev.ModifiedAt == file.ModifiedAt {
+			file.Hash = prev.Hash
+		} else {
+			hash, err := store.putObject(filepath.Join(root, filepath.FromSlash(p)))
+			switch {
+			case err == nil:
+			case errors.Is(err, os.ErrNotExist):
+				// 快照期间被删除
+				continue
+			default:
+				return nil, err
+			}
+			file.Hash = hash
+		}
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	if latest != nil && sameSnapshotFiles(latest, manifest) {
+		return latest, nil
+	}
+
+	manifest.Id = time.Now().UnixMilli()
+	if latest != nil && manifest.Id <= latest.Id {
+		manifest.Id = latest.Id + 1
+	}
+	if err := store.saveManifest(manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func sameSnapshotFiles(a, b *pb.SnapshotManifest) bool {
+	if len(a.Files) != len(b.Files) {
+		return false
+	}
+	for index, file := range a.Files {
+		if file.Path != b.Files[index].Path || file.Hash != b.Files[index].Hash {
+			return false
+		}
+	}
+	return true
+}
+
+// prune 保留最近 keep_last 个快照及 keep_days 天内每天最后一个快照，其余快照删除后回收不再被引用的文件
+func (s *snapshotStore) prune(now time.Time) error {
+	ids, err := s.manifestIDs()
+	if err != nil {
+		return err
+	}
+
+	keepLast := config.IDEServer.GetInt("snapshot.keep_last")
+	since := now.AddDate(0, 0, -config.IDEServer.GetInt("snapshot.keep_days"))
+	days := make(map[string]struct{})
+	var removed bool
+	for index, id := range ids {
+		createdAt := time.UnixMilli(id)
+		day := createdAt.Format("2006-01-02")
+		_, seen := days[day]
+		days[day] = struct{}{}
+		if index < keepLast || (!seen && createdAt.After(since)) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.manifestsDir(), strconv.FormatInt(id, 10)+manifestExt)); err != nil {
+			return err
+		}
+		removed = true
+	}
+	if !removed {
+		return nil
+	}
+	return s.collectObjects()
+}
+
+func (s *snapshotStore) collectObjects() error {
+	ids, err := s.manifestIDs()
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]struct{})
+	for _, id := range ids {
+		manifest, err := s.manifest(id)
+		if err != nil {
+			return err
+		}
+		for _, file := range manifest.Files {
+			referenced[file.Hash] = struct{}{}
+		}
+	}
+
+	return filepath.WalkDir(s.objectsDir(), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if _, ok := referenced[d.Name()]; ok {
+			return nil
+		}
+		return os.Remove(p)
+	})
+}
+
+func snapshotToPB(manifest *pb.SnapshotManifest) *pb.WorkspaceSnapshot {
+	snapshot := &pb.WorkspaceSnapshot{
+		Id:        manifest.Id,
+		Reason:    manifest.Reason,
+		FileCount: int32(len(manifest.Files)),
+		Skipped:   manifest.Skipped,
+	}
+	for _, file := range manifest.Files {
+		snapshot.TotalSize += file.Size
+	}
+	return snapshot
+}
+
+func (i *IDEServer) SnapshotWorkspace(ctx context.Context, req *pb.SnapshotWorkspaceRequest) (*pb.WorkspaceSnapshot, error) {
+	unlock := lockWorkspace(req.LabId, req.StudentId)
+	defer unlock()
+
+	manifest, err := snapshotWorkspace(ctx, req.LabId, req.StudentId, req.Reason)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			i.Logger.Errorf(err, "snapshot workspace of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		}
+		return nil, workspaceStatusError(err)
+	}
+	if err := getSnapshotStore(req.LabId, req.StudentId).prune(time.Now()); err != nil {
+		i.Logger.Errorf(err, "prune snapshots of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+	}
+	return snapshotToPB(manifest), nil
+}
+
+func (i *IDEServer) ListWorkspaceSnapshots(ctx context.Context, req *pb.ListWorkspaceSnapshotsRequest) (*pb.ListWorkspaceSnapshotsResponse, error) {
+	store := getSnapshotStore(req.LabId, req.StudentId)
+	ids, err := store.manifestIDs()
+	if err != nil {
+		i.Logger.Errorf(err, "list snapshots of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	snapshots := make([]*pb.WorkspaceSnapshot, 0, len(ids))
+	for _, id := range ids {
+		manifest, err := store.manifest(id)
+		switch {
+		case err == nil:
+			snapshots = append(snapshots, snapshotToPB(manifest))
+		case errors.Is(err, os.ErrNotExist):
+			// 读取期间被清理
+		default:
+			i.Logger.Errorf(err, "read snapshot[%d] of labID[%d] and studentID[%d] failed", id, req.LabId, req.StudentId)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &pb.ListWorkspaceSnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+// RestoreWorkspaceSnapshot 先为当前工作区创建快照，再将工作区恢复为目标快照；被忽略的文件与过大未纳入快照的文件保持不变
+func (i *IDEServer) RestoreWorkspaceSnapshot(ctx context.Context, req *pb.RestoreWorkspaceSnapshotRequest) (*pb.RestoreWorkspaceSnapshotResponse, error) {
+	unlock := lockWorkspace(req.LabId, req.StudentId)
+	defer unlock()
+
+	if isWorkspaceFrozen(req.LabId, req.StudentId) {
+		return nil, workspaceStatusError(errWorkspaceFrozen)
+	}
+	store := getSnapshotStore(req.LabId, req.StudentId)
+	target, err := store.manifest(req.SnapshotId)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	root := getMountWorkSpace(req.LabId, req.StudentId)
+	resp := &pb.RestoreWorkspaceSnapshotResponse{}
+	current := make(map[string]string)
+	safety, err := snapshotWorkspace(ctx, req.LabId, req.StudentId, define.SnapshotReasonPreRestore)
+	switch {
+	case err == nil:
+		resp.SafetySnapshotId = safety.Id
+		for _, file := range safety.Files {
+			current[file.Path] = file.Hash
+		}
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(root, os.ModePerm); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	default:
+		i.Logger.Errorf(err, "snapshot workspace of labID[%d] and studentID[%d] before restore failed", req.LabId, req.StudentId)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	wanted := make(map[string]struct{}, len(target.Files))
+	for _, file := range target.Files {
+		wanted[file.Path] = struct{}{}
+		if current[file.Path] == file.Hash {
+			continue
+		}
+		if err := restoreObject(store.objectPath(file.Hash), root, file.Path); err != nil {
+			i.Logger.Errorf(err, "restore %q of labID[%d] and studentID[%d] failed", file.Path, req.LabId, req.StudentId)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	// 仅删除安全快照中存在的文件，确保删除的内容可以找回
+	for p := range current {
+		if _, ok := wanted[p]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(root, filepath.FromSlash(p))); err != nil && !errors.Is(err, os.ErrNotExist) {
+			i.Logger.Errorf(err, "remove %q of labID[%d] and studentID[%d] failed", p, req.LabId, req.StudentId)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// 恢复完成后再清理，避免目标快照引用的文件被提前回收
+	if err := store.prune(time.Now()); err != nil {
+		i.Logger.Errorf(err, "prune snapshots of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+	}
+	return resp, nil
+}
+
+func restoreObject(objectPath, root, rel string) error {
+	filePath, err := resolveWorkspacePath(root, rel)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	src, err := os.Open(objectPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmp, err := os.CreateTemp(dir, ".tmp-"+filepath.Base(filePath)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, src); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+// SnapshotWorkspaces 周期性为运行中的学生容器对应的工作区创建快照
+func (i *IDEServer) SnapshotWorkspaces(ctx context.Context) {
+	ticker := time.NewTicker(config.IDEServer.GetDuration("snapshot.interval"))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		resp, err := i.GetContainerNames(ctx, &pb.Empty{})
+		if err != nil {
+			continue
+		}
+		i.snapshotContainers(ctx, resp.Infos, define.SnapshotReasonPeriodic)
+	}
+}
+
+// snapshotContainers 教师容器与学生容器共享工作区，同一工作区只快照一次
+func (i *IDEServer) snapshotContainers(ctx context.Context, infos []*pb.GetContainerNamesResponse_ContainerNameInfo, reason string) {
+	done := make(map[[2]uint64]struct{}, len(infos))
+	for _, info := range infos {
+		key := [2]uint64{info.LabId, info.StudentId}
+		if _, ok := done[key]; ok {
+			continue
+		}
+		done[key] = struct{}{}
+
+		if _, err := i.SnapshotWorkspace(ctx, &pb.SnapshotWorkspaceRequest{
+			LabId:     info.LabId,
+			StudentId: info.StudentId,
+			Reason:    reason,
+		}); err != nil && status.Code(err) != codes.NotFound {
+			i.Logger.Errorf(err, "snapshot workspace of labID[%d] and studentID[%d] failed", info.LabId, info.StudentId)
+		}
+	}
+}
+
+// openSnapshotFS 以只读文件系统的形式打开快照
+func openSnapshotFS(labID, studentID uint64, snapshotID int64) (*snapshotFS, error) {
+	store := getSnapshotStore(labID, studentID)
+	manifest, err := store.manifest(snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	return newSnapshotFS(store, manifest), nil
+}
+
+// snapshotFS 实现 fs.FS、fs.ReadDirFS 与 fs.StatFS
+type snapshotFS struct {
+	store *snapshotStore
+	files map[string]*pb.SnapshotManifest_File
+	// dirs 目录路径（根目录为 "."）-> 按名称排序的子项
+	dirs    map[string][]fs.DirEntry
+	modTime time.Time
+}
+
+func newSnapshotFS(store *snapshotStore, manifest *pb.SnapshotManifest) *snapshotFS {
+	sfs := &snapshotFS{
+		store:   store,
+		files:   make(map[string]*pb.SnapshotManifest_File, len(manifest.Files)),
+		dirs:    map[string][]fs.DirEntry{".": nil},
+		modTime: time.UnixMilli(manifest.Id),
+	}
+	for _, file := range manifest.Files {
+		sfs.files[file.Path] = file
+		child := fs.DirEntry(&snapshotFileInfo{name: pathBase(file.Path), size: file.Size, modTime: time.Unix(0, file.ModifiedAt)})
+		for dir := pathDir(file.Path); ; dir = pathDir(dir) {
+			_, exists := sfs.dirs[dir]
+			sfs.dirs[dir] = append(sfs.dirs[dir], child)
+			if exists || dir == "." {
+				break
+			}
+			child = &snapshotFileInfo{name: pathBase(dir), isDir: true, modTime: sfs.modTime}
+		}
+	}
+	for _, entries := range sfs.dirs {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	}
+	return sfs
+}
+
+func pathDir(p string) string {
+	if index := strings.LastIndexByte(p, '/'); index >= 0 {
+		return p[:index]
+	}
+	return "."
+}
+
+func pathBase(p string) string {
+	return p[strings.LastIndexByte(p, '/')+1:]
+}
+
+func (sfs *snapshotFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if file, ok := sfs.files[name]; ok {
+		f, err := os.Open(sfs.store.objectPath(file.Hash))
+		if err != nil {
+			return nil, err
+		}
+		return &snapshotFile{File: f, info: &snapshotFileInfo{name: pathBase(name), size: file.Size, modTime: time.Unix(0, file.ModifiedAt)}}, nil
+	}
+	if entries, ok := sfs.dirs[name]; ok {
+		return &snapshotDir{info: &snapshotFileInfo{name: pathBase(name), isDir: true, modTime: sfs.modTime}, entries: entries}, nil
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (sfs *snapshotFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	entries, ok := sfs.dirs[name]
+	if !ok {
+		if _, isFile := sfs.files[name]; isFile {
+			return nil, &fs.PathError{Op: "readdir", Path: name, Err: errIsDir}
+		}
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	return append([]fs.DirEntry(nil), entries...), nil
+}
+
+func (sfs *snapshotFS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+	if file, ok := sfs.files[name]; ok {
+		return &snapshotFileInfo{name: pathBase(name), size: file.Size, modTime: time.Unix(0, file.ModifiedAt)}, nil
+	}
+	if _, ok := sfs.dirs[name]; ok {
+		return &snapshotFileInfo{name: pathBase(name), isDir: true, modTime: sfs.modTime}, nil
+	}
+	return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+}
+
+// snapshotFileInfo 同时实现 fs.FileInfo 与 fs.DirEntry
+type snapshotFileInfo struct {
+	modTime time.Time
+	name    string
+	size    int64
+	isDir   bool
+}
+
+func (info *snapshotFileInfo) Name() string               { return info.name }
+func (info *snapshotFileInfo) Size() int64                { return info.size }
+func (info *snapshotFileInfo) ModTime() time.Time         { return info.modTime }
+func (info *snapshotFileInfo) IsDir() bool                { return info.isDir }
+func (info *snapshotFileInfo) Sys() interface{}           { return nil }
+func (info *snapshotFileInfo) Type() fs.FileMode          { return info.Mode().Type() }
+func (info *snapshotFileInfo) Info() (fs.FileInfo, error) { return info, nil }
+
+func (info *snapshotFileInfo) Mode() fs.FileMode {
+	if info.isDir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+type snapshotFile struct {
+	*os.File
+	info fs.FileInfo
+}
+
+func (f *snapshotFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+type snapshotDir struct {
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *snapshotDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *snapshotDir) Close() error               { return nil }
+
+func (d *snapshotDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.Name(), Err: errIsDir}
+}
+
+func (d *snapshotDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return append([]fs.DirEntry(nil), rest...), nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(rest) {
+		n = len(rest)
+	}
+	d.offset += n
+	return append([]fs.DirEntry(nil), rest[:n]...), nil
+}
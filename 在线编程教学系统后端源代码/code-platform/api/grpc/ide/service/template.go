@@ -0,0 +1,510 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/charsetx"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// templateNewSuffix 学生修改过的文件不覆盖，模板的新内容另存为同名加此后缀的文件
+const templateNewSuffix = ".template-new"
+
+// templateBasePath 实验初始代码模板，不放在 codespaces 下，避免随工作区挂载进容器
+var templateBasePath = filepath.Join(define.InitBasePath, "templates")
+
+var (
+	errInvalidTemplate  = errors.New("template is not a valid zip")
+	errTemplateTooLarge = errors.New("template is too large")
+)
+
+// templateLocks 同一实验的模板更新与工作区初始化串行执行，需要同时持有工作区锁时先持有模板锁
+var templateLocks sync.Map
+
+func lockTemplate(labID uint64) func() {
+	value, _ := templateLocks.LoadOrStore(labID, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// templateStore 单个实验的模板仓库，versions 下按 zip 的 sha256 保存解压后的各版本，
+// current 记录当前版本，seeded 下记录各工作区最近一次合并的版本，作为下次合并的共同祖先
+type templateStore struct {
+	root string
+}
+
+func getTemplateStore(labID uint64) *templateStore {
+	return &templateStore{root: filepath.Join(templateBasePath, fmt.Sprintf("lab-%d", labID))}
+}
+
+func (s *templateStore) versionDir(hash string) string {
+	return filepath.Join(s.root, "versions", hash)
+}
+
+func (s *templateStore) seededPath(studentID uint64) string {
+	return filepath.Join(s.root, "seeded", strconv.FormatUint(studentID, 10))
+}
+
+// current 当前模板版本，实验没有模板时返回空串
+func (s *templateStore) current() (string, error) {
+	return readVersionFile(filepath.Join(s.root, "current"))
+}
+
+func (s *templateStore) setCurrent(hash string) error {
+	return writeFileAtomic(filepath.Join(s.root, "current"), []byte(hash))
+}
+
+// seeded 工作区最近一次合并的模板版本，从未合并时返回空串
+func (s *templateStore) seeded(studentID uint64) (string, error) {
+	return readVersionFile(s.seededPath(studentID))
+}
+
+func (s *templateStore) setSeeded(studentID uint64, hash string) error {
+	return writeFileAtomic(s.seededPath(studentID), []byte(hash))
+}
+
+func readVersionFile(filePath string) (string, error) {
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	return strings.TrimSpace(string(data)), err
+}
+
+// PutLabTemplate 接收实验的初始代码模板并设为当前版本，随后合并进已打开过的工作区，尚未打开的工作区在首次打开时初始化
+func (i *IDEServer) PutLabTemplate(stream pb.IDEServerService_PutLabTemplateServer) error {
+	ctx := stream.Context()
+	if err := os.MkdirAll(templateBasePath, os.ModePerm); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	labID, zipPath, hash, err := receiveTemplate(stream)
+	if zipPath != "" {
+		defer os.Remove(zipPath)
+	}
+	if err != nil {
+		return templateStatusError(err)
+	}
+
+	unlock := lockTemplate(labID)
+	defer unlock()
+
+	store := getTemplateStore(labID)
+	fileCount, err := store.extract(zipPath, hash)
+	if err != nil {
+		i.Logger.Errorf(err, "extract template of labID[%d] failed", labID)
+		return templateStatusError(err)
+	}
+	if err := store.setCurrent(hash); err != nil {
+		i.Logger.Errorf(err, "set current template of labID[%d] failed", labID)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.PutLabTemplateResponse{Sha256: hash, FileCount: fileCount}
+	studentIDs, err := listWorkspaceStudentIDs(labID)
+	if err != nil {
+		i.Logger.Errorf(err, "list workspaces of labID[%d] failed", labID)
+		return status.Error(codes.Internal, err.Error())
+	}
+	for _, studentID := range studentIDs {
+		// 冻结后的工作区不再更新
+		if isWorkspaceFrozen(labID, studentID) {
+			continue
+		}
+		unlockWorkspace := lockWorkspace(labID, studentID)
+		updated, conflicts, err := mergeTemplate(ctx, store, labID, studentID, hash)
+		unlockWorkspace()
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return status.FromContextError(ctxErr).Err()
+			}
+			i.Logger.Errorf(err, "merge template into workspace of labID[%d] and studentID[%d] failed", labID, studentID)
+			resp.Failed++
+			continue
+		}
+		resp.WorkspaceCount++
+		resp.Updated += updated
+		resp.Conflicts += conflicts
+	}
+
+	if err := store.prune(); err != nil {
+		i.Logger.Errorf(err, "prune templates of labID[%d] failed", labID)
+	}
+	return stream.SendAndClose(resp)
+}
+
+func templateStatusError(err error) error {
+	switch {
+	case errors.Is(err, errInvalidTemplate):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errTemplateTooLarge):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// labZipStream PutLabTemplate 与 PutLabTests 的服务端流
+type labZipStream interface {
+	Recv() (*pb.LabTemplateChunk, error)
+}
+
+// receiveTemplate 将模板 zip 写入临时文件并计算 sha256，zip 需随机读取，无法边接收边解压
+func receiveTemplate(stream labZipStream) (labID uint64, zipPath, hash string, err error) {
+	f, err := os.CreateTemp(templateBasePath, ".tmp-*.zip")
+	if err != nil {
+		return 0, "", "", err
+	}
+	defer f.Close()
+
+	maxSize := config.IDEServer.GetInt64("template.max_size")
+	hasher := sha256.New()
+	var size int64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, f.Name(), "", err
+		}
+		if labID == 0 {
+			labID = chunk.LabId
+		}
+		if size += int64(len(chunk.Data)); size > maxSize {
+			return 0, f.Name(), "", errTemplateTooLarge
+		}
+		if _, err := io.MultiWriter(f, hasher).Write(chunk.Data); err != nil {
+			return 0, f.Name(), "", err
+		}
+	}
+	if labID == 0 {
+		return 0, f.Name(), "", status.Error(codes.InvalidArgument, "lab_id is required")
+	}
+	return labID, f.Name(), hex.EncodeToString(hasher.Sum(nil)), f.Close()
+}
+
+// extract 将模板解压为 hash 对应的版本，先解压到临时目录再重命名，已存在的版本直接复用
+func (s *templateStore) extract(zipPath, hash string) (fileCount int32, err error) {
+	zr, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errInvalidTemplate, err)
+	}
+	defer zr.Close()
+
+	names := templateEntryNames(zr.File)
+	maxFiles := config.IDEServer.GetInt("template.max_files")
+	if len(names) > maxFiles {
+		return 0, fmt.Errorf("%w: more than %d files", errTemplateTooLarge, maxFiles)
+	}
+
+	if err := os.MkdirAll(filepath.Join(s.root, "versions"), os.ModePerm); err != nil {
+		return 0, err
+	}
+	tmp, err := os.MkdirTemp(filepath.Join(s.root, "versions"), ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(tmp)
+
+	// 头部声明的大小不可信，按实际解压出的字节数限制
+	remaining := config.IDEServer.GetInt64("template.max_size")
+	for f, name := range names {
+		n, err := extractTemplateFile(f, filepath.Join(tmp, filepath.FromSlash(name)), remaining)
+		if err != nil {
+			return 0, err
+		}
+		remaining -= n
+		fileCount++
+	}
+
+	dir := s.versionDir(hash)
+	if _, err := os.Stat(dir); err == nil {
+		return fileCount, nil
+	}
+	return fileCount, os.Rename(tmp, dir)
+}
+
+// templateEntryNames 返回 zip 中普通文件在工作区中的路径，所有文件位于同一顶层目录时去掉该目录
+func templateEntryNames(files []*zip.File) map[*zip.File]string {
+	names := make(map[*zip.File]string, len(files))
+	var (
+		topDir    string
+		stripable = true
+	)
+	for _, f := range files {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		name := f.Name
+		if f.NonUTF8 {
+			// Windows 自带压缩工具以 GBK 编码文件名
+			if content, _, ok := charsetx.ToUTF8([]byte(name)); ok {
+				name = content
+			}
+		}
+		name = strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(name, `\`, "/")), "/")
+		if name == "" || strings.HasPrefix(name, "__MACOSX/") || path.Base(name) == ".DS_Store" {
+			continue
+		}
+		names[f] = name
+
+		dir := strings.SplitN(name, "/", 2)[0]
+		if dir == name || (topDir != "" && topDir != dir) {
+			stripable = false
+		}
+		topDir = dir
+	}
+
+	if stripable && len(names) != 0 {
+		for f, name := range names {
+			names[f] = strings.TrimPrefix(name, topDir+"/")
+		}
+	}
+	return names
+}
+
+func extractTemplateFile(f *zip.File, dst string, remaining int64) (int64, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errInvalidTemplate, err)
+	}
+	defer rc.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return 0, err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(out, io.LimitReader(rc, remaining+1))
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if errors.Is(err, zip.ErrChecksum) || errors.Is(err, zip.ErrFormat) {
+			return 0, fmt.Errorf("%w: %v", errInvalidTemplate, err)
+		}
+		return 0, err
+	}
+	if n > remaining {
+		return 0, errTemplateTooLarge
+	}
+	return n, os.Chtimes(dst, f.Modified, f.Modified)
+}
+
+// listWorkspaceStudentIDs 列出实验下已创建工作区的学生
+func listWorkspaceStudentIDs(labID uint64) ([]uint64, error) {
+	entries, err := os.ReadDir(filepath.Dir(getMountWorkSpace(labID, 0)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	studentIDs := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		studentID, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		studentIDs = append(studentIDs, studentID)
+	}
+	return studentIDs, nil
+}
+
+// seedWorkspace 工作区尚未合并当前模板时合并，首次打开时即将模板复制进工作区
+func seedWorkspace(ctx context.Context, labID, studentID uint64) error {
+	store := getTemplateStore(labID)
+	if upToDate, err := store.isSeeded(studentID); err != nil || upToDate {
+		return err
+	}
+
+	unlock := lockTemplate(labID)
+	defer unlock()
+	unlockWorkspace := lockWorkspace(labID, studentID)
+	defer unlockWorkspace()
+
+	hash, err := store.current()
+	if err != nil || hash == "" {
+		return err
+	}
+	_, _, err = mergeTemplate(ctx, store, labID, studentID, hash)
+	return err
+}
+
+// isSeeded 实验没有模板或工作区已合并当前版本
+func (s *templateStore) isSeeded(studentID uint64) (bool, error) {
+	hash, err := s.current()
+	if err != nil || hash == "" {
+		return true, err
+	}
+	seeded, err := s.seeded(studentID)
+	return seeded == hash, err
+}
+
+// mergeTemplate 以工作区上次合并的版本为共同祖先，将模板版本 hash 按文件三方合并进工作区，调用方需持有模板锁与工作区锁：
+// 学生未修改的文件直接更新，学生已修改的文件保留并将模板内容另存为 .template-new，学生删除且模板未改动的文件不再恢复，
+// 模板中删除的文件保留在工作区
+func mergeTemplate(ctx context.Context, store *templateStore, labID, studentID uint64, hash string) (updated, conflicts int32, err error) {
+	base, err := store.seeded(studentID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if base == hash {
+		return 0, 0, nil
+	}
+
+	root := getMountWorkSpace(labID, studentID)
+	if err := os.MkdirAll(root, os.ModePerm); err != nil {
+		return 0, 0, err
+	}
+	theirsDir := store.versionDir(hash)
+	err = filepath.WalkDir(theirsDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(theirsDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		target, err := resolveWorkspacePath(root, rel)
+		if errors.Is(err, errPathOutsideWorkspace) {
+			// 学生以符号链接指向工作区外的路径不写入
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		theirs, err := regularFileHash(filePath)
+		if err != nil {
+			return err
+		}
+		ours, err := regularFileHash(target)
+		if err != nil {
+			return err
+		}
+		var ancestor string
+		if base != "" {
+			// 共同祖先已被清理时视为空
+			if ancestor, err = regularFileHash(filepath.Join(store.versionDir(base), rel)); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case ours == theirs:
+		case ours == ancestor:
+			updated++
+			return copyTemplateFile(filePath, target)
+		case ours == "" && ancestor == theirs:
+			// 学生删除了模板未改动的文件
+		default:
+			conflicts++
+			return copyTemplateFile(filePath, target+templateNewSuffix)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return updated, conflicts, store.setSeeded(studentID, hash)
+}
+
+// regularFileHash 返回普通文件内容的 sha256，文件不存在时返回空串，目录等非普通文件返回不可能与摘要相同的占位值
+func regularFileHash(filePath string) (string, error) {
+	info, err := os.Lstat(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !info.Mode().IsRegular() {
+		return "-", nil
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func copyTemplateFile(src, dst string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(dst, content)
+}
+
+// prune 删除当前版本与各工作区合并过的版本以外的模板版本
+func (s *templateStore) prune() error {
+	referenced := make(map[string]bool)
+	current, err := s.current()
+	if err != nil {
+		return err
+	}
+	referenced[current] = true
+
+	seeded, err := os.ReadDir(filepath.Join(s.root, "seeded"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for _, entry := range seeded {
+		hash, err := readVersionFile(filepath.Join(s.root, "seeded", entry.Name()))
+		if err != nil {
+			return err
+		}
+		referenced[hash] = true
+	}
+
+	versions, err := os.ReadDir(filepath.Join(s.root, "versions"))
+	if err != nil {
+		return err
+	}
+	for _, entry := range versions {
+		if referenced[entry.Name()] || strings.HasPrefix(entry.Name(), ".tmp-") {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(s.root, "versions", entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/osx"
+	"code-platform/service/ide/define"
+
+	"github.com/bytedance/sonic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// terminalShell 优先使用 bash，精简镜像中退回 sh
+	terminalShell = `if command -v bash >/dev/null 2>&1; then exec bash -l; else exec sh -l; fi`
+
+	terminalReadBufferSize = 32 << 10
+)
+
+// AttachTerminal 在学生的终端模式容器中启动一个带 TTY 的 shell，并在流上双向转发输入输出与窗口大小变化，
+// 流中第一条消息须携带 open；流结束时 shell 随之退出，容器保留并交由心跳清理
+func (i *IDEServer) AttachTerminal(stream pb.IDEServerService_AttachTerminalServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	req := first.Open
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "the first message must be open")
+	}
+	ctx := stream.Context()
+
+	containerName := define.GetContainerNameForStudent(req.LabId, req.StudentId)
+	mountWorkSpace := getViewWorkSpace(req.LabId, req.StudentId)
+	canEdit := req.CanEdit && !isWorkspaceFrozen(req.LabId, req.StudentId)
+	if canEdit {
+		if err := seedWorkspace(ctx, req.LabId, req.StudentId); err != nil {
+			i.Logger.Errorf(err, "seed workspace of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		}
+	}
+	// 工作区由 IDE 服务以 root 写入（初始化、模板合并），每次打开时交给终端用户，使其可以修改
+	if canEdit {
+		if err := chownWorkspace(mountWorkSpace); err != nil {
+			i.Logger.Errorf(err, "chown workspace %q failed", mountWorkSpace)
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	if err := i.ensureTerminalContainer(ctx, containerName, mountWorkSpace, canEdit, int8(req.Language)); err != nil {
+		i.Logger.Errorf(err, "prepare terminal container %q failed", containerName)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	execID, err := createTerminalExec(ctx, containerName)
+	if err != nil {
+		i.Logger.Errorf(err, "create terminal exec in container %q failed", containerName)
+		return status.Error(codes.Internal, err.Error())
+	}
+	conn, reader, err := startTerminalExec(ctx, execID)
+	if err != nil {
+		i.Logger.Errorf(err, "start terminal exec in container %q failed", containerName)
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer conn.Close()
+
+	if first.Resize != nil {
+		if err := resizeTerminalExec(ctx, execID, first.Resize); err != nil {
+			i.Logger.Errorf(err, "resize terminal exec in container %q failed", containerName)
+		}
+	}
+
+	errCh := make(chan error, 2)
+	go func() {
+		buf := make([]byte, terminalReadBufferSize)
+		for {
+			n, err := reader.Read(buf)
+			if n > 0 {
+				data := make([]byte, n)
+				copy(data, buf[:n])
+				if err := stream.Send(&pb.TerminalOutput{Data: data}); err != nil {
+					errCh <- err
+					return
+				}
+			}
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				errCh <- err
+				return
+			}
+		}
+	}()
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				errCh <- err
+				return
+			}
+			if len(in.Data) > 0 {
+				if _, err := conn.Write(in.Data); err != nil {
+					errCh <- err
+					return
+				}
+			}
+			if in.Resize != nil {
+				if err := resizeTerminalExec(ctx, execID, in.Resize); err != nil {
+					i.Logger.Errorf(err, "resize terminal exec in container %q failed", containerName)
+				}
+			}
+		}
+	}()
+
+	// 任一方向结束即关闭连接，shell 读到 EOF 后退出
+	err = <-errCh
+	if err != nil && status.Code(err) != codes.Canceled {
+		i.Logger.Errorf(err, "terminal of container %q closed", containerName)
+	}
+	return nil
+}
+
+// ensureTerminalContainer 复用或恢复已有的终端容器；课程切换 IDE 类型后同名的 Theia 容器被替换
+func (i *IDEServer) ensureTerminalContainer(ctx context.Context, containerName, mountWorkSpace string, canEdit bool, language int8) error {
+	switch {
+	case isContainerAlive(ctx, containerName):
+		if isTerminalContainer(ctx, containerName) {
+			return nil
+		}
+		if err := removeContainer(ctx, containerName); err != nil {
+			return err
+		}
+	case isContainerStop(ctx, containerName):
+		if isTerminalContainer(ctx, containerName) {
+			err := resumeContainer(ctx, containerName)
+			if err == nil {
+				return nil
+			}
+			i.Logger.Errorf(err, "start container failed for %q", containerName)
+		}
+		if err := removeContainer(ctx, containerName); err != nil {
+			i.Logger.Errorf(err, "remove container failed for %q", containerName)
+		}
+	}
+	return i.runTerminalContainer(ctx, containerName, mountWorkSpace, canEdit, language)
+}
+
+// runTerminalContainer 以 sleep 常驻一个不运行 Theia 的小容器，终端通过 docker exec 接入；
+// 与测试运行的容器一致，以非 root 用户运行、不联网并去掉全部 capability
+func (i *IDEServer) runTerminalContainer(ctx context.Context, containerName, mountWorkSpace string, canEdit bool, language int8) error {
+	readOnlyOpt := "rw"
+	if !canEdit {
+		readOnlyOpt = "ro"
+	}
+
+	dockerRunCommand := fmt.Sprintf(
+		`run -d -u %s -e HOME=/tmp --network=none --cap-drop=ALL --security-opt=no-new-privileges --restart=always --label=%s=%s --cpus=%s --memory=%s --pids-limit=%d -v %s:/home/project:%s -w /home/project --name=%s --entrypoint=sleep %s infinity`,
+		terminalUser(),
+		ideKindLabelKey,
+		ideKindLabelTerminal,
+		config.IDEServer.GetString("terminal.cpus"),
+		config.IDEServer.GetString("terminal.memory"),
+		config.IDEServer.GetInt("terminal.pids_limit"),
+		mountWorkSpace,
+		readOnlyOpt,
+		containerName,
+		define.GetTerminalImageName(language),
+	)
+
+	cmd := exec.CommandContext(ctx, "docker", strings.Fields(dockerRunCommand)...)
+	if _, stderr, err := osx.CommandOutput(ctx, cmd); err != nil {
+		i.Logger.Errorf(err, "Failed to exec command %q for %s", cmd.String(), string(stderr))
+		return err
+	}
+	return nil
+}
+
+// chownWorkspace 将工作区交给终端容器内的用户，符号链接本身改变属主而不跟随
+func chownWorkspace(mountWorkSpace string) error {
+	uid, gid := config.IDEServer.GetInt("terminal.uid"), config.IDEServer.GetInt("terminal.gid")
+	if err := os.MkdirAll(mountWorkSpace, os.ModePerm); err != nil {
+		return err
+	}
+	return filepath.WalkDir(mountWorkSpace, func(filePath string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Lchown(filePath, uid, gid)
+	})
+}
+
+// isTerminalContainer 此前以 root 创建的终端容器不再复用
+func isTerminalContainer(ctx context.Context, containerName string) bool {
+	label, ok := getContainerIDEKind(ctx, containerName)
+	if !ok || label != ideKindLabelTerminal {
+		return false
+	}
+	cmd := exec.CommandContext(ctx, "docker", "inspect", "-f", "{{.Config.User}}", containerName)
+	stdout, _, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return false
+	}
+	return string(bytes.TrimSpace(stdout)) == terminalUser()
+}
+
+func terminalUser() string {
+	return fmt.Sprintf("%d:%d", config.IDEServer.GetInt("terminal.uid"), config.IDEServer.GetInt("terminal.gid"))
+}
+
+// Docker CLI 无法在非终端环境下分配 TTY，终端会话直接调用 Docker Engine API
+
+func dialDocker(ctx context.Context) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "unix", config.IDEServer.GetString("terminal.docker_socket"))
+}
+
+var dockerAPIClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialDocker(ctx)
+		},
+	},
+}
+
+func newDockerRequest(ctx context.Context, path string, body interface{}) (*http.Request, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = sonic.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://docker"+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func dockerResponseError(resp *http.Response) error {
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+	return fmt.Errorf("docker api %s returned %s: %s", resp.Request.URL.Path, resp.Status, bytes.TrimSpace(message))
+}
+
+func callDockerAPI(ctx context.Context, path string, body, out interface{}) error {
+	req, err := newDockerRequest(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	resp, err := dockerAPIClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return dockerResponseError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return sonic.Unmarshal(data, out)
+}
+
+func createTerminalExec(ctx context.Context, containerName string) (string, error) {
+	body := map[string]interface{}{
+		"AttachStdin":  true,
+		"AttachStdout": true,
+		"AttachStderr": true,
+		"Tty":          true,
+		"Env":          []string{"TERM=xterm-256color"},
+		"WorkingDir":   "/home/project",
+		"Cmd":          []string{"sh", "-c", terminalShell},
+	}
+	var resp struct {
+		ID string `json:"Id"`
+	}
+	if err := callDockerAPI(ctx, "/containers/"+containerName+"/exec", body, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// startTerminalExec 启动 exec 并劫持连接，TTY 模式下连接上即为未复用的原始终端字节流
+func startTerminalExec(ctx context.Context, execID string) (net.Conn, *bufio.Reader, error) {
+	req, err := newDockerRequest(ctx, "/exec/"+execID+"/start", map[string]bool{"Detach": false, "Tty": true})
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "tcp")
+
+	conn, err := dialDocker(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols && resp.StatusCode != http.StatusOK {
+		err := dockerResponseError(resp)
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, reader, nil
+}
+
+func resizeTerminalExec(ctx context.Context, execID string, size *pb.TerminalSize) error {
+	if size.Cols == 0 || size.Rows == 0 {
+		return nil
+	}
+	return callDockerAPI(ctx, fmt.Sprintf("/exec/%s/resize?h=%d&w=%d", execID, size.Rows, size.Cols), nil, nil)
+}
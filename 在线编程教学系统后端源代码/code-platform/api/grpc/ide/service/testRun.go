@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/randx"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testContainerNamePrefix 与 IDE 容器区分，避免被心跳清理与容器管理误认
+const testContainerNamePrefix = "mytest-"
+
+var (
+	// labTestsBasePath 实验的隐藏测试文件，与模板相同按版本保存，仅在运行测试时覆盖到工作区副本上
+	labTestsBasePath = filepath.Join(define.InitBasePath, "tests")
+	// testRunBasePath 每次运行测试时的工作区副本与报告目录，运行结束即删除
+	testRunBasePath = filepath.Join(define.InitBasePath, "testruns")
+)
+
+// labTestsLocks 隐藏测试文件的更新与复制串行执行，避免复制到一半的版本被清理
+var labTestsLocks sync.Map
+
+func lockLabTests(labID uint64) func() {
+	value, _ := labTestsLocks.LoadOrStore(labID, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func getLabTestsStore(labID uint64) *templateStore {
+	return &templateStore{root: filepath.Join(labTestsBasePath, fmt.Sprintf("lab-%d", labID))}
+}
+
+// PutLabTests 接收实验的隐藏测试文件并设为当前版本
+func (i *IDEServer) PutLabTests(stream pb.IDEServerService_PutLabTestsServer) error {
+	if err := os.MkdirAll(templateBasePath, os.ModePerm); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	labID, zipPath, hash, err := receiveTemplate(stream)
+	if zipPath != "" {
+		defer os.Remove(zipPath)
+	}
+	if err != nil {
+		return templateStatusError(err)
+	}
+
+	unlock := lockLabTests(labID)
+	defer unlock()
+
+	store := getLabTestsStore(labID)
+	fileCount, err := store.extract(zipPath, hash)
+	if err != nil {
+		i.Logger.Errorf(err, "extract tests of labID[%d] failed", labID)
+		return templateStatusError(err)
+	}
+	if err := store.setCurrent(hash); err != nil {
+		i.Logger.Errorf(err, "set current tests of labID[%d] failed", labID)
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := store.prune(); err != nil {
+		i.Logger.Errorf(err, "prune tests of labID[%d] failed", labID)
+	}
+	return stream.SendAndClose(&pb.PutLabTestsResponse{Sha256: hash, FileCount: fileCount})
+}
+
+// RunWorkspaceTests 在断网的一次性容器中对工作区副本运行测试命令，返回输出与 JUnit 报告，
+// 隐藏测试文件不存在时返回 FailedPrecondition，由调用方重新上传后重试
+func (i *IDEServer) RunWorkspaceTests(ctx context.Context, req *pb.RunWorkspaceTestsRequest) (*pb.RunWorkspaceTestsResponse, error) {
+	if strings.TrimSpace(req.Command) == "" {
+		return nil, status.Error(codes.InvalidArgument, "command is required")
+	}
+	select {
+	case i.testSlots <- struct{}{}:
+		defer func() { <-i.testSlots }()
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	if err := os.MkdirAll(testRunBasePath, os.ModePerm); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	dir, err := os.MkdirTemp(testRunBasePath, fmt.Sprintf("%d-%d-*", req.LabId, req.StudentId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	project, results := filepath.Join(dir, "project"), filepath.Join(dir, "results")
+	if err := prepareTestRun(ctx, req, project, results); err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, err
+		}
+		i.Logger.Errorf(err, "prepare test run of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp, err := runTestContainer(ctx, req, project, results)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		i.Logger.Errorf(err, "run tests of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if resp.Reports, err = collectJUnitReports(results); err != nil {
+		i.Logger.Errorf(err, "collect reports of labID[%d] and studentID[%d] failed", req.LabId, req.StudentId)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// prepareTestRun 复制工作区（已冻结时为冻结副本）并覆盖隐藏测试文件，学生无法通过同名文件改写测试
+func prepareTestRun(ctx context.Context, req *pb.RunWorkspaceTestsRequest, project, results string) error {
+	for _, p := range []string{project, results} {
+		if err := os.MkdirAll(p, os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	unlock := lockWorkspace(req.LabId, req.StudentId)
+	root := getViewWorkSpace(req.LabId, req.StudentId)
+	var err error
+	if _, err = os.Stat(root); err == nil {
+		_, err = copyWorkspaceFiles(ctx, root, project)
+	} else if errors.Is(err, os.ErrNotExist) {
+		// 学生尚未打开过工作区时对空工作区运行
+		err = nil
+	}
+	unlock()
+	if err != nil || req.TestsSha256 == "" {
+		return err
+	}
+
+	unlock = lockLabTests(req.LabId)
+	defer unlock()
+	testsDir := getLabTestsStore(req.LabId).versionDir(req.TestsSha256)
+	if _, err := os.Stat(testsDir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return status.Errorf(codes.FailedPrecondition, "tests %s of lab %d are not found", req.TestsSha256, req.LabId)
+		}
+		return err
+	}
+	return filepath.WalkDir(testsDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(testsDir, filePath)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(project, rel)
+		// 学生可能以同名目录占位
+		if err := os.RemoveAll(dst); err != nil {
+			return err
+		}
+		return copyFile(filePath, dst, info.ModTime())
+	})
+}
+
+// runTestContainer 以 sh -c 运行测试命令，超时后直接删除容器，仅结束 docker 客户端不会停止容器
+func runTestContainer(ctx context.Context, req *pb.RunWorkspaceTestsRequest, project, results string) (*pb.RunWorkspaceTestsResponse, error) {
+	uuid, err := randx.NewRandCode(6)
+	if err != nil {
+		return nil, err
+	}
+	containerName := fmt.Sprintf(testContainerNamePrefix+"%d-%d-%s", req.LabId, req.StudentId, uuid)
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	if maxTimeout := config.IDEServer.GetDuration("test.max_timeout"); timeout <= 0 || timeout > maxTimeout {
+		timeout = maxTimeout
+	}
+	memory := config.IDEServer.GetString("test.memory")
+	cmd := exec.Command("docker", "run", "--rm", "--name="+containerName,
+		"--network=none", "--cap-drop=ALL", "--security-opt=no-new-privileges",
+		"--cpus="+config.IDEServer.GetString("test.cpus"),
+		"--memory="+memory, "--memory-swap="+memory,
+		"--pids-limit="+strconv.Itoa(config.IDEServer.GetInt("test.pids_limit")),
+		"-u", "root", "-e", "JUNIT_DIR=/results",
+		"-v", project+":/home/project", "-v", results+":/results", "-w", "/home/project",
+		"--entrypoint", "sh", define.GetImageName(int8(req.Language), req.Image), "-c", req.Command,
+	)
+	output := &limitedBuffer{limit: config.IDEServer.GetInt("test.max_output")}
+	cmd.Stdout, cmd.Stderr = output, output
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	resp := &pb.RunWorkspaceTestsResponse{}
+	select {
+	case err = <-done:
+	case <-timer.C:
+		resp.TimedOut = true
+		err = killTestContainer(containerName, done)
+	case <-ctx.Done():
+		if err := killTestContainer(containerName, done); err != nil {
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) {
+				return nil, err
+			}
+		}
+		return nil, ctx.Err()
+	}
+	resp.DurationMs = time.Since(start).Milliseconds()
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr):
+		resp.ExitCode = int32(exitErr.ExitCode())
+		// 125 为 docker 自身出错，如镜像不存在
+		if resp.ExitCode == 125 && !resp.TimedOut {
+			return nil, fmt.Errorf("docker run failed: %s", output.String())
+		}
+	default:
+		return nil, err
+	}
+	resp.Output, resp.OutputTruncated = output.String(), output.truncated
+	return resp, nil
+}
+
+// killTestContainer 删除容器并等待 docker 客户端退出，返回其退出错误
+func killTestContainer(containerName string, done <-chan error) error {
+	if err := exec.Command("docker", "rm", "-f", containerName).Run(); err != nil {
+		return fmt.Errorf("remove container %q failed: %w", containerName, err)
+	}
+	return <-done
+}
+
+// collectJUnitReports 按文件名顺序读取报告目录下的 XML 文件，跳过过大的文件
+func collectJUnitReports(results string) ([]*pb.JUnitReport, error) {
+	maxReports := config.IDEServer.GetInt("test.max_reports")
+	maxReportSize := config.IDEServer.GetInt64("test.max_report_size")
+
+	var paths []string
+	err := filepath.WalkDir(results, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() && strings.EqualFold(filepath.Ext(filePath), ".xml") {
+			paths = append(paths, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	reports := make([]*pb.JUnitReport, 0, len(paths))
+	for _, filePath := range paths {
+		if len(reports) >= maxReports {
+			break
+		}
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() > maxReportSize {
+			continue
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		name, err := filepath.Rel(results, filePath)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, &pb.JUnitReport{Name: filepath.ToSlash(name), Data: data})
+	}
+	return reports, nil
+}
+
+// limitedBuffer 只保留前 limit 字节，其余丢弃并标记截断，写入总是成功以免测试进程因管道关闭而退出
+type limitedBuffer struct {
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - len(b.buf); remaining < len(p) {
+		b.buf = append(b.buf, p[:remaining]...)
+		b.truncated = true
+	} else {
+		b.buf = append(b.buf, p...)
+	}
+	return len(p), nil
+}
+
+// String 截断处可能位于多字节字符中间，proto 的 string 字段要求合法的 UTF-8
+func (b *limitedBuffer) String() string {
+	return strings.ToValidUTF8(string(b.buf), "\ufffd")
+}
@@ -3,17 +3,20 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math/rand"
 	"net"
+	"net/http"
 	"os/exec"
 	"path/filepath"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"code-platform/config"
+	"code-platform/log"
 	"code-platform/pkg/osx"
 	"code-platform/pkg/randx"
 	"code-platform/pkg/strconvx"
@@ -37,14 +40,9 @@ func (i *IDEServer) runTheiaContainer(ctx context.Context, imageName string, con
 		return 0, "", err
 	}
 
-	/*
-		单容器最高 15% CPU 占用率
-		默认最大内存 500M
-		交换内存后最多使用 900M
-	*/
-
 	dockerRunCommand := fmt.Sprintf(
-		`run -d -u root --restart=always --cpus=0.38 --memory=500m --memory-swap=900m -e token=%s -p %d:10443 -v %s:/home/project:%s --name=%s %s`,
+		`run -d -u root --restart=always %s -e token=%s -p %d:10443 -v %s:/home/project:%s --name=%s %s`,
+		theiaResourceOpts,
 		token,
 		port,
 		mountWorkSpace,
@@ -60,10 +58,81 @@ func (i *IDEServer) runTheiaContainer(ctx context.Context, imageName string, con
 		return 0, "", err
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := waitForTheia(ctx, containerName, port); err != nil {
+		i.Logger.Errorf(err, "wait for container %q failed", containerName)
+		return 0, "", err
+	}
 	return port, token, nil
 }
 
+// 单容器最高 15% CPU 占用率
+// 默认最大内存 500M
+// 交换内存后最多使用 900M
+const theiaResourceOpts = "--cpus=0.38 --memory=500m --memory-swap=900m"
+
+// theiaBackendProbe 在容器内探测 Theia 后端是否已开始监听
+const theiaBackendProbe = `require("http").get("http://localhost:3000", () => process.exit(0)).on("error", () => process.exit(1))`
+
+var probeClient = &http.Client{
+	Timeout: time.Second,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	},
+}
+
+// waitForTheia 轮询直至容器内 Theia 后端与宿主机端口上的认证代理均可响应
+func waitForTheia(ctx context.Context, containerName string, port uint16) error {
+	return waitForReady(ctx, containerName, port, isTheiaReady)
+}
+
+// waitForIDE 按容器的 IDE 类型标签等待就绪
+func waitForIDE(ctx context.Context, label, containerName string, port uint16) error {
+	if label == ideKindLabelJupyter {
+		return waitForJupyter(ctx, containerName, port)
+	}
+	return waitForTheia(ctx, containerName, port)
+}
+
+func waitForReady(ctx context.Context, containerName string, port uint16, isReady func(ctx context.Context, containerName string, port uint16) bool) error {
+	ctx, cancel := context.WithTimeout(ctx, config.IDEServer.GetDuration("ready_timeout"))
+	defer cancel()
+
+	ticker := time.NewTicker(config.IDEServer.GetDuration("ready_probe_interval"))
+	defer ticker.Stop()
+
+	for {
+		if isReady(ctx, containerName, port) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("container %q is not ready on port %d: %w", containerName, port, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func isTheiaReady(ctx context.Context, containerName string, port uint16) bool {
+	cmd := exec.CommandContext(ctx, "docker", "exec", containerName, "node", "-e", theiaBackendProbe)
+	if _, _, err := osx.CommandOutput(ctx, cmd); err != nil {
+		return false
+	}
+
+	// docker-proxy 在容器内端口未监听时也会接受 TCP 连接，需以收到 HTTP 响应为准；代理可能启用了 TLS，两种协议均尝试
+	for _, scheme := range []string{"http", "https"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://127.0.0.1:%d/", scheme, port), nil)
+		if err != nil {
+			return false
+		}
+		resp, err := probeClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			return true
+		}
+	}
+	return false
+}
+
 func getAvailablePort() uint16 {
 	rand.Seed(time.Now().UnixNano())
 	for {
@@ -87,10 +156,17 @@ func startContainer(ctx context.Context, containerName string) error {
 }
 
 func removeContainer(ctx context.Context, containerName string) error {
+	// 槽位只能从容器标签中读取，列出失败时仍移除容器，遗留的槽位由启动时的 reconcile 清理
+	slots, err := pooledSlots(ctx)
+	if err != nil {
+		log.Errorf(err, "list pooled containers before removing %q failed", containerName)
+	}
+
 	cmd := exec.CommandContext(ctx, "docker", "rm", "-f", containerName)
 	if _, stderr, err := osx.CommandOutput(ctx, cmd); err != nil {
 		return errors.New(err.Error() + "\n" + string(stderr))
 	}
+	releasePoolSlots(ctx, slots, containerName)
 	return nil
 }
 
@@ -152,15 +228,50 @@ func getMountWorkSpace(labID, studentID uint64) string {
 	return filepath.Join(define.InitBasePath, "codespaces", fmt.Sprintf("workspace-%d", labID), strconv.FormatUint(studentID, 10))
 }
 
-var tokenRegexpCompiled = regexp.MustCompile(`token: (\w+)`)
+const theiaTokenScript = `if [ -f /home/theia/ssl/token ]; then cat /home/theia/ssl/token; else printf %s "$token"; fi`
 
 func getContainerToken(ctx context.Context, containerName string) (string, error) {
-	cmd := exec.CommandContext(ctx, "docker", "logs", containerName)
+	if label, _ := getContainerIDEKind(ctx, containerName); label == ideKindLabelJupyter {
+		return getJupyterToken(ctx, containerName)
+	}
+
+	// 与 pack.sh 一致：代理优先读取轮换时写入的 token 文件，从未轮换过的容器使用启动时的环境变量
+	cmd := exec.CommandContext(ctx, "docker", "exec", containerName, "sh", "-c", theiaTokenScript)
 	stdout, stderr, err := osx.CommandOutput(ctx, cmd)
 	if err != nil {
-		return "", fmt.Errorf("failed to find port for container %q because of error %s", containerName, err.Error()+"\n"+string(stderr))
+		return "", fmt.Errorf("failed to read token of container %q because of error %s", containerName, err.Error()+"\n"+string(stderr))
+	}
+	token := strings.TrimSpace(strconvx.BytesToString(stdout))
+	if token == "" {
+		return "", fmt.Errorf("token not found in container %q", containerName)
 	}
+	return token, nil
+}
 
-	data := tokenRegexpCompiled.FindSubmatch(stdout)
-	return strconvx.BytesToString(data[1]), nil
+// ideKindLabelKey 标记非 Theia 的 IDE 容器，各类 IDE 容器与 Theia 容器同名，以复用心跳清理、休眠与冻结逻辑
+const (
+	ideKindLabelKey      = "code-platform.ide"
+	ideKindLabelTerminal = "terminal"
+	ideKindLabelJupyter  = "jupyter"
+)
+
+func ideKindLabel(kind int8) string {
+	switch kind {
+	case define.IDEKindTerminal:
+		return ideKindLabelTerminal
+	case define.IDEKindJupyter:
+		return ideKindLabelJupyter
+	default:
+		return ""
+	}
+}
+
+// getContainerIDEKind 返回容器的 IDE 类型标签，Theia 容器为空，容器不存在时 ok 为 false
+func getContainerIDEKind(ctx context.Context, containerName string) (label string, ok bool) {
+	cmd := exec.CommandContext(ctx, "docker", "inspect", "-f", fmt.Sprintf(`{{index .Config.Labels %q}}`, ideKindLabelKey), containerName)
+	stdout, _, err := osx.CommandOutput(ctx, cmd)
+	if err != nil {
+		return "", false
+	}
+	return string(bytes.TrimSpace(stdout)), true
 }
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
+	"code-platform/pkg/filex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errPathOutsideWorkspace = errors.New("path is outside of workspace")
+	errWorkspaceRoot        = errors.New("workspace root can not be modified")
+)
+
+// resolveWorkspacePath 将相对路径限定在工作区内，符号链接解析后仍需位于工作区内
+func resolveWorkspacePath(root, rel string) (string, error) {
+	path := filepath.Join(root, filepath.Clean("/"+rel))
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	realPath, err := evalExistingSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	if !isWithin(realRoot, realPath) {
+		return "", errPathOutsideWorkspace
+	}
+	return path, nil
+}
+
+// evalExistingSymlinks 解析路径中已存在部分的符号链接，不存在的部分原样拼接
+func evalExistingSymlinks(path string) (string, error) {
+	var rest []string
+	for {
+		realPath, err := filepath.EvalSymlinks(path)
+		switch {
+		case err == nil:
+			return filepath.Join(append([]string{realPath}, rest...)...), nil
+		case errors.Is(err, os.ErrNotExist):
+			parent := filepath.Dir(path)
+			if parent == path {
+				return "", err
+			}
+			rest = append([]string{filepath.Base(path)}, rest...)
+			path = parent
+		default:
+			return "", err
+		}
+	}
+}
+
+func isWithin(root, path string) bool {
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+func workspaceStatusError(err error) error {
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, os.ErrExist):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, errPathOutsideWorkspace), errors.Is(err, errWorkspaceRoot):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errWorkspaceFrozen):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (i *IDEServer) ListWorkspaceDir(ctx context.Context, req *pb.WorkspaceFileRequest) (*pb.ListWorkspaceDirResponse, error) {
+	if req.SnapshotId != 0 {
+		sfs, err := openSnapshotFS(req.LabId, req.StudentId, req.SnapshotId)
+		if err != nil {
+			return nil, workspaceStatusError(err)
+		}
+		dirEntries, err := sfs.ReadDir(snapshotPath(req.Path))
+		if err != nil {
+			return nil, workspaceStatusError(err)
+		}
+		return &pb.ListWorkspaceDirResponse{Entries: workspaceEntries(dirEntries)}, nil
+	}
+
+	path, err := resolveWorkspacePath(getViewWorkSpace(req.LabId, req.StudentId), req.Path)
+	if err != nil {
+		// 尚未打开过 IDE 的学生没有工作区
+		if errors.Is(err, os.ErrNotExist) && filepath.Clean("/"+req.Path) == "/" {
+			return &pb.ListWorkspaceDirResponse{}, nil
+		}
+		return nil, workspaceStatusError(err)
+	}
+
+	dirEntries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	return &pb.ListWorkspaceDirResponse{Entries: workspaceEntries(dirEntries)}, nil
+}
+
+func workspaceEntries(dirEntries []fs.DirEntry) []*pb.WorkspaceEntry {
+	entries := make([]*pb.WorkspaceEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		info, err := dirEntry.Info()
+		if err != nil {
+			// 读取期间被删除
+			continue
+		}
+		entries = append(entries, &pb.WorkspaceEntry{
+			Name:       dirEntry.Name(),
+			IsDir:      dirEntry.IsDir(),
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime().Unix(),
+		})
+	}
+
+	// 目录在前
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].IsDir && !entries[j].IsDir
+	})
+	return entries
+}
+
+// snapshotPath 将相对路径转换为快照文件系统中的路径
+func snapshotPath(rel string) string {
+	if p := strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+rel)), "/"); p != "" {
+		return p
+	}
+	return "."
+}
+
+func (i *IDEServer) ReadWorkspaceFile(ctx context.Context, req *pb.WorkspaceFileRequest) (*pb.ReadWorkspaceFileResponse, error) {
+	var (
+		path string
+		f    *os.File
+		info fs.FileInfo
+		err  error
+	)
+	if req.SnapshotId != 0 {
+		path = snapshotPath(req.Path)
+		f, info, err = openSnapshotFile(req.LabId, req.StudentId, req.SnapshotId, path)
+	} else {
+		if path, err = resolveWorkspacePath(getViewWorkSpace(req.LabId, req.StudentId), req.Path); err != nil {
+			return nil, workspaceStatusError(err)
+		}
+		f, info, err = openFile(path)
+	}
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	if info.IsDir() {
+		if f != nil {
+			f.Close()
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%q is a directory", req.Path)
+	}
+	defer f.Close()
+	if info.Size() > config.IDEServer.GetInt64("workspace.max_file_size") {
+		return nil, status.Errorf(codes.FailedPrecondition, "%q is too large", req.Path)
+	}
+
+	isBinary, err := filex.IsBinary(f)
+	if err != nil {
+		i.Logger.Errorf(err, "check whether %q is binary failed", path)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.ReadWorkspaceFileResponse{
+		Size:       info.Size(),
+		ModifiedAt: info.ModTime().Unix(),
+		IsBinary:   isBinary,
+	}
+	if !isBinary {
+		if resp.Content, err = io.ReadAll(f); err != nil {
+			i.Logger.Errorf(err, "read file %q failed", path)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
+
+func (i *IDEServer) WriteWorkspaceFile(ctx context.Context, req *pb.WriteWorkspaceFileRequest) (*pb.Empty, error) {
+	if int64(len(req.Content)) > config.IDEServer.GetInt64("workspace.max_file_size") {
+		return nil, status.Errorf(codes.FailedPrecondition, "content of %q is too large", req.Path)
+	}
+
+	path, err := resolveModifiableWorkspacePath(req.LabId, req.StudentId, req.Path)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is a directory", req.Path)
+	}
+
+	if err := writeFileAtomic(path, req.Content); err != nil {
+		i.Logger.Errorf(err, "write file %q failed", path)
+		return nil, workspaceStatusError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (i *IDEServer) CreateWorkspaceFile(ctx context.Context, req *pb.CreateWorkspaceFileRequest) (*pb.Empty, error) {
+	path, err := resolveModifiableWorkspacePath(req.LabId, req.StudentId, req.Path)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	if req.IsDir {
+		if err := os.Mkdir(path, os.ModePerm); err != nil {
+			return nil, workspaceStatusError(err)
+		}
+		return &pb.Empty{}, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (i *IDEServer) RenameWorkspaceFile(ctx context.Context, req *pb.RenameWorkspaceFileRequest) (*pb.Empty, error) {
+	from, err := resolveModifiableWorkspacePath(req.LabId, req.StudentId, req.From)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	to, err := resolveModifiableWorkspacePath(req.LabId, req.StudentId, req.To)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	if _, err := os.Lstat(from); err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	// os.Rename 会覆盖已存在的文件
+	if _, err := os.Lstat(to); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "%q already exists", req.To)
+	}
+	if err := os.MkdirAll(filepath.Dir(to), os.ModePerm); err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	if err := os.Rename(from, to); err != nil {
+		i.Logger.Errorf(err, "rename %q to %q failed", from, to)
+		return nil, workspaceStatusError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (i *IDEServer) DeleteWorkspaceFile(ctx context.Context, req *pb.WorkspaceFileRequest) (*pb.Empty, error) {
+	if req.SnapshotId != 0 {
+		return nil, status.Error(codes.InvalidArgument, "snapshot is read-only")
+	}
+
+	path, err := resolveModifiableWorkspacePath(req.LabId, req.StudentId, req.Path)
+	if err != nil {
+		return nil, workspaceStatusError(err)
+	}
+
+	if _, err := os.Lstat(path); err != nil {
+		return nil, workspaceStatusError(err)
+	}
+	if err := os.RemoveAll(path); err != nil {
+		i.Logger.Errorf(err, "remove %q failed", path)
+		return nil, workspaceStatusError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func openFile(path string) (*os.File, fs.FileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, info, nil
+}
+
+// openSnapshotFile 打开快照中的文件，目录返回 nil 文件
+func openSnapshotFile(labID, studentID uint64, snapshotID int64, name string) (*os.File, fs.FileInfo, error) {
+	sfs, err := openSnapshotFS(labID, studentID, snapshotID)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := sfs.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if sf, ok := f.(*snapshotFile); ok {
+		return sf.File, info, nil
+	}
+	f.Close()
+	return nil, info, nil
+}
+
+// resolveModifiableWorkspacePath 同 resolveWorkspacePath，但不允许指向工作区根目录，已冻结的工作区不可修改
+func resolveModifiableWorkspacePath(labID, studentID uint64, rel string) (string, error) {
+	if isWorkspaceFrozen(labID, studentID) {
+		return "", errWorkspaceFrozen
+	}
+	root := getMountWorkSpace(labID, studentID)
+	if err := os.MkdirAll(root, os.ModePerm); err != nil {
+		return "", err
+	}
+	path, err := resolveWorkspacePath(root, rel)
+	if err != nil {
+		return "", err
+	}
+	if path == filepath.Clean(root) {
+		return "", errWorkspaceRoot
+	}
+	return path, nil
+}
+
+// writeFileAtomic 先写入同目录下的临时文件再重命名，避免 IDE 读到写了一半的文件
+func writeFileAtomic(path string, content []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, ".tmp-"+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -22,7 +22,10 @@ import (
 func (m *MonacoServer) ExecCode(ctx context.Context, req *pb.ExecCodeRequest) (*pb.ExecCodeResponse, error) {
 	language := int8(req.Language)
 	code := req.Code
-	imageName := getImageName(language)
+	imageName := req.Image
+	if imageName == "" {
+		imageName = getImageName(language)
+	}
 
 	// rand code 加以混淆，防止同一时刻同时产生多个容器名
 	uuid, err := randx.NewRandCode(6)
@@ -49,7 +52,8 @@ func (m *MonacoServer) ExecCode(ctx context.Context, req *pb.ExecCodeRequest) (*
 		return nil, status.Error(codes.OutOfRange, "OOM")
 	case ctx.Err(), errorx.ErrContextCancel:
 		m.Logger.Debugf("command %q is canceled or deadline", dockerRunCommand)
-		// 异步开启任务停止
+		// 异步开启任务停止；容器在 Monaco 服务所在的主机上，后端的调度器无法执行 docker stop，
+		// 因此不作为调度器的持久任务，重试仍失败时容器以 --rm 运行，在代码结束后自行删除
 		parallelx.DoAsyncWithTimeOut(context.TODO(), 30*time.Second, m.Logger, func(ctx context.Context) (err error) {
 			// 重试5次
 			for i := 0; i < 5; i++ {
@@ -73,6 +73,62 @@ func (Language) EnumDescriptor() ([]byte, []int) {
 	return file_plagiarism_detection_proto_rawDescGZIP(), []int{0}
 }
 
+type SourceFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name 相对路径，以 / 分隔
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *SourceFile) Reset() {
+	*x = SourceFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceFile) ProtoMessage() {}
+
+func (x *SourceFile) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceFile.ProtoReflect.Descriptor instead.
+func (*SourceFile) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SourceFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SourceFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
 type DuplicateCheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -80,12 +136,20 @@ type DuplicateCheckRequest struct {
 
 	LabID uint64   `protobuf:"varint,1,opt,name=labID,proto3" json:"labID,omitempty"`
 	Lan   Language `protobuf:"varint,2,opt,name=lan,proto3,enum=plagiarism_detection.Language" json:"lan,omitempty"`
+	// base_files 实验下发的基础代码，与其相同的片段不计入相似度
+	BaseFiles []*SourceFile `protobuf:"bytes,3,rep,name=base_files,json=baseFiles,proto3" json:"base_files,omitempty"`
+	// min_match 最短匹配的词法单元数，为 0 时使用语言的默认值
+	MinMatch int32 `protobuf:"varint,4,opt,name=min_match,json=minMatch,proto3" json:"min_match,omitempty"`
+	// ignore 追加在服务端配置之后的 .gitignore 规则
+	Ignore []string `protobuf:"bytes,5,rep,name=ignore,proto3" json:"ignore,omitempty"`
+	// reference_lab_ids 作为参考的其他实验（含往届已冻结的实验），其提交只与本实验的提交比对
+	ReferenceLabIds []uint64 `protobuf:"varint,6,rep,packed,name=reference_lab_ids,json=referenceLabIds,proto3" json:"reference_lab_ids,omitempty"`
 }
 
 func (x *DuplicateCheckRequest) Reset() {
 	*x = DuplicateCheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[0]
+		mi := &file_plagiarism_detection_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -98,7 +162,7 @@ func (x *DuplicateCheckRequest) String() string {
 func (*DuplicateCheckRequest) ProtoMessage() {}
 
 func (x *DuplicateCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[0]
+	mi := &file_plagiarism_detection_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +175,7 @@ func (x *DuplicateCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DuplicateCheckRequest.ProtoReflect.Descriptor instead.
 func (*DuplicateCheckRequest) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{0}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *DuplicateCheckRequest) GetLabID() uint64 {
@@ -128,6 +192,34 @@ func (x *DuplicateCheckRequest) GetLan() Language {
 	return Language_python3
 }
 
+func (x *DuplicateCheckRequest) GetBaseFiles() []*SourceFile {
+	if x != nil {
+		return x.BaseFiles
+	}
+	return nil
+}
+
+func (x *DuplicateCheckRequest) GetMinMatch() int32 {
+	if x != nil {
+		return x.MinMatch
+	}
+	return 0
+}
+
+func (x *DuplicateCheckRequest) GetIgnore() []string {
+	if x != nil {
+		return x.Ignore
+	}
+	return nil
+}
+
+func (x *DuplicateCheckRequest) GetReferenceLabIds() []uint64 {
+	if x != nil {
+		return x.ReferenceLabIds
+	}
+	return nil
+}
+
 type DuplicateCheckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -140,7 +232,7 @@ type DuplicateCheckResponse struct {
 func (x *DuplicateCheckResponse) Reset() {
 	*x = DuplicateCheckResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[1]
+		mi := &file_plagiarism_detection_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -153,7 +245,7 @@ func (x *DuplicateCheckResponse) String() string {
 func (*DuplicateCheckResponse) ProtoMessage() {}
 
 func (x *DuplicateCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[1]
+	mi := &file_plagiarism_detection_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +258,7 @@ func (x *DuplicateCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DuplicateCheckResponse.ProtoReflect.Descriptor instead.
 func (*DuplicateCheckResponse) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{1}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DuplicateCheckResponse) GetComparision() *DuplicateCheckResponse_DuplicateCheckResponseValue {
@@ -183,6 +275,63 @@ func (x *DuplicateCheckResponse) GetTimeStamp() string {
 	return ""
 }
 
+// DuplicateCheckProgress 查重过程中依次返回进度，最后一条携带结果
+type DuplicateCheckProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// progress 已完成的百分比，0 到 100
+	Progress int32                   `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Result   *DuplicateCheckResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DuplicateCheckProgress) Reset() {
+	*x = DuplicateCheckProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateCheckProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCheckProgress) ProtoMessage() {}
+
+func (x *DuplicateCheckProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCheckProgress.ProtoReflect.Descriptor instead.
+func (*DuplicateCheckProgress) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DuplicateCheckProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *DuplicateCheckProgress) GetResult() *DuplicateCheckResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type ViewReportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -196,7 +345,7 @@ type ViewReportRequest struct {
 func (x *ViewReportRequest) Reset() {
 	*x = ViewReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[2]
+		mi := &file_plagiarism_detection_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -206,71 +355,369 @@ func (x *ViewReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ViewReportRequest) ProtoMessage() {}
+func (*ViewReportRequest) ProtoMessage() {}
+
+func (x *ViewReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewReportRequest.ProtoReflect.Descriptor instead.
+func (*ViewReportRequest) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ViewReportRequest) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *ViewReportRequest) GetTimeStamp() string {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return ""
+}
+
+func (x *ViewReportRequest) GetHtmlFileName() string {
+	if x != nil {
+		return x.HtmlFileName
+	}
+	return ""
+}
+
+type ViewReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HtmlFileContent string `protobuf:"bytes,1,opt,name=html_file_content,json=htmlFileContent,proto3" json:"html_file_content,omitempty"`
+}
+
+func (x *ViewReportResponse) Reset() {
+	*x = ViewReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewReportResponse) ProtoMessage() {}
+
+func (x *ViewReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewReportResponse.ProtoReflect.Descriptor instead.
+func (*ViewReportResponse) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ViewReportResponse) GetHtmlFileContent() string {
+	if x != nil {
+		return x.HtmlFileContent
+	}
+	return ""
+}
+
+type Region struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// start_line、end_line 从 1 开始，包含 end_line
+	StartLine int32 `protobuf:"varint,2,opt,name=start_line,json=startLine,proto3" json:"start_line,omitempty"`
+	EndLine   int32 `protobuf:"varint,3,opt,name=end_line,json=endLine,proto3" json:"end_line,omitempty"`
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Region) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Region) GetStartLine() int32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *Region) GetEndLine() int32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+type MatchRegion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *Region `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B *Region `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	// tokens 片段包含的词法单元数
+	Tokens int32 `protobuf:"varint,3,opt,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *MatchRegion) Reset() {
+	*x = MatchRegion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRegion) ProtoMessage() {}
+
+func (x *MatchRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRegion.ProtoReflect.Descriptor instead.
+func (*MatchRegion) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MatchRegion) GetA() *Region {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *MatchRegion) GetB() *Region {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *MatchRegion) GetTokens() int32 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+// ComparisonDetail 一组比对的相似度、相同片段与查重时的代码，与 HTML 报告一同保存；
+// 保存时不含代码，files_a、files_b 在查看时从每份提交只保存一次的 SubmissionSource 中填充
+type ComparisonDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AnotherUserId  uint64 `protobuf:"varint,2,opt,name=another_user_id,json=anotherUserId,proto3" json:"another_user_id,omitempty"`
+	ReferenceLabId uint64 `protobuf:"varint,3,opt,name=reference_lab_id,json=referenceLabId,proto3" json:"reference_lab_id,omitempty"`
+	// similarity 0 到 1，similarity_a、similarity_b 分别为相同片段占两份提交的比例
+	Similarity      float64        `protobuf:"fixed64,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	SimilarityA     float64        `protobuf:"fixed64,5,opt,name=similarity_a,json=similarityA,proto3" json:"similarity_a,omitempty"`
+	SimilarityB     float64        `protobuf:"fixed64,6,opt,name=similarity_b,json=similarityB,proto3" json:"similarity_b,omitempty"`
+	Matches         []*MatchRegion `protobuf:"bytes,7,rep,name=matches,proto3" json:"matches,omitempty"`
+	FilesA          []*SourceFile  `protobuf:"bytes,8,rep,name=files_a,json=filesA,proto3" json:"files_a,omitempty"`
+	FilesB          []*SourceFile  `protobuf:"bytes,9,rep,name=files_b,json=filesB,proto3" json:"files_b,omitempty"`
+	HtmlFileContent string         `protobuf:"bytes,10,opt,name=html_file_content,json=htmlFileContent,proto3" json:"html_file_content,omitempty"`
+}
+
+func (x *ComparisonDetail) Reset() {
+	*x = ComparisonDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparisonDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparisonDetail) ProtoMessage() {}
+
+func (x *ComparisonDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparisonDetail.ProtoReflect.Descriptor instead.
+func (*ComparisonDetail) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ComparisonDetail) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ComparisonDetail) GetAnotherUserId() uint64 {
+	if x != nil {
+		return x.AnotherUserId
+	}
+	return 0
+}
+
+func (x *ComparisonDetail) GetReferenceLabId() uint64 {
+	if x != nil {
+		return x.ReferenceLabId
+	}
+	return 0
+}
+
+func (x *ComparisonDetail) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *ComparisonDetail) GetSimilarityA() float64 {
+	if x != nil {
+		return x.SimilarityA
+	}
+	return 0
+}
 
-func (x *ViewReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ComparisonDetail) GetSimilarityB() float64 {
+	if x != nil {
+		return x.SimilarityB
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ViewReportRequest.ProtoReflect.Descriptor instead.
-func (*ViewReportRequest) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{2}
+func (x *ComparisonDetail) GetMatches() []*MatchRegion {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
 }
 
-func (x *ViewReportRequest) GetLabId() uint64 {
+func (x *ComparisonDetail) GetFilesA() []*SourceFile {
 	if x != nil {
-		return x.LabId
+		return x.FilesA
 	}
-	return 0
+	return nil
 }
 
-func (x *ViewReportRequest) GetTimeStamp() string {
+func (x *ComparisonDetail) GetFilesB() []*SourceFile {
 	if x != nil {
-		return x.TimeStamp
+		return x.FilesB
 	}
-	return ""
+	return nil
 }
 
-func (x *ViewReportRequest) GetHtmlFileName() string {
+func (x *ComparisonDetail) GetHtmlFileContent() string {
 	if x != nil {
-		return x.HtmlFileName
+		return x.HtmlFileContent
 	}
 	return ""
 }
 
-type ViewReportResponse struct {
+// SubmissionSource 一份提交查重时的代码，每次查重每份提交保存一份
+type SubmissionSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HtmlFileContent string `protobuf:"bytes,1,opt,name=html_file_content,json=htmlFileContent,proto3" json:"html_file_content,omitempty"`
+	Files []*SourceFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *ViewReportResponse) Reset() {
-	*x = ViewReportResponse{}
+func (x *SubmissionSource) Reset() {
+	*x = SubmissionSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[3]
+		mi := &file_plagiarism_detection_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ViewReportResponse) String() string {
+func (x *SubmissionSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ViewReportResponse) ProtoMessage() {}
+func (*SubmissionSource) ProtoMessage() {}
 
-func (x *ViewReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[3]
+func (x *SubmissionSource) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,16 +728,16 @@ func (x *ViewReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ViewReportResponse.ProtoReflect.Descriptor instead.
-func (*ViewReportResponse) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SubmissionSource.ProtoReflect.Descriptor instead.
+func (*SubmissionSource) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ViewReportResponse) GetHtmlFileContent() string {
+func (x *SubmissionSource) GetFiles() []*SourceFile {
 	if x != nil {
-		return x.HtmlFileContent
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
 type GenerateTestFilesForDuplicateCheckRequest struct {
@@ -305,7 +752,7 @@ type GenerateTestFilesForDuplicateCheckRequest struct {
 func (x *GenerateTestFilesForDuplicateCheckRequest) Reset() {
 	*x = GenerateTestFilesForDuplicateCheckRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[4]
+		mi := &file_plagiarism_detection_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -318,7 +765,7 @@ func (x *GenerateTestFilesForDuplicateCheckRequest) String() string {
 func (*GenerateTestFilesForDuplicateCheckRequest) ProtoMessage() {}
 
 func (x *GenerateTestFilesForDuplicateCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[4]
+	mi := &file_plagiarism_detection_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,7 +778,7 @@ func (x *GenerateTestFilesForDuplicateCheckRequest) ProtoReflect() protoreflect.
 
 // Deprecated: Use GenerateTestFilesForDuplicateCheckRequest.ProtoReflect.Descriptor instead.
 func (*GenerateTestFilesForDuplicateCheckRequest) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{4}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GenerateTestFilesForDuplicateCheckRequest) GetCodeContent() string {
@@ -360,7 +807,7 @@ type GenerateTestHTMLFileForViewReportRequest struct {
 func (x *GenerateTestHTMLFileForViewReportRequest) Reset() {
 	*x = GenerateTestHTMLFileForViewReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[5]
+		mi := &file_plagiarism_detection_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -373,7 +820,7 @@ func (x *GenerateTestHTMLFileForViewReportRequest) String() string {
 func (*GenerateTestHTMLFileForViewReportRequest) ProtoMessage() {}
 
 func (x *GenerateTestHTMLFileForViewReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[5]
+	mi := &file_plagiarism_detection_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +833,7 @@ func (x *GenerateTestHTMLFileForViewReportRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GenerateTestHTMLFileForViewReportRequest.ProtoReflect.Descriptor instead.
 func (*GenerateTestHTMLFileForViewReportRequest) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{5}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GenerateTestHTMLFileForViewReportRequest) GetTimeStamp() string {
@@ -412,7 +859,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[6]
+		mi := &file_plagiarism_detection_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +872,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[6]
+	mi := &file_plagiarism_detection_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +885,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{6}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{12}
 }
 
 type DuplicateCheckResponse_DuplicateCheckResponseValue struct {
@@ -446,13 +893,14 @@ type DuplicateCheckResponse_DuplicateCheckResponseValue struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comparisions []*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion `protobuf:"bytes,1,rep,name=comparisions,proto3" json:"comparisions,omitempty"`
+	Comparisions     []*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion      `protobuf:"bytes,1,rep,name=comparisions,proto3" json:"comparisions,omitempty"`
+	ReferenceSources []*DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource `protobuf:"bytes,2,rep,name=reference_sources,json=referenceSources,proto3" json:"reference_sources,omitempty"`
 }
 
 func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) Reset() {
 	*x = DuplicateCheckResponse_DuplicateCheckResponseValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[7]
+		mi := &file_plagiarism_detection_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +913,7 @@ func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) String() string {
 func (*DuplicateCheckResponse_DuplicateCheckResponseValue) ProtoMessage() {}
 
 func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[7]
+	mi := &file_plagiarism_detection_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +926,7 @@ func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) ProtoReflect() prot
 
 // Deprecated: Use DuplicateCheckResponse_DuplicateCheckResponseValue.ProtoReflect.Descriptor instead.
 func (*DuplicateCheckResponse_DuplicateCheckResponseValue) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{1, 0}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) GetComparisions() []*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion {
@@ -488,6 +936,13 @@ func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) GetComparisions() [
 	return nil
 }
 
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue) GetReferenceSources() []*DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource {
+	if x != nil {
+		return x.ReferenceSources
+	}
+	return nil
+}
+
 type DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -497,12 +952,14 @@ type DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion struct {
 	AnotherUserId uint64 `protobuf:"varint,2,opt,name=another_user_id,json=anotherUserId,proto3" json:"another_user_id,omitempty"`
 	HtmlFileName  string `protobuf:"bytes,3,opt,name=html_file_name,json=htmlFileName,proto3" json:"html_file_name,omitempty"`
 	Similarity    int32  `protobuf:"varint,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	// reference_lab_id 不为 0 时 another_user_id 为该参考实验中的提交
+	ReferenceLabId uint64 `protobuf:"varint,5,opt,name=reference_lab_id,json=referenceLabId,proto3" json:"reference_lab_id,omitempty"`
 }
 
 func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) Reset() {
 	*x = DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plagiarism_detection_proto_msgTypes[8]
+		mi := &file_plagiarism_detection_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +972,7 @@ func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) String()
 func (*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) ProtoMessage() {}
 
 func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) ProtoReflect() protoreflect.Message {
-	mi := &file_plagiarism_detection_proto_msgTypes[8]
+	mi := &file_plagiarism_detection_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +985,7 @@ func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) ProtoRef
 
 // Deprecated: Use DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion.ProtoReflect.Descriptor instead.
 func (*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) Descriptor() ([]byte, []int) {
-	return file_plagiarism_detection_proto_rawDescGZIP(), []int{1, 0, 0}
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{2, 0, 0}
 }
 
 func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) GetUserId() uint64 {
@@ -559,124 +1016,284 @@ func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) GetSimil
 	return 0
 }
 
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion) GetReferenceLabId() uint64 {
+	if x != nil {
+		return x.ReferenceLabId
+	}
+	return 0
+}
+
+// ReferenceSource 参考实验的提交来源，source 为 frozen（截止时冻结的副本）、live（当前的工作区）或 missing（均不存在）
+type DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LabId  uint64 `protobuf:"varint,1,opt,name=lab_id,json=labId,proto3" json:"lab_id,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) Reset() {
+	*x = DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plagiarism_detection_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) ProtoMessage() {}
+
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) ProtoReflect() protoreflect.Message {
+	mi := &file_plagiarism_detection_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource.ProtoReflect.Descriptor instead.
+func (*DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) Descriptor() ([]byte, []int) {
+	return file_plagiarism_detection_proto_rawDescGZIP(), []int{2, 0, 1}
+}
+
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) GetLabId() uint64 {
+	if x != nil {
+		return x.LabId
+	}
+	return 0
+}
+
+func (x *DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 var File_plagiarism_detection_proto protoreflect.FileDescriptor
 
 var file_plagiarism_detection_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74,
 	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x70, 0x6c,
 	0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49,
-	0x44, 0x12, 0x30, 0x0a, 0x03, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x81,
+	0x02, 0x0a, 0x15, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x49, 0x44, 0x12, 0x30,
+	0x0a, 0x03, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6c,
+	0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6c, 0x61, 0x6e,
+	0x12, 0x3f, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73,
+	0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x49,
+	0x64, 0x73, 0x22, 0xc6, 0x05, 0x0a, 0x16, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x48, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0xa0, 0x04, 0x0a, 0x1b, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x53,
 	0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x03,
-	0x6c, 0x61, 0x6e, 0x22, 0xd2, 0x03, 0x0a, 0x16, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d,
-	0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0xac, 0x02, 0x0a, 0x1b, 0x44, 0x75,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x53, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x75,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x93, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6e,
-	0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x58, 0x2e,
+	0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0xbd, 0x01, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6d,
+	0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x49, 0x64, 0x1a, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x7a, 0x0a, 0x16, 0x44,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6f, 0x0a, 0x11, 0x56, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69,
-	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x69,
-	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x6f, 0x0a, 0x11, 0x56, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x74, 0x6d,
-	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x11, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x74, 0x6d, 0x6c,
-	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x29,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x64,
-	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x03,
-	0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x67,
+	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x56, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x74, 0x6d, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x06, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x6e, 0x65, 0x22, 0x7d, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x01, 0x61, 0x12, 0x2a, 0x0a,
+	0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x01, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x22, 0xc2, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x41, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x42, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69,
+	0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x12,
+	0x39, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x62, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x74,
+	0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x74, 0x6d, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x67,
 	0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6c, 0x61, 0x6e, 0x22, 0x6f,
-	0x0a, 0x28, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x48, 0x54,
-	0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6d,
-	0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x2a, 0x2a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x63, 0x70, 0x70, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x6a, 0x61,
-	0x76, 0x61, 0x10, 0x02, 0x32, 0xa6, 0x05, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72,
-	0x69, 0x73, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x0e,
-	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2b,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x29, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x6c, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x52, 0x03, 0x6c, 0x61, 0x6e, 0x22, 0x6f, 0x0a, 0x28, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x73, 0x74, 0x48, 0x54, 0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x46, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x2a,
+	0x2a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x70,
+	0x79, 0x74, 0x68, 0x6f, 0x6e, 0x33, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x63, 0x70, 0x70, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x6a, 0x61, 0x76, 0x61, 0x10, 0x02, 0x32, 0x84, 0x07, 0x0a, 0x13,
+	0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x0e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69,
+	0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x79, 0x0a, 0x1a, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b,
 	0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
 	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6c,
 	0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0a, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61,
-	0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x22, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x46, 0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x3f, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64,
-	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f,
-	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x5c, 0x0a, 0x20, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d,
-	0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x80, 0x01,
-	0x0a, 0x21, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x48, 0x54,
-	0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x3e, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d,
-	0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x48, 0x54, 0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46,
-	0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d,
-	0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x5b, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x73, 0x74, 0x48, 0x54,
-	0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d,
-	0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x2e, 0x0a,
-	0x02, 0x70, 0x62, 0x42, 0x21, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x44,
-	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d,
-	0x70, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x50, 0x00, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x0a, 0x56,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x6c, 0x61, 0x67,
+	0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e,
+	0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x27,
+	0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61,
+	0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x82, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x3f, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72,
+	0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61,
+	0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x20, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69,
+	0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x48, 0x54, 0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x48, 0x54, 0x4d, 0x4c,
+	0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x48, 0x54, 0x4d, 0x4c, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69,
+	0x61, 0x72, 0x69, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61, 0x72, 0x69,
+	0x73, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x2e, 0x0a, 0x02, 0x70, 0x62, 0x42, 0x21, 0x70, 0x6c, 0x61, 0x67, 0x69, 0x61,
+	0x72, 0x69, 0x73, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6c, 0x42, 0x61, 0x73, 0x65, 0x50, 0x00, 0x5a, 0x03, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -692,41 +1309,61 @@ func file_plagiarism_detection_proto_rawDescGZIP() []byte {
 }
 
 var file_plagiarism_detection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_plagiarism_detection_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_plagiarism_detection_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_plagiarism_detection_proto_goTypes = []interface{}{
-	(Language)(0),                                                         // 0: plagiarism_detection.language
-	(*DuplicateCheckRequest)(nil),                                         // 1: plagiarism_detection.DuplicateCheckRequest
-	(*DuplicateCheckResponse)(nil),                                        // 2: plagiarism_detection.DuplicateCheckResponse
-	(*ViewReportRequest)(nil),                                             // 3: plagiarism_detection.ViewReportRequest
-	(*ViewReportResponse)(nil),                                            // 4: plagiarism_detection.ViewReportResponse
-	(*GenerateTestFilesForDuplicateCheckRequest)(nil),                     // 5: plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest
-	(*GenerateTestHTMLFileForViewReportRequest)(nil),                      // 6: plagiarism_detection.GenerateTestHTMLFileForViewReportRequest
-	(*Empty)(nil),                                                         // 7: plagiarism_detection.Empty
-	(*DuplicateCheckResponse_DuplicateCheckResponseValue)(nil),            // 8: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue
-	(*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion)(nil), // 9: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion
+	(Language)(0),                                              // 0: plagiarism_detection.language
+	(*SourceFile)(nil),                                         // 1: plagiarism_detection.SourceFile
+	(*DuplicateCheckRequest)(nil),                              // 2: plagiarism_detection.DuplicateCheckRequest
+	(*DuplicateCheckResponse)(nil),                             // 3: plagiarism_detection.DuplicateCheckResponse
+	(*DuplicateCheckProgress)(nil),                             // 4: plagiarism_detection.DuplicateCheckProgress
+	(*ViewReportRequest)(nil),                                  // 5: plagiarism_detection.ViewReportRequest
+	(*ViewReportResponse)(nil),                                 // 6: plagiarism_detection.ViewReportResponse
+	(*Region)(nil),                                             // 7: plagiarism_detection.Region
+	(*MatchRegion)(nil),                                        // 8: plagiarism_detection.MatchRegion
+	(*ComparisonDetail)(nil),                                   // 9: plagiarism_detection.ComparisonDetail
+	(*SubmissionSource)(nil),                                   // 10: plagiarism_detection.SubmissionSource
+	(*GenerateTestFilesForDuplicateCheckRequest)(nil),          // 11: plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest
+	(*GenerateTestHTMLFileForViewReportRequest)(nil),           // 12: plagiarism_detection.GenerateTestHTMLFileForViewReportRequest
+	(*Empty)(nil),                                              // 13: plagiarism_detection.Empty
+	(*DuplicateCheckResponse_DuplicateCheckResponseValue)(nil), // 14: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue
+	(*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion)(nil),      // 15: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion
+	(*DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource)(nil), // 16: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.ReferenceSource
 }
 var file_plagiarism_detection_proto_depIdxs = []int32{
 	0,  // 0: plagiarism_detection.DuplicateCheckRequest.lan:type_name -> plagiarism_detection.language
-	8,  // 1: plagiarism_detection.DuplicateCheckResponse.comparision:type_name -> plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue
-	0,  // 2: plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest.lan:type_name -> plagiarism_detection.language
-	9,  // 3: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.comparisions:type_name -> plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion
-	1,  // 4: plagiarism_detection.plagiarismDetection.DuplicateCheck:input_type -> plagiarism_detection.DuplicateCheckRequest
-	3,  // 5: plagiarism_detection.plagiarismDetection.ViewReport:input_type -> plagiarism_detection.ViewReportRequest
-	5,  // 6: plagiarism_detection.plagiarismDetection.GenerateTestFilesForDuplicateCheck:input_type -> plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest
-	7,  // 7: plagiarism_detection.plagiarismDetection.RemoveTestFilesForDuplicateCheck:input_type -> plagiarism_detection.Empty
-	6,  // 8: plagiarism_detection.plagiarismDetection.GenerateTestHTMLFileForViewReport:input_type -> plagiarism_detection.GenerateTestHTMLFileForViewReportRequest
-	7,  // 9: plagiarism_detection.plagiarismDetection.RemoveTestHTMLFileForViewReport:input_type -> plagiarism_detection.Empty
-	2,  // 10: plagiarism_detection.plagiarismDetection.DuplicateCheck:output_type -> plagiarism_detection.DuplicateCheckResponse
-	4,  // 11: plagiarism_detection.plagiarismDetection.ViewReport:output_type -> plagiarism_detection.ViewReportResponse
-	7,  // 12: plagiarism_detection.plagiarismDetection.GenerateTestFilesForDuplicateCheck:output_type -> plagiarism_detection.Empty
-	7,  // 13: plagiarism_detection.plagiarismDetection.RemoveTestFilesForDuplicateCheck:output_type -> plagiarism_detection.Empty
-	7,  // 14: plagiarism_detection.plagiarismDetection.GenerateTestHTMLFileForViewReport:output_type -> plagiarism_detection.Empty
-	7,  // 15: plagiarism_detection.plagiarismDetection.RemoveTestHTMLFileForViewReport:output_type -> plagiarism_detection.Empty
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 1: plagiarism_detection.DuplicateCheckRequest.base_files:type_name -> plagiarism_detection.SourceFile
+	14, // 2: plagiarism_detection.DuplicateCheckResponse.comparision:type_name -> plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue
+	3,  // 3: plagiarism_detection.DuplicateCheckProgress.result:type_name -> plagiarism_detection.DuplicateCheckResponse
+	7,  // 4: plagiarism_detection.MatchRegion.a:type_name -> plagiarism_detection.Region
+	7,  // 5: plagiarism_detection.MatchRegion.b:type_name -> plagiarism_detection.Region
+	8,  // 6: plagiarism_detection.ComparisonDetail.matches:type_name -> plagiarism_detection.MatchRegion
+	1,  // 7: plagiarism_detection.ComparisonDetail.files_a:type_name -> plagiarism_detection.SourceFile
+	1,  // 8: plagiarism_detection.ComparisonDetail.files_b:type_name -> plagiarism_detection.SourceFile
+	1,  // 9: plagiarism_detection.SubmissionSource.files:type_name -> plagiarism_detection.SourceFile
+	0,  // 10: plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest.lan:type_name -> plagiarism_detection.language
+	15, // 11: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.comparisions:type_name -> plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion
+	16, // 12: plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.reference_sources:type_name -> plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.ReferenceSource
+	2,  // 13: plagiarism_detection.plagiarismDetection.DuplicateCheck:input_type -> plagiarism_detection.DuplicateCheckRequest
+	2,  // 14: plagiarism_detection.plagiarismDetection.DuplicateCheckWithProgress:input_type -> plagiarism_detection.DuplicateCheckRequest
+	5,  // 15: plagiarism_detection.plagiarismDetection.ViewReport:input_type -> plagiarism_detection.ViewReportRequest
+	5,  // 16: plagiarism_detection.plagiarismDetection.ViewComparison:input_type -> plagiarism_detection.ViewReportRequest
+	11, // 17: plagiarism_detection.plagiarismDetection.GenerateTestFilesForDuplicateCheck:input_type -> plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest
+	13, // 18: plagiarism_detection.plagiarismDetection.RemoveTestFilesForDuplicateCheck:input_type -> plagiarism_detection.Empty
+	12, // 19: plagiarism_detection.plagiarismDetection.GenerateTestHTMLFileForViewReport:input_type -> plagiarism_detection.GenerateTestHTMLFileForViewReportRequest
+	13, // 20: plagiarism_detection.plagiarismDetection.RemoveTestHTMLFileForViewReport:input_type -> plagiarism_detection.Empty
+	3,  // 21: plagiarism_detection.plagiarismDetection.DuplicateCheck:output_type -> plagiarism_detection.DuplicateCheckResponse
+	4,  // 22: plagiarism_detection.plagiarismDetection.DuplicateCheckWithProgress:output_type -> plagiarism_detection.DuplicateCheckProgress
+	6,  // 23: plagiarism_detection.plagiarismDetection.ViewReport:output_type -> plagiarism_detection.ViewReportResponse
+	9,  // 24: plagiarism_detection.plagiarismDetection.ViewComparison:output_type -> plagiarism_detection.ComparisonDetail
+	13, // 25: plagiarism_detection.plagiarismDetection.GenerateTestFilesForDuplicateCheck:output_type -> plagiarism_detection.Empty
+	13, // 26: plagiarism_detection.plagiarismDetection.RemoveTestFilesForDuplicateCheck:output_type -> plagiarism_detection.Empty
+	13, // 27: plagiarism_detection.plagiarismDetection.GenerateTestHTMLFileForViewReport:output_type -> plagiarism_detection.Empty
+	13, // 28: plagiarism_detection.plagiarismDetection.RemoveTestHTMLFileForViewReport:output_type -> plagiarism_detection.Empty
+	21, // [21:29] is the sub-list for method output_type
+	13, // [13:21] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_plagiarism_detection_proto_init() }
@@ -736,7 +1373,7 @@ func file_plagiarism_detection_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_plagiarism_detection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DuplicateCheckRequest); i {
+			switch v := v.(*SourceFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -748,7 +1385,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DuplicateCheckResponse); i {
+			switch v := v.(*DuplicateCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -760,7 +1397,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ViewReportRequest); i {
+			switch v := v.(*DuplicateCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -772,7 +1409,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ViewReportResponse); i {
+			switch v := v.(*DuplicateCheckProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -784,7 +1421,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateTestFilesForDuplicateCheckRequest); i {
+			switch v := v.(*ViewReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -796,7 +1433,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateTestHTMLFileForViewReportRequest); i {
+			switch v := v.(*ViewReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -808,7 +1445,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+			switch v := v.(*Region); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -820,7 +1457,7 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DuplicateCheckResponse_DuplicateCheckResponseValue); i {
+			switch v := v.(*MatchRegion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -832,6 +1469,78 @@ func file_plagiarism_detection_proto_init() {
 			}
 		}
 		file_plagiarism_detection_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparisonDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plagiarism_detection_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmissionSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plagiarism_detection_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateTestFilesForDuplicateCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plagiarism_detection_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateTestHTMLFileForViewReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plagiarism_detection_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plagiarism_detection_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateCheckResponse_DuplicateCheckResponseValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plagiarism_detection_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion); i {
 			case 0:
 				return &v.state
@@ -843,6 +1552,18 @@ func file_plagiarism_detection_proto_init() {
 				return nil
 			}
 		}
+		file_plagiarism_detection_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -850,7 +1571,7 @@ func file_plagiarism_detection_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plagiarism_detection_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -878,7 +1599,10 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type PlagiarismDetectionClient interface {
 	DuplicateCheck(ctx context.Context, in *DuplicateCheckRequest, opts ...grpc.CallOption) (*DuplicateCheckResponse, error)
+	DuplicateCheckWithProgress(ctx context.Context, in *DuplicateCheckRequest, opts ...grpc.CallOption) (PlagiarismDetection_DuplicateCheckWithProgressClient, error)
 	ViewReport(ctx context.Context, in *ViewReportRequest, opts ...grpc.CallOption) (*ViewReportResponse, error)
+	// ViewComparison 返回 HTML 报告及其比对详情，早于该接口的报告没有详情
+	ViewComparison(ctx context.Context, in *ViewReportRequest, opts ...grpc.CallOption) (*ComparisonDetail, error)
 	// GenerateTestFiles 生成代码文件以作测试用
 	GenerateTestFilesForDuplicateCheck(ctx context.Context, in *GenerateTestFilesForDuplicateCheckRequest, opts ...grpc.CallOption) (*Empty, error)
 	RemoveTestFilesForDuplicateCheck(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
@@ -904,6 +1628,38 @@ func (c *plagiarismDetectionClient) DuplicateCheck(ctx context.Context, in *Dupl
 	return out, nil
 }
 
+func (c *plagiarismDetectionClient) DuplicateCheckWithProgress(ctx context.Context, in *DuplicateCheckRequest, opts ...grpc.CallOption) (PlagiarismDetection_DuplicateCheckWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PlagiarismDetection_serviceDesc.Streams[0], "/plagiarism_detection.plagiarismDetection/DuplicateCheckWithProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &plagiarismDetectionDuplicateCheckWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlagiarismDetection_DuplicateCheckWithProgressClient interface {
+	Recv() (*DuplicateCheckProgress, error)
+	grpc.ClientStream
+}
+
+type plagiarismDetectionDuplicateCheckWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *plagiarismDetectionDuplicateCheckWithProgressClient) Recv() (*DuplicateCheckProgress, error) {
+	m := new(DuplicateCheckProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *plagiarismDetectionClient) ViewReport(ctx context.Context, in *ViewReportRequest, opts ...grpc.CallOption) (*ViewReportResponse, error) {
 	out := new(ViewReportResponse)
 	err := c.cc.Invoke(ctx, "/plagiarism_detection.plagiarismDetection/ViewReport", in, out, opts...)
@@ -913,6 +1669,15 @@ func (c *plagiarismDetectionClient) ViewReport(ctx context.Context, in *ViewRepo
 	return out, nil
 }
 
+func (c *plagiarismDetectionClient) ViewComparison(ctx context.Context, in *ViewReportRequest, opts ...grpc.CallOption) (*ComparisonDetail, error) {
+	out := new(ComparisonDetail)
+	err := c.cc.Invoke(ctx, "/plagiarism_detection.plagiarismDetection/ViewComparison", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *plagiarismDetectionClient) GenerateTestFilesForDuplicateCheck(ctx context.Context, in *GenerateTestFilesForDuplicateCheckRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/plagiarism_detection.plagiarismDetection/GenerateTestFilesForDuplicateCheck", in, out, opts...)
@@ -952,7 +1717,10 @@ func (c *plagiarismDetectionClient) RemoveTestHTMLFileForViewReport(ctx context.
 // PlagiarismDetectionServer is the server API for PlagiarismDetection service.
 type PlagiarismDetectionServer interface {
 	DuplicateCheck(context.Context, *DuplicateCheckRequest) (*DuplicateCheckResponse, error)
+	DuplicateCheckWithProgress(*DuplicateCheckRequest, PlagiarismDetection_DuplicateCheckWithProgressServer) error
 	ViewReport(context.Context, *ViewReportRequest) (*ViewReportResponse, error)
+	// ViewComparison 返回 HTML 报告及其比对详情，早于该接口的报告没有详情
+	ViewComparison(context.Context, *ViewReportRequest) (*ComparisonDetail, error)
 	// GenerateTestFiles 生成代码文件以作测试用
 	GenerateTestFilesForDuplicateCheck(context.Context, *GenerateTestFilesForDuplicateCheckRequest) (*Empty, error)
 	RemoveTestFilesForDuplicateCheck(context.Context, *Empty) (*Empty, error)
@@ -968,9 +1736,15 @@ type UnimplementedPlagiarismDetectionServer struct {
 func (*UnimplementedPlagiarismDetectionServer) DuplicateCheck(context.Context, *DuplicateCheckRequest) (*DuplicateCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DuplicateCheck not implemented")
 }
+func (*UnimplementedPlagiarismDetectionServer) DuplicateCheckWithProgress(*DuplicateCheckRequest, PlagiarismDetection_DuplicateCheckWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method DuplicateCheckWithProgress not implemented")
+}
 func (*UnimplementedPlagiarismDetectionServer) ViewReport(context.Context, *ViewReportRequest) (*ViewReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewReport not implemented")
 }
+func (*UnimplementedPlagiarismDetectionServer) ViewComparison(context.Context, *ViewReportRequest) (*ComparisonDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewComparison not implemented")
+}
 func (*UnimplementedPlagiarismDetectionServer) GenerateTestFilesForDuplicateCheck(context.Context, *GenerateTestFilesForDuplicateCheckRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateTestFilesForDuplicateCheck not implemented")
 }
@@ -1006,6 +1780,27 @@ func _PlagiarismDetection_DuplicateCheck_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlagiarismDetection_DuplicateCheckWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DuplicateCheckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlagiarismDetectionServer).DuplicateCheckWithProgress(m, &plagiarismDetectionDuplicateCheckWithProgressServer{stream})
+}
+
+type PlagiarismDetection_DuplicateCheckWithProgressServer interface {
+	Send(*DuplicateCheckProgress) error
+	grpc.ServerStream
+}
+
+type plagiarismDetectionDuplicateCheckWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *plagiarismDetectionDuplicateCheckWithProgressServer) Send(m *DuplicateCheckProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PlagiarismDetection_ViewReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ViewReportRequest)
 	if err := dec(in); err != nil {
@@ -1024,6 +1819,24 @@ func _PlagiarismDetection_ViewReport_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlagiarismDetection_ViewComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlagiarismDetectionServer).ViewComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/plagiarism_detection.plagiarismDetection/ViewComparison",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlagiarismDetectionServer).ViewComparison(ctx, req.(*ViewReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlagiarismDetection_GenerateTestFilesForDuplicateCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateTestFilesForDuplicateCheckRequest)
 	if err := dec(in); err != nil {
@@ -1108,6 +1921,10 @@ var _PlagiarismDetection_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ViewReport",
 			Handler:    _PlagiarismDetection_ViewReport_Handler,
 		},
+		{
+			MethodName: "ViewComparison",
+			Handler:    _PlagiarismDetection_ViewComparison_Handler,
+		},
 		{
 			MethodName: "GenerateTestFilesForDuplicateCheck",
 			Handler:    _PlagiarismDetection_GenerateTestFilesForDuplicateCheck_Handler,
@@ -1125,6 +1942,12 @@ var _PlagiarismDetection_serviceDesc = grpc.ServiceDesc{
 			Handler:    _PlagiarismDetection_RemoveTestHTMLFileForViewReport_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DuplicateCheckWithProgress",
+			Handler:       _PlagiarismDetection_DuplicateCheckWithProgress_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "plagiarism_detection.proto",
 }
@@ -0,0 +1,382 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/config"
+	"code-platform/pkg/charsetx"
+	"code-platform/pkg/ignorex"
+	"code-platform/pkg/plagiarism"
+	"code-platform/service/ide/define"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	codeBasePath = filepath.Join(define.InitBasePath, "codespaces")
+	// frozenBasePath 截止时冻结的工作区副本，存在时优先查重冻结副本
+	frozenBasePath = filepath.Join(define.InitBasePath, "frozen")
+)
+
+const (
+	htmlFileLayout = "match%d.html"
+	// maxMinMatch 请求中最短匹配长度的上限，过大时几乎不会有匹配
+	maxMinMatch = 1000
+)
+
+// 参考实验的提交来源，记录在查重结果中
+const (
+	referenceSourceFrozen  = "frozen"
+	referenceSourceLive    = "live"
+	referenceSourceMissing = "missing"
+)
+
+var languageExtensions = map[plagiarism.Language][]string{
+	plagiarism.Python3: {".py"},
+	plagiarism.Cpp:     {".cpp", ".cc", ".cxx", ".c", ".h", ".hpp", ".hh"},
+	plagiarism.Java:    {".java"},
+}
+
+func getReportPath(labID uint64, timeStamp string) string {
+	return filepath.Join(config.PlagiarismDetectionServer.GetString("report_path"), strconv.FormatUint(labID, 10), timeStamp)
+}
+
+func (p *PlagiarismDetectionServer) DuplicateCheck(ctx context.Context, request *pb.DuplicateCheckRequest) (*pb.DuplicateCheckResponse, error) {
+	return p.duplicateCheck(ctx, request, nil)
+}
+
+// DuplicateCheckWithProgress 比对过程中百分比变化时返回进度，写入报告前最多为 99，最后一条携带结果
+func (p *PlagiarismDetectionServer) DuplicateCheckWithProgress(request *pb.DuplicateCheckRequest, stream pb.PlagiarismDetection_DuplicateCheckWithProgressServer) error {
+	last := int32(-1)
+	resp, err := p.duplicateCheck(stream.Context(), request, func(done, total int) {
+		progress := int32(done * 99 / total)
+		if progress == last {
+			return
+		}
+		last = progress
+		// 发送失败时连接已断开，ctx 随之结束
+		_ = stream.Send(&pb.DuplicateCheckProgress{Progress: progress})
+	})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.DuplicateCheckProgress{Progress: 100, Result: resp})
+}
+
+func (p *PlagiarismDetectionServer) duplicateCheck(ctx context.Context, request *pb.DuplicateCheckRequest, progress func(done, total int)) (*pb.DuplicateCheckResponse, error) {
+	if request.GetMinMatch() < 0 || request.GetMinMatch() > maxMinMatch {
+		return nil, status.Errorf(codes.InvalidArgument, "min match should be in [0, %d]", maxMinMatch)
+	}
+
+	labDir, _, ok := findLabDir(request.GetLabID())
+	if !ok {
+		return nil, status.Error(codes.NotFound, "lab dir is not found")
+	}
+
+	select {
+	case p.checkSlots <- struct{}{}:
+		defer func() { <-p.checkSlots }()
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	language := plagiarism.Language(request.GetLan())
+	matcher := p.matcher
+	if len(request.GetIgnore()) != 0 {
+		matcher = ignorex.New(append(config.PlagiarismDetectionServer.GetStringSlice("ignore"), request.GetIgnore()...)...)
+	}
+	submissions, sources, err := p.loadSubmissions(labDir, language, matcher, 0)
+	if err != nil {
+		p.Logger.Errorf(err, "load submissions of labID[%d] failed", request.GetLabID())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	referenceSources := make([]*pb.DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource, 0, len(request.GetReferenceLabIds()))
+	for _, referenceLabID := range request.GetReferenceLabIds() {
+		if referenceLabID == request.GetLabID() {
+			continue
+		}
+		referenceDir, frozen, ok := findLabDir(referenceLabID)
+		referenceSource := &pb.DuplicateCheckResponse_DuplicateCheckResponseValue_ReferenceSource{LabId: referenceLabID, Source: referenceSourceMissing}
+		referenceSources = append(referenceSources, referenceSource)
+		if !ok {
+			p.Logger.Debugf("dir of reference lab[%d] is not found", referenceLabID)
+			continue
+		}
+		referenceSource.Source = referenceSourceLive
+		if frozen {
+			referenceSource.Source = referenceSourceFrozen
+		}
+		references, referenceSources, err := p.loadSubmissions(referenceDir, language, matcher, referenceLabID)
+		if err != nil {
+			p.Logger.Errorf(err, "load submissions of reference labID[%d] failed", referenceLabID)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		submissions = append(submissions, references...)
+		for name, files := range referenceSources {
+			sources[name] = files
+		}
+	}
+
+	options := plagiarism.DefaultOptions(language)
+	if request.GetMinMatch() != 0 {
+		options.MinMatch = int(request.GetMinMatch())
+	}
+	if baseFiles := p.baseFiles(request.GetBaseFiles(), language); len(baseFiles) != 0 {
+		options.Base = plagiarism.NewSubmission("base", language, baseFiles)
+	}
+	options.Progress = progress
+	comparisons, err := plagiarism.DetectContext(ctx, submissions, options)
+	switch {
+	case err == nil:
+	case errors.Is(err, plagiarism.ErrNotEnoughSubmissions):
+		return nil, status.Error(codes.DataLoss, err.Error())
+	case ctx.Err() != nil:
+		return nil, status.FromContextError(ctx.Err()).Err()
+	default:
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	comparisons = excludeOwnReferences(comparisons)
+
+	timeStamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+	reportPath := getReportPath(request.GetLabID(), timeStamp)
+	if err := writeReports(reportPath, comparisons, sources); err != nil {
+		p.Logger.Errorf(err, "write reports of labID[%d] failed", request.GetLabID())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	value := &pb.DuplicateCheckResponse_DuplicateCheckResponseValue{
+		Comparisions:     make([]*pb.DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion, 0, len(comparisons)),
+		ReferenceSources: referenceSources,
+	}
+	for index, comparison := range comparisons {
+		_, userID := parseSubmissionName(comparison.A.Name)
+		referenceLabID, anotherUserID := parseSubmissionName(comparison.B.Name)
+		value.Comparisions = append(value.Comparisions, &pb.DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion{
+			UserId:         userID,
+			AnotherUserId:  anotherUserID,
+			HtmlFileName:   fmt.Sprintf(htmlFileLayout, index),
+			Similarity:     wireSimilarity(comparison.Similarity),
+			ReferenceLabId: referenceLabID,
+		})
+	}
+
+	return &pb.DuplicateCheckResponse{
+		Comparision: value,
+		TimeStamp:   timeStamp,
+	}, nil
+}
+
+// wireSimilarity 与原 JPlag 服务一致，响应中的相似度为百分比乘以 100，即 0 到 10000
+func wireSimilarity(similarity float64) int32 {
+	return int32(math.Round(similarity * 10000))
+}
+
+// findLabDir 实验冻结的副本存在时优先使用，往届实验的冻结副本在归档后仍然保留；
+// 未截止的实验使用当前的工作区，其内容在截止前仍会变化，frozen 表示使用的是否为冻结副本
+func findLabDir(labID uint64) (labDir string, frozen bool, ok bool) {
+	for _, base := range []string{frozenBasePath, codeBasePath} {
+		labDir := filepath.Join(base, fmt.Sprintf("workspace-%d", labID))
+		if _, err := os.Stat(labDir); err == nil {
+			return labDir, base == frozenBasePath, true
+		}
+	}
+	return "", false, false
+}
+
+// submissionName 参考实验的提交以 <实验 ID>/<学号> 命名，与本实验的提交区分
+func submissionName(referenceLabID uint64, userID string) string {
+	if referenceLabID == 0 {
+		return userID
+	}
+	return strconv.FormatUint(referenceLabID, 10) + "/" + userID
+}
+
+func parseSubmissionName(name string) (referenceLabID, userID uint64) {
+	if index := strings.IndexByte(name, '/'); index >= 0 {
+		referenceLabID, _ = strconv.ParseUint(name[:index], 10, 64)
+		name = name[index+1:]
+	}
+	// 只有以学号命名的目录会成为提交
+	userID, _ = strconv.ParseUint(name, 10, 64)
+	return referenceLabID, userID
+}
+
+// excludeOwnReferences 学生与自己在参考实验中的提交相似不视为抄袭
+func excludeOwnReferences(comparisons []*plagiarism.Comparison) []*plagiarism.Comparison {
+	kept := comparisons[:0]
+	for _, comparison := range comparisons {
+		if comparison.B.Reference {
+			_, userID := parseSubmissionName(comparison.A.Name)
+			if _, anotherUserID := parseSubmissionName(comparison.B.Name); userID == anotherUserID {
+				continue
+			}
+		}
+		kept = append(kept, comparison)
+	}
+	return kept
+}
+
+// loadSubmissions 实验目录下每个以学号命名的目录为一份提交，同时返回各提交的源文件以生成报告；
+// referenceLabID 不为 0 时作为参考实验加载
+func (p *PlagiarismDetectionServer) loadSubmissions(labDir string, language plagiarism.Language, matcher *ignorex.Matcher, referenceLabID uint64) ([]*plagiarism.Submission, map[string][]plagiarism.File, error) {
+	entries, err := os.ReadDir(labDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	submissions := make([]*plagiarism.Submission, 0, len(entries))
+	sources := make(map[string][]plagiarism.File, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := strconv.ParseUint(entry.Name(), 10, 64); err != nil {
+			continue
+		}
+		files, err := p.loadFiles(filepath.Join(labDir, entry.Name()), language, matcher)
+		if err != nil {
+			return nil, nil, err
+		}
+		name := submissionName(referenceLabID, entry.Name())
+		submission := plagiarism.NewSubmission(name, language, files)
+		submission.Reference = referenceLabID != 0
+		submissions = append(submissions, submission)
+		sources[name] = files
+	}
+	return submissions, sources, nil
+}
+
+// loadFiles 按路径排序读取目录下该语言的源文件，跳过被忽略的路径、过大的文件与无法识别编码的文件
+func (p *PlagiarismDetectionServer) loadFiles(root string, language plagiarism.Language, matcher *ignorex.Matcher) ([]plagiarism.File, error) {
+	maxFileSize := config.PlagiarismDetectionServer.GetInt64("max_file_size")
+	extensions := languageExtensions[language]
+
+	var files []plagiarism.File
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if matcher.Match(rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !hasExtension(rel, extensions) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Size() > maxFileSize {
+			p.Logger.Debugf("skip %q of size %d", path, info.Size())
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		content, _, ok := charsetx.ToUTF8(data)
+		if !ok {
+			p.Logger.Debugf("skip %q of unknown encoding", path)
+			return nil
+		}
+		files = append(files, plagiarism.File{Name: rel, Content: []byte(content)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// baseFiles 按路径排序的该语言的基础代码，与提交使用相同的大小与编码限制
+func (p *PlagiarismDetectionServer) baseFiles(sourceFiles []*pb.SourceFile, language plagiarism.Language) []plagiarism.File {
+	maxFileSize := config.PlagiarismDetectionServer.GetInt64("max_file_size")
+	extensions := languageExtensions[language]
+
+	files := make([]plagiarism.File, 0, len(sourceFiles))
+	for _, sourceFile := range sourceFiles {
+		if !hasExtension(sourceFile.GetName(), extensions) || int64(len(sourceFile.GetContent())) > maxFileSize {
+			continue
+		}
+		content, _, ok := charsetx.ToUTF8(sourceFile.GetContent())
+		if !ok {
+			p.Logger.Debugf("skip base file %q of unknown encoding", sourceFile.GetName())
+			continue
+		}
+		files = append(files, plagiarism.File{Name: sourceFile.GetName(), Content: []byte(content)})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files
+}
+
+func hasExtension(name string, extensions []string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, extension := range extensions {
+		if ext == extension {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PlagiarismDetectionServer) GenerateTestFilesForDuplicateCheck(ctx context.Context, request *pb.GenerateTestFilesForDuplicateCheckRequest) (*pb.Empty, error) {
+	var fileName string
+	switch request.GetLan() {
+	case pb.Language_python3:
+		fileName = "1.py"
+	case pb.Language_cpp:
+		fileName = "1.cpp"
+	default:
+		fileName = "Solution.java"
+	}
+
+	for studentID := 1; studentID <= 2; studentID++ {
+		dir := filepath.Join(codeBasePath, "workspace-0", strconv.Itoa(studentID))
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if err := os.WriteFile(filepath.Join(dir, fileName), []byte(request.GetCodeContent()), 0o644); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &pb.Empty{}, nil
+}
+
+func (p *PlagiarismDetectionServer) RemoveTestFilesForDuplicateCheck(ctx context.Context, request *pb.Empty) (*pb.Empty, error) {
+	if err := os.RemoveAll(filepath.Join(codeBasePath, "workspace-0")); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
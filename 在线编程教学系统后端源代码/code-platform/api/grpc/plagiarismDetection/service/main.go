@@ -0,0 +1,50 @@
+package main
+
+import (
+	"net"
+
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/config"
+	"code-platform/log"
+	"code-platform/pkg/ignorex"
+
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"google.golang.org/grpc"
+)
+
+type PlagiarismDetectionServer struct {
+	Logger  *log.Logger
+	matcher *ignorex.Matcher
+	// checkSlots 限制同时进行的查重数
+	checkSlots chan struct{}
+}
+
+func NewPlagiarismDetectionServer(logger *log.Logger) *PlagiarismDetectionServer {
+	return &PlagiarismDetectionServer{
+		Logger:     logger,
+		matcher:    ignorex.New(config.PlagiarismDetectionServer.GetStringSlice("ignore")...),
+		checkSlots: make(chan struct{}, config.PlagiarismDetectionServer.GetInt("max_concurrent")),
+	}
+}
+
+var _ pb.PlagiarismDetectionServer = (*PlagiarismDetectionServer)(nil)
+
+func main() {
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(grpc_recovery.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(grpc_recovery.StreamServerInterceptor()),
+	)
+	plagiarismDetectionServer := NewPlagiarismDetectionServer(log.Sub("plagiarism_detection_server"))
+	pb.RegisterPlagiarismDetectionServer(server, plagiarismDetectionServer)
+
+	port := config.PlagiarismDetectionServer.GetString("port")
+	address := ":" + port
+	conn, err := net.Listen("tcp", address)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := server.Serve(conn); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/config"
+	"code-platform/pkg/plagiarism"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var reportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.A.Name}} - {{.B.Name}}</title>
+<style>
+body { font-family: sans-serif; margin: 0; }
+h1 { font-size: 18px; margin: 12px; }
+.columns { display: flex; }
+.column { flex: 1; min-width: 0; margin: 0 12px; }
+h2 { font-size: 16px; }
+h3 { font-size: 14px; margin: 12px 0 4px; }
+pre { margin: 0; border: 1px solid #ddd; overflow-x: auto; font-size: 13px; }
+.line { display: block; white-space: pre; }
+.line-number { display: inline-block; width: 40px; color: #999; text-align: right; margin-right: 8px; user-select: none; }
+{{range $index, $color := .Colors}}.match-{{$index}} { background: {{$color}}; }
+{{end}}
+</style>
+</head>
+<body>
+<h1>{{.A.Name}} - {{.B.Name}}：{{.Similarity}}</h1>
+<div class="columns">
+{{range .Sides}}<div class="column">
+<h2>{{.Name}}：{{.Similarity}}</h2>
+{{range .Files}}<h3>{{.Name}}</h3>
+<pre>{{range .Lines}}<span class="line{{if ge .Match 0}} match-{{.Match}}{{end}}"><span class="line-number">{{.Number}}</span>{{.Content}}</span>{{end}}</pre>
+{{end}}</div>
+{{end}}</div>
+</body>
+</html>
+`))
+
+// matchColors 同一片段在两侧使用相同的颜色，片段数多于颜色数时循环使用
+var matchColors = []template.CSS{
+	"#ffd6d6", "#d6ffd6", "#d6e4ff", "#fff3c4", "#f0d6ff", "#c4f5ff", "#ffe0c4", "#e0e0e0",
+}
+
+type reportLine struct {
+	Number  int
+	Content string
+	// Match 所在片段的颜色序号，不在任何片段中时为 -1
+	Match int
+}
+
+type reportFile struct {
+	Name  string
+	Lines []reportLine
+}
+
+type reportSide struct {
+	Name       string
+	Similarity string
+	Files      []reportFile
+}
+
+type reportData struct {
+	A, B       *plagiarism.Submission
+	Similarity string
+	Colors     []template.CSS
+	Sides      [2]reportSide
+}
+
+// writeReports 每组比对生成一个 HTML 报告，文件名与响应中 HtmlFileName 一致，同名的 .json 文件保存比对详情；
+// 比对详情不含代码，每份提交的代码只保存一次
+func writeReports(reportPath string, comparisons []*plagiarism.Comparison, sources map[string][]plagiarism.File) error {
+	if err := os.MkdirAll(reportPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	for name, files := range sources {
+		data, err := protojson.Marshal(&pb.SubmissionSource{Files: sourceFiles(files)})
+		if err != nil {
+			return err
+		}
+		referenceLabID, userID := parseSubmissionName(name)
+		if err := os.WriteFile(filepath.Join(reportPath, sourceFileName(referenceLabID, userID)), data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	for index, comparison := range comparisons {
+		buf.Reset()
+		if err := reportTemplate.Execute(&buf, newReportData(comparison, sources)); err != nil {
+			return err
+		}
+		htmlFileName := fmt.Sprintf(htmlFileLayout, index)
+		if err := os.WriteFile(filepath.Join(reportPath, htmlFileName), buf.Bytes(), 0o644); err != nil {
+			return err
+		}
+
+		detail, err := protojson.Marshal(newComparisonDetail(comparison))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(reportPath, detailFileName(htmlFileName)), detail, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func detailFileName(htmlFileName string) string {
+	return strings.TrimSuffix(htmlFileName, filepath.Ext(htmlFileName)) + ".json"
+}
+
+func sourceFileName(referenceLabID, userID uint64) string {
+	return fmt.Sprintf("source_%d_%d.json", referenceLabID, userID)
+}
+
+// newComparisonDetail 不包含 HTML 报告与代码，查看时再读取
+func newComparisonDetail(comparison *plagiarism.Comparison) *pb.ComparisonDetail {
+	_, userID := parseSubmissionName(comparison.A.Name)
+	referenceLabID, anotherUserID := parseSubmissionName(comparison.B.Name)
+	detail := &pb.ComparisonDetail{
+		UserId:         userID,
+		AnotherUserId:  anotherUserID,
+		ReferenceLabId: referenceLabID,
+		Similarity:     comparison.Similarity,
+		SimilarityA:    comparison.SimilarityA,
+		SimilarityB:    comparison.SimilarityB,
+		Matches:        make([]*pb.MatchRegion, len(comparison.Matches)),
+	}
+	for index, match := range comparison.Matches {
+		detail.Matches[index] = &pb.MatchRegion{
+			A:      &pb.Region{File: match.A.File, StartLine: int32(match.A.StartLine), EndLine: int32(match.A.EndLine)},
+			B:      &pb.Region{File: match.B.File, StartLine: int32(match.B.StartLine), EndLine: int32(match.B.EndLine)},
+			Tokens: int32(match.Tokens),
+		}
+	}
+	return detail
+}
+
+func sourceFiles(files []plagiarism.File) []*pb.SourceFile {
+	sourceFiles := make([]*pb.SourceFile, len(files))
+	for index, file := range files {
+		sourceFiles[index] = &pb.SourceFile{Name: file.Name, Content: file.Content}
+	}
+	return sourceFiles
+}
+
+func newReportData(comparison *plagiarism.Comparison, sources map[string][]plagiarism.File) *reportData {
+	regionsA := make([]plagiarism.Region, len(comparison.Matches))
+	regionsB := make([]plagiarism.Region, len(comparison.Matches))
+	for index, match := range comparison.Matches {
+		regionsA[index] = match.A
+		regionsB[index] = match.B
+	}
+
+	return &reportData{
+		A:          comparison.A,
+		B:          comparison.B,
+		Similarity: formatPercent(comparison.Similarity),
+		Colors:     matchColors,
+		Sides: [2]reportSide{
+			newReportSide(comparison.A.Name, comparison.SimilarityA, sources[comparison.A.Name], regionsA),
+			newReportSide(comparison.B.Name, comparison.SimilarityB, sources[comparison.B.Name], regionsB),
+		},
+	}
+}
+
+func newReportSide(name string, similarity float64, files []plagiarism.File, regions []plagiarism.Region) reportSide {
+	side := reportSide{Name: name, Similarity: formatPercent(similarity), Files: make([]reportFile, 0, len(files))}
+	for _, file := range files {
+		lines := strings.Split(strings.ReplaceAll(string(file.Content), "\r\n", "\n"), "\n")
+		f := reportFile{Name: file.Name, Lines: make([]reportLine, len(lines))}
+		for index, content := range lines {
+			f.Lines[index] = reportLine{Number: index + 1, Content: content, Match: -1}
+		}
+		for index, region := range regions {
+			if region.File != file.Name {
+				continue
+			}
+			for line := region.StartLine; line <= region.EndLine && line <= len(lines); line++ {
+				f.Lines[line-1].Match = index % len(matchColors)
+			}
+		}
+		side.Files = append(side.Files, f)
+	}
+	return side
+}
+
+func isPlainName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+func formatPercent(value float64) string {
+	return fmt.Sprintf("%.2f%%", value*100)
+}
+
+func (p *PlagiarismDetectionServer) ViewReport(ctx context.Context, request *pb.ViewReportRequest) (*pb.ViewReportResponse, error) {
+	// 防止通过文件名读取报告目录之外的文件
+	if !isPlainName(request.GetTimeStamp()) || !isPlainName(request.GetHtmlFileName()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid report name")
+	}
+
+	data, err := os.ReadFile(filepath.Join(getReportPath(request.GetLabId(), request.GetTimeStamp()), request.GetHtmlFileName()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Error(codes.NotFound, "report is not found")
+		}
+		return nil, status.Error(codes.Internal, "read html file failed")
+	}
+	return &pb.ViewReportResponse{HtmlFileContent: string(data)}, nil
+}
+
+func (p *PlagiarismDetectionServer) ViewComparison(ctx context.Context, request *pb.ViewReportRequest) (*pb.ComparisonDetail, error) {
+	if !isPlainName(request.GetTimeStamp()) || !isPlainName(request.GetHtmlFileName()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid report name")
+	}
+
+	reportPath := getReportPath(request.GetLabId(), request.GetTimeStamp())
+	html, err := os.ReadFile(filepath.Join(reportPath, request.GetHtmlFileName()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Error(codes.NotFound, "report is not found")
+		}
+		return nil, status.Error(codes.Internal, "read html file failed")
+	}
+	data, err := os.ReadFile(filepath.Join(reportPath, detailFileName(request.GetHtmlFileName())))
+	if err != nil {
+		// 早于保存比对详情的报告只有 HTML 文件
+		if os.IsNotExist(err) {
+			return nil, status.Error(codes.FailedPrecondition, "report predates evidence export")
+		}
+		return nil, status.Error(codes.Internal, "read detail file failed")
+	}
+
+	detail := &pb.ComparisonDetail{}
+	if err := protojson.Unmarshal(data, detail); err != nil {
+		p.Logger.Errorf(err, "unmarshal detail of report %q failed", request.GetHtmlFileName())
+		return nil, status.Error(codes.Internal, "unmarshal detail file failed")
+	}
+	// 代码单独保存之前的比对详情自带代码
+	if len(detail.FilesA) == 0 {
+		if detail.FilesA, err = p.readSource(reportPath, 0, detail.GetUserId()); err != nil {
+			return nil, err
+		}
+	}
+	if len(detail.FilesB) == 0 {
+		if detail.FilesB, err = p.readSource(reportPath, detail.GetReferenceLabId(), detail.GetAnotherUserId()); err != nil {
+			return nil, err
+		}
+	}
+	detail.HtmlFileContent = string(html)
+	return detail, nil
+}
+
+func (p *PlagiarismDetectionServer) readSource(reportPath string, referenceLabID, userID uint64) ([]*pb.SourceFile, error) {
+	data, err := os.ReadFile(filepath.Join(reportPath, sourceFileName(referenceLabID, userID)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Error(codes.NotFound, "source of submission is not found")
+		}
+		return nil, status.Error(codes.Internal, "read source file failed")
+	}
+
+	source := &pb.SubmissionSource{}
+	if err := protojson.Unmarshal(data, source); err != nil {
+		p.Logger.Errorf(err, "unmarshal source of submission[%d/%d] failed", referenceLabID, userID)
+		return nil, status.Error(codes.Internal, "unmarshal source file failed")
+	}
+	return source.GetFiles(), nil
+}
+
+func (p *PlagiarismDetectionServer) GenerateTestHTMLFileForViewReport(ctx context.Context, request *pb.GenerateTestHTMLFileForViewReportRequest) (*pb.Empty, error) {
+	dir := getReportPath(0, request.GetTimeStamp())
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := os.WriteFile(filepath.Join(dir, request.GetHtmlFileName()), nil, 0o644); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := os.WriteFile(filepath.Join(dir, detailFileName(request.GetHtmlFileName())), []byte("{}"), 0o644); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := os.WriteFile(filepath.Join(dir, sourceFileName(0, 0)), []byte("{}"), 0o644); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (p *PlagiarismDetectionServer) RemoveTestHTMLFileForViewReport(ctx context.Context, request *pb.Empty) (*pb.Empty, error) {
+	if err := os.RemoveAll(filepath.Join(config.PlagiarismDetectionServer.GetString("report_path"), "0")); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.Empty{}, nil
+}
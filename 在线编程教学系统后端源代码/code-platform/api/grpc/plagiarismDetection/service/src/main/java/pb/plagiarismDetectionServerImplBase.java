@@ -1,6399 +0,0 @@
-// Generated by the protocol buffer compiler.  DO NOT EDIT!
-// source: plagiarism_detection.proto
-
-package pb;
-
-public final class plagiarismDetectionServerImplBase {
-  private plagiarismDetectionServerImplBase() {}
-  public static void registerAllExtensions(
-      com.google.protobuf.ExtensionRegistryLite registry) {
-  }
-
-  public static void registerAllExtensions(
-      com.google.protobuf.ExtensionRegistry registry) {
-    registerAllExtensions(
-        (com.google.protobuf.ExtensionRegistryLite) registry);
-  }
-  /**
-   * Protobuf enum {@code plagiarism_detection.language}
-   */
-  public enum language
-      implements com.google.protobuf.ProtocolMessageEnum {
-    /**
-     * <code>python3 = 0;</code>
-     */
-    python3(0),
-    /**
-     * <code>cpp = 1;</code>
-     */
-    cpp(1),
-    /**
-     * <code>java = 2;</code>
-     */
-    java(2),
-    UNRECOGNIZED(-1),
-    ;
-
-    /**
-     * <code>python3 = 0;</code>
-     */
-    public static final int python3_VALUE = 0;
-    /**
-     * <code>cpp = 1;</code>
-     */
-    public static final int cpp_VALUE = 1;
-    /**
-     * <code>java = 2;</code>
-     */
-    public static final int java_VALUE = 2;
-
-
-    public final int getNumber() {
-      if (this == UNRECOGNIZED) {
-        throw new java.lang.IllegalArgumentException(
-            "Can't get the number of an unknown enum value.");
-      }
-      return value;
-    }
-
-    /**
-     * @param value The numeric wire value of the corresponding enum entry.
-     * @return The enum associated with the given numeric wire value.
-     * @deprecated Use {@link #forNumber(int)} instead.
-     */
-    @java.lang.Deprecated
-    public static language valueOf(int value) {
-      return forNumber(value);
-    }
-
-    /**
-     * @param value The numeric wire value of the corresponding enum entry.
-     * @return The enum associated with the given numeric wire value.
-     */
-    public static language forNumber(int value) {
-      switch (value) {
-        case 0: return python3;
-        case 1: return cpp;
-        case 2: return java;
-        default: return null;
-      }
-    }
-
-    public static com.google.protobuf.Internal.EnumLiteMap<language>
-        internalGetValueMap() {
-      return internalValueMap;
-    }
-    private static final com.google.protobuf.Internal.EnumLiteMap<
-        language> internalValueMap =
-          new com.google.protobuf.Internal.EnumLiteMap<language>() {
-            public language findValueByNumber(int number) {
-              return language.forNumber(number);
-            }
-          };
-
-    public final com.google.protobuf.Descriptors.EnumValueDescriptor
-        getValueDescriptor() {
-      if (this == UNRECOGNIZED) {
-        throw new java.lang.IllegalStateException(
-            "Can't get the descriptor of an unrecognized enum value.");
-      }
-      return getDescriptor().getValues().get(ordinal());
-    }
-    public final com.google.protobuf.Descriptors.EnumDescriptor
-        getDescriptorForType() {
-      return getDescriptor();
-    }
-    public static final com.google.protobuf.Descriptors.EnumDescriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.getDescriptor().getEnumTypes().get(0);
-    }
-
-    private static final language[] VALUES = values();
-
-    public static language valueOf(
-        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
-      if (desc.getType() != getDescriptor()) {
-        throw new java.lang.IllegalArgumentException(
-          "EnumValueDescriptor is not for this type.");
-      }
-      if (desc.getIndex() == -1) {
-        return UNRECOGNIZED;
-      }
-      return VALUES[desc.getIndex()];
-    }
-
-    private final int value;
-
-    private language(int value) {
-      this.value = value;
-    }
-
-    // @@protoc_insertion_point(enum_scope:plagiarism_detection.language)
-  }
-
-  public interface DuplicateCheckRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.DuplicateCheckRequest)
-      com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>uint64 labID = 1;</code>
-     * @return The labID.
-     */
-    long getLabID();
-
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The enum numeric value on the wire for lan.
-     */
-    int getLanValue();
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The lan.
-     */
-    pb.plagiarismDetectionServerImplBase.language getLan();
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.DuplicateCheckRequest}
-   */
-  public static final class DuplicateCheckRequest extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.DuplicateCheckRequest)
-      DuplicateCheckRequestOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use DuplicateCheckRequest.newBuilder() to construct.
-    private DuplicateCheckRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private DuplicateCheckRequest() {
-      lan_ = 0;
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new DuplicateCheckRequest();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private DuplicateCheckRequest(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 8: {
-
-              labID_ = input.readUInt64();
-              break;
-            }
-            case 16: {
-              int rawValue = input.readEnum();
-
-              lan_ = rawValue;
-              break;
-            }
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckRequest_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckRequest_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.Builder.class);
-    }
-
-    public static final int LABID_FIELD_NUMBER = 1;
-    private long labID_;
-    /**
-     * <code>uint64 labID = 1;</code>
-     * @return The labID.
-     */
-    @java.lang.Override
-    public long getLabID() {
-      return labID_;
-    }
-
-    public static final int LAN_FIELD_NUMBER = 2;
-    private int lan_;
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The enum numeric value on the wire for lan.
-     */
-    @java.lang.Override public int getLanValue() {
-      return lan_;
-    }
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The lan.
-     */
-    @java.lang.Override public pb.plagiarismDetectionServerImplBase.language getLan() {
-      @SuppressWarnings("deprecation")
-      pb.plagiarismDetectionServerImplBase.language result = pb.plagiarismDetectionServerImplBase.language.valueOf(lan_);
-      return result == null ? pb.plagiarismDetectionServerImplBase.language.UNRECOGNIZED : result;
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (labID_ != 0L) {
-        output.writeUInt64(1, labID_);
-      }
-      if (lan_ != pb.plagiarismDetectionServerImplBase.language.python3.getNumber()) {
-        output.writeEnum(2, lan_);
-      }
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      if (labID_ != 0L) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeUInt64Size(1, labID_);
-      }
-      if (lan_ != pb.plagiarismDetectionServerImplBase.language.python3.getNumber()) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeEnumSize(2, lan_);
-      }
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest other = (pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest) obj;
-
-      if (getLabID()
-          != other.getLabID()) return false;
-      if (lan_ != other.lan_) return false;
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + LABID_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getLabID());
-      hash = (37 * hash) + LAN_FIELD_NUMBER;
-      hash = (53 * hash) + lan_;
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.DuplicateCheckRequest}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.DuplicateCheckRequest)
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckRequestOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckRequest_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckRequest_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        labID_ = 0L;
-
-        lan_ = 0;
-
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckRequest_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest build() {
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest buildPartial() {
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest result = new pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest(this);
-        result.labID_ = labID_;
-        result.lan_ = lan_;
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest other) {
-        if (other == pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest.getDefaultInstance()) return this;
-        if (other.getLabID() != 0L) {
-          setLabID(other.getLabID());
-        }
-        if (other.lan_ != 0) {
-          setLanValue(other.getLanValue());
-        }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-
-      private long labID_ ;
-      /**
-       * <code>uint64 labID = 1;</code>
-       * @return The labID.
-       */
-      @java.lang.Override
-      public long getLabID() {
-        return labID_;
-      }
-      /**
-       * <code>uint64 labID = 1;</code>
-       * @param value The labID to set.
-       * @return This builder for chaining.
-       */
-      public Builder setLabID(long value) {
-        
-        labID_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>uint64 labID = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearLabID() {
-        
-        labID_ = 0L;
-        onChanged();
-        return this;
-      }
-
-      private int lan_ = 0;
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @return The enum numeric value on the wire for lan.
-       */
-      @java.lang.Override public int getLanValue() {
-        return lan_;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @param value The enum numeric value on the wire for lan to set.
-       * @return This builder for chaining.
-       */
-      public Builder setLanValue(int value) {
-        
-        lan_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @return The lan.
-       */
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.language getLan() {
-        @SuppressWarnings("deprecation")
-        pb.plagiarismDetectionServerImplBase.language result = pb.plagiarismDetectionServerImplBase.language.valueOf(lan_);
-        return result == null ? pb.plagiarismDetectionServerImplBase.language.UNRECOGNIZED : result;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @param value The lan to set.
-       * @return This builder for chaining.
-       */
-      public Builder setLan(pb.plagiarismDetectionServerImplBase.language value) {
-        if (value == null) {
-          throw new NullPointerException();
-        }
-        
-        lan_ = value.getNumber();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearLan() {
-        
-        lan_ = 0;
-        onChanged();
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.DuplicateCheckRequest)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.DuplicateCheckRequest)
-    private static final pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<DuplicateCheckRequest>
-        PARSER = new com.google.protobuf.AbstractParser<DuplicateCheckRequest>() {
-      @java.lang.Override
-      public DuplicateCheckRequest parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new DuplicateCheckRequest(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<DuplicateCheckRequest> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<DuplicateCheckRequest> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.DuplicateCheckRequest getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  public interface DuplicateCheckResponseOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.DuplicateCheckResponse)
-      com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-     * @return Whether the comparision field is set.
-     */
-    boolean hasComparision();
-    /**
-     * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-     * @return The comparision.
-     */
-    pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue getComparision();
-    /**
-     * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-     */
-    pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder getComparisionOrBuilder();
-
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The timeStamp.
-     */
-    java.lang.String getTimeStamp();
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The bytes for timeStamp.
-     */
-    com.google.protobuf.ByteString
-        getTimeStampBytes();
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.DuplicateCheckResponse}
-   */
-  public static final class DuplicateCheckResponse extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.DuplicateCheckResponse)
-      DuplicateCheckResponseOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use DuplicateCheckResponse.newBuilder() to construct.
-    private DuplicateCheckResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private DuplicateCheckResponse() {
-      timeStamp_ = "";
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new DuplicateCheckResponse();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private DuplicateCheckResponse(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 10: {
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder subBuilder = null;
-              if (comparision_ != null) {
-                subBuilder = comparision_.toBuilder();
-              }
-              comparision_ = input.readMessage(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.parser(), extensionRegistry);
-              if (subBuilder != null) {
-                subBuilder.mergeFrom(comparision_);
-                comparision_ = subBuilder.buildPartial();
-              }
-
-              break;
-            }
-            case 18: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              timeStamp_ = s;
-              break;
-            }
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.Builder.class);
-    }
-
-    public interface DuplicateCheckResponseValueOrBuilder extends
-        // @@protoc_insertion_point(interface_extends:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue)
-        com.google.protobuf.MessageOrBuilder {
-
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      java.util.List<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion> 
-          getComparisionsList();
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion getComparisions(int index);
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      int getComparisionsCount();
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      java.util.List<? extends pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder> 
-          getComparisionsOrBuilderList();
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder getComparisionsOrBuilder(
-          int index);
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue}
-     */
-    public static final class DuplicateCheckResponseValue extends
-        com.google.protobuf.GeneratedMessageV3 implements
-        // @@protoc_insertion_point(message_implements:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue)
-        DuplicateCheckResponseValueOrBuilder {
-    private static final long serialVersionUID = 0L;
-      // Use DuplicateCheckResponseValue.newBuilder() to construct.
-      private DuplicateCheckResponseValue(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-        super(builder);
-      }
-      private DuplicateCheckResponseValue() {
-        comparisions_ = java.util.Collections.emptyList();
-      }
-
-      @java.lang.Override
-      @SuppressWarnings({"unused"})
-      protected java.lang.Object newInstance(
-          UnusedPrivateParameter unused) {
-        return new DuplicateCheckResponseValue();
-      }
-
-      @java.lang.Override
-      public final com.google.protobuf.UnknownFieldSet
-      getUnknownFields() {
-        return this.unknownFields;
-      }
-      private DuplicateCheckResponseValue(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        this();
-        if (extensionRegistry == null) {
-          throw new java.lang.NullPointerException();
-        }
-        int mutable_bitField0_ = 0;
-        com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-            com.google.protobuf.UnknownFieldSet.newBuilder();
-        try {
-          boolean done = false;
-          while (!done) {
-            int tag = input.readTag();
-            switch (tag) {
-              case 0:
-                done = true;
-                break;
-              case 10: {
-                if (!((mutable_bitField0_ & 0x00000001) != 0)) {
-                  comparisions_ = new java.util.ArrayList<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion>();
-                  mutable_bitField0_ |= 0x00000001;
-                }
-                comparisions_.add(
-                    input.readMessage(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.parser(), extensionRegistry));
-                break;
-              }
-              default: {
-                if (!parseUnknownField(
-                    input, unknownFields, extensionRegistry, tag)) {
-                  done = true;
-                }
-                break;
-              }
-            }
-          }
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          throw e.setUnfinishedMessage(this);
-        } catch (java.io.IOException e) {
-          throw new com.google.protobuf.InvalidProtocolBufferException(
-              e).setUnfinishedMessage(this);
-        } finally {
-          if (((mutable_bitField0_ & 0x00000001) != 0)) {
-            comparisions_ = java.util.Collections.unmodifiableList(comparisions_);
-          }
-          this.unknownFields = unknownFields.build();
-          makeExtensionsImmutable();
-        }
-      }
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder.class);
-      }
-
-      public interface ComparsionOrBuilder extends
-          // @@protoc_insertion_point(interface_extends:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)
-          com.google.protobuf.MessageOrBuilder {
-
-        /**
-         * <code>uint64 user_id = 1;</code>
-         * @return The userId.
-         */
-        long getUserId();
-
-        /**
-         * <code>uint64 another_user_id = 2;</code>
-         * @return The anotherUserId.
-         */
-        long getAnotherUserId();
-
-        /**
-         * <code>string html_file_name = 3;</code>
-         * @return The htmlFileName.
-         */
-        java.lang.String getHtmlFileName();
-        /**
-         * <code>string html_file_name = 3;</code>
-         * @return The bytes for htmlFileName.
-         */
-        com.google.protobuf.ByteString
-            getHtmlFileNameBytes();
-
-        /**
-         * <code>int32 similarity = 4;</code>
-         * @return The similarity.
-         */
-        int getSimilarity();
-      }
-      /**
-       * Protobuf type {@code plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion}
-       */
-      public static final class Comparsion extends
-          com.google.protobuf.GeneratedMessageV3 implements
-          // @@protoc_insertion_point(message_implements:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)
-          ComparsionOrBuilder {
-      private static final long serialVersionUID = 0L;
-        // Use Comparsion.newBuilder() to construct.
-        private Comparsion(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-          super(builder);
-        }
-        private Comparsion() {
-          htmlFileName_ = "";
-        }
-
-        @java.lang.Override
-        @SuppressWarnings({"unused"})
-        protected java.lang.Object newInstance(
-            UnusedPrivateParameter unused) {
-          return new Comparsion();
-        }
-
-        @java.lang.Override
-        public final com.google.protobuf.UnknownFieldSet
-        getUnknownFields() {
-          return this.unknownFields;
-        }
-        private Comparsion(
-            com.google.protobuf.CodedInputStream input,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          this();
-          if (extensionRegistry == null) {
-            throw new java.lang.NullPointerException();
-          }
-          com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-              com.google.protobuf.UnknownFieldSet.newBuilder();
-          try {
-            boolean done = false;
-            while (!done) {
-              int tag = input.readTag();
-              switch (tag) {
-                case 0:
-                  done = true;
-                  break;
-                case 8: {
-
-                  userId_ = input.readUInt64();
-                  break;
-                }
-                case 16: {
-
-                  anotherUserId_ = input.readUInt64();
-                  break;
-                }
-                case 26: {
-                  java.lang.String s = input.readStringRequireUtf8();
-
-                  htmlFileName_ = s;
-                  break;
-                }
-                case 32: {
-
-                  similarity_ = input.readInt32();
-                  break;
-                }
-                default: {
-                  if (!parseUnknownField(
-                      input, unknownFields, extensionRegistry, tag)) {
-                    done = true;
-                  }
-                  break;
-                }
-              }
-            }
-          } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-            throw e.setUnfinishedMessage(this);
-          } catch (java.io.IOException e) {
-            throw new com.google.protobuf.InvalidProtocolBufferException(
-                e).setUnfinishedMessage(this);
-          } finally {
-            this.unknownFields = unknownFields.build();
-            makeExtensionsImmutable();
-          }
-        }
-        public static final com.google.protobuf.Descriptors.Descriptor
-            getDescriptor() {
-          return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_descriptor;
-        }
-
-        @java.lang.Override
-        protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-            internalGetFieldAccessorTable() {
-          return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_fieldAccessorTable
-              .ensureFieldAccessorsInitialized(
-                  pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder.class);
-        }
-
-        public static final int USER_ID_FIELD_NUMBER = 1;
-        private long userId_;
-        /**
-         * <code>uint64 user_id = 1;</code>
-         * @return The userId.
-         */
-        @java.lang.Override
-        public long getUserId() {
-          return userId_;
-        }
-
-        public static final int ANOTHER_USER_ID_FIELD_NUMBER = 2;
-        private long anotherUserId_;
-        /**
-         * <code>uint64 another_user_id = 2;</code>
-         * @return The anotherUserId.
-         */
-        @java.lang.Override
-        public long getAnotherUserId() {
-          return anotherUserId_;
-        }
-
-        public static final int HTML_FILE_NAME_FIELD_NUMBER = 3;
-        private volatile java.lang.Object htmlFileName_;
-        /**
-         * <code>string html_file_name = 3;</code>
-         * @return The htmlFileName.
-         */
-        @java.lang.Override
-        public java.lang.String getHtmlFileName() {
-          java.lang.Object ref = htmlFileName_;
-          if (ref instanceof java.lang.String) {
-            return (java.lang.String) ref;
-          } else {
-            com.google.protobuf.ByteString bs = 
-                (com.google.protobuf.ByteString) ref;
-            java.lang.String s = bs.toStringUtf8();
-            htmlFileName_ = s;
-            return s;
-          }
-        }
-        /**
-         * <code>string html_file_name = 3;</code>
-         * @return The bytes for htmlFileName.
-         */
-        @java.lang.Override
-        public com.google.protobuf.ByteString
-            getHtmlFileNameBytes() {
-          java.lang.Object ref = htmlFileName_;
-          if (ref instanceof java.lang.String) {
-            com.google.protobuf.ByteString b = 
-                com.google.protobuf.ByteString.copyFromUtf8(
-                    (java.lang.String) ref);
-            htmlFileName_ = b;
-            return b;
-          } else {
-            return (com.google.protobuf.ByteString) ref;
-          }
-        }
-
-        public static final int SIMILARITY_FIELD_NUMBER = 4;
-        private int similarity_;
-        /**
-         * <code>int32 similarity = 4;</code>
-         * @return The similarity.
-         */
-        @java.lang.Override
-        public int getSimilarity() {
-          return similarity_;
-        }
-
-        private byte memoizedIsInitialized = -1;
-        @java.lang.Override
-        public final boolean isInitialized() {
-          byte isInitialized = memoizedIsInitialized;
-          if (isInitialized == 1) return true;
-          if (isInitialized == 0) return false;
-
-          memoizedIsInitialized = 1;
-          return true;
-        }
-
-        @java.lang.Override
-        public void writeTo(com.google.protobuf.CodedOutputStream output)
-                            throws java.io.IOException {
-          if (userId_ != 0L) {
-            output.writeUInt64(1, userId_);
-          }
-          if (anotherUserId_ != 0L) {
-            output.writeUInt64(2, anotherUserId_);
-          }
-          if (!getHtmlFileNameBytes().isEmpty()) {
-            com.google.protobuf.GeneratedMessageV3.writeString(output, 3, htmlFileName_);
-          }
-          if (similarity_ != 0) {
-            output.writeInt32(4, similarity_);
-          }
-          unknownFields.writeTo(output);
-        }
-
-        @java.lang.Override
-        public int getSerializedSize() {
-          int size = memoizedSize;
-          if (size != -1) return size;
-
-          size = 0;
-          if (userId_ != 0L) {
-            size += com.google.protobuf.CodedOutputStream
-              .computeUInt64Size(1, userId_);
-          }
-          if (anotherUserId_ != 0L) {
-            size += com.google.protobuf.CodedOutputStream
-              .computeUInt64Size(2, anotherUserId_);
-          }
-          if (!getHtmlFileNameBytes().isEmpty()) {
-            size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, htmlFileName_);
-          }
-          if (similarity_ != 0) {
-            size += com.google.protobuf.CodedOutputStream
-              .computeInt32Size(4, similarity_);
-          }
-          size += unknownFields.getSerializedSize();
-          memoizedSize = size;
-          return size;
-        }
-
-        @java.lang.Override
-        public boolean equals(final java.lang.Object obj) {
-          if (obj == this) {
-           return true;
-          }
-          if (!(obj instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)) {
-            return super.equals(obj);
-          }
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion other = (pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion) obj;
-
-          if (getUserId()
-              != other.getUserId()) return false;
-          if (getAnotherUserId()
-              != other.getAnotherUserId()) return false;
-          if (!getHtmlFileName()
-              .equals(other.getHtmlFileName())) return false;
-          if (getSimilarity()
-              != other.getSimilarity()) return false;
-          if (!unknownFields.equals(other.unknownFields)) return false;
-          return true;
-        }
-
-        @java.lang.Override
-        public int hashCode() {
-          if (memoizedHashCode != 0) {
-            return memoizedHashCode;
-          }
-          int hash = 41;
-          hash = (19 * hash) + getDescriptor().hashCode();
-          hash = (37 * hash) + USER_ID_FIELD_NUMBER;
-          hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-              getUserId());
-          hash = (37 * hash) + ANOTHER_USER_ID_FIELD_NUMBER;
-          hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-              getAnotherUserId());
-          hash = (37 * hash) + HTML_FILE_NAME_FIELD_NUMBER;
-          hash = (53 * hash) + getHtmlFileName().hashCode();
-          hash = (37 * hash) + SIMILARITY_FIELD_NUMBER;
-          hash = (53 * hash) + getSimilarity();
-          hash = (29 * hash) + unknownFields.hashCode();
-          memoizedHashCode = hash;
-          return hash;
-        }
-
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            java.nio.ByteBuffer data)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return PARSER.parseFrom(data);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            java.nio.ByteBuffer data,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return PARSER.parseFrom(data, extensionRegistry);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            com.google.protobuf.ByteString data)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return PARSER.parseFrom(data);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            com.google.protobuf.ByteString data,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return PARSER.parseFrom(data, extensionRegistry);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(byte[] data)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return PARSER.parseFrom(data);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            byte[] data,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return PARSER.parseFrom(data, extensionRegistry);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(java.io.InputStream input)
-            throws java.io.IOException {
-          return com.google.protobuf.GeneratedMessageV3
-              .parseWithIOException(PARSER, input);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            java.io.InputStream input,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws java.io.IOException {
-          return com.google.protobuf.GeneratedMessageV3
-              .parseWithIOException(PARSER, input, extensionRegistry);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseDelimitedFrom(java.io.InputStream input)
-            throws java.io.IOException {
-          return com.google.protobuf.GeneratedMessageV3
-              .parseDelimitedWithIOException(PARSER, input);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseDelimitedFrom(
-            java.io.InputStream input,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws java.io.IOException {
-          return com.google.protobuf.GeneratedMessageV3
-              .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            com.google.protobuf.CodedInputStream input)
-            throws java.io.IOException {
-          return com.google.protobuf.GeneratedMessageV3
-              .parseWithIOException(PARSER, input);
-        }
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parseFrom(
-            com.google.protobuf.CodedInputStream input,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws java.io.IOException {
-          return com.google.protobuf.GeneratedMessageV3
-              .parseWithIOException(PARSER, input, extensionRegistry);
-        }
-
-        @java.lang.Override
-        public Builder newBuilderForType() { return newBuilder(); }
-        public static Builder newBuilder() {
-          return DEFAULT_INSTANCE.toBuilder();
-        }
-        public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion prototype) {
-          return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-        }
-        @java.lang.Override
-        public Builder toBuilder() {
-          return this == DEFAULT_INSTANCE
-              ? new Builder() : new Builder().mergeFrom(this);
-        }
-
-        @java.lang.Override
-        protected Builder newBuilderForType(
-            com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-          Builder builder = new Builder(parent);
-          return builder;
-        }
-        /**
-         * Protobuf type {@code plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion}
-         */
-        public static final class Builder extends
-            com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-            // @@protoc_insertion_point(builder_implements:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder {
-          public static final com.google.protobuf.Descriptors.Descriptor
-              getDescriptor() {
-            return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_descriptor;
-          }
-
-          @java.lang.Override
-          protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-              internalGetFieldAccessorTable() {
-            return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_fieldAccessorTable
-                .ensureFieldAccessorsInitialized(
-                    pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder.class);
-          }
-
-          // Construct using pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.newBuilder()
-          private Builder() {
-            maybeForceBuilderInitialization();
-          }
-
-          private Builder(
-              com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-            super(parent);
-            maybeForceBuilderInitialization();
-          }
-          private void maybeForceBuilderInitialization() {
-            if (com.google.protobuf.GeneratedMessageV3
-                    .alwaysUseFieldBuilders) {
-            }
-          }
-          @java.lang.Override
-          public Builder clear() {
-            super.clear();
-            userId_ = 0L;
-
-            anotherUserId_ = 0L;
-
-            htmlFileName_ = "";
-
-            similarity_ = 0;
-
-            return this;
-          }
-
-          @java.lang.Override
-          public com.google.protobuf.Descriptors.Descriptor
-              getDescriptorForType() {
-            return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_descriptor;
-          }
-
-          @java.lang.Override
-          public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion getDefaultInstanceForType() {
-            return pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.getDefaultInstance();
-          }
-
-          @java.lang.Override
-          public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion build() {
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion result = buildPartial();
-            if (!result.isInitialized()) {
-              throw newUninitializedMessageException(result);
-            }
-            return result;
-          }
-
-          @java.lang.Override
-          public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion buildPartial() {
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion result = new pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion(this);
-            result.userId_ = userId_;
-            result.anotherUserId_ = anotherUserId_;
-            result.htmlFileName_ = htmlFileName_;
-            result.similarity_ = similarity_;
-            onBuilt();
-            return result;
-          }
-
-          @java.lang.Override
-          public Builder clone() {
-            return super.clone();
-          }
-          @java.lang.Override
-          public Builder setField(
-              com.google.protobuf.Descriptors.FieldDescriptor field,
-              java.lang.Object value) {
-            return super.setField(field, value);
-          }
-          @java.lang.Override
-          public Builder clearField(
-              com.google.protobuf.Descriptors.FieldDescriptor field) {
-            return super.clearField(field);
-          }
-          @java.lang.Override
-          public Builder clearOneof(
-              com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-            return super.clearOneof(oneof);
-          }
-          @java.lang.Override
-          public Builder setRepeatedField(
-              com.google.protobuf.Descriptors.FieldDescriptor field,
-              int index, java.lang.Object value) {
-            return super.setRepeatedField(field, index, value);
-          }
-          @java.lang.Override
-          public Builder addRepeatedField(
-              com.google.protobuf.Descriptors.FieldDescriptor field,
-              java.lang.Object value) {
-            return super.addRepeatedField(field, value);
-          }
-          @java.lang.Override
-          public Builder mergeFrom(com.google.protobuf.Message other) {
-            if (other instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion) {
-              return mergeFrom((pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)other);
-            } else {
-              super.mergeFrom(other);
-              return this;
-            }
-          }
-
-          public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion other) {
-            if (other == pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.getDefaultInstance()) return this;
-            if (other.getUserId() != 0L) {
-              setUserId(other.getUserId());
-            }
-            if (other.getAnotherUserId() != 0L) {
-              setAnotherUserId(other.getAnotherUserId());
-            }
-            if (!other.getHtmlFileName().isEmpty()) {
-              htmlFileName_ = other.htmlFileName_;
-              onChanged();
-            }
-            if (other.getSimilarity() != 0) {
-              setSimilarity(other.getSimilarity());
-            }
-            this.mergeUnknownFields(other.unknownFields);
-            onChanged();
-            return this;
-          }
-
-          @java.lang.Override
-          public final boolean isInitialized() {
-            return true;
-          }
-
-          @java.lang.Override
-          public Builder mergeFrom(
-              com.google.protobuf.CodedInputStream input,
-              com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-              throws java.io.IOException {
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion parsedMessage = null;
-            try {
-              parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-            } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-              parsedMessage = (pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion) e.getUnfinishedMessage();
-              throw e.unwrapIOException();
-            } finally {
-              if (parsedMessage != null) {
-                mergeFrom(parsedMessage);
-              }
-            }
-            return this;
-          }
-
-          private long userId_ ;
-          /**
-           * <code>uint64 user_id = 1;</code>
-           * @return The userId.
-           */
-          @java.lang.Override
-          public long getUserId() {
-            return userId_;
-          }
-          /**
-           * <code>uint64 user_id = 1;</code>
-           * @param value The userId to set.
-           * @return This builder for chaining.
-           */
-          public Builder setUserId(long value) {
-            
-            userId_ = value;
-            onChanged();
-            return this;
-          }
-          /**
-           * <code>uint64 user_id = 1;</code>
-           * @return This builder for chaining.
-           */
-          public Builder clearUserId() {
-            
-            userId_ = 0L;
-            onChanged();
-            return this;
-          }
-
-          private long anotherUserId_ ;
-          /**
-           * <code>uint64 another_user_id = 2;</code>
-           * @return The anotherUserId.
-           */
-          @java.lang.Override
-          public long getAnotherUserId() {
-            return anotherUserId_;
-          }
-          /**
-           * <code>uint64 another_user_id = 2;</code>
-           * @param value The anotherUserId to set.
-           * @return This builder for chaining.
-           */
-          public Builder setAnotherUserId(long value) {
-            
-            anotherUserId_ = value;
-            onChanged();
-            return this;
-          }
-          /**
-           * <code>uint64 another_user_id = 2;</code>
-           * @return This builder for chaining.
-           */
-          public Builder clearAnotherUserId() {
-            
-            anotherUserId_ = 0L;
-            onChanged();
-            return this;
-          }
-
-          private java.lang.Object htmlFileName_ = "";
-          /**
-           * <code>string html_file_name = 3;</code>
-           * @return The htmlFileName.
-           */
-          public java.lang.String getHtmlFileName() {
-            java.lang.Object ref = htmlFileName_;
-            if (!(ref instanceof java.lang.String)) {
-              com.google.protobuf.ByteString bs =
-                  (com.google.protobuf.ByteString) ref;
-              java.lang.String s = bs.toStringUtf8();
-              htmlFileName_ = s;
-              return s;
-            } else {
-              return (java.lang.String) ref;
-            }
-          }
-          /**
-           * <code>string html_file_name = 3;</code>
-           * @return The bytes for htmlFileName.
-           */
-          public com.google.protobuf.ByteString
-              getHtmlFileNameBytes() {
-            java.lang.Object ref = htmlFileName_;
-            if (ref instanceof String) {
-              com.google.protobuf.ByteString b = 
-                  com.google.protobuf.ByteString.copyFromUtf8(
-                      (java.lang.String) ref);
-              htmlFileName_ = b;
-              return b;
-            } else {
-              return (com.google.protobuf.ByteString) ref;
-            }
-          }
-          /**
-           * <code>string html_file_name = 3;</code>
-           * @param value The htmlFileName to set.
-           * @return This builder for chaining.
-           */
-          public Builder setHtmlFileName(
-              java.lang.String value) {
-            if (value == null) {
-    throw new NullPointerException();
-  }
-  
-            htmlFileName_ = value;
-            onChanged();
-            return this;
-          }
-          /**
-           * <code>string html_file_name = 3;</code>
-           * @return This builder for chaining.
-           */
-          public Builder clearHtmlFileName() {
-            
-            htmlFileName_ = getDefaultInstance().getHtmlFileName();
-            onChanged();
-            return this;
-          }
-          /**
-           * <code>string html_file_name = 3;</code>
-           * @param value The bytes for htmlFileName to set.
-           * @return This builder for chaining.
-           */
-          public Builder setHtmlFileNameBytes(
-              com.google.protobuf.ByteString value) {
-            if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-            
-            htmlFileName_ = value;
-            onChanged();
-            return this;
-          }
-
-          private int similarity_ ;
-          /**
-           * <code>int32 similarity = 4;</code>
-           * @return The similarity.
-           */
-          @java.lang.Override
-          public int getSimilarity() {
-            return similarity_;
-          }
-          /**
-           * <code>int32 similarity = 4;</code>
-           * @param value The similarity to set.
-           * @return This builder for chaining.
-           */
-          public Builder setSimilarity(int value) {
-            
-            similarity_ = value;
-            onChanged();
-            return this;
-          }
-          /**
-           * <code>int32 similarity = 4;</code>
-           * @return This builder for chaining.
-           */
-          public Builder clearSimilarity() {
-            
-            similarity_ = 0;
-            onChanged();
-            return this;
-          }
-          @java.lang.Override
-          public final Builder setUnknownFields(
-              final com.google.protobuf.UnknownFieldSet unknownFields) {
-            return super.setUnknownFields(unknownFields);
-          }
-
-          @java.lang.Override
-          public final Builder mergeUnknownFields(
-              final com.google.protobuf.UnknownFieldSet unknownFields) {
-            return super.mergeUnknownFields(unknownFields);
-          }
-
-
-          // @@protoc_insertion_point(builder_scope:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)
-        }
-
-        // @@protoc_insertion_point(class_scope:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion)
-        private static final pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion DEFAULT_INSTANCE;
-        static {
-          DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion();
-        }
-
-        public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion getDefaultInstance() {
-          return DEFAULT_INSTANCE;
-        }
-
-        private static final com.google.protobuf.Parser<Comparsion>
-            PARSER = new com.google.protobuf.AbstractParser<Comparsion>() {
-          @java.lang.Override
-          public Comparsion parsePartialFrom(
-              com.google.protobuf.CodedInputStream input,
-              com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-              throws com.google.protobuf.InvalidProtocolBufferException {
-            return new Comparsion(input, extensionRegistry);
-          }
-        };
-
-        public static com.google.protobuf.Parser<Comparsion> parser() {
-          return PARSER;
-        }
-
-        @java.lang.Override
-        public com.google.protobuf.Parser<Comparsion> getParserForType() {
-          return PARSER;
-        }
-
-        @java.lang.Override
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion getDefaultInstanceForType() {
-          return DEFAULT_INSTANCE;
-        }
-
-      }
-
-      public static final int COMPARISIONS_FIELD_NUMBER = 1;
-      private java.util.List<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion> comparisions_;
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      @java.lang.Override
-      public java.util.List<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion> getComparisionsList() {
-        return comparisions_;
-      }
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      @java.lang.Override
-      public java.util.List<? extends pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder> 
-          getComparisionsOrBuilderList() {
-        return comparisions_;
-      }
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      @java.lang.Override
-      public int getComparisionsCount() {
-        return comparisions_.size();
-      }
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion getComparisions(int index) {
-        return comparisions_.get(index);
-      }
-      /**
-       * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-       */
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder getComparisionsOrBuilder(
-          int index) {
-        return comparisions_.get(index);
-      }
-
-      private byte memoizedIsInitialized = -1;
-      @java.lang.Override
-      public final boolean isInitialized() {
-        byte isInitialized = memoizedIsInitialized;
-        if (isInitialized == 1) return true;
-        if (isInitialized == 0) return false;
-
-        memoizedIsInitialized = 1;
-        return true;
-      }
-
-      @java.lang.Override
-      public void writeTo(com.google.protobuf.CodedOutputStream output)
-                          throws java.io.IOException {
-        for (int i = 0; i < comparisions_.size(); i++) {
-          output.writeMessage(1, comparisions_.get(i));
-        }
-        unknownFields.writeTo(output);
-      }
-
-      @java.lang.Override
-      public int getSerializedSize() {
-        int size = memoizedSize;
-        if (size != -1) return size;
-
-        size = 0;
-        for (int i = 0; i < comparisions_.size(); i++) {
-          size += com.google.protobuf.CodedOutputStream
-            .computeMessageSize(1, comparisions_.get(i));
-        }
-        size += unknownFields.getSerializedSize();
-        memoizedSize = size;
-        return size;
-      }
-
-      @java.lang.Override
-      public boolean equals(final java.lang.Object obj) {
-        if (obj == this) {
-         return true;
-        }
-        if (!(obj instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue)) {
-          return super.equals(obj);
-        }
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue other = (pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue) obj;
-
-        if (!getComparisionsList()
-            .equals(other.getComparisionsList())) return false;
-        if (!unknownFields.equals(other.unknownFields)) return false;
-        return true;
-      }
-
-      @java.lang.Override
-      public int hashCode() {
-        if (memoizedHashCode != 0) {
-          return memoizedHashCode;
-        }
-        int hash = 41;
-        hash = (19 * hash) + getDescriptor().hashCode();
-        if (getComparisionsCount() > 0) {
-          hash = (37 * hash) + COMPARISIONS_FIELD_NUMBER;
-          hash = (53 * hash) + getComparisionsList().hashCode();
-        }
-        hash = (29 * hash) + unknownFields.hashCode();
-        memoizedHashCode = hash;
-        return hash;
-      }
-
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          java.nio.ByteBuffer data)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return PARSER.parseFrom(data);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          java.nio.ByteBuffer data,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return PARSER.parseFrom(data, extensionRegistry);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          com.google.protobuf.ByteString data)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return PARSER.parseFrom(data);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          com.google.protobuf.ByteString data,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return PARSER.parseFrom(data, extensionRegistry);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(byte[] data)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return PARSER.parseFrom(data);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          byte[] data,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return PARSER.parseFrom(data, extensionRegistry);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(java.io.InputStream input)
-          throws java.io.IOException {
-        return com.google.protobuf.GeneratedMessageV3
-            .parseWithIOException(PARSER, input);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          java.io.InputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        return com.google.protobuf.GeneratedMessageV3
-            .parseWithIOException(PARSER, input, extensionRegistry);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseDelimitedFrom(java.io.InputStream input)
-          throws java.io.IOException {
-        return com.google.protobuf.GeneratedMessageV3
-            .parseDelimitedWithIOException(PARSER, input);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseDelimitedFrom(
-          java.io.InputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        return com.google.protobuf.GeneratedMessageV3
-            .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          com.google.protobuf.CodedInputStream input)
-          throws java.io.IOException {
-        return com.google.protobuf.GeneratedMessageV3
-            .parseWithIOException(PARSER, input);
-      }
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parseFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        return com.google.protobuf.GeneratedMessageV3
-            .parseWithIOException(PARSER, input, extensionRegistry);
-      }
-
-      @java.lang.Override
-      public Builder newBuilderForType() { return newBuilder(); }
-      public static Builder newBuilder() {
-        return DEFAULT_INSTANCE.toBuilder();
-      }
-      public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue prototype) {
-        return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-      }
-      @java.lang.Override
-      public Builder toBuilder() {
-        return this == DEFAULT_INSTANCE
-            ? new Builder() : new Builder().mergeFrom(this);
-      }
-
-      @java.lang.Override
-      protected Builder newBuilderForType(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        Builder builder = new Builder(parent);
-        return builder;
-      }
-      /**
-       * Protobuf type {@code plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue}
-       */
-      public static final class Builder extends
-          com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-          // @@protoc_insertion_point(builder_implements:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue)
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder {
-        public static final com.google.protobuf.Descriptors.Descriptor
-            getDescriptor() {
-          return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor;
-        }
-
-        @java.lang.Override
-        protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-            internalGetFieldAccessorTable() {
-          return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_fieldAccessorTable
-              .ensureFieldAccessorsInitialized(
-                  pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder.class);
-        }
-
-        // Construct using pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.newBuilder()
-        private Builder() {
-          maybeForceBuilderInitialization();
-        }
-
-        private Builder(
-            com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-          super(parent);
-          maybeForceBuilderInitialization();
-        }
-        private void maybeForceBuilderInitialization() {
-          if (com.google.protobuf.GeneratedMessageV3
-                  .alwaysUseFieldBuilders) {
-            getComparisionsFieldBuilder();
-          }
-        }
-        @java.lang.Override
-        public Builder clear() {
-          super.clear();
-          if (comparisionsBuilder_ == null) {
-            comparisions_ = java.util.Collections.emptyList();
-            bitField0_ = (bitField0_ & ~0x00000001);
-          } else {
-            comparisionsBuilder_.clear();
-          }
-          return this;
-        }
-
-        @java.lang.Override
-        public com.google.protobuf.Descriptors.Descriptor
-            getDescriptorForType() {
-          return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor;
-        }
-
-        @java.lang.Override
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue getDefaultInstanceForType() {
-          return pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.getDefaultInstance();
-        }
-
-        @java.lang.Override
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue build() {
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue result = buildPartial();
-          if (!result.isInitialized()) {
-            throw newUninitializedMessageException(result);
-          }
-          return result;
-        }
-
-        @java.lang.Override
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue buildPartial() {
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue result = new pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue(this);
-          int from_bitField0_ = bitField0_;
-          if (comparisionsBuilder_ == null) {
-            if (((bitField0_ & 0x00000001) != 0)) {
-              comparisions_ = java.util.Collections.unmodifiableList(comparisions_);
-              bitField0_ = (bitField0_ & ~0x00000001);
-            }
-            result.comparisions_ = comparisions_;
-          } else {
-            result.comparisions_ = comparisionsBuilder_.build();
-          }
-          onBuilt();
-          return result;
-        }
-
-        @java.lang.Override
-        public Builder clone() {
-          return super.clone();
-        }
-        @java.lang.Override
-        public Builder setField(
-            com.google.protobuf.Descriptors.FieldDescriptor field,
-            java.lang.Object value) {
-          return super.setField(field, value);
-        }
-        @java.lang.Override
-        public Builder clearField(
-            com.google.protobuf.Descriptors.FieldDescriptor field) {
-          return super.clearField(field);
-        }
-        @java.lang.Override
-        public Builder clearOneof(
-            com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-          return super.clearOneof(oneof);
-        }
-        @java.lang.Override
-        public Builder setRepeatedField(
-            com.google.protobuf.Descriptors.FieldDescriptor field,
-            int index, java.lang.Object value) {
-          return super.setRepeatedField(field, index, value);
-        }
-        @java.lang.Override
-        public Builder addRepeatedField(
-            com.google.protobuf.Descriptors.FieldDescriptor field,
-            java.lang.Object value) {
-          return super.addRepeatedField(field, value);
-        }
-        @java.lang.Override
-        public Builder mergeFrom(com.google.protobuf.Message other) {
-          if (other instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue) {
-            return mergeFrom((pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue)other);
-          } else {
-            super.mergeFrom(other);
-            return this;
-          }
-        }
-
-        public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue other) {
-          if (other == pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.getDefaultInstance()) return this;
-          if (comparisionsBuilder_ == null) {
-            if (!other.comparisions_.isEmpty()) {
-              if (comparisions_.isEmpty()) {
-                comparisions_ = other.comparisions_;
-                bitField0_ = (bitField0_ & ~0x00000001);
-              } else {
-                ensureComparisionsIsMutable();
-                comparisions_.addAll(other.comparisions_);
-              }
-              onChanged();
-            }
-          } else {
-            if (!other.comparisions_.isEmpty()) {
-              if (comparisionsBuilder_.isEmpty()) {
-                comparisionsBuilder_.dispose();
-                comparisionsBuilder_ = null;
-                comparisions_ = other.comparisions_;
-                bitField0_ = (bitField0_ & ~0x00000001);
-                comparisionsBuilder_ = 
-                  com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
-                     getComparisionsFieldBuilder() : null;
-              } else {
-                comparisionsBuilder_.addAllMessages(other.comparisions_);
-              }
-            }
-          }
-          this.mergeUnknownFields(other.unknownFields);
-          onChanged();
-          return this;
-        }
-
-        @java.lang.Override
-        public final boolean isInitialized() {
-          return true;
-        }
-
-        @java.lang.Override
-        public Builder mergeFrom(
-            com.google.protobuf.CodedInputStream input,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws java.io.IOException {
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue parsedMessage = null;
-          try {
-            parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-          } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-            parsedMessage = (pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue) e.getUnfinishedMessage();
-            throw e.unwrapIOException();
-          } finally {
-            if (parsedMessage != null) {
-              mergeFrom(parsedMessage);
-            }
-          }
-          return this;
-        }
-        private int bitField0_;
-
-        private java.util.List<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion> comparisions_ =
-          java.util.Collections.emptyList();
-        private void ensureComparisionsIsMutable() {
-          if (!((bitField0_ & 0x00000001) != 0)) {
-            comparisions_ = new java.util.ArrayList<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion>(comparisions_);
-            bitField0_ |= 0x00000001;
-           }
-        }
-
-        private com.google.protobuf.RepeatedFieldBuilderV3<
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder> comparisionsBuilder_;
-
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public java.util.List<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion> getComparisionsList() {
-          if (comparisionsBuilder_ == null) {
-            return java.util.Collections.unmodifiableList(comparisions_);
-          } else {
-            return comparisionsBuilder_.getMessageList();
-          }
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public int getComparisionsCount() {
-          if (comparisionsBuilder_ == null) {
-            return comparisions_.size();
-          } else {
-            return comparisionsBuilder_.getCount();
-          }
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion getComparisions(int index) {
-          if (comparisionsBuilder_ == null) {
-            return comparisions_.get(index);
-          } else {
-            return comparisionsBuilder_.getMessage(index);
-          }
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder setComparisions(
-            int index, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion value) {
-          if (comparisionsBuilder_ == null) {
-            if (value == null) {
-              throw new NullPointerException();
-            }
-            ensureComparisionsIsMutable();
-            comparisions_.set(index, value);
-            onChanged();
-          } else {
-            comparisionsBuilder_.setMessage(index, value);
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder setComparisions(
-            int index, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder builderForValue) {
-          if (comparisionsBuilder_ == null) {
-            ensureComparisionsIsMutable();
-            comparisions_.set(index, builderForValue.build());
-            onChanged();
-          } else {
-            comparisionsBuilder_.setMessage(index, builderForValue.build());
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder addComparisions(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion value) {
-          if (comparisionsBuilder_ == null) {
-            if (value == null) {
-              throw new NullPointerException();
-            }
-            ensureComparisionsIsMutable();
-            comparisions_.add(value);
-            onChanged();
-          } else {
-            comparisionsBuilder_.addMessage(value);
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder addComparisions(
-            int index, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion value) {
-          if (comparisionsBuilder_ == null) {
-            if (value == null) {
-              throw new NullPointerException();
-            }
-            ensureComparisionsIsMutable();
-            comparisions_.add(index, value);
-            onChanged();
-          } else {
-            comparisionsBuilder_.addMessage(index, value);
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder addComparisions(
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder builderForValue) {
-          if (comparisionsBuilder_ == null) {
-            ensureComparisionsIsMutable();
-            comparisions_.add(builderForValue.build());
-            onChanged();
-          } else {
-            comparisionsBuilder_.addMessage(builderForValue.build());
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder addComparisions(
-            int index, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder builderForValue) {
-          if (comparisionsBuilder_ == null) {
-            ensureComparisionsIsMutable();
-            comparisions_.add(index, builderForValue.build());
-            onChanged();
-          } else {
-            comparisionsBuilder_.addMessage(index, builderForValue.build());
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder addAllComparisions(
-            java.lang.Iterable<? extends pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion> values) {
-          if (comparisionsBuilder_ == null) {
-            ensureComparisionsIsMutable();
-            com.google.protobuf.AbstractMessageLite.Builder.addAll(
-                values, comparisions_);
-            onChanged();
-          } else {
-            comparisionsBuilder_.addAllMessages(values);
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder clearComparisions() {
-          if (comparisionsBuilder_ == null) {
-            comparisions_ = java.util.Collections.emptyList();
-            bitField0_ = (bitField0_ & ~0x00000001);
-            onChanged();
-          } else {
-            comparisionsBuilder_.clear();
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public Builder removeComparisions(int index) {
-          if (comparisionsBuilder_ == null) {
-            ensureComparisionsIsMutable();
-            comparisions_.remove(index);
-            onChanged();
-          } else {
-            comparisionsBuilder_.remove(index);
-          }
-          return this;
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder getComparisionsBuilder(
-            int index) {
-          return getComparisionsFieldBuilder().getBuilder(index);
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder getComparisionsOrBuilder(
-            int index) {
-          if (comparisionsBuilder_ == null) {
-            return comparisions_.get(index);  } else {
-            return comparisionsBuilder_.getMessageOrBuilder(index);
-          }
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public java.util.List<? extends pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder> 
-             getComparisionsOrBuilderList() {
-          if (comparisionsBuilder_ != null) {
-            return comparisionsBuilder_.getMessageOrBuilderList();
-          } else {
-            return java.util.Collections.unmodifiableList(comparisions_);
-          }
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder addComparisionsBuilder() {
-          return getComparisionsFieldBuilder().addBuilder(
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.getDefaultInstance());
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder addComparisionsBuilder(
-            int index) {
-          return getComparisionsFieldBuilder().addBuilder(
-              index, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.getDefaultInstance());
-        }
-        /**
-         * <code>repeated .plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion comparisions = 1;</code>
-         */
-        public java.util.List<pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder> 
-             getComparisionsBuilderList() {
-          return getComparisionsFieldBuilder().getBuilderList();
-        }
-        private com.google.protobuf.RepeatedFieldBuilderV3<
-            pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder> 
-            getComparisionsFieldBuilder() {
-          if (comparisionsBuilder_ == null) {
-            comparisionsBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
-                pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Comparsion.Builder, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.ComparsionOrBuilder>(
-                    comparisions_,
-                    ((bitField0_ & 0x00000001) != 0),
-                    getParentForChildren(),
-                    isClean());
-            comparisions_ = null;
-          }
-          return comparisionsBuilder_;
-        }
-        @java.lang.Override
-        public final Builder setUnknownFields(
-            final com.google.protobuf.UnknownFieldSet unknownFields) {
-          return super.setUnknownFields(unknownFields);
-        }
-
-        @java.lang.Override
-        public final Builder mergeUnknownFields(
-            final com.google.protobuf.UnknownFieldSet unknownFields) {
-          return super.mergeUnknownFields(unknownFields);
-        }
-
-
-        // @@protoc_insertion_point(builder_scope:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue)
-      }
-
-      // @@protoc_insertion_point(class_scope:plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue)
-      private static final pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue DEFAULT_INSTANCE;
-      static {
-        DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue();
-      }
-
-      public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue getDefaultInstance() {
-        return DEFAULT_INSTANCE;
-      }
-
-      private static final com.google.protobuf.Parser<DuplicateCheckResponseValue>
-          PARSER = new com.google.protobuf.AbstractParser<DuplicateCheckResponseValue>() {
-        @java.lang.Override
-        public DuplicateCheckResponseValue parsePartialFrom(
-            com.google.protobuf.CodedInputStream input,
-            com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-            throws com.google.protobuf.InvalidProtocolBufferException {
-          return new DuplicateCheckResponseValue(input, extensionRegistry);
-        }
-      };
-
-      public static com.google.protobuf.Parser<DuplicateCheckResponseValue> parser() {
-        return PARSER;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Parser<DuplicateCheckResponseValue> getParserForType() {
-        return PARSER;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue getDefaultInstanceForType() {
-        return DEFAULT_INSTANCE;
-      }
-
-    }
-
-    public static final int COMPARISION_FIELD_NUMBER = 1;
-    private pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue comparision_;
-    /**
-     * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-     * @return Whether the comparision field is set.
-     */
-    @java.lang.Override
-    public boolean hasComparision() {
-      return comparision_ != null;
-    }
-    /**
-     * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-     * @return The comparision.
-     */
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue getComparision() {
-      return comparision_ == null ? pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.getDefaultInstance() : comparision_;
-    }
-    /**
-     * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-     */
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder getComparisionOrBuilder() {
-      return getComparision();
-    }
-
-    public static final int TIME_STAMP_FIELD_NUMBER = 2;
-    private volatile java.lang.Object timeStamp_;
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The timeStamp.
-     */
-    @java.lang.Override
-    public java.lang.String getTimeStamp() {
-      java.lang.Object ref = timeStamp_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        timeStamp_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The bytes for timeStamp.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getTimeStampBytes() {
-      java.lang.Object ref = timeStamp_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        timeStamp_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (comparision_ != null) {
-        output.writeMessage(1, getComparision());
-      }
-      if (!getTimeStampBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, timeStamp_);
-      }
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      if (comparision_ != null) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeMessageSize(1, getComparision());
-      }
-      if (!getTimeStampBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, timeStamp_);
-      }
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse other = (pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse) obj;
-
-      if (hasComparision() != other.hasComparision()) return false;
-      if (hasComparision()) {
-        if (!getComparision()
-            .equals(other.getComparision())) return false;
-      }
-      if (!getTimeStamp()
-          .equals(other.getTimeStamp())) return false;
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      if (hasComparision()) {
-        hash = (37 * hash) + COMPARISION_FIELD_NUMBER;
-        hash = (53 * hash) + getComparision().hashCode();
-      }
-      hash = (37 * hash) + TIME_STAMP_FIELD_NUMBER;
-      hash = (53 * hash) + getTimeStamp().hashCode();
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.DuplicateCheckResponse}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.DuplicateCheckResponse)
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckResponseOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.class, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        if (comparisionBuilder_ == null) {
-          comparision_ = null;
-        } else {
-          comparision_ = null;
-          comparisionBuilder_ = null;
-        }
-        timeStamp_ = "";
-
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse build() {
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse buildPartial() {
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse result = new pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse(this);
-        if (comparisionBuilder_ == null) {
-          result.comparision_ = comparision_;
-        } else {
-          result.comparision_ = comparisionBuilder_.build();
-        }
-        result.timeStamp_ = timeStamp_;
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse other) {
-        if (other == pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.getDefaultInstance()) return this;
-        if (other.hasComparision()) {
-          mergeComparision(other.getComparision());
-        }
-        if (!other.getTimeStamp().isEmpty()) {
-          timeStamp_ = other.timeStamp_;
-          onChanged();
-        }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-
-      private pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue comparision_;
-      private com.google.protobuf.SingleFieldBuilderV3<
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder> comparisionBuilder_;
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       * @return Whether the comparision field is set.
-       */
-      public boolean hasComparision() {
-        return comparisionBuilder_ != null || comparision_ != null;
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       * @return The comparision.
-       */
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue getComparision() {
-        if (comparisionBuilder_ == null) {
-          return comparision_ == null ? pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.getDefaultInstance() : comparision_;
-        } else {
-          return comparisionBuilder_.getMessage();
-        }
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      public Builder setComparision(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue value) {
-        if (comparisionBuilder_ == null) {
-          if (value == null) {
-            throw new NullPointerException();
-          }
-          comparision_ = value;
-          onChanged();
-        } else {
-          comparisionBuilder_.setMessage(value);
-        }
-
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      public Builder setComparision(
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder builderForValue) {
-        if (comparisionBuilder_ == null) {
-          comparision_ = builderForValue.build();
-          onChanged();
-        } else {
-          comparisionBuilder_.setMessage(builderForValue.build());
-        }
-
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      public Builder mergeComparision(pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue value) {
-        if (comparisionBuilder_ == null) {
-          if (comparision_ != null) {
-            comparision_ =
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.newBuilder(comparision_).mergeFrom(value).buildPartial();
-          } else {
-            comparision_ = value;
-          }
-          onChanged();
-        } else {
-          comparisionBuilder_.mergeFrom(value);
-        }
-
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      public Builder clearComparision() {
-        if (comparisionBuilder_ == null) {
-          comparision_ = null;
-          onChanged();
-        } else {
-          comparision_ = null;
-          comparisionBuilder_ = null;
-        }
-
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder getComparisionBuilder() {
-        
-        onChanged();
-        return getComparisionFieldBuilder().getBuilder();
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder getComparisionOrBuilder() {
-        if (comparisionBuilder_ != null) {
-          return comparisionBuilder_.getMessageOrBuilder();
-        } else {
-          return comparision_ == null ?
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.getDefaultInstance() : comparision_;
-        }
-      }
-      /**
-       * <code>.plagiarism_detection.DuplicateCheckResponse.DuplicateCheckResponseValue comparision = 1;</code>
-       */
-      private com.google.protobuf.SingleFieldBuilderV3<
-          pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder> 
-          getComparisionFieldBuilder() {
-        if (comparisionBuilder_ == null) {
-          comparisionBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
-              pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValue.Builder, pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse.DuplicateCheckResponseValueOrBuilder>(
-                  getComparision(),
-                  getParentForChildren(),
-                  isClean());
-          comparision_ = null;
-        }
-        return comparisionBuilder_;
-      }
-
-      private java.lang.Object timeStamp_ = "";
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @return The timeStamp.
-       */
-      public java.lang.String getTimeStamp() {
-        java.lang.Object ref = timeStamp_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          timeStamp_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @return The bytes for timeStamp.
-       */
-      public com.google.protobuf.ByteString
-          getTimeStampBytes() {
-        java.lang.Object ref = timeStamp_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          timeStamp_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @param value The timeStamp to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTimeStamp(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        timeStamp_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearTimeStamp() {
-        
-        timeStamp_ = getDefaultInstance().getTimeStamp();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @param value The bytes for timeStamp to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTimeStampBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        timeStamp_ = value;
-        onChanged();
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.DuplicateCheckResponse)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.DuplicateCheckResponse)
-    private static final pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<DuplicateCheckResponse>
-        PARSER = new com.google.protobuf.AbstractParser<DuplicateCheckResponse>() {
-      @java.lang.Override
-      public DuplicateCheckResponse parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new DuplicateCheckResponse(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<DuplicateCheckResponse> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<DuplicateCheckResponse> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.DuplicateCheckResponse getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  public interface ViewReportRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.ViewReportRequest)
-      com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>uint64 lab_id = 1;</code>
-     * @return The labId.
-     */
-    long getLabId();
-
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The timeStamp.
-     */
-    java.lang.String getTimeStamp();
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The bytes for timeStamp.
-     */
-    com.google.protobuf.ByteString
-        getTimeStampBytes();
-
-    /**
-     * <code>string html_file_name = 3;</code>
-     * @return The htmlFileName.
-     */
-    java.lang.String getHtmlFileName();
-    /**
-     * <code>string html_file_name = 3;</code>
-     * @return The bytes for htmlFileName.
-     */
-    com.google.protobuf.ByteString
-        getHtmlFileNameBytes();
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.ViewReportRequest}
-   */
-  public static final class ViewReportRequest extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.ViewReportRequest)
-      ViewReportRequestOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use ViewReportRequest.newBuilder() to construct.
-    private ViewReportRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private ViewReportRequest() {
-      timeStamp_ = "";
-      htmlFileName_ = "";
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new ViewReportRequest();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private ViewReportRequest(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 8: {
-
-              labId_ = input.readUInt64();
-              break;
-            }
-            case 18: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              timeStamp_ = s;
-              break;
-            }
-            case 26: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              htmlFileName_ = s;
-              break;
-            }
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportRequest_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportRequest_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.ViewReportRequest.class, pb.plagiarismDetectionServerImplBase.ViewReportRequest.Builder.class);
-    }
-
-    public static final int LAB_ID_FIELD_NUMBER = 1;
-    private long labId_;
-    /**
-     * <code>uint64 lab_id = 1;</code>
-     * @return The labId.
-     */
-    @java.lang.Override
-    public long getLabId() {
-      return labId_;
-    }
-
-    public static final int TIME_STAMP_FIELD_NUMBER = 2;
-    private volatile java.lang.Object timeStamp_;
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The timeStamp.
-     */
-    @java.lang.Override
-    public java.lang.String getTimeStamp() {
-      java.lang.Object ref = timeStamp_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        timeStamp_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string time_stamp = 2;</code>
-     * @return The bytes for timeStamp.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getTimeStampBytes() {
-      java.lang.Object ref = timeStamp_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        timeStamp_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    public static final int HTML_FILE_NAME_FIELD_NUMBER = 3;
-    private volatile java.lang.Object htmlFileName_;
-    /**
-     * <code>string html_file_name = 3;</code>
-     * @return The htmlFileName.
-     */
-    @java.lang.Override
-    public java.lang.String getHtmlFileName() {
-      java.lang.Object ref = htmlFileName_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        htmlFileName_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string html_file_name = 3;</code>
-     * @return The bytes for htmlFileName.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getHtmlFileNameBytes() {
-      java.lang.Object ref = htmlFileName_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        htmlFileName_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (labId_ != 0L) {
-        output.writeUInt64(1, labId_);
-      }
-      if (!getTimeStampBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, timeStamp_);
-      }
-      if (!getHtmlFileNameBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, htmlFileName_);
-      }
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      if (labId_ != 0L) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeUInt64Size(1, labId_);
-      }
-      if (!getTimeStampBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, timeStamp_);
-      }
-      if (!getHtmlFileNameBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, htmlFileName_);
-      }
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.ViewReportRequest)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.ViewReportRequest other = (pb.plagiarismDetectionServerImplBase.ViewReportRequest) obj;
-
-      if (getLabId()
-          != other.getLabId()) return false;
-      if (!getTimeStamp()
-          .equals(other.getTimeStamp())) return false;
-      if (!getHtmlFileName()
-          .equals(other.getHtmlFileName())) return false;
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + LAB_ID_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getLabId());
-      hash = (37 * hash) + TIME_STAMP_FIELD_NUMBER;
-      hash = (53 * hash) + getTimeStamp().hashCode();
-      hash = (37 * hash) + HTML_FILE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getHtmlFileName().hashCode();
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.ViewReportRequest prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.ViewReportRequest}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.ViewReportRequest)
-        pb.plagiarismDetectionServerImplBase.ViewReportRequestOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportRequest_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportRequest_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.ViewReportRequest.class, pb.plagiarismDetectionServerImplBase.ViewReportRequest.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.ViewReportRequest.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        labId_ = 0L;
-
-        timeStamp_ = "";
-
-        htmlFileName_ = "";
-
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportRequest_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.ViewReportRequest getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.ViewReportRequest.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.ViewReportRequest build() {
-        pb.plagiarismDetectionServerImplBase.ViewReportRequest result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.ViewReportRequest buildPartial() {
-        pb.plagiarismDetectionServerImplBase.ViewReportRequest result = new pb.plagiarismDetectionServerImplBase.ViewReportRequest(this);
-        result.labId_ = labId_;
-        result.timeStamp_ = timeStamp_;
-        result.htmlFileName_ = htmlFileName_;
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.ViewReportRequest) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.ViewReportRequest)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.ViewReportRequest other) {
-        if (other == pb.plagiarismDetectionServerImplBase.ViewReportRequest.getDefaultInstance()) return this;
-        if (other.getLabId() != 0L) {
-          setLabId(other.getLabId());
-        }
-        if (!other.getTimeStamp().isEmpty()) {
-          timeStamp_ = other.timeStamp_;
-          onChanged();
-        }
-        if (!other.getHtmlFileName().isEmpty()) {
-          htmlFileName_ = other.htmlFileName_;
-          onChanged();
-        }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.ViewReportRequest parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.ViewReportRequest) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-
-      private long labId_ ;
-      /**
-       * <code>uint64 lab_id = 1;</code>
-       * @return The labId.
-       */
-      @java.lang.Override
-      public long getLabId() {
-        return labId_;
-      }
-      /**
-       * <code>uint64 lab_id = 1;</code>
-       * @param value The labId to set.
-       * @return This builder for chaining.
-       */
-      public Builder setLabId(long value) {
-        
-        labId_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>uint64 lab_id = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearLabId() {
-        
-        labId_ = 0L;
-        onChanged();
-        return this;
-      }
-
-      private java.lang.Object timeStamp_ = "";
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @return The timeStamp.
-       */
-      public java.lang.String getTimeStamp() {
-        java.lang.Object ref = timeStamp_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          timeStamp_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @return The bytes for timeStamp.
-       */
-      public com.google.protobuf.ByteString
-          getTimeStampBytes() {
-        java.lang.Object ref = timeStamp_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          timeStamp_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @param value The timeStamp to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTimeStamp(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        timeStamp_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearTimeStamp() {
-        
-        timeStamp_ = getDefaultInstance().getTimeStamp();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string time_stamp = 2;</code>
-       * @param value The bytes for timeStamp to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTimeStampBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        timeStamp_ = value;
-        onChanged();
-        return this;
-      }
-
-      private java.lang.Object htmlFileName_ = "";
-      /**
-       * <code>string html_file_name = 3;</code>
-       * @return The htmlFileName.
-       */
-      public java.lang.String getHtmlFileName() {
-        java.lang.Object ref = htmlFileName_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          htmlFileName_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string html_file_name = 3;</code>
-       * @return The bytes for htmlFileName.
-       */
-      public com.google.protobuf.ByteString
-          getHtmlFileNameBytes() {
-        java.lang.Object ref = htmlFileName_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          htmlFileName_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string html_file_name = 3;</code>
-       * @param value The htmlFileName to set.
-       * @return This builder for chaining.
-       */
-      public Builder setHtmlFileName(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        htmlFileName_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string html_file_name = 3;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearHtmlFileName() {
-        
-        htmlFileName_ = getDefaultInstance().getHtmlFileName();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string html_file_name = 3;</code>
-       * @param value The bytes for htmlFileName to set.
-       * @return This builder for chaining.
-       */
-      public Builder setHtmlFileNameBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        htmlFileName_ = value;
-        onChanged();
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.ViewReportRequest)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.ViewReportRequest)
-    private static final pb.plagiarismDetectionServerImplBase.ViewReportRequest DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.ViewReportRequest();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.ViewReportRequest getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<ViewReportRequest>
-        PARSER = new com.google.protobuf.AbstractParser<ViewReportRequest>() {
-      @java.lang.Override
-      public ViewReportRequest parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new ViewReportRequest(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<ViewReportRequest> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<ViewReportRequest> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.ViewReportRequest getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  public interface ViewReportResponseOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.ViewReportResponse)
-      com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>string html_file_content = 1;</code>
-     * @return The htmlFileContent.
-     */
-    java.lang.String getHtmlFileContent();
-    /**
-     * <code>string html_file_content = 1;</code>
-     * @return The bytes for htmlFileContent.
-     */
-    com.google.protobuf.ByteString
-        getHtmlFileContentBytes();
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.ViewReportResponse}
-   */
-  public static final class ViewReportResponse extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.ViewReportResponse)
-      ViewReportResponseOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use ViewReportResponse.newBuilder() to construct.
-    private ViewReportResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private ViewReportResponse() {
-      htmlFileContent_ = "";
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new ViewReportResponse();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private ViewReportResponse(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 10: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              htmlFileContent_ = s;
-              break;
-            }
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportResponse_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportResponse_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.ViewReportResponse.class, pb.plagiarismDetectionServerImplBase.ViewReportResponse.Builder.class);
-    }
-
-    public static final int HTML_FILE_CONTENT_FIELD_NUMBER = 1;
-    private volatile java.lang.Object htmlFileContent_;
-    /**
-     * <code>string html_file_content = 1;</code>
-     * @return The htmlFileContent.
-     */
-    @java.lang.Override
-    public java.lang.String getHtmlFileContent() {
-      java.lang.Object ref = htmlFileContent_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        htmlFileContent_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string html_file_content = 1;</code>
-     * @return The bytes for htmlFileContent.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getHtmlFileContentBytes() {
-      java.lang.Object ref = htmlFileContent_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        htmlFileContent_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (!getHtmlFileContentBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, htmlFileContent_);
-      }
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      if (!getHtmlFileContentBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, htmlFileContent_);
-      }
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.ViewReportResponse)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.ViewReportResponse other = (pb.plagiarismDetectionServerImplBase.ViewReportResponse) obj;
-
-      if (!getHtmlFileContent()
-          .equals(other.getHtmlFileContent())) return false;
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + HTML_FILE_CONTENT_FIELD_NUMBER;
-      hash = (53 * hash) + getHtmlFileContent().hashCode();
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.ViewReportResponse prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.ViewReportResponse}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.ViewReportResponse)
-        pb.plagiarismDetectionServerImplBase.ViewReportResponseOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportResponse_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportResponse_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.ViewReportResponse.class, pb.plagiarismDetectionServerImplBase.ViewReportResponse.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.ViewReportResponse.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        htmlFileContent_ = "";
-
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_ViewReportResponse_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.ViewReportResponse getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.ViewReportResponse.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.ViewReportResponse build() {
-        pb.plagiarismDetectionServerImplBase.ViewReportResponse result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.ViewReportResponse buildPartial() {
-        pb.plagiarismDetectionServerImplBase.ViewReportResponse result = new pb.plagiarismDetectionServerImplBase.ViewReportResponse(this);
-        result.htmlFileContent_ = htmlFileContent_;
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.ViewReportResponse) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.ViewReportResponse)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.ViewReportResponse other) {
-        if (other == pb.plagiarismDetectionServerImplBase.ViewReportResponse.getDefaultInstance()) return this;
-        if (!other.getHtmlFileContent().isEmpty()) {
-          htmlFileContent_ = other.htmlFileContent_;
-          onChanged();
-        }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.ViewReportResponse parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.ViewReportResponse) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-
-      private java.lang.Object htmlFileContent_ = "";
-      /**
-       * <code>string html_file_content = 1;</code>
-       * @return The htmlFileContent.
-       */
-      public java.lang.String getHtmlFileContent() {
-        java.lang.Object ref = htmlFileContent_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          htmlFileContent_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string html_file_content = 1;</code>
-       * @return The bytes for htmlFileContent.
-       */
-      public com.google.protobuf.ByteString
-          getHtmlFileContentBytes() {
-        java.lang.Object ref = htmlFileContent_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          htmlFileContent_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string html_file_content = 1;</code>
-       * @param value The htmlFileContent to set.
-       * @return This builder for chaining.
-       */
-      public Builder setHtmlFileContent(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        htmlFileContent_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string html_file_content = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearHtmlFileContent() {
-        
-        htmlFileContent_ = getDefaultInstance().getHtmlFileContent();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string html_file_content = 1;</code>
-       * @param value The bytes for htmlFileContent to set.
-       * @return This builder for chaining.
-       */
-      public Builder setHtmlFileContentBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        htmlFileContent_ = value;
-        onChanged();
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.ViewReportResponse)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.ViewReportResponse)
-    private static final pb.plagiarismDetectionServerImplBase.ViewReportResponse DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.ViewReportResponse();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.ViewReportResponse getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<ViewReportResponse>
-        PARSER = new com.google.protobuf.AbstractParser<ViewReportResponse>() {
-      @java.lang.Override
-      public ViewReportResponse parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new ViewReportResponse(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<ViewReportResponse> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<ViewReportResponse> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.ViewReportResponse getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  public interface GenerateTestFilesForDuplicateCheckRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest)
-      com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>string code_content = 1;</code>
-     * @return The codeContent.
-     */
-    java.lang.String getCodeContent();
-    /**
-     * <code>string code_content = 1;</code>
-     * @return The bytes for codeContent.
-     */
-    com.google.protobuf.ByteString
-        getCodeContentBytes();
-
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The enum numeric value on the wire for lan.
-     */
-    int getLanValue();
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The lan.
-     */
-    pb.plagiarismDetectionServerImplBase.language getLan();
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest}
-   */
-  public static final class GenerateTestFilesForDuplicateCheckRequest extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest)
-      GenerateTestFilesForDuplicateCheckRequestOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use GenerateTestFilesForDuplicateCheckRequest.newBuilder() to construct.
-    private GenerateTestFilesForDuplicateCheckRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private GenerateTestFilesForDuplicateCheckRequest() {
-      codeContent_ = "";
-      lan_ = 0;
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new GenerateTestFilesForDuplicateCheckRequest();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private GenerateTestFilesForDuplicateCheckRequest(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 10: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              codeContent_ = s;
-              break;
-            }
-            case 16: {
-              int rawValue = input.readEnum();
-
-              lan_ = rawValue;
-              break;
-            }
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.class, pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.Builder.class);
-    }
-
-    public static final int CODE_CONTENT_FIELD_NUMBER = 1;
-    private volatile java.lang.Object codeContent_;
-    /**
-     * <code>string code_content = 1;</code>
-     * @return The codeContent.
-     */
-    @java.lang.Override
-    public java.lang.String getCodeContent() {
-      java.lang.Object ref = codeContent_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        codeContent_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string code_content = 1;</code>
-     * @return The bytes for codeContent.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getCodeContentBytes() {
-      java.lang.Object ref = codeContent_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        codeContent_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    public static final int LAN_FIELD_NUMBER = 2;
-    private int lan_;
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The enum numeric value on the wire for lan.
-     */
-    @java.lang.Override public int getLanValue() {
-      return lan_;
-    }
-    /**
-     * <code>.plagiarism_detection.language lan = 2;</code>
-     * @return The lan.
-     */
-    @java.lang.Override public pb.plagiarismDetectionServerImplBase.language getLan() {
-      @SuppressWarnings("deprecation")
-      pb.plagiarismDetectionServerImplBase.language result = pb.plagiarismDetectionServerImplBase.language.valueOf(lan_);
-      return result == null ? pb.plagiarismDetectionServerImplBase.language.UNRECOGNIZED : result;
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (!getCodeContentBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, codeContent_);
-      }
-      if (lan_ != pb.plagiarismDetectionServerImplBase.language.python3.getNumber()) {
-        output.writeEnum(2, lan_);
-      }
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      if (!getCodeContentBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, codeContent_);
-      }
-      if (lan_ != pb.plagiarismDetectionServerImplBase.language.python3.getNumber()) {
-        size += com.google.protobuf.CodedOutputStream
-          .computeEnumSize(2, lan_);
-      }
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest other = (pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest) obj;
-
-      if (!getCodeContent()
-          .equals(other.getCodeContent())) return false;
-      if (lan_ != other.lan_) return false;
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + CODE_CONTENT_FIELD_NUMBER;
-      hash = (53 * hash) + getCodeContent().hashCode();
-      hash = (37 * hash) + LAN_FIELD_NUMBER;
-      hash = (53 * hash) + lan_;
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest)
-        pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequestOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.class, pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        codeContent_ = "";
-
-        lan_ = 0;
-
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest build() {
-        pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest buildPartial() {
-        pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest result = new pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest(this);
-        result.codeContent_ = codeContent_;
-        result.lan_ = lan_;
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest other) {
-        if (other == pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest.getDefaultInstance()) return this;
-        if (!other.getCodeContent().isEmpty()) {
-          codeContent_ = other.codeContent_;
-          onChanged();
-        }
-        if (other.lan_ != 0) {
-          setLanValue(other.getLanValue());
-        }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-
-      private java.lang.Object codeContent_ = "";
-      /**
-       * <code>string code_content = 1;</code>
-       * @return The codeContent.
-       */
-      public java.lang.String getCodeContent() {
-        java.lang.Object ref = codeContent_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          codeContent_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string code_content = 1;</code>
-       * @return The bytes for codeContent.
-       */
-      public com.google.protobuf.ByteString
-          getCodeContentBytes() {
-        java.lang.Object ref = codeContent_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          codeContent_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string code_content = 1;</code>
-       * @param value The codeContent to set.
-       * @return This builder for chaining.
-       */
-      public Builder setCodeContent(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        codeContent_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string code_content = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearCodeContent() {
-        
-        codeContent_ = getDefaultInstance().getCodeContent();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string code_content = 1;</code>
-       * @param value The bytes for codeContent to set.
-       * @return This builder for chaining.
-       */
-      public Builder setCodeContentBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        codeContent_ = value;
-        onChanged();
-        return this;
-      }
-
-      private int lan_ = 0;
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @return The enum numeric value on the wire for lan.
-       */
-      @java.lang.Override public int getLanValue() {
-        return lan_;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @param value The enum numeric value on the wire for lan to set.
-       * @return This builder for chaining.
-       */
-      public Builder setLanValue(int value) {
-        
-        lan_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @return The lan.
-       */
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.language getLan() {
-        @SuppressWarnings("deprecation")
-        pb.plagiarismDetectionServerImplBase.language result = pb.plagiarismDetectionServerImplBase.language.valueOf(lan_);
-        return result == null ? pb.plagiarismDetectionServerImplBase.language.UNRECOGNIZED : result;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @param value The lan to set.
-       * @return This builder for chaining.
-       */
-      public Builder setLan(pb.plagiarismDetectionServerImplBase.language value) {
-        if (value == null) {
-          throw new NullPointerException();
-        }
-        
-        lan_ = value.getNumber();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>.plagiarism_detection.language lan = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearLan() {
-        
-        lan_ = 0;
-        onChanged();
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.GenerateTestFilesForDuplicateCheckRequest)
-    private static final pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<GenerateTestFilesForDuplicateCheckRequest>
-        PARSER = new com.google.protobuf.AbstractParser<GenerateTestFilesForDuplicateCheckRequest>() {
-      @java.lang.Override
-      public GenerateTestFilesForDuplicateCheckRequest parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new GenerateTestFilesForDuplicateCheckRequest(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<GenerateTestFilesForDuplicateCheckRequest> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<GenerateTestFilesForDuplicateCheckRequest> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.GenerateTestFilesForDuplicateCheckRequest getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  public interface GenerateTestHTMLFileForViewReportRequestOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.GenerateTestHTMLFileForViewReportRequest)
-      com.google.protobuf.MessageOrBuilder {
-
-    /**
-     * <code>string time_stamp = 1;</code>
-     * @return The timeStamp.
-     */
-    java.lang.String getTimeStamp();
-    /**
-     * <code>string time_stamp = 1;</code>
-     * @return The bytes for timeStamp.
-     */
-    com.google.protobuf.ByteString
-        getTimeStampBytes();
-
-    /**
-     * <code>string html_file_name = 2;</code>
-     * @return The htmlFileName.
-     */
-    java.lang.String getHtmlFileName();
-    /**
-     * <code>string html_file_name = 2;</code>
-     * @return The bytes for htmlFileName.
-     */
-    com.google.protobuf.ByteString
-        getHtmlFileNameBytes();
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.GenerateTestHTMLFileForViewReportRequest}
-   */
-  public static final class GenerateTestHTMLFileForViewReportRequest extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.GenerateTestHTMLFileForViewReportRequest)
-      GenerateTestHTMLFileForViewReportRequestOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use GenerateTestHTMLFileForViewReportRequest.newBuilder() to construct.
-    private GenerateTestHTMLFileForViewReportRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private GenerateTestHTMLFileForViewReportRequest() {
-      timeStamp_ = "";
-      htmlFileName_ = "";
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new GenerateTestHTMLFileForViewReportRequest();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private GenerateTestHTMLFileForViewReportRequest(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            case 10: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              timeStamp_ = s;
-              break;
-            }
-            case 18: {
-              java.lang.String s = input.readStringRequireUtf8();
-
-              htmlFileName_ = s;
-              break;
-            }
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.class, pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.Builder.class);
-    }
-
-    public static final int TIME_STAMP_FIELD_NUMBER = 1;
-    private volatile java.lang.Object timeStamp_;
-    /**
-     * <code>string time_stamp = 1;</code>
-     * @return The timeStamp.
-     */
-    @java.lang.Override
-    public java.lang.String getTimeStamp() {
-      java.lang.Object ref = timeStamp_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        timeStamp_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string time_stamp = 1;</code>
-     * @return The bytes for timeStamp.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getTimeStampBytes() {
-      java.lang.Object ref = timeStamp_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        timeStamp_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    public static final int HTML_FILE_NAME_FIELD_NUMBER = 2;
-    private volatile java.lang.Object htmlFileName_;
-    /**
-     * <code>string html_file_name = 2;</code>
-     * @return The htmlFileName.
-     */
-    @java.lang.Override
-    public java.lang.String getHtmlFileName() {
-      java.lang.Object ref = htmlFileName_;
-      if (ref instanceof java.lang.String) {
-        return (java.lang.String) ref;
-      } else {
-        com.google.protobuf.ByteString bs = 
-            (com.google.protobuf.ByteString) ref;
-        java.lang.String s = bs.toStringUtf8();
-        htmlFileName_ = s;
-        return s;
-      }
-    }
-    /**
-     * <code>string html_file_name = 2;</code>
-     * @return The bytes for htmlFileName.
-     */
-    @java.lang.Override
-    public com.google.protobuf.ByteString
-        getHtmlFileNameBytes() {
-      java.lang.Object ref = htmlFileName_;
-      if (ref instanceof java.lang.String) {
-        com.google.protobuf.ByteString b = 
-            com.google.protobuf.ByteString.copyFromUtf8(
-                (java.lang.String) ref);
-        htmlFileName_ = b;
-        return b;
-      } else {
-        return (com.google.protobuf.ByteString) ref;
-      }
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      if (!getTimeStampBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, timeStamp_);
-      }
-      if (!getHtmlFileNameBytes().isEmpty()) {
-        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, htmlFileName_);
-      }
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      if (!getTimeStampBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, timeStamp_);
-      }
-      if (!getHtmlFileNameBytes().isEmpty()) {
-        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, htmlFileName_);
-      }
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest other = (pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest) obj;
-
-      if (!getTimeStamp()
-          .equals(other.getTimeStamp())) return false;
-      if (!getHtmlFileName()
-          .equals(other.getHtmlFileName())) return false;
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + TIME_STAMP_FIELD_NUMBER;
-      hash = (53 * hash) + getTimeStamp().hashCode();
-      hash = (37 * hash) + HTML_FILE_NAME_FIELD_NUMBER;
-      hash = (53 * hash) + getHtmlFileName().hashCode();
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.GenerateTestHTMLFileForViewReportRequest}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.GenerateTestHTMLFileForViewReportRequest)
-        pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequestOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.class, pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        timeStamp_ = "";
-
-        htmlFileName_ = "";
-
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest build() {
-        pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest buildPartial() {
-        pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest result = new pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest(this);
-        result.timeStamp_ = timeStamp_;
-        result.htmlFileName_ = htmlFileName_;
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest other) {
-        if (other == pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest.getDefaultInstance()) return this;
-        if (!other.getTimeStamp().isEmpty()) {
-          timeStamp_ = other.timeStamp_;
-          onChanged();
-        }
-        if (!other.getHtmlFileName().isEmpty()) {
-          htmlFileName_ = other.htmlFileName_;
-          onChanged();
-        }
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-
-      private java.lang.Object timeStamp_ = "";
-      /**
-       * <code>string time_stamp = 1;</code>
-       * @return The timeStamp.
-       */
-      public java.lang.String getTimeStamp() {
-        java.lang.Object ref = timeStamp_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          timeStamp_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string time_stamp = 1;</code>
-       * @return The bytes for timeStamp.
-       */
-      public com.google.protobuf.ByteString
-          getTimeStampBytes() {
-        java.lang.Object ref = timeStamp_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          timeStamp_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string time_stamp = 1;</code>
-       * @param value The timeStamp to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTimeStamp(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        timeStamp_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string time_stamp = 1;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearTimeStamp() {
-        
-        timeStamp_ = getDefaultInstance().getTimeStamp();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string time_stamp = 1;</code>
-       * @param value The bytes for timeStamp to set.
-       * @return This builder for chaining.
-       */
-      public Builder setTimeStampBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        timeStamp_ = value;
-        onChanged();
-        return this;
-      }
-
-      private java.lang.Object htmlFileName_ = "";
-      /**
-       * <code>string html_file_name = 2;</code>
-       * @return The htmlFileName.
-       */
-      public java.lang.String getHtmlFileName() {
-        java.lang.Object ref = htmlFileName_;
-        if (!(ref instanceof java.lang.String)) {
-          com.google.protobuf.ByteString bs =
-              (com.google.protobuf.ByteString) ref;
-          java.lang.String s = bs.toStringUtf8();
-          htmlFileName_ = s;
-          return s;
-        } else {
-          return (java.lang.String) ref;
-        }
-      }
-      /**
-       * <code>string html_file_name = 2;</code>
-       * @return The bytes for htmlFileName.
-       */
-      public com.google.protobuf.ByteString
-          getHtmlFileNameBytes() {
-        java.lang.Object ref = htmlFileName_;
-        if (ref instanceof String) {
-          com.google.protobuf.ByteString b = 
-              com.google.protobuf.ByteString.copyFromUtf8(
-                  (java.lang.String) ref);
-          htmlFileName_ = b;
-          return b;
-        } else {
-          return (com.google.protobuf.ByteString) ref;
-        }
-      }
-      /**
-       * <code>string html_file_name = 2;</code>
-       * @param value The htmlFileName to set.
-       * @return This builder for chaining.
-       */
-      public Builder setHtmlFileName(
-          java.lang.String value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  
-        htmlFileName_ = value;
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string html_file_name = 2;</code>
-       * @return This builder for chaining.
-       */
-      public Builder clearHtmlFileName() {
-        
-        htmlFileName_ = getDefaultInstance().getHtmlFileName();
-        onChanged();
-        return this;
-      }
-      /**
-       * <code>string html_file_name = 2;</code>
-       * @param value The bytes for htmlFileName to set.
-       * @return This builder for chaining.
-       */
-      public Builder setHtmlFileNameBytes(
-          com.google.protobuf.ByteString value) {
-        if (value == null) {
-    throw new NullPointerException();
-  }
-  checkByteStringIsUtf8(value);
-        
-        htmlFileName_ = value;
-        onChanged();
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.GenerateTestHTMLFileForViewReportRequest)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.GenerateTestHTMLFileForViewReportRequest)
-    private static final pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<GenerateTestHTMLFileForViewReportRequest>
-        PARSER = new com.google.protobuf.AbstractParser<GenerateTestHTMLFileForViewReportRequest>() {
-      @java.lang.Override
-      public GenerateTestHTMLFileForViewReportRequest parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new GenerateTestHTMLFileForViewReportRequest(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<GenerateTestHTMLFileForViewReportRequest> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<GenerateTestHTMLFileForViewReportRequest> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.GenerateTestHTMLFileForViewReportRequest getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  public interface EmptyOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:plagiarism_detection.Empty)
-      com.google.protobuf.MessageOrBuilder {
-  }
-  /**
-   * Protobuf type {@code plagiarism_detection.Empty}
-   */
-  public static final class Empty extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:plagiarism_detection.Empty)
-      EmptyOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use Empty.newBuilder() to construct.
-    private Empty(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private Empty() {
-    }
-
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new Empty();
-    }
-
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private Empty(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
-      try {
-        boolean done = false;
-        while (!done) {
-          int tag = input.readTag();
-          switch (tag) {
-            case 0:
-              done = true;
-              break;
-            default: {
-              if (!parseUnknownField(
-                  input, unknownFields, extensionRegistry, tag)) {
-                done = true;
-              }
-              break;
-            }
-          }
-        }
-      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-        throw e.setUnfinishedMessage(this);
-      } catch (java.io.IOException e) {
-        throw new com.google.protobuf.InvalidProtocolBufferException(
-            e).setUnfinishedMessage(this);
-      } finally {
-        this.unknownFields = unknownFields.build();
-        makeExtensionsImmutable();
-      }
-    }
-    public static final com.google.protobuf.Descriptors.Descriptor
-        getDescriptor() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_Empty_descriptor;
-    }
-
-    @java.lang.Override
-    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-        internalGetFieldAccessorTable() {
-      return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_Empty_fieldAccessorTable
-          .ensureFieldAccessorsInitialized(
-              pb.plagiarismDetectionServerImplBase.Empty.class, pb.plagiarismDetectionServerImplBase.Empty.Builder.class);
-    }
-
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
-      memoizedIsInitialized = 1;
-      return true;
-    }
-
-    @java.lang.Override
-    public void writeTo(com.google.protobuf.CodedOutputStream output)
-                        throws java.io.IOException {
-      unknownFields.writeTo(output);
-    }
-
-    @java.lang.Override
-    public int getSerializedSize() {
-      int size = memoizedSize;
-      if (size != -1) return size;
-
-      size = 0;
-      size += unknownFields.getSerializedSize();
-      memoizedSize = size;
-      return size;
-    }
-
-    @java.lang.Override
-    public boolean equals(final java.lang.Object obj) {
-      if (obj == this) {
-       return true;
-      }
-      if (!(obj instanceof pb.plagiarismDetectionServerImplBase.Empty)) {
-        return super.equals(obj);
-      }
-      pb.plagiarismDetectionServerImplBase.Empty other = (pb.plagiarismDetectionServerImplBase.Empty) obj;
-
-      if (!unknownFields.equals(other.unknownFields)) return false;
-      return true;
-    }
-
-    @java.lang.Override
-    public int hashCode() {
-      if (memoizedHashCode != 0) {
-        return memoizedHashCode;
-      }
-      int hash = 41;
-      hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (29 * hash) + unknownFields.hashCode();
-      memoizedHashCode = hash;
-      return hash;
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        java.nio.ByteBuffer data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        java.nio.ByteBuffer data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        com.google.protobuf.ByteString data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        com.google.protobuf.ByteString data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(byte[] data)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        byte[] data,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      return PARSER.parseFrom(data, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseDelimitedFrom(java.io.InputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseDelimitedFrom(
-        java.io.InputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        com.google.protobuf.CodedInputStream input)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input);
-    }
-    public static pb.plagiarismDetectionServerImplBase.Empty parseFrom(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws java.io.IOException {
-      return com.google.protobuf.GeneratedMessageV3
-          .parseWithIOException(PARSER, input, extensionRegistry);
-    }
-
-    @java.lang.Override
-    public Builder newBuilderForType() { return newBuilder(); }
-    public static Builder newBuilder() {
-      return DEFAULT_INSTANCE.toBuilder();
-    }
-    public static Builder newBuilder(pb.plagiarismDetectionServerImplBase.Empty prototype) {
-      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
-    }
-    @java.lang.Override
-    public Builder toBuilder() {
-      return this == DEFAULT_INSTANCE
-          ? new Builder() : new Builder().mergeFrom(this);
-    }
-
-    @java.lang.Override
-    protected Builder newBuilderForType(
-        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-      Builder builder = new Builder(parent);
-      return builder;
-    }
-    /**
-     * Protobuf type {@code plagiarism_detection.Empty}
-     */
-    public static final class Builder extends
-        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:plagiarism_detection.Empty)
-        pb.plagiarismDetectionServerImplBase.EmptyOrBuilder {
-      public static final com.google.protobuf.Descriptors.Descriptor
-          getDescriptor() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_Empty_descriptor;
-      }
-
-      @java.lang.Override
-      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-          internalGetFieldAccessorTable() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_Empty_fieldAccessorTable
-            .ensureFieldAccessorsInitialized(
-                pb.plagiarismDetectionServerImplBase.Empty.class, pb.plagiarismDetectionServerImplBase.Empty.Builder.class);
-      }
-
-      // Construct using pb.plagiarismDetectionServerImplBase.Empty.newBuilder()
-      private Builder() {
-        maybeForceBuilderInitialization();
-      }
-
-      private Builder(
-          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
-        super(parent);
-        maybeForceBuilderInitialization();
-      }
-      private void maybeForceBuilderInitialization() {
-        if (com.google.protobuf.GeneratedMessageV3
-                .alwaysUseFieldBuilders) {
-        }
-      }
-      @java.lang.Override
-      public Builder clear() {
-        super.clear();
-        return this;
-      }
-
-      @java.lang.Override
-      public com.google.protobuf.Descriptors.Descriptor
-          getDescriptorForType() {
-        return pb.plagiarismDetectionServerImplBase.internal_static_plagiarism_detection_Empty_descriptor;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.Empty getDefaultInstanceForType() {
-        return pb.plagiarismDetectionServerImplBase.Empty.getDefaultInstance();
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.Empty build() {
-        pb.plagiarismDetectionServerImplBase.Empty result = buildPartial();
-        if (!result.isInitialized()) {
-          throw newUninitializedMessageException(result);
-        }
-        return result;
-      }
-
-      @java.lang.Override
-      public pb.plagiarismDetectionServerImplBase.Empty buildPartial() {
-        pb.plagiarismDetectionServerImplBase.Empty result = new pb.plagiarismDetectionServerImplBase.Empty(this);
-        onBuilt();
-        return result;
-      }
-
-      @java.lang.Override
-      public Builder clone() {
-        return super.clone();
-      }
-      @java.lang.Override
-      public Builder setField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.setField(field, value);
-      }
-      @java.lang.Override
-      public Builder clearField(
-          com.google.protobuf.Descriptors.FieldDescriptor field) {
-        return super.clearField(field);
-      }
-      @java.lang.Override
-      public Builder clearOneof(
-          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
-        return super.clearOneof(oneof);
-      }
-      @java.lang.Override
-      public Builder setRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          int index, java.lang.Object value) {
-        return super.setRepeatedField(field, index, value);
-      }
-      @java.lang.Override
-      public Builder addRepeatedField(
-          com.google.protobuf.Descriptors.FieldDescriptor field,
-          java.lang.Object value) {
-        return super.addRepeatedField(field, value);
-      }
-      @java.lang.Override
-      public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof pb.plagiarismDetectionServerImplBase.Empty) {
-          return mergeFrom((pb.plagiarismDetectionServerImplBase.Empty)other);
-        } else {
-          super.mergeFrom(other);
-          return this;
-        }
-      }
-
-      public Builder mergeFrom(pb.plagiarismDetectionServerImplBase.Empty other) {
-        if (other == pb.plagiarismDetectionServerImplBase.Empty.getDefaultInstance()) return this;
-        this.mergeUnknownFields(other.unknownFields);
-        onChanged();
-        return this;
-      }
-
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
-      }
-
-      @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        pb.plagiarismDetectionServerImplBase.Empty parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (pb.plagiarismDetectionServerImplBase.Empty) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
-        }
-        return this;
-      }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
-
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
-      }
-
-
-      // @@protoc_insertion_point(builder_scope:plagiarism_detection.Empty)
-    }
-
-    // @@protoc_insertion_point(class_scope:plagiarism_detection.Empty)
-    private static final pb.plagiarismDetectionServerImplBase.Empty DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new pb.plagiarismDetectionServerImplBase.Empty();
-    }
-
-    public static pb.plagiarismDetectionServerImplBase.Empty getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
-
-    private static final com.google.protobuf.Parser<Empty>
-        PARSER = new com.google.protobuf.AbstractParser<Empty>() {
-      @java.lang.Override
-      public Empty parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new Empty(input, extensionRegistry);
-      }
-    };
-
-    public static com.google.protobuf.Parser<Empty> parser() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public com.google.protobuf.Parser<Empty> getParserForType() {
-      return PARSER;
-    }
-
-    @java.lang.Override
-    public pb.plagiarismDetectionServerImplBase.Empty getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
-
-  }
-
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_DuplicateCheckRequest_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_DuplicateCheckRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_DuplicateCheckResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_ViewReportRequest_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_ViewReportRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_ViewReportResponse_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_ViewReportResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_plagiarism_detection_Empty_descriptor;
-  private static final 
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_plagiarism_detection_Empty_fieldAccessorTable;
-
-  public static com.google.protobuf.Descriptors.FileDescriptor
-      getDescriptor() {
-    return descriptor;
-  }
-  private static  com.google.protobuf.Descriptors.FileDescriptor
-      descriptor;
-  static {
-    java.lang.String[] descriptorData = {
-      "\n\032plagiarism_detection.proto\022\024plagiarism" +
-      "_detection\"S\n\025DuplicateCheckRequest\022\r\n\005l" +
-      "abID\030\001 \001(\004\022+\n\003lan\030\002 \001(\0162\036.plagiarism_det" +
-      "ection.language\"\372\002\n\026DuplicateCheckRespon" +
-      "se\022]\n\013comparision\030\001 \001(\0132H.plagiarism_det" +
-      "ection.DuplicateCheckResponse.DuplicateC" +
-      "heckResponseValue\022\022\n\ntime_stamp\030\002 \001(\t\032\354\001" +
-      "\n\033DuplicateCheckResponseValue\022i\n\014compari" +
-      "sions\030\001 \003(\0132S.plagiarism_detection.Dupli" +
-      "cateCheckResponse.DuplicateCheckResponse" +
-      "Value.Comparsion\032b\n\nComparsion\022\017\n\007user_i" +
-      "d\030\001 \001(\004\022\027\n\017another_user_id\030\002 \001(\004\022\026\n\016html" +
-      "_file_name\030\003 \001(\t\022\022\n\nsimilarity\030\004 \001(\005\"O\n\021" +
-      "ViewReportRequest\022\016\n\006lab_id\030\001 \001(\004\022\022\n\ntim" +
-      "e_stamp\030\002 \001(\t\022\026\n\016html_file_name\030\003 \001(\t\"/\n" +
-      "\022ViewReportResponse\022\031\n\021html_file_content" +
-      "\030\001 \001(\t\"n\n)GenerateTestFilesForDuplicateC" +
-      "heckRequest\022\024\n\014code_content\030\001 \001(\t\022+\n\003lan" +
-      "\030\002 \001(\0162\036.plagiarism_detection.language\"V" +
-      "\n(GenerateTestHTMLFileForViewReportReque" +
-      "st\022\022\n\ntime_stamp\030\001 \001(\t\022\026\n\016html_file_name" +
-      "\030\002 \001(\t\"\007\n\005Empty**\n\010language\022\013\n\007python3\020\000" +
-      "\022\007\n\003cpp\020\001\022\010\n\004java\020\0022\246\005\n\023plagiarismDetect" +
-      "ion\022k\n\016DuplicateCheck\022+.plagiarism_detec" +
-      "tion.DuplicateCheckRequest\032,.plagiarism_" +
-      "detection.DuplicateCheckResponse\022_\n\nView" +
-      "Report\022\'.plagiarism_detection.ViewReport" +
-      "Request\032(.plagiarism_detection.ViewRepor" +
-      "tResponse\022\202\001\n\"GenerateTestFilesForDuplic" +
-      "ateCheck\022?.plagiarism_detection.Generate" +
-      "TestFilesForDuplicateCheckRequest\032\033.plag" +
-      "iarism_detection.Empty\022\\\n RemoveTestFile" +
-      "sForDuplicateCheck\022\033.plagiarism_detectio" +
-      "n.Empty\032\033.plagiarism_detection.Empty\022\200\001\n" +
-      "!GenerateTestHTMLFileForViewReport\022>.pla" +
-      "giarism_detection.GenerateTestHTMLFileFo" +
-      "rViewReportRequest\032\033.plagiarism_detectio" +
-      "n.Empty\022[\n\037RemoveTestHTMLFileForViewRepo" +
-      "rt\022\033.plagiarism_detection.Empty\032\033.plagia" +
-      "rism_detection.EmptyB.\n\002pbB!plagiarismDe" +
-      "tectionServerImplBaseP\000Z\003/pbb\006proto3"
-    };
-    descriptor = com.google.protobuf.Descriptors.FileDescriptor
-      .internalBuildGeneratedFileFrom(descriptorData,
-        new com.google.protobuf.Descriptors.FileDescriptor[] {
-        });
-    internal_static_plagiarism_detection_DuplicateCheckRequest_descriptor =
-      getDescriptor().getMessageTypes().get(0);
-    internal_static_plagiarism_detection_DuplicateCheckRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_DuplicateCheckRequest_descriptor,
-        new java.lang.String[] { "LabID", "Lan", });
-    internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor =
-      getDescriptor().getMessageTypes().get(1);
-    internal_static_plagiarism_detection_DuplicateCheckResponse_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor,
-        new java.lang.String[] { "Comparision", "TimeStamp", });
-    internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor =
-      internal_static_plagiarism_detection_DuplicateCheckResponse_descriptor.getNestedTypes().get(0);
-    internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor,
-        new java.lang.String[] { "Comparisions", });
-    internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_descriptor =
-      internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_descriptor.getNestedTypes().get(0);
-    internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion_descriptor,
-        new java.lang.String[] { "UserId", "AnotherUserId", "HtmlFileName", "Similarity", });
-    internal_static_plagiarism_detection_ViewReportRequest_descriptor =
-      getDescriptor().getMessageTypes().get(2);
-    internal_static_plagiarism_detection_ViewReportRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_ViewReportRequest_descriptor,
-        new java.lang.String[] { "LabId", "TimeStamp", "HtmlFileName", });
-    internal_static_plagiarism_detection_ViewReportResponse_descriptor =
-      getDescriptor().getMessageTypes().get(3);
-    internal_static_plagiarism_detection_ViewReportResponse_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_ViewReportResponse_descriptor,
-        new java.lang.String[] { "HtmlFileContent", });
-    internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_descriptor =
-      getDescriptor().getMessageTypes().get(4);
-    internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_GenerateTestFilesForDuplicateCheckRequest_descriptor,
-        new java.lang.String[] { "CodeContent", "Lan", });
-    internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_descriptor =
-      getDescriptor().getMessageTypes().get(5);
-    internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_GenerateTestHTMLFileForViewReportRequest_descriptor,
-        new java.lang.String[] { "TimeStamp", "HtmlFileName", });
-    internal_static_plagiarism_detection_Empty_descriptor =
-      getDescriptor().getMessageTypes().get(6);
-    internal_static_plagiarism_detection_Empty_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_plagiarism_detection_Empty_descriptor,
-        new java.lang.String[] { });
-  }
-
-  // @@protoc_insertion_point(outer_class_scope)
-}
@@ -36,6 +36,10 @@ func MakeAdminHandler(router gin.IRouter, s *xhttp.UnionService) {
 	{
 		routerCourse.GET("", md.Tracer("admin.course.makeListAllCourses"), md.CheckPage, makeListAllCourses)
 		routerCourse.POST("/amend", md.Tracer("admin.course.makeAmendCourse"), makeAmendCourse)
+		routerCourse.GET("/image", md.Tracer("admin.course.makeListCourseImages"), md.CheckPage, makeListCourseImages)
+		routerCourse.GET("/image/detail", md.Tracer("admin.course.makeGetCourseImage"), md.CheckQueryID("imageId"), makeGetCourseImage("imageId"))
+		routerCourse.POST("/image/approve", md.Tracer("admin.course.makeApproveCourseImage"), makeApproveCourseImage)
+		routerCourse.POST("/image/reject", md.Tracer("admin.course.makeRejectCourseImage"), makeRejectCourseImage)
 	}
 
 	routerIDE := router.Group("/ide")
@@ -43,4 +47,11 @@ func MakeAdminHandler(router gin.IRouter, s *xhttp.UnionService) {
 		routerIDE.GET("", md.Tracer("admin.ide.makeListContainers"), md.CheckPage, makeListContainers)
 		routerIDE.POST("/quit", md.Tracer("admin.ide.makeStopContainer"), makeStopContainer)
 	}
+
+	routerJob := router.Group("/job")
+	{
+		routerJob.GET("/run", md.Tracer("admin.job.makeListJobRuns"), md.CheckPage, makeListJobRuns)
+		routerJob.GET("/failure", md.Tracer("admin.job.makeListFailedJobRuns"), md.CheckPage, makeListFailedJobRuns)
+		routerJob.POST("/retry", md.Tracer("admin.job.makeRetryJobRun"), makeRetryJobRun)
+	}
 }
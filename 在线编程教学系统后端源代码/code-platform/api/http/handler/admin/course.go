@@ -3,6 +3,8 @@ package admin
 import (
 	"database/sql"
 	"net/http"
+	"strings"
+	"unicode/utf8"
 
 	"code-platform/api/http/md"
 	"code-platform/pkg/httpx"
@@ -23,12 +25,14 @@ func makeListAllCourses(c *gin.Context) {
 	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
 }
 
+// makeAmendCourse family_code 为课程编号，同一课程的不同教学班填写相同的编号，查重时可以互相作为参考实验
 func makeAmendCourse(c *gin.Context) {
 	type updateCourseRequest struct {
 		Name        string `json:"name"`
 		Description string `json:"description"`
 		PicURL      string `json:"pic_url"`
 		SecretKey   string `json:"secret_key"`
+		FamilyCode  string `json:"family_code"`
 		CourseID    uint64 `json:"course_id"`
 		IsClosed    bool   `json:"is_closed"`
 		NeedAudit   bool   `json:"need_audit"`
@@ -45,8 +49,14 @@ func makeAmendCourse(c *gin.Context) {
 		return
 	}
 
+	req.FamilyCode = strings.TrimSpace(req.FamilyCode)
+	if utf8.RuneCountInString(req.FamilyCode) > 50 {
+		httpx.AbortInvalidLength(c, "family_code is too long")
+		return
+	}
+
 	ctx := c.Request.Context()
-	if err := srv.CourseService.UpdateCourseByAdmin(ctx, req.CourseID, req.Name, req.Description, req.PicURL, sql.NullString{Valid: req.SecretKey != "", String: req.SecretKey}, req.IsClosed, req.NeedAudit); err != nil {
+	if err := srv.CourseService.UpdateCourseByAdmin(ctx, req.CourseID, req.Name, req.Description, req.PicURL, sql.NullString{Valid: req.SecretKey != "", String: req.SecretKey}, req.FamilyCode, req.IsClosed, req.NeedAudit); err != nil {
 		httpx.AbortInternalErr(c)
 		return
 	}
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+	"code-platform/repository/rdb/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// makeListCourseImages 按状态列出课程镜像，默认为待审核
+func makeListCourseImages(c *gin.Context) {
+	type listCourseImagesRequest struct {
+		Status int8 `form:"status"`
+	}
+
+	var req listCourseImagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get request")
+		return
+	}
+	if req.Status < model.CourseImageStatusPending || req.Status > model.CourseImageStatusRejected {
+		httpx.AbortBadParamsErr(c, "status is invalid")
+		return
+	}
+
+	pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+	ctx := c.Request.Context()
+	resp, err := srv.IDEService.ListCourseImagesByStatus(ctx, req.Status, (pageCurrent-1)*pageSize, pageSize)
+	if err != nil {
+		httpx.AbortInternalErr(c)
+		return
+	}
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeGetCourseImage(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		imageID := c.GetUint64(tag)
+
+		ctx := c.Request.Context()
+		resp, err := srv.IDEService.GetCourseImage(ctx, 0, imageID)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortNotFound(c, "course image is not found by imageId")
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+// makeApproveCourseImage 审核通过并开始构建，构建失败的镜像可再次通过以重新构建
+func makeApproveCourseImage(c *gin.Context) {
+	type approveCourseImageRequest struct {
+		ImageID uint64 `json:"imageId"`
+	}
+
+	var req approveCourseImageRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "fail to get params for approve course image request")
+		return
+	}
+	if req.ImageID == 0 {
+		httpx.AbortBadParamsErr(c, "imageId is invalid")
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := srv.IDEService.ApproveCourseImage(ctx, req.ImageID, c.GetUint64(md.KeyUserID))
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "course image is not found by imageId")
+		return
+	case errorx.ErrImageNotReviewable:
+		httpx.AbortBadParamsErr(c, "course image is building or has been reviewed")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeRejectCourseImage(c *gin.Context) {
+	type rejectCourseImageRequest struct {
+		Comment string `json:"comment"`
+		ImageID uint64 `json:"imageId"`
+	}
+
+	var req rejectCourseImageRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "fail to get params for reject course image request")
+		return
+	}
+	if req.ImageID == 0 {
+		httpx.AbortBadParamsErr(c, "imageId is invalid")
+		return
+	}
+	req.Comment = strings.TrimSpace(req.Comment)
+	if utf8.RuneCountInString(req.Comment) > 500 {
+		httpx.AbortInvalidLength(c, "comment is too long")
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := srv.IDEService.RejectCourseImage(ctx, req.ImageID, c.GetUint64(md.KeyUserID), req.Comment)
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "course image is not found by imageId")
+		return
+	case errorx.ErrImageNotReviewable:
+		httpx.AbortBadParamsErr(c, "course image has been reviewed")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
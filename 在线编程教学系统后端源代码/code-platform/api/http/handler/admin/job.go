@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"net/http"
+
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+	"code-platform/repository/rdb/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// makeListJobRuns 按任务名与状态列出执行记录，未指定时不限，最近提交的在前
+func makeListJobRuns(c *gin.Context) {
+	type listJobRunsRequest struct {
+		Status *int8  `form:"status"`
+		Name   string `form:"name"`
+	}
+
+	var req listJobRunsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get request")
+		return
+	}
+	status := int8(-1)
+	if req.Status != nil {
+		if *req.Status < model.JobRunStatusPending || *req.Status > model.JobRunStatusFailed {
+			httpx.AbortBadParamsErr(c, "status is invalid")
+			return
+		}
+		status = *req.Status
+	}
+
+	listJobRuns(c, req.Name, status)
+}
+
+// makeListFailedJobRuns 列出已用完重试次数的执行记录
+func makeListFailedJobRuns(c *gin.Context) {
+	listJobRuns(c, c.Query("name"), model.JobRunStatusFailed)
+}
+
+func listJobRuns(c *gin.Context, name string, status int8) {
+	pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+	ctx := c.Request.Context()
+	resp, err := srv.SchedulerService.ListJobRuns(ctx, name, status, (pageCurrent-1)*pageSize, pageSize)
+	if err != nil {
+		httpx.AbortInternalErr(c)
+		return
+	}
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+// makeRetryJobRun 立即重新执行失败的延时任务
+func makeRetryJobRun(c *gin.Context) {
+	type retryJobRunRequest struct {
+		RunID uint64 `json:"runId"`
+	}
+
+	var req retryJobRunRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "fail to get params for retry job run request")
+		return
+	}
+	if req.RunID == 0 {
+		httpx.AbortBadParamsErr(c, "runId is invalid")
+		return
+	}
+
+	ctx := c.Request.Context()
+	err := srv.SchedulerService.RetryJobRun(ctx, req.RunID)
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "job run is not found by runId")
+		return
+	case errorx.ErrJobRunNotRetryable:
+		httpx.AbortBadParamsErr(c, "only failed delayed job runs can be retried")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -9,6 +9,7 @@ import (
 	"code-platform/pkg/errorx"
 	"code-platform/pkg/httpx"
 	"code-platform/pkg/jsonx"
+	"code-platform/service/ide/define"
 
 	"github.com/gin-gonic/gin"
 )
@@ -54,6 +55,7 @@ func makeAddCourse(c *gin.Context) {
 		PicURL            string `json:"picurl"`
 		SecretKey         string `json:"secretkey"`
 		Language          int8   `json:"language"`
+		IDEKind           int8   `json:"ide_kind"`
 		NeedAudit         bool   `json:"need_audit"`
 	}
 	var req addCourseRequest
@@ -62,12 +64,17 @@ func makeAddCourse(c *gin.Context) {
 		return
 	}
 
+	if !isValidIDEKind(req.IDEKind, req.Language) {
+		httpx.AbortBadParamsErr(c, "ide_kind is invalid")
+		return
+	}
+
 	userID := c.GetUint64(md.KeyUserID)
 	// 去除密钥前后空格
 	req.SecretKey = strings.TrimSpace(req.SecretKey)
 
 	ctx := c.Request.Context()
-	if err := srv.CourseService.AddCourse(ctx, userID, req.CourseName, req.CourseDescription, false, req.PicURL, req.SecretKey, req.Language, req.NeedAudit); err != nil {
+	if err := srv.CourseService.AddCourse(ctx, userID, req.CourseName, req.CourseDescription, false, req.PicURL, req.SecretKey, req.Language, req.IDEKind, req.NeedAudit); err != nil {
 		httpx.AbortInternalErr(c)
 		return
 	}
@@ -83,6 +90,7 @@ func makeUpdateCourse(c *gin.Context) {
 		PicURL            string `json:"picUrl"`
 		CourseID          uint64 `json:"courseId"`
 		Language          int8   `json:"language"`
+		IDEKind           int8   `json:"ide_kind"`
 		NeedAudit         bool   `json:"need_audit"`
 	}
 
@@ -103,6 +111,11 @@ func makeUpdateCourse(c *gin.Context) {
 		return
 	}
 
+	if !isValidIDEKind(req.IDEKind, req.Language) {
+		httpx.AbortBadParamsErr(c, "ide_kind is invalid")
+		return
+	}
+
 	req.SecretKey = strings.TrimSpace(req.SecretKey)
 
 	teacherID := c.GetUint64(md.KeyUserID)
@@ -111,7 +124,7 @@ func makeUpdateCourse(c *gin.Context) {
 		return
 	}
 
-	err := srv.CourseService.UpdateCourse(ctx, req.CourseID, req.CourseName, req.CourseDescription, req.SecretKey, req.PicURL, req.Language, req.NeedAudit)
+	err := srv.CourseService.UpdateCourse(ctx, req.CourseID, req.CourseName, req.CourseDescription, req.SecretKey, req.PicURL, req.Language, req.IDEKind, req.NeedAudit)
 	switch err {
 	case nil:
 	case errorx.ErrIsNotFound:
@@ -423,3 +436,15 @@ func makeListCourseScores(tag string) gin.HandlerFunc {
 		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
 	}
 }
+
+// isValidIDEKind Jupyter 仅支持 Python 课程（language 为 0）
+func isValidIDEKind(kind, language int8) bool {
+	switch kind {
+	case define.IDEKindTheia, define.IDEKindTerminal:
+		return true
+	case define.IDEKindJupyter:
+		return language == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,129 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+	"code-platform/service/ide/define"
+
+	"github.com/gin-gonic/gin"
+)
+
+// makeSubmitCourseImage 教师为课程提交自定义镜像，审核通过后构建，构建完成后课程的 IDE 与测试使用该镜像
+func makeSubmitCourseImage(c *gin.Context) {
+	type submitCourseImageRequest struct {
+		Base        string   `json:"base"`
+		Dockerfile  string   `json:"dockerfile"`
+		AptPackages []string `json:"aptPackages"`
+		PipPackages []string `json:"pipPackages"`
+		CourseID    uint64   `json:"courseId"`
+	}
+
+	var req submitCourseImageRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get data from submit course image request")
+		return
+	}
+
+	if req.CourseID == 0 {
+		httpx.AbortBadParamsErr(c, "courseId is invalid")
+		return
+	}
+	if req.Base != define.ImageBaseTheia && req.Base != define.ImageBaseMonaco {
+		httpx.AbortBadParamsErr(c, "base is invalid")
+		return
+	}
+
+	req.AptPackages = define.NormalizeImagePackages(req.AptPackages)
+	req.PipPackages = define.NormalizeImagePackages(req.PipPackages)
+	if len(req.AptPackages)+len(req.PipPackages) > define.MaxImagePackages {
+		httpx.AbortBadParamsErr(c, "at most %d packages are allowed", define.MaxImagePackages)
+		return
+	}
+	for _, packages := range [][]string{req.AptPackages, req.PipPackages} {
+		for _, p := range packages {
+			if !define.IsValidImagePackage(p) {
+				httpx.AbortBadParamsErr(c, "package %q is invalid", p)
+				return
+			}
+		}
+	}
+	if err := define.CheckDockerfileFragment(req.Dockerfile); err != nil {
+		httpx.AbortBadParamsErr(c, "dockerfile is invalid: %v", err)
+		return
+	}
+	if len(req.AptPackages)+len(req.PipPackages) == 0 && strings.TrimSpace(req.Dockerfile) == "" {
+		httpx.AbortBadParamsErr(c, "packages and dockerfile are empty")
+		return
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthCourseForTeacher(ctx, c, srv, req.CourseID, teacherID) {
+		return
+	}
+
+	resp, err := srv.IDEService.SubmitCourseImage(ctx, req.CourseID, teacherID, req.Base, req.AptPackages, req.PipPackages, req.Dockerfile)
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortBadParamsErr(c, "course is not found by courseId")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeListCourseImages(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+
+		ctx := c.Request.Context()
+		if !md.AuthCourseForTeacher(ctx, c, srv, courseID, teacherID) {
+			return
+		}
+
+		resp, err := srv.IDEService.ListCourseImages(ctx, courseID, (pageCurrent-1)*pageSize, pageSize)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+// makeGetCourseImage 返回包含构建日志的镜像详情
+func makeGetCourseImage(courseTag, imageTag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseID, imageID := c.GetUint64(courseTag), c.GetUint64(imageTag)
+		teacherID := c.GetUint64(md.KeyUserID)
+
+		ctx := c.Request.Context()
+		if !md.AuthCourseForTeacher(ctx, c, srv, courseID, teacherID) {
+			return
+		}
+
+		resp, err := srv.IDEService.GetCourseImage(ctx, courseID, imageID)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortNotFound(c, "course image is not found by imageId")
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
@@ -23,12 +23,15 @@ func makeOpenIDE(tag string) gin.HandlerFunc {
 			return
 		}
 
-		port, token, err := srv.IDEService.OpenIDE(ctx, labID, teacherID)
+		port, token, path, err := srv.IDEService.OpenIDE(ctx, labID, teacherID)
 		switch err {
 		case nil:
 		case errorx.ErrIsNotFound:
 			httpx.AbortBadParamsErr(c, "userID is invalid")
 			return
+		case errorx.ErrWrongIDEKind:
+			httpx.AbortBadParamsErr(c, "course uses terminal ide")
+			return
 		default:
 			httpx.AbortInternalErr(c)
 			return
@@ -36,7 +39,7 @@ func makeOpenIDE(tag string) gin.HandlerFunc {
 
 		host := config.Theia.GetString("dockerHost")
 		c.SetCookie("token", token, 0, "/", "", false, true)
-		url := fmt.Sprintf("http://%s:%d", host, port)
+		url := fmt.Sprintf("http://%s:%d%s", host, port, path)
 		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(gin.H{"url": url, "token": token})))
 	}
 }
@@ -81,26 +84,42 @@ func makeCheckCode(c *gin.Context) {
 	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(gin.H{"url": url, "token": token})))
 }
 
-func makeHeartBeatForStudent(tag string) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		labID := c.GetUint64(tag)
-		studentID := c.GetUint64(md.KeyUserID)
-		ctx := c.Request.Context()
-		if !md.AuthLabForStudent(ctx, c, srv, labID, studentID) {
-			return
-		}
+// makeHeartBeatForStudent active 由前端根据上次心跳以来是否有编辑或按键给出，仅活跃时间计入编码时间；
+// 未发送 active 的旧前端视为活跃，与之前每次心跳都计入编码时间一致
+func makeHeartBeatForStudent(c *gin.Context) {
+	type heartBeatForStudentRequest struct {
+		Active *bool  `json:"active"`
+		LabID  uint64 `json:"labid"`
+	}
 
-		switch err := srv.IDEService.HeartBeatForStudent(ctx, labID, studentID); err {
-		case nil:
-		case errorx.ErrRedisKeyNil:
-			httpx.AbortNotFound(c, "ide is closed")
-			return
-		default:
-			httpx.AbortInternalErr(c)
-			return
-		}
-		c.Status(http.StatusOK)
+	var req heartBeatForStudentRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Failed to get heart beat request")
+		return
 	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labid is invalid")
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForStudent(ctx, c, srv, req.LabID, studentID) {
+		return
+	}
+
+	active := req.Active == nil || *req.Active
+	switch err := srv.IDEService.HeartBeatForStudent(ctx, req.LabID, studentID, active); err {
+	case nil:
+	case errorx.ErrRedisKeyNil:
+		httpx.AbortNotFound(c, "ide is closed")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+	c.Status(http.StatusOK)
 }
 
 func makeHeartBeatForTeacher(c *gin.Context) {
@@ -0,0 +1,165 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"code-platform/api/http/md"
+	"code-platform/config"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+	"code-platform/service/ide/define"
+
+	"github.com/gin-gonic/gin"
+)
+
+type assistSessionRequest struct {
+	LabID     uint64 `json:"labId"`
+	StudentID uint64 `json:"stuId"`
+}
+
+// bindAssistSessionRequest 学生不传 stuId 时为本人的工作区
+func bindAssistSessionRequest(c *gin.Context) (*assistSessionRequest, bool) {
+	var req assistSessionRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in assist session request")
+		return nil, false
+	}
+
+	if req.StudentID == 0 {
+		req.StudentID = c.GetUint64(md.KeyUserID)
+	}
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return nil, false
+	}
+	return &req, true
+}
+
+func abortAssistSessionErr(c *gin.Context, err error) {
+	switch err {
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "assist session is not found")
+	case errorx.ErrFailToAuth:
+		httpx.AbortForbidden(c)
+	case errorx.ErrLabHasEnded:
+		httpx.AbortFailToAuth(c, "lab has ended")
+	case errorx.ErrIDENotRunning:
+		httpx.AbortBadParamsErr(c, "ide of student is not running")
+	default:
+		httpx.AbortInternalErr(c)
+	}
+}
+
+func makeStartAssistSession(c *gin.Context) {
+	type startAssistSessionRequest struct {
+		Permission string `json:"permission"`
+		LabID      uint64 `json:"labId"`
+	}
+
+	var req startAssistSessionRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in start assist session request")
+		return
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return
+	}
+	if req.Permission != define.AssistPermissionView && req.Permission != define.AssistPermissionEdit {
+		httpx.AbortBadParamsErr(c, "permission should be %q or %q", define.AssistPermissionView, define.AssistPermissionEdit)
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForStudent(ctx, c, srv, req.LabID, studentID) {
+		return
+	}
+
+	resp, err := srv.IDEService.StartAssistSession(ctx, req.LabID, studentID, req.Permission)
+	if err != nil {
+		abortAssistSessionErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeJoinAssistSession(c *gin.Context) {
+	req, ok := bindAssistSessionRequest(c)
+	if !ok {
+		return
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	resp, err := srv.IDEService.JoinAssistSession(c.Request.Context(), req.LabID, req.StudentID, teacherID)
+	if err != nil {
+		abortAssistSessionErr(c, err)
+		return
+	}
+
+	host := config.Theia.GetString("dockerHost")
+	c.SetCookie("token", resp.Token, 0, "/", "", false, true)
+	url := fmt.Sprintf("http://%s:%d%s", host, resp.Port, resp.Path)
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(gin.H{
+		"url":        url,
+		"token":      resp.Token,
+		"permission": resp.Permission,
+		"session_id": resp.SessionID,
+	})))
+}
+
+func makeReportAssistPresence(c *gin.Context) {
+	req, ok := bindAssistSessionRequest(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint64(md.KeyUserID)
+	resp, err := srv.IDEService.ReportAssistPresence(c.Request.Context(), req.LabID, req.StudentID, userID)
+	if err != nil {
+		abortAssistSessionErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeEndAssistSession(c *gin.Context) {
+	req, ok := bindAssistSessionRequest(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint64(md.KeyUserID)
+	if err := srv.IDEService.EndAssistSession(c.Request.Context(), req.LabID, req.StudentID, userID); err != nil {
+		abortAssistSessionErr(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeListAssistSessions(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+		teacherID := c.GetUint64(md.KeyUserID)
+
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		resp, err := srv.IDEService.ListAssistSessions(ctx, labID, (pageCurrent-1)*pageSize, pageSize)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
@@ -0,0 +1,167 @@
+package web
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+	"code-platform/service/ide/define"
+
+	"github.com/bytedance/sonic"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// 终端 WebSocket 协议：客户端每条消息的首字节为操作类型，'0' 后接键盘输入，'1' 后接 JSON 格式的窗口大小 {"cols":80,"rows":24}；
+// 服务端以二进制消息返回终端输出，可直接写入 xterm.js
+const (
+	terminalOpInput  = '0'
+	terminalOpResize = '1'
+)
+
+func makeCreateTerminalTicket(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		studentID := c.GetUint64(md.KeyUserID)
+
+		ctx := c.Request.Context()
+		if !md.AuthLabForStudent(ctx, c, srv, labID, studentID) {
+			return
+		}
+
+		ticket, err := srv.IDEService.CreateTerminalTicket(ctx, labID, studentID)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "labId is invalid")
+			return
+		case errorx.ErrWrongIDEKind:
+			httpx.AbortBadParamsErr(c, "course uses theia ide")
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(gin.H{"ticket": ticket})))
+	}
+}
+
+// makeAttachTerminal 以 makeCreateTerminalTicket 获取的一次性票据代替访问令牌完成认证
+func makeAttachTerminal(c *gin.Context) {
+	ctx := c.Request.Context()
+	labID, studentID, err := srv.IDEService.RedeemTerminalTicket(ctx, c.Query("ticket"))
+	switch err {
+	case nil:
+	case errorx.ErrFailToAuth:
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	// 初始窗口大小可选，缺省时使用容器内默认大小
+	size := &pb.TerminalSize{}
+	if cols, err := strconv.ParseUint(c.Query("cols"), 10, 16); err == nil {
+		size.Cols = uint32(cols)
+	}
+	if rows, err := strconv.ParseUint(c.Query("rows"), 10, 16); err == nil {
+		size.Rows = uint32(rows)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// 令牌不通过 cookie 携带，无需校验 Origin；握手成功后才打开终端，握手失败不会创建容器
+	websocket.Server{Handler: func(ws *websocket.Conn) {
+		stream, err := srv.IDEService.OpenTerminal(ctx, labID, studentID, size)
+		if err != nil {
+			ws.PayloadType = websocket.BinaryFrame
+			_, _ = ws.Write([]byte(terminalOpenFailedMessage))
+			ws.Close()
+			return
+		}
+		serveTerminal(ctx, ws, stream, labID, studentID)
+	}}.ServeHTTP(c.Writer, c.Request)
+}
+
+// terminalOpenFailedMessage 握手后已无法返回 HTTP 状态码，以终端输出告知用户
+const terminalOpenFailedMessage = "\r\n终端打开失败，请稍后重试\r\n"
+
+func serveTerminal(ctx context.Context, ws *websocket.Conn, stream pb.IDEServerService_AttachTerminalClient, labID, studentID uint64) {
+	ws.PayloadType = websocket.BinaryFrame
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// 终端退出或容器被回收时关闭连接，使下方的读取返回
+		defer ws.Close()
+		for {
+			out, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if _, err := ws.Write(out.Data); err != nil {
+				return
+			}
+		}
+	}()
+
+	// 连接期间代替前端轮询上报心跳，两次心跳之间有输入即为活跃
+	var active int32
+	go func() {
+		ticker := time.NewTicker(define.HeartBeatDuration / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = srv.IDEService.HeartBeatForStudent(ctx, labID, studentID, atomic.SwapInt32(&active, 0) == 1)
+			}
+		}
+	}()
+
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			break
+		}
+		if len(msg) == 0 {
+			continue
+		}
+
+		var in *pb.TerminalInput
+		switch msg[0] {
+		case terminalOpInput:
+			in = &pb.TerminalInput{Data: msg[1:]}
+			atomic.StoreInt32(&active, 1)
+		case terminalOpResize:
+			var size struct {
+				Cols uint32 `json:"cols"`
+				Rows uint32 `json:"rows"`
+			}
+			if err := sonic.Unmarshal(msg[1:], &size); err != nil {
+				continue
+			}
+			in = &pb.TerminalInput{Resize: &pb.TerminalSize{Cols: size.Cols, Rows: size.Rows}}
+		default:
+			continue
+		}
+		if err := stream.Send(in); err != nil {
+			break
+		}
+	}
+
+	// 关闭输入后 shell 退出，等待输出转发结束
+	_ = stream.CloseSend()
+	<-done
+}
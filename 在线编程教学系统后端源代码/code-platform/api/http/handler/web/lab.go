@@ -1,13 +1,16 @@
 package web
 
 import (
+	"context"
 	"net/http"
+	"strconv"
 	"time"
 
 	"code-platform/api/http/md"
 	"code-platform/pkg/errorx"
 	"code-platform/pkg/httpx"
 	"code-platform/pkg/jsonx"
+	"code-platform/service/lab"
 
 	"github.com/gin-gonic/gin"
 )
@@ -69,7 +72,12 @@ func makeUpdateLab(c *gin.Context) {
 	if !md.AuthLabForTeacher(ctx, c, srv, req.LabID, teacherID) {
 		return
 	}
-	if err := srv.LabService.UpdateLab(ctx, req.LabID, req.Title, req.Content, req.AttachmentURL, req.DeadLine); err != nil {
+	switch err := srv.LabService.UpdateLab(ctx, req.LabID, req.Title, req.Content, req.AttachmentURL, req.DeadLine); err {
+	case nil:
+	case errorx.ErrLabHasEnded:
+		httpx.AbortBadParamsErr(c, "deadline of archived lab can not be changed")
+		return
+	default:
 		httpx.AbortInternalErr(c)
 		return
 	}
@@ -77,6 +85,91 @@ func makeUpdateLab(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+func makeUpdateLabTemplate(labTag, fileTag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(labTag)
+		fileHeader := md.GetFileHeader(c, fileTag)
+
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		file, err := srv.FileService.MIMEHeaderToFile(fileHeader)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		defer file.Close()
+
+		resp, err := srv.LabService.UpdateLabTemplate(ctx, labID, file, fileHeader.Size)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "record is not found by labID")
+			return
+		case errorx.ErrLabHasEnded:
+			httpx.AbortBadParamsErr(c, "template of archived lab can not be changed")
+			return
+		case errorx.ErrUnsupportFileType:
+			httpx.AbortUnsupportFileType(c, "template is not a valid zip")
+			return
+		case errorx.ErrFileTooLarge:
+			httpx.AbortInvalidLength(c, "template is too large")
+			return
+		case errorx.ErrTemplateChanged:
+			httpx.AbortBadParamsErr(c, "template is being changed, please retry")
+			return
+		case context.Canceled:
+			c.Abort()
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeRestoreLabTemplate(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		resp, err := srv.LabService.RestoreLabTemplate(ctx, labID)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "record is not found by labID")
+			return
+		case errorx.ErrNoPreviousTemplate:
+			httpx.AbortBadParamsErr(c, "lab has no previous template")
+			return
+		case errorx.ErrLabHasEnded:
+			httpx.AbortBadParamsErr(c, "template of archived lab can not be changed")
+			return
+		case errorx.ErrTemplateChanged:
+			httpx.AbortBadParamsErr(c, "template is being changed, please retry")
+			return
+		case context.Canceled:
+			c.Abort()
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
 func makeListLabsByUserIDAndCourseID(tag string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		courseID := c.GetUint64(tag)
@@ -200,10 +293,12 @@ func makeListLabsScoreByStudentIDAndCourseID(c *gin.Context) {
 	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
 }
 
+// makeGetTreeNode 返回按上限截断的目录树，默认不含文件内容，文件内容见 makeGetTreeNodeFile
 func makeGetTreeNode(c *gin.Context) {
 	type checkCodeRequest struct {
-		StudentID uint64 `form:"stuID"`
-		LabID     uint64 `form:"labID"`
+		StudentID   uint64 `form:"stuID"`
+		LabID       uint64 `form:"labID"`
+		WithContent bool   `form:"withContent"`
 	}
 
 	var req checkCodeRequest
@@ -223,7 +318,7 @@ func makeGetTreeNode(c *gin.Context) {
 		return
 	}
 
-	resp, err := srv.LabService.QuickCheckCode(ctx, req.LabID, req.StudentID)
+	resp, err := srv.LabService.QuickCheckCode(ctx, req.LabID, req.StudentID, req.WithContent)
 	if err != nil {
 		httpx.AbortInternalErr(c)
 		return
@@ -232,6 +327,75 @@ func makeGetTreeNode(c *gin.Context) {
 	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
 }
 
+func makeGetTreeNodeFile(c *gin.Context) {
+	type checkFileRequest struct {
+		Path      string `form:"path"`
+		StudentID uint64 `form:"stuID"`
+		LabID     uint64 `form:"labID"`
+	}
+
+	var req checkFileRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in gettreeNodeFile request")
+		return
+	}
+
+	if req.LabID <= 0 || req.StudentID <= 0 || req.Path == "" {
+		httpx.AbortBadParamsErr(c, "params are invalid")
+		return
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForTeacher(ctx, c, srv, req.LabID, teacherID) {
+		return
+	}
+
+	resp, err := srv.LabService.QuickCheckFile(ctx, req.LabID, req.StudentID, req.Path)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeDiffWorkspaces(c *gin.Context) {
+	type diffWorkspacesRequest struct {
+		LabID         uint64 `form:"labID"`
+		OldStudentID  uint64 `form:"oldStuID"`
+		NewStudentID  uint64 `form:"newStuID"`
+		OldSnapshotID int64  `form:"oldSnapshotID"`
+		NewSnapshotID int64  `form:"newSnapshotID"`
+		ContextLines  int32  `form:"context"`
+	}
+
+	var req diffWorkspacesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in diffWorkspaces request")
+		return
+	}
+
+	if req.LabID <= 0 || req.OldStudentID <= 0 || req.NewStudentID <= 0 || req.OldSnapshotID < 0 || req.NewSnapshotID < 0 {
+		httpx.AbortBadParamsErr(c, "id is invalid")
+		return
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForTeacher(ctx, c, srv, req.LabID, teacherID) {
+		return
+	}
+
+	resp, err := srv.LabService.DiffWorkspaces(ctx, req.LabID, req.OldStudentID, req.NewStudentID, req.OldSnapshotID, req.NewSnapshotID, req.ContextLines)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
 func makeListHistoryDetectionReports(tag string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		labID := c.GetUint64(tag)
@@ -272,3 +436,37 @@ func makeGetDetectionReport(tag string) gin.HandlerFunc {
 		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
 	}
 }
+
+// makeGetDetectionGraph 可通过 threshold 指定 0 到 1 之间的分组阈值
+func makeGetDetectionGraph(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reportID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+
+		threshold := lab.DefaultPlagiarismClusterThreshold
+		if value, ok := c.GetQuery("threshold"); ok {
+			var err error
+			threshold, err = strconv.ParseFloat(value, 64)
+			if err != nil || threshold < 0 || threshold > 1 {
+				httpx.AbortBadParamsErr(c, "threshold should be in [0, 1]")
+				return
+			}
+		}
+
+		ctx := c.Request.Context()
+		resp, err := srv.LabService.ViewDetectionGraph(ctx, reportID, teacherID, c.Request.Host, threshold)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "report_id is invalid")
+			return
+		case errorx.ErrFailToAuth:
+			httpx.AbortForbidden(c)
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
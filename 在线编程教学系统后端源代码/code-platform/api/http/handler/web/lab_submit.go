@@ -1,6 +1,7 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -248,8 +249,30 @@ func makeGetCommentsByUserIDAndLabID(c *gin.Context) {
 	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(gin.H{"comment": comment})))
 }
 
+// maxReferenceLabs 一次查重可附带的参考实验数
+const maxReferenceLabs = 10
+
+// makePlagiarismCheck 提交后台查重并返回任务，通过 plagiarism_job 查询进度，完成后结果保存为查重报告；
+// 请求体可附带作为参考的其他实验或往届实验
 func makePlagiarismCheck(tag string) gin.HandlerFunc {
+	type plagiarismCheckRequest struct {
+		ReferenceLabIDs []uint64 `json:"referenceLabIds"`
+	}
+
 	return func(c *gin.Context) {
+		var req plagiarismCheckRequest
+		// 不附带参考实验时可以没有请求体
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+				httpx.AbortGetParamsErr(c, "Fail to get params in plagiarism check request")
+				return
+			}
+		}
+		if len(req.ReferenceLabIDs) > maxReferenceLabs {
+			httpx.AbortBadParamsErr(c, "at most %d reference labs are allowed", maxReferenceLabs)
+			return
+		}
+
 		labID := c.GetUint64(tag)
 		teacherID := c.GetUint64(md.KeyUserID)
 
@@ -258,25 +281,23 @@ func makePlagiarismCheck(tag string) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := srv.LabService.PlagiarismCheck(ctx, labID)
+		resp, err := srv.LabService.StartPlagiarismCheck(ctx, labID, teacherID, req.ReferenceLabIDs)
 		switch err {
 		case nil:
 		case errorx.ErrIsNotFound:
-			httpx.AbortBadParamsErr(c, "code is not found by labId")
+			httpx.AbortBadParamsErr(c, "lab or reference lab is not found")
 			return
-		case errorx.ErrWrongCode:
-			httpx.AbortBadParamsErr(c, "code is invalid")
+		case errorx.ErrFailToAuth:
+			httpx.AbortFailToAuth(c, "reference lab does not belong to you or a course with the same family code")
+			return
+		case errorx.ErrReferenceLabLanguage:
+			httpx.AbortBadParamsErr(c, "language of reference lab does not match")
 			return
 		default:
 			httpx.AbortInternalErr(c)
 			return
 		}
 
-		baseURL := "http://" + c.Request.Host + c.Request.URL.Path
-		for _, v := range resp {
-			v.URL = baseURL + "/" + v.URL
-		}
-
 		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
 	}
 }
@@ -331,3 +352,71 @@ func makeClickPlagiarismURL(tag string) gin.HandlerFunc {
 		c.Data(http.StatusOK, "Content-Type: text/html", data)
 	}
 }
+
+// makeGetSubmitArchive 下载学生截止时冻结的工作区归档，响应头 X-Archive-Sha256 为归档的 sha256
+func makeGetSubmitArchive(c *gin.Context) {
+	type getSubmitArchiveRequest struct {
+		LabID     uint64 `form:"labId"`
+		StudentID uint64 `form:"stuId"`
+	}
+
+	var req getSubmitArchiveRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in get submit archive request")
+		return
+	}
+
+	if req.LabID <= 0 || req.StudentID <= 0 {
+		httpx.AbortBadParamsErr(c, "id is invalid")
+		return
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForTeacher(ctx, c, srv, req.LabID, teacherID) {
+		return
+	}
+
+	archive, err := srv.LabService.GetSubmitArchive(ctx, req.LabID, req.StudentID)
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "archive is not found")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+	defer archive.Reader.Close()
+
+	c.DataFromReader(http.StatusOK, archive.Size, "application/gzip", archive.Reader, map[string]string{
+		"Content-Disposition": `attachment; filename="` + archive.Name + `"`,
+		"X-Archive-Sha256":    archive.Hash,
+	})
+}
+
+// makeDownloadLabSubmits 以 zip 流式下载实验全部学生的代码、实验报告与成绩清单
+func makeDownloadLabSubmits(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		c.Header("Content-Type", "application/zip")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="lab-%d-submissions.zip"`, labID))
+		switch err := srv.LabService.WriteLabSubmitsZip(ctx, labID, c.Writer); {
+		case err == nil:
+		case !c.Writer.Written():
+			c.Writer.Header().Del("Content-Type")
+			c.Writer.Header().Del("Content-Disposition")
+			httpx.AbortInternalErr(c)
+		default:
+			// 响应已开始发送，只能中断连接
+			c.Abort()
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxLabTestTimeoutSeconds 与 IDE 服务的 test.max_timeout 一致
+const maxLabTestTimeoutSeconds = 600
+
+func makeGetLabTest(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		resp, err := srv.LabService.GetLabTest(ctx, labID)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortNotFound(c, "test of lab is not configured")
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeUpdateLabTest(c *gin.Context) {
+	type updateLabTestRequest struct {
+		Command        string `json:"command"`
+		LabID          uint64 `json:"labId"`
+		TimeoutSeconds int32  `json:"timeoutSeconds"`
+		FullScore      int32  `json:"fullScore"`
+		Language       int8   `json:"language"`
+		AutoScore      bool   `json:"autoScore"`
+	}
+
+	var req updateLabTestRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in update lab test request")
+		return
+	}
+
+	switch {
+	case req.LabID <= 0:
+		httpx.AbortBadParamsErr(c, "labID is invalid")
+		return
+	case len(req.Command) > 1000:
+		httpx.AbortBadParamsErr(c, "command is too long")
+		return
+	case req.Language < 0 || req.Language > 2:
+		httpx.AbortBadParamsErr(c, "language is invalid")
+		return
+	case req.TimeoutSeconds <= 0 || req.TimeoutSeconds > maxLabTestTimeoutSeconds:
+		httpx.AbortBadParamsErr(c, "timeoutSeconds should be in (0, %d]", maxLabTestTimeoutSeconds)
+		return
+	case req.FullScore <= 0 || req.FullScore > 100:
+		httpx.AbortBadParamsErr(c, "fullScore should be in (0, 100]")
+		return
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForTeacher(ctx, c, srv, req.LabID, teacherID) {
+		return
+	}
+	switch err := srv.LabService.UpdateLabTest(ctx, req.LabID, req.Command, req.Language, req.TimeoutSeconds, req.FullScore, req.AutoScore); err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortBadParamsErr(c, "record is not found by labID")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeUpdateLabTestFiles(labTag, fileTag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(labTag)
+		fileHeader := md.GetFileHeader(c, fileTag)
+
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		file, err := srv.FileService.MIMEHeaderToFile(fileHeader)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		defer file.Close()
+
+		resp, err := srv.LabService.UpdateLabTestFiles(ctx, labID, file, fileHeader.Size)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "record is not found by labID")
+			return
+		case errorx.ErrUnsupportFileType:
+			httpx.AbortUnsupportFileType(c, "tests is not a valid zip")
+			return
+		case errorx.ErrFileTooLarge:
+			httpx.AbortInvalidLength(c, "tests is too large")
+			return
+		case context.Canceled:
+			c.Abort()
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+// makeRunLabTests 不传 studentId 时运行本人工作区，教师需指定学生
+func makeRunLabTests(c *gin.Context) {
+	type runLabTestsRequest struct {
+		LabID     uint64 `json:"labId"`
+		StudentID uint64 `json:"studentId"`
+	}
+
+	var req runLabTestsRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in run lab tests request")
+		return
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return
+	}
+
+	userID := c.GetUint64(md.KeyUserID)
+	if req.StudentID == 0 {
+		req.StudentID = userID
+	}
+	resp, err := srv.LabService.RunLabTests(c.Request.Context(), req.LabID, req.StudentID, userID)
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "test of lab is not configured")
+		return
+	case errorx.ErrFailToAuth:
+		httpx.AbortForbidden(c)
+		return
+	case errorx.ErrTestRunning:
+		httpx.AbortBadParamsErr(c, "previous test run is in progress")
+		return
+	case context.Canceled:
+		c.Abort()
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeListLabTestRuns(c *gin.Context) {
+	req, ok := bindWorkspaceQuery(c)
+	if !ok {
+		return
+	}
+
+	pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+	userID := c.GetUint64(md.KeyUserID)
+	resp, err := srv.LabService.ListLabTestRuns(c.Request.Context(), req.LabID, req.StudentID, userID, (pageCurrent-1)*pageSize, pageSize)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeGetLabTestRun(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		runID := c.GetUint64(tag)
+		userID := c.GetUint64(md.KeyUserID)
+		resp, err := srv.LabService.GetLabTestRun(c.Request.Context(), runID, userID)
+		if err != nil {
+			abortWorkspaceErr(c, err)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeListLatestLabTestRuns(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		resp, err := srv.LabService.ListLatestLabTestRuns(ctx, labID)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
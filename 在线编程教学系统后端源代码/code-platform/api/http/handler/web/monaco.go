@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	"code-platform/api/http/md"
 	"code-platform/pkg/errorx"
 	"code-platform/pkg/httpx"
 	"code-platform/pkg/jsonx"
@@ -13,7 +14,9 @@ import (
 
 func makeExecCode(c *gin.Context) {
 	type execCodeRequest struct {
-		Code     string `json:"code"`
+		Code string `json:"code"`
+		// CourseID 可选，在课程的自定义镜像中运行
+		CourseID uint64 `json:"courseId"`
 		Language int8   `json:"language"`
 	}
 
@@ -42,7 +45,11 @@ func makeExecCode(c *gin.Context) {
 	var response *execCodeResponse
 
 	ctx := c.Request.Context()
-	dockerResp, err := srv.MonacoService.ExecCode(ctx, req.Language, req.Code)
+	// 只有课程的学生与教师可以使用课程的自定义镜像
+	if req.CourseID != 0 && !md.AuthCourseForStudentAndTeacher(ctx, c, srv, req.CourseID, c.GetUint64(md.KeyUserID)) {
+		return
+	}
+	dockerResp, err := srv.MonacoService.ExecCode(ctx, req.CourseID, req.Language, req.Code)
 	switch err {
 	case nil:
 		response = &execCodeResponse{
@@ -0,0 +1,336 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+	"code-platform/service/lab"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getPlagiarismJobForTeacher 查询任务并校验教师是否为实验所属课程的教师，失败时已中止请求
+func getPlagiarismJobForTeacher(c *gin.Context, jobID uint64) (*lab.PlagiarismJob, bool) {
+	ctx := c.Request.Context()
+	job, err := srv.LabService.GetPlagiarismJob(ctx, jobID)
+	switch err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortBadParamsErr(c, "plagiarism job is not found by id")
+		return nil, false
+	default:
+		httpx.AbortInternalErr(c)
+		return nil, false
+	}
+	if !md.AuthLabForTeacher(ctx, c, srv, job.LabID, c.GetUint64(md.KeyUserID)) {
+		return nil, false
+	}
+	return job, true
+}
+
+func makeGetPlagiarismJob(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		job, ok := getPlagiarismJobForTeacher(c, c.GetUint64(tag))
+		if !ok {
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(job)))
+	}
+}
+
+func makeListPlagiarismJobs(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+		resp, err := srv.LabService.ListPlagiarismJobsByLabID(ctx, labID, (pageCurrent-1)*pageSize, pageSize)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeCancelPlagiarismJob(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		job, ok := getPlagiarismJobForTeacher(c, c.GetUint64(tag))
+		if !ok {
+			return
+		}
+
+		err := srv.LabService.CancelPlagiarismJob(c.Request.Context(), job.ID)
+		switch err {
+		case nil:
+		case errorx.ErrPlagiarismJobFinished:
+			httpx.AbortBadParamsErr(c, "plagiarism job has finished")
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Status(http.StatusOK)
+	}
+}
+
+const (
+	// maxPlagiarismMinMatch 与查重服务允许的最短匹配长度上限一致
+	maxPlagiarismMinMatch = 1000
+	maxIgnorePatterns     = 100
+	maxIgnorePatternLen   = 200
+)
+
+func makeGetPlagiarismSetting(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		resp, err := srv.LabService.GetPlagiarismSetting(ctx, labID)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeUpdatePlagiarismSetting(c *gin.Context) {
+	type updatePlagiarismSettingRequest struct {
+		Ignore   []string `json:"ignore"`
+		LabID    uint64   `json:"labId"`
+		MinMatch int32    `json:"minMatch"`
+	}
+
+	var req updatePlagiarismSettingRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in update plagiarism setting request")
+		return
+	}
+
+	switch {
+	case req.LabID <= 0:
+		httpx.AbortBadParamsErr(c, "labID is invalid")
+		return
+	case req.MinMatch < 0 || req.MinMatch > maxPlagiarismMinMatch:
+		httpx.AbortBadParamsErr(c, "minMatch should be in [0, %d]", maxPlagiarismMinMatch)
+		return
+	case len(req.Ignore) > maxIgnorePatterns:
+		httpx.AbortBadParamsErr(c, "too many ignore patterns")
+		return
+	}
+	for _, pattern := range req.Ignore {
+		// 规则以换行分隔保存
+		if len(pattern) > maxIgnorePatternLen || strings.ContainsAny(pattern, "\r\n") {
+			httpx.AbortBadParamsErr(c, "ignore pattern %q is invalid", pattern)
+			return
+		}
+	}
+
+	teacherID := c.GetUint64(md.KeyUserID)
+	ctx := c.Request.Context()
+	if !md.AuthLabForTeacher(ctx, c, srv, req.LabID, teacherID) {
+		return
+	}
+	switch err := srv.LabService.UpdatePlagiarismSetting(ctx, req.LabID, req.MinMatch, req.Ignore); err {
+	case nil:
+	case errorx.ErrIsNotFound:
+		httpx.AbortBadParamsErr(c, "record is not found by labID")
+		return
+	default:
+		httpx.AbortInternalErr(c)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeUpdatePlagiarismBase(labTag, fileTag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(labTag)
+		fileHeader := md.GetFileHeader(c, fileTag)
+
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		file, err := srv.FileService.MIMEHeaderToFile(fileHeader)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		defer file.Close()
+
+		resp, err := srv.LabService.UpdatePlagiarismBase(ctx, labID, fileHeader.Filename, file, fileHeader.Size)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "record is not found by labID")
+			return
+		case errorx.ErrUnsupportFileType:
+			httpx.AbortUnsupportFileType(c, "base code is not a valid zip")
+			return
+		case errorx.ErrFileTooLarge:
+			httpx.AbortInvalidLength(c, "base code is too large")
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeRemovePlagiarismBase(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		if err := srv.LabService.RemovePlagiarismBase(ctx, labID); err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Status(http.StatusOK)
+	}
+}
+
+// maxPlagiarismEvidenceMembers 一次导出证据的学生数上限
+const maxPlagiarismEvidenceMembers = 20
+
+// makeExportPlagiarismEvidence 以 zip 导出查重记录中一对学生或一个分组的证据，members 为关系图中节点的 ID，
+// 只包含两两之间相似度不低于 threshold 的比对
+func makeExportPlagiarismEvidence(tag string) gin.HandlerFunc {
+	type exportPlagiarismEvidenceRequest struct {
+		Members   []string `json:"members"`
+		Threshold float64  `json:"threshold"`
+	}
+
+	return func(c *gin.Context) {
+		var req exportPlagiarismEvidenceRequest
+		if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+			httpx.AbortGetParamsErr(c, "Fail to get params in export plagiarism evidence request")
+			return
+		}
+		switch {
+		case len(req.Members) < 2 || len(req.Members) > maxPlagiarismEvidenceMembers:
+			httpx.AbortBadParamsErr(c, "members should contain 2 to %d students", maxPlagiarismEvidenceMembers)
+			return
+		case req.Threshold < 0 || req.Threshold > 1:
+			httpx.AbortBadParamsErr(c, "threshold should be in [0, 1]")
+			return
+		}
+
+		reportID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		evidence, err := srv.LabService.GetPlagiarismEvidence(ctx, reportID, teacherID, req.Members, req.Threshold)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "no comparison among members is found")
+			return
+		case errorx.ErrReportPredatesEvidence:
+			httpx.AbortBadParamsErr(c, "report predates evidence export, please check again")
+			return
+		case errorx.ErrFailToAuth:
+			httpx.AbortForbidden(c)
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+
+		c.Header("Content-Type", "application/zip")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="plagiarism-evidence-%d.zip"`, reportID))
+		switch err := evidence.WriteZip(c.Writer); {
+		case err == nil:
+		case !c.Writer.Written():
+			c.Writer.Header().Del("Content-Type")
+			c.Writer.Header().Del("Content-Disposition")
+			httpx.AbortInternalErr(c)
+		default:
+			// 响应已开始发送，只能中断连接
+			c.Abort()
+		}
+	}
+}
+
+// makeReportPlagiarismCheck 提交后台的报告查重，进度与结果通过 /lab/plagiarism_job 查询
+func makeReportPlagiarismCheck(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		resp, err := srv.LabService.StartReportPlagiarismCheck(ctx, labID, teacherID)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeListReportPlagiarismHistory(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		labID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+		ctx := c.Request.Context()
+		if !md.AuthLabForTeacher(ctx, c, srv, labID, teacherID) {
+			return
+		}
+
+		pageCurrent, pageSize := c.GetInt(md.KeyPageCurrent), c.GetInt(md.KeyPageSize)
+		resp, err := srv.LabService.ListReportDetectionsByLabID(ctx, labID, (pageCurrent-1)*pageSize, pageSize)
+		if err != nil {
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
+
+func makeGetReportPlagiarism(tag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reportID := c.GetUint64(tag)
+		teacherID := c.GetUint64(md.KeyUserID)
+
+		resp, err := srv.LabService.ViewReportDetection(c.Request.Context(), reportID, teacherID)
+		switch err {
+		case nil:
+		case errorx.ErrIsNotFound:
+			httpx.AbortBadParamsErr(c, "report_id is invalid")
+			return
+		case errorx.ErrFailToAuth:
+			httpx.AbortForbidden(c)
+			return
+		default:
+			httpx.AbortInternalErr(c)
+			return
+		}
+		c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+	}
+}
@@ -20,6 +20,37 @@ func MakeWebHandler(router gin.IRouter, s *xhttp.UnionService) {
 		c.String(200, "ok")
 	})
 
+	// 打包下载全部提交的耗时与学生人数成正比，不受统一超时限制
+	router.GET("/lab/submit/download",
+		md.Tracer("web.lab.summit.makeDownloadLabSubmits"), md.RestoreUserStat(srv), md.CheckQueryID("labId"), md.RequireTeacher(srv),
+		makeDownloadLabSubmits("labId"),
+	)
+
+	// 更新模板需上传 zip 并合并进所有已打开的工作区，同样不受统一超时限制
+	router.POST("/lab/template",
+		md.Tracer("web.lab.makeUpdateLabTemplate"), md.RestoreUserStat(srv), md.RequireTeacher(srv),
+		md.CheckFormID("labId"), md.CheckFileHeader("template"), md.CheckFileExt("template", []string{"zip"}),
+		makeUpdateLabTemplate("labId", "template"),
+	)
+	router.POST("/lab/template/restore",
+		md.Tracer("web.lab.makeRestoreLabTemplate"), md.RestoreUserStat(srv), md.RequireTeacher(srv), md.CheckJSONID("labId"),
+		makeRestoreLabTemplate("labId"),
+	)
+	router.POST("/lab/test/files",
+		md.Tracer("web.lab.test.makeUpdateLabTestFiles"), md.RestoreUserStat(srv), md.RequireTeacher(srv),
+		md.CheckFormID("labId"), md.CheckFileHeader("tests"), md.CheckFileExt("tests", []string{"zip"}),
+		makeUpdateLabTestFiles("labId", "tests"),
+	)
+	router.POST("/lab/plagiarism_setting/base",
+		md.Tracer("web.lab.plagiarism_setting.makeUpdatePlagiarismBase"), md.RestoreUserStat(srv), md.RequireTeacher(srv),
+		md.CheckFormID("labId"), md.CheckFileHeader("base"),
+		md.CheckFileExt("base", []string{"zip", "py", "cpp", "cc", "cxx", "c", "h", "hpp", "hh", "java"}),
+		makeUpdatePlagiarismBase("labId", "base"),
+	)
+
+	// 终端模式 IDE 的 WebSocket 连接长期保持，以一次性票据认证
+	router.GET("/ide/terminal", md.Tracer("web.ide.makeAttachTerminal"), makeAttachTerminal)
+
 	router.Use(md.Timeout(10 * time.Second))
 
 	router.POST("/login", md.Tracer("web.makeLoginHandler"), makeLoginHandler)
@@ -115,6 +146,15 @@ func MakeWebHandler(router gin.IRouter, s *xhttp.UnionService) {
 		routerCourse.POST("/student/import", md.Tracer("web.course.makeImportStudentCSV"), md.CheckFormID("courseId"), md.RequireTeacher(srv), makeImportStudentCSV("courseId"))
 		routerCourse.PUT("", md.Tracer("web.course.makeUpdateCourse"), md.RequireTeacher(srv), makeUpdateCourse)
 		routerCourse.DELETE("/:courseID", md.Tracer("web.course.makeDeleteCourse"), md.CheckParamID("courseID"), md.RequireTeacher(srv), makeDeleteCourse("courseID"))
+		routerCourse.GET("/image",
+			md.Tracer("web.course.makeListCourseImages"), md.CheckPage, md.CheckQueryID("courseId"), md.RequireTeacher(srv),
+			makeListCourseImages("courseId"),
+		)
+		routerCourse.GET("/image/detail",
+			md.Tracer("web.course.makeGetCourseImage"), md.CheckQueryID("courseId"), md.CheckQueryID("imageId"), md.RequireTeacher(srv),
+			makeGetCourseImage("courseId", "imageId"),
+		)
+		routerCourse.POST("/image", md.Tracer("web.course.makeSubmitCourseImage"), md.RequireTeacher(srv), makeSubmitCourseImage)
 
 		// student
 		routerCourse.GET("/study", md.Tracer("web.course.makeListCoursesByStudentID"), md.CheckPage, md.RequireStudent(srv), makeListCoursesByStudentID)
@@ -166,8 +206,19 @@ func MakeWebHandler(router gin.IRouter, s *xhttp.UnionService) {
 	routerIDE := router.Group("/ide")
 	{
 		routerIDE.POST("", md.Tracer("web.ide.makeOpenIDE"), md.CheckJSONID("labId"), md.RequireStudent(srv), makeOpenIDE("labId"))
-		routerIDE.POST("/heartbeat", md.Tracer("web.ide.makeHeartBeatForStudent"), md.CheckJSONID("labid"), md.RequireStudent(srv), makeHeartBeatForStudent("labid"))
+		routerIDE.POST("/terminal/ticket", md.Tracer("web.ide.makeCreateTerminalTicket"), md.CheckJSONID("labId"), md.RequireStudent(srv), makeCreateTerminalTicket("labId"))
+		routerIDE.POST("/heartbeat", md.Tracer("web.ide.makeHeartBeatForStudent"), md.RequireStudent(srv), makeHeartBeatForStudent)
 		routerIDE.POST("/heartbeat/teacher", md.Tracer("web.ide.makeHeartBeatForTeacher"), md.RequireTeacher(srv), makeHeartBeatForTeacher)
+
+		// 学生邀请教师协助，教师以学生选择的权限加入
+		routerIDE.POST("/assist", md.Tracer("web.ide.makeStartAssistSession"), md.RequireStudent(srv), makeStartAssistSession)
+		routerIDE.POST("/assist/join", md.Tracer("web.ide.makeJoinAssistSession"), md.RequireTeacher(srv), makeJoinAssistSession)
+		routerIDE.POST("/assist/presence", md.Tracer("web.ide.makeReportAssistPresence"), makeReportAssistPresence)
+		routerIDE.DELETE("/assist", md.Tracer("web.ide.makeEndAssistSession"), makeEndAssistSession)
+		routerIDE.GET("/assist/sessions",
+			md.Tracer("web.ide.makeListAssistSessions"), md.CheckPage, md.CheckQueryID("labId"), md.RequireTeacher(srv),
+			makeListAssistSessions("labId"),
+		)
 	}
 
 	routerLab := router.Group("/lab")
@@ -181,12 +232,61 @@ func MakeWebHandler(router gin.IRouter, s *xhttp.UnionService) {
 		routerLab.PUT("", md.Tracer("web.lab.makeUpdateLab"), md.RequireTeacher(srv), makeUpdateLab)
 		routerLab.DELETE("", md.Tracer("web.lab.makeDeleteLabByID"), md.CheckJSONID("labId"), md.RequireTeacher(srv), makeDeleteLabByID("labId"))
 		routerLab.GET("/check_code/quick", md.Tracer("web.lab.makeGetTreeNode"), md.RequireTeacher(srv), makeGetTreeNode)
+		routerLab.GET("/check_code/quick/file", md.Tracer("web.lab.makeGetTreeNodeFile"), md.RequireTeacher(srv), makeGetTreeNodeFile)
+		routerLab.GET("/check_code/diff", md.Tracer("web.lab.makeDiffWorkspaces"), md.RequireTeacher(srv), makeDiffWorkspaces)
 		routerLab.POST("/check_code", md.Tracer("web.lab.makeCheckCode"), md.RequireTeacher(srv), makeCheckCode)
 		routerLab.GET("/plagiarism_history/:labid",
 			md.Tracer("web.lab.makeListHistoryDetectionReports"), md.CheckPage, md.CheckParamID("labid"), md.RequireTeacher(srv),
 			makeListHistoryDetectionReports("labid"),
 		)
 		routerLab.GET("/plagiarism_view/:reportid", md.Tracer("web.lab.makeGetDetectionReport"), md.CheckParamID("reportid"), md.RequireTeacher(srv), makeGetDetectionReport("reportid"))
+		routerLab.GET("/plagiarism_graph/:reportid", md.Tracer("web.lab.makeGetDetectionGraph"), md.CheckParamID("reportid"), md.RequireTeacher(srv), makeGetDetectionGraph("reportid"))
+		routerLab.POST("/plagiarism_evidence/:reportid",
+			md.Tracer("web.lab.makeExportPlagiarismEvidence"), md.CheckParamID("reportid"), md.RequireTeacher(srv),
+			makeExportPlagiarismEvidence("reportid"),
+		)
+
+		// 实验报告（txt、docx、pdf）的文本查重
+		routerLabReportPlagiarism := routerLab.Group("/report_plagiarism")
+		{
+			routerLabReportPlagiarism.POST("/:labID",
+				md.Tracer("web.lab.report_plagiarism.makeReportPlagiarismCheck"), md.CheckParamID("labID"), md.RequireTeacher(srv),
+				makeReportPlagiarismCheck("labID"),
+			)
+			routerLabReportPlagiarism.GET("/history/:labID",
+				md.Tracer("web.lab.report_plagiarism.makeListReportPlagiarismHistory"), md.CheckPage, md.CheckParamID("labID"), md.RequireTeacher(srv),
+				makeListReportPlagiarismHistory("labID"),
+			)
+			routerLabReportPlagiarism.GET("/view/:reportID",
+				md.Tracer("web.lab.report_plagiarism.makeGetReportPlagiarism"), md.CheckParamID("reportID"), md.RequireTeacher(srv),
+				makeGetReportPlagiarism("reportID"),
+			)
+		}
+
+		// 后台查重的进度与取消
+		routerLabPlagiarismJob := routerLab.Group("/plagiarism_job")
+		{
+			routerLabPlagiarismJob.GET("",
+				md.Tracer("web.lab.plagiarism_job.makeListPlagiarismJobs"), md.CheckPage, md.CheckQueryID("labId"), md.RequireTeacher(srv),
+				makeListPlagiarismJobs("labId"),
+			)
+			routerLabPlagiarismJob.GET("/:jobID", md.Tracer("web.lab.plagiarism_job.makeGetPlagiarismJob"), md.CheckParamID("jobID"), md.RequireTeacher(srv), makeGetPlagiarismJob("jobID"))
+			routerLabPlagiarismJob.POST("/cancel", md.Tracer("web.lab.plagiarism_job.makeCancelPlagiarismJob"), md.CheckJSONID("jobId"), md.RequireTeacher(srv), makeCancelPlagiarismJob("jobId"))
+		}
+
+		// 查重的基础代码与比对参数，上传基础代码见 /lab/plagiarism_setting/base
+		routerLabPlagiarismSetting := routerLab.Group("/plagiarism_setting")
+		{
+			routerLabPlagiarismSetting.GET("",
+				md.Tracer("web.lab.plagiarism_setting.makeGetPlagiarismSetting"), md.CheckQueryID("labId"), md.RequireTeacher(srv),
+				makeGetPlagiarismSetting("labId"),
+			)
+			routerLabPlagiarismSetting.PUT("", md.Tracer("web.lab.plagiarism_setting.makeUpdatePlagiarismSetting"), md.RequireTeacher(srv), makeUpdatePlagiarismSetting)
+			routerLabPlagiarismSetting.DELETE("/base",
+				md.Tracer("web.lab.plagiarism_setting.makeRemovePlagiarismBase"), md.CheckJSONID("labId"), md.RequireTeacher(srv),
+				makeRemovePlagiarismBase("labId"),
+			)
+		}
 
 		// student only
 		routerLab.GET("/details",
@@ -195,6 +295,33 @@ func MakeWebHandler(router gin.IRouter, s *xhttp.UnionService) {
 		)
 		routerLab.GET("/student", md.Tracer("web.lab.makeGetLabByStudentID"), md.CheckPage, md.RequireStudent(srv), makeGetLabByStudentID)
 
+		// 学生读写本人工作区，教师只读访问学生工作区
+		routerLabWorkspace := routerLab.Group("/workspace")
+		{
+			routerLabWorkspace.GET("/dir", md.Tracer("web.lab.workspace.makeListWorkspaceDir"), makeListWorkspaceDir)
+			routerLabWorkspace.GET("/file", md.Tracer("web.lab.workspace.makeReadWorkspaceFile"), makeReadWorkspaceFile)
+			routerLabWorkspace.PUT("/file", md.Tracer("web.lab.workspace.makeWriteWorkspaceFile"), md.RequireStudent(srv), makeWriteWorkspaceFile)
+			routerLabWorkspace.POST("/file", md.Tracer("web.lab.workspace.makeCreateWorkspaceFile"), md.RequireStudent(srv), makeCreateWorkspaceFile)
+			routerLabWorkspace.PUT("/rename", md.Tracer("web.lab.workspace.makeRenameWorkspaceFile"), md.RequireStudent(srv), makeRenameWorkspaceFile)
+			routerLabWorkspace.DELETE("/file", md.Tracer("web.lab.workspace.makeDeleteWorkspaceFile"), md.RequireStudent(srv), makeDeleteWorkspaceFile)
+			routerLabWorkspace.GET("/snapshots", md.Tracer("web.lab.workspace.makeListWorkspaceSnapshots"), makeListWorkspaceSnapshots)
+			routerLabWorkspace.POST("/snapshots/restore", md.Tracer("web.lab.workspace.makeRestoreWorkspaceSnapshot"), md.RequireStudent(srv), makeRestoreWorkspaceSnapshot)
+		}
+
+		// 教师配置测试命令，学生对本人工作区、教师对学生工作区运行测试
+		routerLabTest := routerLab.Group("/test")
+		{
+			routerLabTest.GET("", md.Tracer("web.lab.test.makeGetLabTest"), md.CheckQueryID("labId"), md.RequireTeacher(srv), makeGetLabTest("labId"))
+			routerLabTest.PUT("", md.Tracer("web.lab.test.makeUpdateLabTest"), md.RequireTeacher(srv), makeUpdateLabTest)
+			routerLabTest.POST("/run", md.Tracer("web.lab.test.makeRunLabTests"), makeRunLabTests)
+			routerLabTest.GET("/runs", md.Tracer("web.lab.test.makeListLabTestRuns"), md.CheckPage, makeListLabTestRuns)
+			routerLabTest.GET("/runs/latest",
+				md.Tracer("web.lab.test.makeListLatestLabTestRuns"), md.CheckQueryID("labId"), md.RequireTeacher(srv),
+				makeListLatestLabTestRuns("labId"),
+			)
+			routerLabTest.GET("/run/:runID", md.Tracer("web.lab.test.makeGetLabTestRun"), md.CheckParamID("runID"), makeGetLabTestRun("runID"))
+		}
+
 		routerLabSumit := routerLab.Group("/summit")
 		{
 			routerLabSumit.GET("/comment", md.Tracer("web.lab.summit.makeGetCommentsByUserIDAndLabID"), makeGetCommentsByUserIDAndLabID)
@@ -207,7 +334,8 @@ func MakeWebHandler(router gin.IRouter, s *xhttp.UnionService) {
 				md.Tracer("web.lab.summit.makeClickPlagiarismURL"), md.CheckParamID("labID"), md.RequireTeacher(srv),
 				makeClickPlagiarismURL("labID"),
 			)
-			routerLabSumit.GET("/plagiarism/:labID", md.Tracer("web.lab.summit.makePlagiarismCheck"), md.CheckParamID("labID"), md.RequireTeacher(srv), makePlagiarismCheck("labID"))
+			routerLabSumit.GET("/archive", md.Tracer("web.lab.summit.makeGetSubmitArchive"), md.RequireTeacher(srv), makeGetSubmitArchive)
+			routerLabSumit.POST("/plagiarism/:labID", md.Tracer("web.lab.summit.makePlagiarismCheck"), md.CheckParamID("labID"), md.RequireTeacher(srv), makePlagiarismCheck("labID"))
 			routerLabSumit.PUT("/comment", md.Tracer("web.lab.summit.makeUpdateLabComment"), md.RequireTeacher(srv), makeUpdateLabComment)
 			routerLabSumit.PUT("/score", md.Tracer("web.lab.summit.makeUpdateLabSubmitScore"), md.RequireTeacher(srv), makeUpdateLabSubmitScore)
 
@@ -0,0 +1,247 @@
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"code-platform/api/http/md"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/httpx"
+	"code-platform/pkg/jsonx"
+
+	"github.com/gin-gonic/gin"
+)
+
+func abortWorkspaceErr(c *gin.Context, err error) {
+	switch err {
+	case errorx.ErrIsNotFound:
+		httpx.AbortNotFound(c, "file is not found")
+	case errorx.ErrFailToAuth:
+		httpx.AbortForbidden(c)
+	case errorx.ErrLabHasEnded:
+		httpx.AbortFailToAuth(c, "lab has ended")
+	case errorx.ErrInvalidPath:
+		httpx.AbortBadParamsErr(c, "path is invalid")
+	case errorx.ErrFileExists:
+		httpx.AbortBadParamsErr(c, "file already exists")
+	case errorx.ErrFileTooLarge:
+		httpx.AbortInvalidLength(c, "file is too large")
+	case context.Canceled:
+		c.Abort()
+	default:
+		httpx.AbortInternalErr(c)
+	}
+}
+
+type workspaceQueryRequest struct {
+	Path       string `form:"path"`
+	LabID      uint64 `form:"labId"`
+	StudentID  uint64 `form:"stuId"`
+	SnapshotID int64  `form:"snapshotId"`
+}
+
+// bindWorkspaceQuery 不传 stuId 时访问本人工作区
+func bindWorkspaceQuery(c *gin.Context) (*workspaceQueryRequest, bool) {
+	var req workspaceQueryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in workspace request")
+		return nil, false
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return nil, false
+	}
+
+	if req.SnapshotID < 0 {
+		httpx.AbortBadParamsErr(c, "snapshotId is invalid")
+		return nil, false
+	}
+
+	if req.StudentID == 0 {
+		req.StudentID = c.GetUint64(md.KeyUserID)
+	}
+	return &req, true
+}
+
+func makeListWorkspaceDir(c *gin.Context) {
+	req, ok := bindWorkspaceQuery(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint64(md.KeyUserID)
+	resp, err := srv.LabService.ListWorkspaceDir(c.Request.Context(), req.LabID, req.StudentID, userID, req.Path, req.SnapshotID)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeReadWorkspaceFile(c *gin.Context) {
+	req, ok := bindWorkspaceQuery(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint64(md.KeyUserID)
+	resp, err := srv.LabService.ReadWorkspaceFile(c.Request.Context(), req.LabID, req.StudentID, userID, req.Path, req.SnapshotID)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeWriteWorkspaceFile(c *gin.Context) {
+	type writeWorkspaceFileRequest struct {
+		Path    string `json:"path"`
+		Content string `json:"content"`
+		LabID   uint64 `json:"labId"`
+	}
+
+	var req writeWorkspaceFileRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in write workspace file request")
+		return
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	if err := srv.LabService.WriteWorkspaceFile(c.Request.Context(), req.LabID, studentID, req.Path, req.Content); err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeCreateWorkspaceFile(c *gin.Context) {
+	type createWorkspaceFileRequest struct {
+		Path  string `json:"path"`
+		LabID uint64 `json:"labId"`
+		IsDir bool   `json:"isDir"`
+	}
+
+	var req createWorkspaceFileRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in create workspace file request")
+		return
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	if err := srv.LabService.CreateWorkspaceFile(c.Request.Context(), req.LabID, studentID, req.Path, req.IsDir); err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeRenameWorkspaceFile(c *gin.Context) {
+	type renameWorkspaceFileRequest struct {
+		From  string `json:"from"`
+		To    string `json:"to"`
+		LabID uint64 `json:"labId"`
+	}
+
+	var req renameWorkspaceFileRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in rename workspace file request")
+		return
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	if err := srv.LabService.RenameWorkspaceFile(c.Request.Context(), req.LabID, studentID, req.From, req.To); err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeDeleteWorkspaceFile(c *gin.Context) {
+	type deleteWorkspaceFileRequest struct {
+		Path  string `json:"path"`
+		LabID uint64 `json:"labId"`
+	}
+
+	var req deleteWorkspaceFileRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in delete workspace file request")
+		return
+	}
+
+	if req.LabID <= 0 {
+		httpx.AbortBadParamsErr(c, "labId is invalid")
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	if err := srv.LabService.DeleteWorkspaceFile(c.Request.Context(), req.LabID, studentID, req.Path); err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func makeListWorkspaceSnapshots(c *gin.Context) {
+	req, ok := bindWorkspaceQuery(c)
+	if !ok {
+		return
+	}
+
+	userID := c.GetUint64(md.KeyUserID)
+	resp, err := srv.LabService.ListWorkspaceSnapshots(c.Request.Context(), req.LabID, req.StudentID, userID)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(resp)))
+}
+
+func makeRestoreWorkspaceSnapshot(c *gin.Context) {
+	type restoreWorkspaceSnapshotRequest struct {
+		LabID      uint64 `json:"labId"`
+		SnapshotID int64  `json:"snapshotId"`
+	}
+
+	var req restoreWorkspaceSnapshotRequest
+	if err := c.ShouldBindWith(&req, jsonx.SonicDecoder); err != nil {
+		httpx.AbortGetParamsErr(c, "Fail to get params in restore workspace snapshot request")
+		return
+	}
+
+	if req.LabID <= 0 || req.SnapshotID <= 0 {
+		httpx.AbortBadParamsErr(c, "id is invalid")
+		return
+	}
+
+	studentID := c.GetUint64(md.KeyUserID)
+	safetySnapshotID, err := srv.LabService.RestoreWorkspaceSnapshot(c.Request.Context(), req.LabID, studentID, req.SnapshotID)
+	if err != nil {
+		abortWorkspaceErr(c, err)
+		return
+	}
+
+	c.Render(http.StatusOK, jsonx.NewSonicEncoder(httpx.NewJSONResponse(gin.H{"safety_snapshot_id": safetySnapshotID})))
+}
@@ -11,6 +11,7 @@ import (
 	"code-platform/service/ide"
 	"code-platform/service/lab"
 	"code-platform/service/monaco"
+	"code-platform/service/scheduler"
 	"code-platform/service/user"
 )
 
@@ -24,6 +25,7 @@ type UnionService struct {
 	FileService           *file.FileService
 	IDEService            *ide.IDEService
 	MonacoService         *monaco.MonacoService
+	SchedulerService      *scheduler.SchedulerService
 }
 
 func NewUnionService() *UnionService {
@@ -31,15 +33,23 @@ func NewUnionService() *UnionService {
 	serviceLogger := log.Sub("service")
 	ideClient := ide.NewIDEClient()
 	monacoClient := monaco.NewMonacoClient()
+	schedulerService := scheduler.NewSchedulerService(dao, serviceLogger.Sub("scheduler"))
+	ideService := ide.NewIDEService(dao, serviceLogger.Sub("ide"), ideClient)
+	ideService.RegisterJobs(schedulerService)
+	labService := lab.NewLabService(dao, serviceLogger.Sub("lab"), lab.NewPlagiarismDetectionClient(), ideClient, monacoClient)
+	labService.RegisterJobs(schedulerService)
+	// 注册全部任务后再开始调度
+	schedulerService.Start()
 	return &UnionService{
 		CheckInService:        checkin.NewCheckInService(dao, serviceLogger.Sub("checkIn")),
 		CommentService:        comment.NewCommentService(dao, serviceLogger.Sub("comment")),
 		UserService:           user.NewUserService(dao, serviceLogger.Sub("user")),
-		LabService:            lab.NewLabService(dao, serviceLogger.Sub("lab"), lab.NewPlagiarismDetectionClient(), ideClient, monacoClient),
+		LabService:            labService,
 		CourseResourceService: courseResource.NewCourseResourceService(dao, serviceLogger.Sub("course_resource")),
 		CourseService:         course.NewCourseService(dao, serviceLogger.Sub("course")),
 		FileService:           file.NewFileService(dao, serviceLogger.Sub("file")),
-		IDEService:            ide.NewIDEService(dao, serviceLogger.Sub("ide"), ideClient),
+		IDEService:            ideService,
 		MonacoService:         monaco.NewMonacoService(dao, log.Sub("monaco"), monacoClient),
+		SchedulerService:      schedulerService,
 	}
 }
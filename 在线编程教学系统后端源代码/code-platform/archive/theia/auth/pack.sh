@@ -3,7 +3,16 @@ node /home/theia/src-gen/backend/main.js /home/project "$@" &
 THEIAPID=$!
 sleep 3s
 if kill -0 $THEIAPID > /dev/null 2> /dev/null; then
-  cert="$CERTFILE" key="$KEYFILE" secure=$secure /usr/local/bin/gen-http-proxy localhost:3000
+  # 代理退出后重新读取 token 再启动，IDE Server 认领预热容器时借此轮换 token
+  while kill -0 $THEIAPID > /dev/null 2> /dev/null; do
+    if [ -f /home/theia/ssl/token ]; then
+      token=$(cat /home/theia/ssl/token)
+      export token
+    fi
+    cert="$CERTFILE" key="$KEYFILE" secure=$secure /usr/local/bin/gen-http-proxy localhost:3000 &
+    echo $! > /home/theia/ssl/proxy.pid
+    wait $!
+  done
   kill $THEIAPID
 else
   echo "could not spawn theia";
@@ -18,6 +18,9 @@ var (
 	IDEServer    *viper.Viper
 	MonacoServer *viper.Viper
 	Mail         *viper.Viper
+
+	PlagiarismDetectionServer *viper.Viper
+	ReportPlagiarism          *viper.Viper
 )
 
 func init() {
@@ -40,6 +43,8 @@ func init() {
 		"windows": "C://code_platform",
 		"linux":   "/code_platform/workspace",
 	})
+	// 检查已截止实验并冻结其工作区的周期
+	viper.SetDefault("workspace.freeze_interval", "1m")
 
 	viper.SetDefault("minio.endpoint", "127.0.0.1:9100")
 	viper.SetDefault("minio.accessKeyID", "admin")
@@ -49,6 +54,10 @@ func init() {
 		"report":     "report",
 		"attachment": "attachment",
 		"video":      "video",
+		// 截止时冻结的工作区归档，不对外公开
+		"submissions": "submissions",
+		// 实验初始代码模板，不对外公开
+		"template": "template",
 	})
 
 	minioHost := os.Getenv("MINIO_HOST")
@@ -76,7 +85,126 @@ func init() {
 	})
 
 	viper.SetDefault("ide_server.port", 8085)
+	// 容器启动后探测就绪的超时时间与轮询间隔
+	viper.SetDefault("ide_server.ready_timeout", "60s")
+	viper.SetDefault("ide_server.ready_probe_interval", "300ms")
+	// 预热容器池：各语言常驻的空闲容器数（为 0 则不预热），补充周期与每轮最多启动的容器数
+	viper.SetDefault("ide_server.pool", map[string]interface{}{
+		"size": map[string]interface{}{
+			"cpp":     1,
+			"java":    1,
+			"python3": 2,
+		},
+		"refill_interval": "10s",
+		"refill_batch":    2,
+	})
+	// 休眠容器回收：超过 max_age 的直接回收，其余按停止时间由旧到新回收直至总磁盘占用不超过 disk_budget
+	viper.SetDefault("ide_server.hibernate", map[string]interface{}{
+		"max_age":     "168h",
+		"disk_budget": "20GB",
+		"gc_interval": "30m",
+	})
+	// 工作区文件接口单文件读写上限
+	viper.SetDefault("ide_server.workspace.max_file_size", 2<<20)
+	// 教师快速查看代码：单文件与全部内容的大小上限、目录树最大条目数，以及在 .gitignore 之外默认忽略的路径
+	viper.SetDefault("ide_server.quick_view", map[string]interface{}{
+		"max_file_size":  256 << 10,
+		"max_total_size": 4 << 20,
+		"max_entries":    2000,
+		// .ipynb 单独查看时的内容上限、可解析的最大文件与单个文本输出的上限
+		"max_notebook_size":      4 << 20,
+		"max_notebook_file_size": 32 << 20,
+		"max_notebook_output":    16 << 10,
+		"ignore": []string{
+			".git/", "node_modules/", "__pycache__/", "*.pyc",
+			"build/", "dist/", "target/", "out/", "bin/", "obj/",
+			".idea/", ".vscode/", "*.class", "*.o", ".ipynb_checkpoints/",
+		},
+	})
+	// 工作区快照：周期快照间隔、心跳触发快照的最小间隔、单文件与文件数上限，保留最近 keep_last 个及 keep_days 天内每天最后一个
+	viper.SetDefault("ide_server.snapshot", map[string]interface{}{
+		"interval":           "15m",
+		"heartbeat_interval": "5m",
+		"max_file_size":      5 << 20,
+		"max_files":          5000,
+		"keep_last":          30,
+		"keep_days":          14,
+	})
+	// 工作区对比：默认上下文行数与单次对比最多返回的差异行数
+	viper.SetDefault("ide_server.diff", map[string]interface{}{
+		"context_lines": 3,
+		"max_lines":     20000,
+	})
+	// 截止冻结：归档的单文件与文件数上限，以及流式返回归档时的分块大小
+	viper.SetDefault("ide_server.freeze", map[string]interface{}{
+		"max_file_size": 50 << 20,
+		"max_files":     20000,
+		"chunk_size":    256 << 10,
+	})
+	// 实验初始代码模板：zip 解压后的总大小与文件数上限
+	viper.SetDefault("ide_server.template", map[string]interface{}{
+		"max_size":  50 << 20,
+		"max_files": 2000,
+	})
+	// 测试运行：同时运行的沙箱数、单次运行的最长时间、沙箱资源限制，以及返回的输出与 JUnit 报告的大小上限
+	viper.SetDefault("ide_server.test", map[string]interface{}{
+		"max_concurrent":  2,
+		"max_timeout":     "10m",
+		"cpus":            "1",
+		"memory":          "1g",
+		"pids_limit":      256,
+		"max_output":      64 << 10,
+		"max_reports":     20,
+		"max_report_size": 1 << 20,
+	})
+	// 终端模式 IDE：各语言的轻量镜像、容器资源限制、容器内的非 root 用户，以及分配 TTY 所用的 Docker Engine API 地址
+	viper.SetDefault("ide_server.terminal", map[string]interface{}{
+		"image_name": map[string]string{
+			"cpp":     "gcc:11",
+			"java":    "eclipse-temurin:17-jdk",
+			"python3": "python:3.10-slim",
+		},
+		"cpus":          "0.25",
+		"memory":        "256m",
+		"pids_limit":    128,
+		"uid":           1000,
+		"gid":           1000,
+		"docker_socket": "/var/run/docker.sock",
+	})
+	// Jupyter IDE：镜像与容器资源限制
+	viper.SetDefault("ide_server.jupyter", map[string]interface{}{
+		"image_name":  "jupyter/scipy-notebook",
+		"cpus":        "0.5",
+		"memory":      "1g",
+		"memory_swap": "1500m",
+	})
+	// 课程自定义镜像：同时构建的镜像数与单次构建的最长时间
+	viper.SetDefault("ide_server.image_build", map[string]interface{}{
+		"max_concurrent": 1,
+		"timeout":        "30m",
+	})
 	viper.SetDefault("monaco_server.port", 8087)
+	viper.SetDefault("plagiarism_detection_server.port", 8086)
+	// 查重：报告保存的目录、同时进行的查重数、单文件大小上限，以及不参与查重的路径
+	viper.SetDefault("plagiarism_detection_server.report_path", "/results")
+	viper.SetDefault("plagiarism_detection_server.max_concurrent", 1)
+	viper.SetDefault("plagiarism_detection_server.max_file_size", 1<<20)
+	// 随查重请求发送的基础代码总大小上限，需小于 gRPC 默认 4MB 的消息上限
+	viper.SetDefault("plagiarism_detection_server.max_base_size", 2<<20)
+	viper.SetDefault("plagiarism_detection_server.ignore", []string{
+		".git/", "node_modules/", "__pycache__/", "build/", "dist/", "target/", "out/", "bin/", "obj/",
+		".idea/", ".vscode/", ".ipynb_checkpoints/",
+	})
+	// 实验报告查重：单份报告的大小上限与提取后参与比对的字符数上限，shingle 长度、MinHash 签名长度、
+	// 返回的最低相似度，以及每组返回的相同段落数
+	viper.SetDefault("report_plagiarism", map[string]interface{}{
+		"max_file_size":  20 << 20,
+		"max_text_size":  1 << 20,
+		"shingle_size":   8,
+		"num_hashes":     128,
+		"min_similarity": 0.1,
+		"max_passages":   20,
+	})
 
 	Mysql = viper.Sub("mysql")
 	Redis = viper.Sub("redis")
@@ -87,6 +215,8 @@ func init() {
 	Monaco = viper.Sub("monaco")
 	IDEServer = viper.Sub("ide_server")
 	MonacoServer = viper.Sub("monaco_server")
+	PlagiarismDetectionServer = viper.Sub("plagiarism_detection_server")
+	ReportPlagiarism = viper.Sub("report_plagiarism")
 
 	Mail = viper.New()
 	path, err := os.Getwd()
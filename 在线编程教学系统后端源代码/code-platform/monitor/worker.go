@@ -2,13 +2,52 @@ package monitor
 
 import "github.com/prometheus/client_golang/prometheus"
 
+// LabelHolder 实例标识为 hostname:pid，不使用 instance 以免与抓取目标的标签冲突
+const LabelHolder = "holder"
+
 var (
 	IDESweaterCollector = prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "ide_sweater_duration_milliseconds",
 		Help: "ide_sweater work latency distributions.",
 	})
+
+	// BackgroundJobLeaderCollector 每个实例上报自身是否持有后台任务租约
+	BackgroundJobLeaderCollector = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "background_job_leader",
+		Help: "Whether this instance holds the background job lease (1) or not (0).",
+	}, []string{LabelHolder})
+
+	// BackgroundJobLeaseHolderCollector 各实例观察到的租约持有者，只有一个标签值为 1
+	BackgroundJobLeaseHolderCollector = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "background_job_lease_holder",
+		Help: "Current holder of the background job lease as observed by this instance.",
+	}, []string{LabelHolder})
+
+	BackgroundJobFencingTokenCollector = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "background_job_fencing_token",
+		Help: "Fencing token of the background job lease held by this instance, 0 if not the leader.",
+	})
+
+	// JobRunCounterCollector 每次执行的结果，result 为 succeeded 或 failed，失败后重试的每次执行都计入
+	JobRunCounterCollector = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "job_runs_total",
+		Help: "Total number of job runs by job and result.",
+	}, []string{"job", "result"})
+
+	JobRunDurationCollector = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "job_run_duration_seconds",
+		Help:    "job run latency distributions.",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"job"})
 )
 
 func init() {
-	prometheus.MustRegister(IDESweaterCollector)
+	prometheus.MustRegister(
+		IDESweaterCollector,
+		BackgroundJobLeaderCollector,
+		BackgroundJobLeaseHolderCollector,
+		BackgroundJobFencingTokenCollector,
+		JobRunCounterCollector,
+		JobRunDurationCollector,
+	)
 }
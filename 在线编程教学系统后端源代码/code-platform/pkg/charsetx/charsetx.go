@@ -2,11 +2,36 @@ package charsetx
 
 import (
 	"io"
+	"unicode/utf8"
 
 	"golang.org/x/text/encoding/simplifiedchinese"
 	"golang.org/x/text/transform"
 )
 
+const (
+	EncodingUTF8 = "utf-8"
+	EncodingGBK  = "gbk"
+)
+
 func GBKToUTF8(reader io.Reader) io.Reader {
 	return transform.NewReader(reader, simplifiedchinese.GBK.NewDecoder())
 }
+
+// ToUTF8 识别 UTF-8 与 GBK 编码的文本并统一转换为 UTF-8，两者均不符合时 ok 为 false
+func ToUTF8(data []byte) (content string, encoding string, ok bool) {
+	if utf8.Valid(data) {
+		return string(data), EncodingUTF8, true
+	}
+
+	decoded, err := simplifiedchinese.GBK.NewDecoder().Bytes(data)
+	if err != nil || !utf8.Valid(decoded) {
+		return "", "", false
+	}
+	// GBK 解码器遇到非法字节时会替换为 U+FFFD
+	for _, r := range string(decoded) {
+		if r == utf8.RuneError {
+			return "", "", false
+		}
+	}
+	return string(decoded), EncodingGBK, true
+}
@@ -0,0 +1,28 @@
+package charsetx_test
+
+import (
+	"testing"
+
+	. "code-platform/pkg/charsetx"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToUTF8(t *testing.T) {
+	for _, c := range []struct {
+		label            string
+		data             []byte
+		expectedContent  string
+		expectedEncoding string
+		expectedOK       bool
+	}{
+		{label: "utf-8", data: []byte("你好"), expectedContent: "你好", expectedEncoding: EncodingUTF8, expectedOK: true},
+		{label: "gbk", data: []byte{0xc4, 0xe3, 0xba, 0xc3}, expectedContent: "你好", expectedEncoding: EncodingGBK, expectedOK: true},
+		{label: "invalid", data: []byte{0xff, 0xff, 0x81}, expectedOK: false},
+	} {
+		content, encoding, ok := ToUTF8(c.data)
+		require.Equal(t, c.expectedOK, ok, c.label)
+		require.Equal(t, c.expectedContent, content, c.label)
+		require.Equal(t, c.expectedEncoding, encoding, c.label)
+	}
+}
@@ -0,0 +1,179 @@
+package cronx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 返回 t 之后的下一次执行时间
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// everySchedule @every 指定的固定间隔，从上一次执行时间起算
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (e everySchedule) Next(t time.Time) time.Time {
+	return t.Add(e.interval)
+}
+
+// fieldSchedule 分 时 日 月 周 五个字段，每个字段为取值的位图
+type fieldSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar, dowStar 日与周同时被限制时满足其一即可，与 crontab 一致
+	domStar, dowStar bool
+	location         *time.Location
+}
+
+type bounds struct {
+	min, max int
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	// 周日可写作 0 或 7
+	dowBounds = bounds{0, 7}
+)
+
+var descriptors = map[string]string{
+	"@yearly":  "0 0 1 1 *",
+	"@monthly": "0 0 1 * *",
+	"@weekly":  "0 0 * * 0",
+	"@daily":   "0 0 * * *",
+	"@hourly":  "0 * * * *",
+}
+
+// Parse 解析标准的五字段 cron 表达式（支持 *、a-b、*/n、a-b/n 与逗号分隔的列表）、
+// @daily 等简写以及 @every <duration>；五字段表达式按 location 计算
+func Parse(spec string, location *time.Location) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(spec[len("@every "):]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval in %q: %w", spec, err)
+		}
+		if interval < time.Second {
+			return nil, fmt.Errorf("interval in %q is less than one second", spec)
+		}
+		return everySchedule{interval: interval}, nil
+	}
+	if descriptor, ok := descriptors[spec]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in %q, found %d", spec, len(fields))
+	}
+	s := &fieldSchedule{location: location}
+	var err error
+	for _, f := range []struct {
+		field  string
+		bounds bounds
+		bits   *uint64
+	}{
+		{fields[0], minuteBounds, &s.minute},
+		{fields[1], hourBounds, &s.hour},
+		{fields[2], domBounds, &s.dom},
+		{fields[3], monthBounds, &s.month},
+		{fields[4], dowBounds, &s.dow},
+	} {
+		if *f.bits, err = parseField(f.field, f.bounds); err != nil {
+			return nil, fmt.Errorf("invalid field %q in %q: %w", f.field, spec, err)
+		}
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = fields[2] == "*"
+	s.dowStar = fields[4] == "*"
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if index := strings.IndexByte(part, '/'); index >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[index+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			rangePart = part[:index]
+		}
+
+		var start, end int
+		switch index := strings.IndexByte(rangePart, '-'); {
+		case rangePart == "*":
+			start, end = b.min, b.max
+		case index >= 0:
+			var err1, err2 error
+			start, err1 = strconv.Atoi(rangePart[:index])
+			end, err2 = strconv.Atoi(rangePart[index+1:])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", rangePart)
+			}
+			start, end = value, value
+			// n/step 表示从 n 开始到最大值
+			if step != 1 {
+				end = b.max
+			}
+		}
+		if start < b.min || end > b.max || start > end {
+			return 0, fmt.Errorf("%q is out of range [%d, %d]", rangePart, b.min, b.max)
+		}
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+// Next 逐级跳过不匹配的月、日、时、分，最多搜索五年，找不到时返回零值
+func (s *fieldSchedule) Next(t time.Time) time.Time {
+	original := t.Location()
+	t = t.In(s.location).Add(time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	yearLimit := t.Year() + 5
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.location)
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.location)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t.In(original)
+	}
+	return time.Time{}
+}
+
+func (s *fieldSchedule) matchDay(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,60 @@
+package cronx_test
+
+import (
+	"testing"
+	"time"
+
+	. "code-platform/pkg/cronx"
+	"code-platform/pkg/timex"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAndNext(t *testing.T) {
+	location := timex.ShanghaiLocation
+	// 2022-03-01 是周二
+	from := time.Date(2022, 3, 1, 10, 17, 30, 0, location)
+
+	for _, c := range []struct {
+		spec string
+		next time.Time
+	}{
+		{spec: "* * * * *", next: time.Date(2022, 3, 1, 10, 18, 0, 0, location)},
+		{spec: "*/15 * * * *", next: time.Date(2022, 3, 1, 10, 30, 0, 0, location)},
+		{spec: "5 * * * *", next: time.Date(2022, 3, 1, 11, 5, 0, 0, location)},
+		{spec: "0 3 * * *", next: time.Date(2022, 3, 2, 3, 0, 0, 0, location)},
+		{spec: "@daily", next: time.Date(2022, 3, 2, 0, 0, 0, 0, location)},
+		{spec: "30 9-17/4 * * 1-5", next: time.Date(2022, 3, 1, 13, 30, 0, 0, location)},
+		{spec: "0 0 * * 7", next: time.Date(2022, 3, 6, 0, 0, 0, 0, location)},
+		{spec: "0 0 31 * *", next: time.Date(2022, 3, 31, 0, 0, 0, 0, location)},
+		{spec: "0 0 29 2 *", next: time.Date(2024, 2, 29, 0, 0, 0, 0, location)},
+		// 日与周同时限制时满足其一即可
+		{spec: "0 0 15 * 4", next: time.Date(2022, 3, 3, 0, 0, 0, 0, location)},
+		{spec: "@every 5m", next: from.Add(5 * time.Minute)},
+	} {
+		schedule, err := Parse(c.spec, location)
+		require.NoError(t, err, c.spec)
+		require.True(t, c.next.Equal(schedule.Next(from)), "%s: %v", c.spec, schedule.Next(from))
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every",
+		"@every 10ms",
+		"@every soon",
+	} {
+		_, err := Parse(spec, timex.ShanghaiLocation)
+		require.Error(t, err, spec)
+	}
+}
@@ -0,0 +1,300 @@
+package diffx
+
+import "strings"
+
+type Kind int8
+
+const (
+	Equal Kind = iota
+	Insert
+	Delete
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	default:
+		return "equal"
+	}
+}
+
+// Line 编辑脚本中的一行，OldLine、NewLine 为从 1 开始的行号，该侧不存在时为 0
+type Line struct {
+	Text    string
+	Kind    Kind
+	OldLine int
+	NewLine int
+}
+
+// Hunk 统一格式 diff 中的一段，Start 的取值与 diff -u 一致
+type Hunk struct {
+	Lines    []Line
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+}
+
+// SplitLines 按行切分文本，兼容 \r\n，末尾换行不产生空行
+func SplitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	for index, line := range lines {
+		lines[index] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// Diff 使用 Myers 算法计算 a 到 b 的最短行级编辑脚本，线性空间
+func Diff(a, b []string) []Line {
+	ids := make(map[string]int, len(a)+len(b))
+	toIDs := func(lines []string) []int {
+		result := make([]int, len(lines))
+		for index, line := range lines {
+			id, ok := ids[line]
+			if !ok {
+				id = len(ids)
+				ids[line] = id
+			}
+			result[index] = id
+		}
+		return result
+	}
+
+	d := &differ{a: toIDs(a), b: toIDs(b)}
+	d.compare(0, len(a), 0, len(b))
+
+	lines := make([]Line, 0, len(a)+len(b))
+	var oldIndex, newIndex int
+	for _, e := range d.edits {
+		for counter := 0; counter < e.count; counter++ {
+			switch e.kind {
+			case Equal:
+				lines = append(lines, Line{Kind: Equal, Text: a[oldIndex], OldLine: oldIndex + 1, NewLine: newIndex + 1})
+				oldIndex++
+				newIndex++
+			case Delete:
+				lines = append(lines, Line{Kind: Delete, Text: a[oldIndex], OldLine: oldIndex + 1})
+				oldIndex++
+			case Insert:
+				lines = append(lines, Line{Kind: Insert, Text: b[newIndex], NewLine: newIndex + 1})
+				newIndex++
+			}
+		}
+	}
+	return lines
+}
+
+// Hunks 将编辑脚本按 context 行上下文分段，间隔不超过 2*context 行的修改合并为一段
+func Hunks(lines []Line, context int) []*Hunk {
+	if context < 0 {
+		context = 0
+	}
+
+	var (
+		hunks []*Hunk
+		start = -1
+		end   int
+	)
+	for index, line := range lines {
+		if line.Kind == Equal {
+			continue
+		}
+		lo, hi := index-context, index+context+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(lines) {
+			hi = len(lines)
+		}
+		if start >= 0 && lo <= end {
+			end = hi
+			continue
+		}
+		if start >= 0 {
+			hunks = append(hunks, newHunk(lines, start, end))
+		}
+		start, end = lo, hi
+	}
+	if start >= 0 {
+		hunks = append(hunks, newHunk(lines, start, end))
+	}
+	return hunks
+}
+
+func newHunk(lines []Line, start, end int) *Hunk {
+	h := &Hunk{Lines: lines[start:end]}
+	for _, line := range h.Lines {
+		if line.Kind != Insert {
+			if h.OldLines == 0 {
+				h.OldStart = line.OldLine
+			}
+			h.OldLines++
+		}
+		if line.Kind != Delete {
+			if h.NewLines == 0 {
+				h.NewStart = line.NewLine
+			}
+			h.NewLines++
+		}
+	}
+
+	// 某侧无行时起始行号取该段之前的最后一行
+	if h.OldLines == 0 {
+		h.OldStart = lastLine(lines[:start], func(l Line) int { return l.OldLine })
+	}
+	if h.NewLines == 0 {
+		h.NewStart = lastLine(lines[:start], func(l Line) int { return l.NewLine })
+	}
+	return h
+}
+
+func lastLine(lines []Line, lineNo func(Line) int) int {
+	for index := len(lines) - 1; index >= 0; index-- {
+		if n := lineNo(lines[index]); n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+type edit struct {
+	kind  Kind
+	count int
+}
+
+type differ struct {
+	a, b  []int
+	edits []edit
+}
+
+func (d *differ) emit(kind Kind, count int) {
+	if count == 0 {
+		return
+	}
+	if n := len(d.edits); n > 0 && d.edits[n-1].kind == kind {
+		d.edits[n-1].count += count
+		return
+	}
+	d.edits = append(d.edits, edit{kind: kind, count: count})
+}
+
+// compare 比较 a[aLo:aHi] 与 b[bLo:bHi]，按顺序输出编辑
+func (d *differ) compare(aLo, aHi, bLo, bHi int) {
+	var prefix int
+	for aLo+prefix < aHi && bLo+prefix < bHi && d.a[aLo+prefix] == d.b[bLo+prefix] {
+		prefix++
+	}
+	d.emit(Equal, prefix)
+	aLo, bLo = aLo+prefix, bLo+prefix
+
+	var suffix int
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.a[aHi-suffix-1] == d.b[bHi-suffix-1] {
+		suffix++
+	}
+	aHi, bHi = aHi-suffix, bHi-suffix
+
+	switch {
+	case aLo == aHi:
+		d.emit(Insert, bHi-bLo)
+	case bLo == bHi:
+		d.emit(Delete, aHi-aLo)
+	default:
+		x, y, ok := d.bisect(aLo, aHi, bLo, bHi)
+		if ok {
+			d.compare(aLo, x, bLo, y)
+			d.compare(x, aHi, y, bHi)
+		} else {
+			d.emit(Delete, aHi-aLo)
+			d.emit(Insert, bHi-bLo)
+		}
+	}
+	d.emit(Equal, suffix)
+}
+
+// bisect 同时从两端搜索，返回最短编辑路径的中间分割点
+func (d *differ) bisect(aLo, aHi, bLo, bHi int) (int, int, bool) {
+	n, m := aHi-aLo, bHi-bLo
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	length := 2*maxD + 2
+
+	forward := make([]int, length)
+	backward := make([]int, length)
+	for index := range forward {
+		forward[index], backward[index] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	// delta 为奇数时两端路径在正向搜索中相遇，否则在反向搜索中相遇
+	front := delta%2 != 0
+	var kStart1, kEnd1, kStart2, kEnd2 int
+	for step := 0; step < maxD; step++ {
+		for k := -step + kStart1; k <= step-kEnd1; k += 2 {
+			index := offset + k
+			var x int
+			if k == -step || (k != step && forward[index-1] < forward[index+1]) {
+				x = forward[index+1]
+			} else {
+				x = forward[index-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.a[aLo+x] == d.b[bLo+y] {
+				x++
+				y++
+			}
+			forward[index] = x
+
+			switch {
+			case x > n:
+				kEnd1 += 2
+			case y > m:
+				kStart1 += 2
+			case front:
+				other := offset + delta - k
+				if other >= 0 && other < length && backward[other] != -1 && x >= n-backward[other] {
+					return aLo + x, bLo + y, true
+				}
+			}
+		}
+
+		for k := -step + kStart2; k <= step-kEnd2; k += 2 {
+			index := offset + k
+			var x int
+			if k == -step || (k != step && backward[index-1] < backward[index+1]) {
+				x = backward[index+1]
+			} else {
+				x = backward[index-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.a[aHi-x-1] == d.b[bHi-y-1] {
+				x++
+				y++
+			}
+			backward[index] = x
+
+			switch {
+			case x > n:
+				kEnd2 += 2
+			case y > m:
+				kStart2 += 2
+			case !front:
+				other := offset + delta - k
+				if other >= 0 && other < length && forward[other] != -1 {
+					x1 := forward[other]
+					y1 := offset + x1 - other
+					if x1 >= n-x {
+						return aLo + x1, bLo + y1, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,114 @@
+package diffx_test
+
+import (
+	"strings"
+	"testing"
+
+	. "code-platform/pkg/diffx"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// render 以 diff -u 的形式输出编辑脚本
+func render(lines []Line) string {
+	var builder strings.Builder
+	for _, line := range lines {
+		switch line.Kind {
+		case Equal:
+			builder.WriteByte(' ')
+		case Insert:
+			builder.WriteByte('+')
+		case Delete:
+			builder.WriteByte('-')
+		}
+		builder.WriteString(line.Text)
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+func TestDiff(t *testing.T) {
+	for _, c := range []struct {
+		label    string
+		a        string
+		b        string
+		expected string
+	}{
+		{label: "both empty", a: "", b: "", expected: ""},
+		{label: "insert all", a: "", b: "a\nb\n", expected: "+a\n+b\n"},
+		{label: "delete all", a: "a\nb\n", b: "", expected: "-a\n-b\n"},
+		{label: "same", a: "a\nb\n", b: "a\r\nb\r\n", expected: " a\n b\n"},
+		{label: "replace middle", a: "a\nb\nc\n", b: "a\nx\nc\n", expected: " a\n-b\n+x\n c\n"},
+	} {
+		a, b := SplitLines(c.a), SplitLines(c.b)
+		lines := Diff(a, b)
+		assert.Equal(t, c.expected, render(lines), c.label)
+
+		// 编辑脚本应能还原出两侧文本
+		var old, new []string
+		for _, line := range lines {
+			if line.Kind != Insert {
+				old = append(old, line.Text)
+				assert.Equal(t, len(old), line.OldLine, c.label)
+			}
+			if line.Kind != Delete {
+				new = append(new, line.Text)
+				assert.Equal(t, len(new), line.NewLine, c.label)
+			}
+		}
+		assert.Equal(t, a, old, c.label)
+		assert.Equal(t, b, new, c.label)
+	}
+}
+
+func countChanges(lines []Line) int {
+	var changes int
+	for _, line := range lines {
+		if line.Kind != Equal {
+			changes++
+		}
+	}
+	return changes
+}
+
+func TestDiffIsMinimal(t *testing.T) {
+	// Myers 论文中的示例，最短编辑距离为 5
+	require.Equal(t, 5, countChanges(Diff(SplitLines("a\nb\nc\na\nb\nb\na\n"), SplitLines("c\nb\na\nb\na\nc\n"))))
+
+	a := SplitLines(strings.Repeat("x\ny\nz\n", 50))
+	b := make([]string, 0, len(a))
+	for index, line := range a {
+		if index%7 == 0 {
+			b = append(b, "changed")
+			continue
+		}
+		b = append(b, line)
+	}
+
+	// 每处修改为一删一增
+	require.Equal(t, 2*((len(a)+6)/7), countChanges(Diff(a, b)))
+}
+
+func TestHunks(t *testing.T) {
+	a := SplitLines("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+	b := SplitLines("1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n")
+
+	hunks := Hunks(Diff(a, b), 1)
+	require.Len(t, hunks, 2)
+	assert.Equal(t, []int{2, 3, 2, 3}, []int{hunks[0].OldStart, hunks[0].OldLines, hunks[0].NewStart, hunks[0].NewLines})
+	assert.Equal(t, " 2\n-3\n+three\n 4\n", render(hunks[0].Lines))
+	assert.Equal(t, []int{10, 1, 10, 2}, []int{hunks[1].OldStart, hunks[1].OldLines, hunks[1].NewStart, hunks[1].NewLines})
+
+	// 上下文足够大时合并为一段
+	hunks = Hunks(Diff(a, b), 4)
+	require.Len(t, hunks, 1)
+	assert.Equal(t, 1, hunks[0].OldStart)
+
+	// 新增文件
+	hunks = Hunks(Diff(nil, b[:2]), 3)
+	require.Len(t, hunks, 1)
+	assert.Equal(t, []int{0, 0, 1, 2}, []int{hunks[0].OldStart, hunks[0].OldLines, hunks[0].NewStart, hunks[0].NewLines})
+
+	assert.Empty(t, Hunks(Diff(a, a), 3))
+}
@@ -0,0 +1,48 @@
+// Package documentx 从实验报告（txt、docx、pdf）中提取纯文本，供报告查重使用，不保留排版与图片
+package documentx
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	"code-platform/pkg/charsetx"
+)
+
+var (
+	// ErrUnsupportedFormat 扩展名不受支持（如 .doc），或 PDF 已加密
+	ErrUnsupportedFormat = errors.New("unsupported document format")
+	ErrInvalidDocument   = errors.New("invalid document")
+	// ErrTooLarge 解压后的内容超过上限
+	ErrTooLarge = errors.New("document is too large")
+)
+
+// maxDecodedSize 单个 docx 部件或 PDF 流解压后的大小上限，防止压缩炸弹
+const maxDecodedSize = 64 << 20
+
+// IsSupported 是否可以提取 name 对应格式的文本
+func IsSupported(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".txt", ".docx", ".pdf":
+		return true
+	}
+	return false
+}
+
+// ExtractText 按 name 的扩展名提取文本，段落之间以换行分隔
+func ExtractText(name string, data []byte) (string, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".txt":
+		content, _, ok := charsetx.ToUTF8(data)
+		if !ok {
+			return "", ErrInvalidDocument
+		}
+		return strings.TrimPrefix(content, "\ufeff"), nil
+	case ".docx":
+		return extractDocx(data)
+	case ".pdf":
+		return extractPDF(data)
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
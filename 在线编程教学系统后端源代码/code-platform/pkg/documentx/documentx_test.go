@@ -0,0 +1,117 @@
+package documentx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "code-platform/pkg/documentx"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestExtractTextTxt(t *testing.T) {
+	text, err := ExtractText("report.TXT", []byte("\ufeff实验报告\n结论"))
+	require.NoError(t, err)
+	require.Equal(t, "实验报告\n结论", text)
+
+	gbk, err := simplifiedchinese.GBK.NewEncoder().Bytes([]byte("实验报告"))
+	require.NoError(t, err)
+	text, err = ExtractText("report.txt", gbk)
+	require.NoError(t, err)
+	require.Equal(t, "实验报告", text)
+
+	_, err = ExtractText("report.doc", nil)
+	require.Equal(t, ErrUnsupportedFormat, err)
+	require.False(t, IsSupported("report.doc"))
+	require.True(t, IsSupported("report.PDF"))
+}
+
+func TestExtractTextDocx(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	fw, err := zw.Create("word/document.xml")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>实验</w:t></w:r><w:r><w:t xml:space="preserve">目的 </w:t></w:r></w:p>
+<w:p><w:r><w:t>a</w:t><w:tab/><w:t>b</w:t><w:br/><w:t>c</w:t></w:r><w:r><w:instrText>PAGE</w:instrText></w:r></w:p>
+</w:body></w:document>`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	text, err := ExtractText("report.docx", buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, "实验目的 \na\tb\nc\n", text)
+
+	_, err = ExtractText("report.docx", []byte("not a zip"))
+	require.Equal(t, ErrInvalidDocument, err)
+}
+
+// buildPDF 按顺序写入对象，不生成交叉引用表
+func buildPDF(objects ...string) []byte {
+	var b strings.Builder
+	b.WriteString("%PDF-1.5\n")
+	for index, object := range objects {
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", index+1, object)
+	}
+	b.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+	return []byte(b.String())
+}
+
+func stream(dict string, data []byte, compress bool) string {
+	if compress {
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		_, _ = zw.Write(data)
+		_ = zw.Close()
+		data = buf.Bytes()
+		dict += " /Filter /FlateDecode"
+	}
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+func TestExtractTextPDF(t *testing.T) {
+	data := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		stream("", []byte(`BT /F1 12 Tf 72 712 Td (Hello \(PDF\)) Tj 0 -14 Td [(Wor) 20 (ld) -300 (again)] TJ T* (\101BC) Tj ET`), false),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	)
+	text, err := ExtractText("report.pdf", data)
+	require.NoError(t, err)
+	require.Equal(t, "Hello (PDF)\nWorld again\nABC\n", text)
+}
+
+func TestExtractTextPDFToUnicode(t *testing.T) {
+	cmap := []byte(`/CIDInit /ProcSet findresource begin
+begincmap
+1 begincodespacerange <0000> <FFFF> endcodespacerange
+2 beginbfchar <0001> <5B9E> <0002> <9A8C> endbfchar
+1 beginbfrange <0010> <0012> <0041> endbfrange
+endcmap`)
+	// 页面（6）与字体（7）位于对象流中
+	page := "<< /Type /Page /Parent 2 0 R /Contents 4 0 R /Resources << /Font << /C0 7 0 R >> >> >>\n"
+	font := "<< /Type /Font /Subtype /Type0 /ToUnicode 5 0 R >>"
+	header := fmt.Sprintf("6 0 7 %d ", len(page))
+	data := buildPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [6 0 R] /Count 1 >>",
+		stream(fmt.Sprintf("/Type /ObjStm /N 2 /First %d", len(header)), []byte(header+page+font), true),
+		stream("", []byte("BT /C0 10 Tf 1 0 0 1 72 700 Tm <00010002> Tj 1 0 0 1 72 680 Tm <001000110012> Tj ET"), true),
+		stream("", cmap, true),
+	)
+	text, err := ExtractText("report.pdf", data)
+	require.NoError(t, err)
+	require.Equal(t, "实验\nABC\n", text)
+
+	_, err = ExtractText("report.pdf", []byte("<html></html>"))
+	require.Equal(t, ErrInvalidDocument, err)
+	_, err = ExtractText("report.pdf", []byte("%PDF-1.4\ntrailer\n<< /Encrypt 5 0 R >>\n"))
+	require.Equal(t, ErrUnsupportedFormat, err)
+}
@@ -0,0 +1,73 @@
+package documentx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// extractDocx 读取 word/document.xml 中 w:t 的文本，页眉、页脚与批注不参与查重
+func extractDocx(data []byte) (string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", ErrInvalidDocument
+	}
+	var document *zip.File
+	for _, file := range zr.File {
+		if file.Name == "word/document.xml" {
+			document = file
+			break
+		}
+	}
+	if document == nil {
+		return "", ErrInvalidDocument
+	}
+	if document.UncompressedSize64 > maxDecodedSize {
+		return "", ErrTooLarge
+	}
+	r, err := document.Open()
+	if err != nil {
+		return "", ErrInvalidDocument
+	}
+	defer r.Close()
+
+	var (
+		b      strings.Builder
+		inText bool
+	)
+	decoder := xml.NewDecoder(io.LimitReader(r, maxDecodedSize))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", ErrInvalidDocument
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				b.WriteByte('\t')
+			case "br", "cr":
+				b.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				b.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				b.Write(t)
+			}
+		}
+	}
+	return b.String(), nil
+}
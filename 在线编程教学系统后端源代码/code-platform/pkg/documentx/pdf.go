@@ -0,0 +1,724 @@
+package documentx
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// 只实现提取文本所需的部分：按顺序扫描全部对象（含对象流），沿页面树读取各页内容流中的文本操作符，
+// 字体有 ToUnicode 时按其映射解码，否则按单字节编码处理；不支持加密文档与 Form XObject 中的文本
+
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfString  []byte
+	pdfArray   []interface{}
+	pdfDict    map[pdfName]interface{}
+	pdfRef     struct{ num, gen int }
+)
+
+type pdfObject struct {
+	value interface{}
+	// stream 未解码的流数据，对象没有流时为 nil
+	stream []byte
+}
+
+type pdfDocument struct {
+	objects map[int]*pdfObject
+	fonts   map[int]*pdfFont
+}
+
+type pdfFont struct {
+	cmap *pdfCMap
+	// twoByte Type0 字体每个字符编码占两个字节
+	twoByte bool
+}
+
+type pdfCMap struct {
+	codeLength int
+	mapping    map[uint32]string
+}
+
+var objectHeader = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+func extractPDF(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, "\x00\t\n\f\r "), []byte("%PDF-")) {
+		return "", ErrInvalidDocument
+	}
+	doc := &pdfDocument{objects: make(map[int]*pdfObject), fonts: make(map[int]*pdfFont)}
+	if err := doc.scanObjects(data); err != nil {
+		return "", err
+	}
+	if doc.isEncrypted(data) {
+		return "", ErrUnsupportedFormat
+	}
+
+	var b strings.Builder
+	for _, page := range doc.pages() {
+		if err := doc.pageText(page, &b); err != nil {
+			return "", err
+		}
+		writeNewline(&b)
+	}
+	return b.String(), nil
+}
+
+// isEncrypted trailer 或交叉引用流中有 /Encrypt
+func (d *pdfDocument) isEncrypted(data []byte) bool {
+	if !bytes.Contains(data, []byte("/Encrypt")) {
+		return false
+	}
+	for _, object := range d.objects {
+		if dict, ok := object.value.(pdfDict); ok && dict["Type"] == pdfName("XRef") && dict["Encrypt"] != nil {
+			return true
+		}
+	}
+	for pos := 0; ; {
+		index := bytes.Index(data[pos:], []byte("trailer"))
+		if index < 0 {
+			return false
+		}
+		lexer := &pdfLexer{data: data, pos: pos + index + len("trailer")}
+		if dict, ok := lexer.value().(pdfDict); ok && dict["Encrypt"] != nil {
+			return true
+		}
+		pos = lexer.pos
+	}
+}
+
+// scanObjects 依次读取全部间接对象，增量更新中后出现的同号对象覆盖之前的
+func (d *pdfDocument) scanObjects(data []byte) error {
+	var objectStreams []*pdfObject
+	for pos := 0; pos < len(data); {
+		loc := objectHeader.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+loc[2] : pos+loc[3]]))
+		lexer := &pdfLexer{data: data, pos: pos + loc[1]}
+		object := &pdfObject{value: lexer.value()}
+		if keyword, ok := lexer.next().(pdfKeyword); ok && keyword == "stream" {
+			start := lexer.pos
+			if start < len(data) && data[start] == '\r' {
+				start++
+			}
+			if start < len(data) && data[start] == '\n' {
+				start++
+			}
+			end := bytes.Index(data[start:], []byte("endstream"))
+			if end < 0 {
+				return ErrInvalidDocument
+			}
+			object.stream = data[start : start+end]
+			lexer.pos = start + end + len("endstream")
+		}
+		d.objects[num] = object
+		if dict, ok := object.value.(pdfDict); ok && dict["Type"] == pdfName("ObjStm") {
+			objectStreams = append(objectStreams, object)
+		}
+		pos = lexer.pos
+	}
+
+	// 对象流中的对象不会再包含流
+	for _, object := range objectStreams {
+		dict := object.value.(pdfDict)
+		content, err := d.decodeStream(object)
+		if err != nil || content == nil {
+			continue
+		}
+		n, _ := d.resolve(dict["N"]).(float64)
+		first, _ := d.resolve(dict["First"]).(float64)
+		if int(first) > len(content) {
+			continue
+		}
+		header := &pdfLexer{data: content[:int(first)]}
+		for index := 0; index < int(n); index++ {
+			num, ok1 := header.next().(float64)
+			offset, ok2 := header.next().(float64)
+			if !ok1 || !ok2 || int(first)+int(offset) > len(content) {
+				break
+			}
+			if _, ok := d.objects[int(num)]; ok {
+				continue
+			}
+			lexer := &pdfLexer{data: content, pos: int(first) + int(offset)}
+			d.objects[int(num)] = &pdfObject{value: lexer.value()}
+		}
+	}
+	return nil
+}
+
+func (d *pdfDocument) resolve(value interface{}) interface{} {
+	for depth := 0; depth < 32; depth++ {
+		ref, ok := value.(pdfRef)
+		if !ok {
+			return value
+		}
+		object, ok := d.objects[ref.num]
+		if !ok {
+			return nil
+		}
+		value = object.value
+	}
+	return nil
+}
+
+func (d *pdfDocument) resolveDict(value interface{}) pdfDict {
+	dict, _ := d.resolve(value).(pdfDict)
+	return dict
+}
+
+// decodeStream 仅支持不压缩与 FlateDecode 的流，其余（如图片）返回 nil
+func (d *pdfDocument) decodeStream(object *pdfObject) ([]byte, error) {
+	dict, _ := object.value.(pdfDict)
+	filter := d.resolve(dict["Filter"])
+	if filters, ok := filter.(pdfArray); ok {
+		if len(filters) > 1 {
+			return nil, nil
+		}
+		filter = nil
+		if len(filters) == 1 {
+			filter = d.resolve(filters[0])
+		}
+	}
+	switch filter {
+	case nil:
+		return object.stream, nil
+	case pdfName("FlateDecode"):
+		r, err := zlib.NewReader(bytes.NewReader(object.stream))
+		if err != nil {
+			return nil, nil
+		}
+		defer r.Close()
+		content, err := io.ReadAll(io.LimitReader(r, maxDecodedSize+1))
+		if len(content) > maxDecodedSize {
+			return nil, ErrTooLarge
+		}
+		// 流末尾损坏时保留已解压的部分
+		if err != nil && len(content) == 0 {
+			return nil, nil
+		}
+		return content, nil
+	default:
+		return nil, nil
+	}
+}
+
+type pdfPage struct {
+	dict      pdfDict
+	resources pdfDict
+}
+
+// pages 沿目录中的页面树按顺序返回页面，找不到目录时按对象编号排列全部页面
+func (d *pdfDocument) pages() []*pdfPage {
+	var catalog pdfDict
+	nums := make([]int, 0, len(d.objects))
+	for num := range d.objects {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		if dict, ok := d.objects[num].value.(pdfDict); ok && dict["Type"] == pdfName("Catalog") {
+			catalog = dict
+		}
+	}
+
+	var pages []*pdfPage
+	if catalog != nil {
+		visited := make(map[pdfRef]bool)
+		var walk func(value interface{}, resources pdfDict)
+		walk = func(value interface{}, resources pdfDict) {
+			if ref, ok := value.(pdfRef); ok {
+				if visited[ref] {
+					return
+				}
+				visited[ref] = true
+			}
+			node := d.resolveDict(value)
+			if node == nil {
+				return
+			}
+			if own := d.resolveDict(node["Resources"]); own != nil {
+				resources = own
+			}
+			if node["Type"] == pdfName("Page") {
+				pages = append(pages, &pdfPage{dict: node, resources: resources})
+				return
+			}
+			kids, _ := d.resolve(node["Kids"]).(pdfArray)
+			for _, kid := range kids {
+				walk(kid, resources)
+			}
+		}
+		walk(catalog["Pages"], nil)
+	}
+	if len(pages) != 0 {
+		return pages
+	}
+	for _, num := range nums {
+		if dict, ok := d.objects[num].value.(pdfDict); ok && dict["Type"] == pdfName("Page") {
+			pages = append(pages, &pdfPage{dict: dict, resources: d.resolveDict(dict["Resources"])})
+		}
+	}
+	return pages
+}
+
+func (d *pdfDocument) font(value interface{}) *pdfFont {
+	ref, isRef := value.(pdfRef)
+	if isRef {
+		if font, ok := d.fonts[ref.num]; ok {
+			return font
+		}
+	}
+	dict := d.resolveDict(value)
+	font := &pdfFont{twoByte: dict["Subtype"] == pdfName("Type0")}
+	if toUnicode, ok := dict["ToUnicode"].(pdfRef); ok {
+		if object, ok := d.objects[toUnicode.num]; ok {
+			if content, err := d.decodeStream(object); err == nil && content != nil {
+				font.cmap = parseCMap(content)
+			}
+		}
+	}
+	if isRef {
+		d.fonts[ref.num] = font
+	}
+	return font
+}
+
+func (d *pdfDocument) pageText(page *pdfPage, b *strings.Builder) error {
+	fonts := make(map[pdfName]*pdfFont)
+	if page.resources != nil {
+		for name, value := range d.resolveDict(page.resources["Font"]) {
+			fonts[name] = d.font(value)
+		}
+	}
+
+	var contents []interface{}
+	switch value := d.resolve(page.dict["Contents"]).(type) {
+	case pdfArray:
+		contents = value
+	case nil:
+	default:
+		contents = []interface{}{page.dict["Contents"]}
+	}
+	for _, content := range contents {
+		ref, ok := content.(pdfRef)
+		if !ok {
+			continue
+		}
+		object, ok := d.objects[ref.num]
+		if !ok || object.stream == nil {
+			continue
+		}
+		data, err := d.decodeStream(object)
+		if err != nil {
+			return err
+		}
+		writeContentText(data, fonts, b)
+		writeNewline(b)
+	}
+	return nil
+}
+
+// writeContentText 解释内容流中的文本操作符，换行位置按文本矩阵的纵向变化推断
+func writeContentText(data []byte, fonts map[pdfName]*pdfFont, b *strings.Builder) {
+	var (
+		font     *pdfFont
+		operands []interface{}
+		lastY    float64
+	)
+	number := func(index int) float64 {
+		if index < len(operands) {
+			value, _ := operands[index].(float64)
+			return value
+		}
+		return 0
+	}
+	lexer := &pdfLexer{data: data}
+	for {
+		value := lexer.value()
+		if value == nil {
+			return
+		}
+		keyword, ok := value.(pdfKeyword)
+		if !ok {
+			operands = append(operands, value)
+			continue
+		}
+
+		switch keyword {
+		case "BI":
+			// 内嵌图片的数据是二进制，跳过至 EI
+			end := bytes.Index(data[lexer.pos:], []byte("EI"))
+			if end < 0 {
+				return
+			}
+			lexer.pos += end + len("EI")
+		case "Tf":
+			if len(operands) != 0 {
+				name, _ := operands[0].(pdfName)
+				font = fonts[name]
+			}
+		case "Tj":
+			if len(operands) != 0 {
+				writeString(b, font, operands[len(operands)-1])
+			}
+		case "'", "\"":
+			writeNewline(b)
+			if len(operands) != 0 {
+				writeString(b, font, operands[len(operands)-1])
+			}
+		case "TJ":
+			if len(operands) == 0 {
+				break
+			}
+			elements, _ := operands[len(operands)-1].(pdfArray)
+			for _, element := range elements {
+				// 较大的负间距视为单词间的空格
+				if offset, ok := element.(float64); ok && offset < -200 {
+					b.WriteByte(' ')
+					continue
+				}
+				writeString(b, font, element)
+			}
+		case "T*":
+			writeNewline(b)
+		case "Td", "TD":
+			if number(1) != 0 {
+				writeNewline(b)
+			}
+		case "Tm":
+			if y := number(5); y != lastY {
+				writeNewline(b)
+				lastY = y
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+func writeString(b *strings.Builder, font *pdfFont, value interface{}) {
+	s, ok := value.(pdfString)
+	if !ok {
+		return
+	}
+	switch {
+	case font != nil && font.cmap != nil:
+		length := font.cmap.codeLength
+		for index := 0; index+length <= len(s); index += length {
+			var code uint32
+			for _, c := range s[index : index+length] {
+				code = code<<8 | uint32(c)
+			}
+			b.WriteString(font.cmap.mapping[code])
+		}
+	case font != nil && font.twoByte:
+		// 没有 ToUnicode 的双字节字体无法得知字符
+	default:
+		for _, c := range s {
+			if c >= 0x20 || c == '\t' {
+				b.WriteRune(rune(c))
+			}
+		}
+	}
+}
+
+func writeNewline(b *strings.Builder) {
+	if s := b.String(); s != "" && !strings.HasSuffix(s, "\n") {
+		b.WriteByte('\n')
+	}
+}
+
+// parseCMap 读取 ToUnicode 中的 bfchar 与 bfrange，编码长度取第一个 codespacerange
+func parseCMap(data []byte) *pdfCMap {
+	cmap := &pdfCMap{codeLength: 0, mapping: make(map[uint32]string)}
+	lexer := &pdfLexer{data: data}
+	var operands []interface{}
+	for {
+		value := lexer.value()
+		if value == nil {
+			break
+		}
+		keyword, ok := value.(pdfKeyword)
+		if !ok {
+			operands = append(operands, value)
+			continue
+		}
+		// 各段的数据位于 begin 与 end 之间，遇到关键字时处理并清空
+		switch keyword {
+		case "endcodespacerange":
+			if low, ok := firstString(operands); ok && cmap.codeLength == 0 {
+				cmap.codeLength = len(low)
+			}
+		case "endbfchar":
+			for index := 0; index+1 < len(operands); index += 2 {
+				src, ok1 := operands[index].(pdfString)
+				dst, ok2 := operands[index+1].(pdfString)
+				if ok1 && ok2 {
+					cmap.mapping[codeOf(src)] = decodeUTF16(dst)
+				}
+			}
+		case "endbfrange":
+			for index := 0; index+2 < len(operands); index += 3 {
+				low, ok1 := operands[index].(pdfString)
+				high, ok2 := operands[index+1].(pdfString)
+				if !ok1 || !ok2 || codeOf(high) < codeOf(low) || codeOf(high)-codeOf(low) > 0xffff {
+					continue
+				}
+				switch dst := operands[index+2].(type) {
+				case pdfString:
+					base := utf16.Decode(bytesToUTF16(dst))
+					for code := codeOf(low); code <= codeOf(high); code++ {
+						if len(base) == 0 {
+							break
+						}
+						runes := append([]rune(nil), base...)
+						runes[len(runes)-1] += rune(code - codeOf(low))
+						cmap.mapping[code] = string(runes)
+					}
+				case pdfArray:
+					for offset, element := range dst {
+						if s, ok := element.(pdfString); ok {
+							cmap.mapping[codeOf(low)+uint32(offset)] = decodeUTF16(s)
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+	if cmap.codeLength == 0 {
+		cmap.codeLength = 2
+	}
+	return cmap
+}
+
+func firstString(values []interface{}) (pdfString, bool) {
+	for _, value := range values {
+		if s, ok := value.(pdfString); ok {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+func codeOf(s pdfString) uint32 {
+	var code uint32
+	for _, c := range s {
+		code = code<<8 | uint32(c)
+	}
+	return code
+}
+
+func bytesToUTF16(s pdfString) []uint16 {
+	units := make([]uint16, 0, len(s)/2)
+	for index := 0; index+1 < len(s); index += 2 {
+		units = append(units, uint16(s[index])<<8|uint16(s[index+1]))
+	}
+	return units
+}
+
+func decodeUTF16(s pdfString) string {
+	return string(utf16.Decode(bytesToUTF16(s)))
+}
+
+// pdfLexer 读取 PDF 的基本对象，数字统一为 float64
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFSpace(c byte) bool {
+	return c == 0 || c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		switch c := l.data[l.pos]; {
+		case isPDFSpace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// next 返回下一个记号，结束时返回 nil；[、]、<<、>> 以 pdfKeyword 返回
+func (l *pdfLexer) next() interface{} {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil
+	}
+	c := l.data[l.pos]
+	switch {
+	case c == '(':
+		return l.literalString()
+	case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+		l.pos += 2
+		return pdfKeyword("<<")
+	case c == '>' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '>':
+		l.pos += 2
+		return pdfKeyword(">>")
+	case c == '<':
+		return l.hexString()
+	case c == '/':
+		l.pos++
+		return pdfName(l.regular(true))
+	case c == '[' || c == ']' || c == '{' || c == '}' || c == ')' || c == '>':
+		l.pos++
+		return pdfKeyword(c)
+	}
+	token := l.regular(false)
+	if value, err := strconv.ParseFloat(token, 64); err == nil {
+		return value
+	}
+	return pdfKeyword(token)
+}
+
+func (l *pdfLexer) regular(name bool) string {
+	var b strings.Builder
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if isPDFSpace(c) || isPDFDelimiter(c) {
+			break
+		}
+		if name && c == '#' && l.pos+2 < len(l.data) {
+			if value, err := strconv.ParseUint(string(l.data[l.pos+1:l.pos+3]), 16, 8); err == nil {
+				b.WriteByte(byte(value))
+				l.pos += 3
+				continue
+			}
+		}
+		b.WriteByte(c)
+		l.pos++
+	}
+	return b.String()
+}
+
+func (l *pdfLexer) literalString() pdfString {
+	l.pos++
+	var s []byte
+	for depth := 1; l.pos < len(l.data); {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return s
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return s
+			}
+			c = l.data[l.pos]
+			l.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if c >= '0' && c <= '7' {
+					value := int(c - '0')
+					for count := 1; count < 3 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; count++ {
+						value = value*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					c = byte(value)
+				}
+			}
+		}
+		s = append(s, c)
+	}
+	return s
+}
+
+func (l *pdfLexer) hexString() pdfString {
+	l.pos++
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; !isPDFSpace(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	s := make(pdfString, 0, len(digits)/2)
+	for index := 0; index < len(digits); index += 2 {
+		value, err := strconv.ParseUint(string(digits[index:index+2]), 16, 8)
+		if err != nil {
+			return s
+		}
+		s = append(s, byte(value))
+	}
+	return s
+}
+
+// value 读取一个完整的对象，数组与字典递归读取，“n g R” 读取为 pdfRef
+func (l *pdfLexer) value() interface{} {
+	token := l.next()
+	switch token {
+	case pdfKeyword("["):
+		array := pdfArray{}
+		for {
+			value := l.value()
+			if value == nil || value == pdfKeyword("]") {
+				return array
+			}
+			array = append(array, value)
+		}
+	case pdfKeyword("<<"):
+		dict := pdfDict{}
+		for {
+			key := l.value()
+			name, ok := key.(pdfName)
+			if !ok {
+				return dict
+			}
+			dict[name] = l.value()
+		}
+	}
+
+	if num, ok := token.(float64); ok && num == float64(int(num)) && num >= 0 {
+		pos := l.pos
+		if gen, ok := l.next().(float64); ok {
+			if keyword, ok := l.next().(pdfKeyword); ok && keyword == "R" {
+				return pdfRef{num: int(num), gen: int(gen)}
+			}
+		}
+		l.pos = pos
+	}
+	return token
+}
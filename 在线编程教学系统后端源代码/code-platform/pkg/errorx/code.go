@@ -8,4 +8,8 @@ const (
 	CodeInternal
 	CodeForbidden
 	CodeTimeout
+	// CodeBadRequest 请求的参数不合法
+	CodeBadRequest
+	// CodeConflict 与资源的当前状态冲突，如已存在、已结束或正在进行
+	CodeConflict
 )
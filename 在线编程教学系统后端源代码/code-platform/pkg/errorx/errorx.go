@@ -25,6 +25,33 @@ var (
 	// ErrMailUserNotFound 发送的邮箱用户并不存在
 	ErrMailUserNotFound = New(CodeNotFound, "email user is not found")
 	ErrOOMKilled        = New(CodeForbidden, "OOM")
+	// ErrLabHasEnded 实验已截止，工作区只读
+	ErrLabHasEnded  = New(CodeConflict, "lab has ended")
+	ErrInvalidPath  = New(CodeBadRequest, "path is invalid")
+	ErrFileExists   = New(CodeConflict, "file already exists")
+	ErrFileTooLarge = New(CodeBadRequest, "file is too large")
+	// ErrTestRunning 同一工作区的上一次测试尚未结束
+	ErrTestRunning = New(CodeConflict, "test run is in progress")
+	// ErrIDENotRunning 学生的 IDE 容器未运行，无法加入
+	ErrIDENotRunning = New(CodeConflict, "ide is not running")
+	// ErrWrongIDEKind 课程的 IDE 类型与请求打开的 IDE 不符
+	ErrWrongIDEKind = New(CodeBadRequest, "ide kind of course does not match")
+	// ErrImageNotReviewable 课程镜像已审核或正在构建
+	ErrImageNotReviewable = New(CodeConflict, "course image can not be reviewed")
+	// ErrJobRunNotRetryable 只有最终失败的执行记录可以重新执行
+	ErrJobRunNotRetryable = New(CodeConflict, "job run can not be retried")
+	// ErrPlagiarismJobFinished 查重任务已结束，不能再取消
+	ErrPlagiarismJobFinished = New(CodeConflict, "plagiarism job has finished")
+	// ErrReferenceLabLanguage 参考实验所属课程的语言与本实验不同
+	ErrReferenceLabLanguage = New(CodeBadRequest, "language of reference lab does not match")
+	// ErrReportPredatesEvidence 查重报告早于比对详情的保存，无法导出证据
+	ErrReportPredatesEvidence = New(CodeConflict, "report predates evidence export")
+	// ErrNotEnoughReports 能提取出文本的实验报告少于两份，无法查重
+	ErrNotEnoughReports = New(CodeBadRequest, "at least two reports with text are required")
+	// ErrNoPreviousTemplate 实验没有可恢复的上一版初始代码模板
+	ErrNoPreviousTemplate = New(CodeNotFound, "previous template is not found")
+	// ErrTemplateChanged 更新期间模板已被其他请求修改
+	ErrTemplateChanged = New(CodeConflict, "template was changed by another request")
 )
 
 func New(code Code, msg string) error {
@@ -25,6 +25,14 @@ func IsNoAuth(err error) bool {
 	return isCodeErr(err, CodeNoAuth)
 }
 
+func IsBadRequest(err error) bool {
+	return isCodeErr(err, CodeBadRequest)
+}
+
+func IsConflict(err error) bool {
+	return isCodeErr(err, CodeConflict)
+}
+
 func IsDuplicateMySQLError(err error) bool {
 	if err == nil {
 		return false
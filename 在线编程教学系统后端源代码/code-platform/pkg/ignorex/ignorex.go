@@ -0,0 +1,109 @@
+package ignorex
+
+import (
+	"path"
+	"strings"
+)
+
+// rule 一条 .gitignore 规则，仅作用于 base 目录（相对根目录，根目录为空串）之下
+type rule struct {
+	base     string
+	segments []string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// Matcher 按 .gitignore 语义匹配路径，后出现的规则优先
+type Matcher struct {
+	rules []*rule
+}
+
+func New(patterns ...string) *Matcher {
+	m := &Matcher{}
+	m.Add("", patterns...)
+	return m
+}
+
+// Add 添加 base 目录下 .gitignore 的规则，patterns 可直接为文件按行切分的结果
+func (m *Matcher) Add(base string, patterns ...string) {
+	base = strings.Trim(base, "/")
+	for _, pattern := range patterns {
+		pattern = strings.TrimRight(pattern, " \r")
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		r := &rule{base: base}
+		if strings.HasPrefix(pattern, "!") {
+			r.negate = true
+			pattern = pattern[1:]
+		}
+		if strings.HasSuffix(pattern, "/") {
+			r.dirOnly = true
+			pattern = strings.TrimRight(pattern, "/")
+		}
+		// 除末尾外含有 / 的规则相对 base 目录匹配，否则匹配任意层级的文件名
+		if strings.Contains(pattern, "/") {
+			r.anchored = true
+			pattern = strings.TrimPrefix(pattern, "/")
+		}
+		if pattern == "" {
+			continue
+		}
+		r.segments = strings.Split(pattern, "/")
+		m.rules = append(m.rules, r)
+	}
+}
+
+// Match 判断相对根目录的路径 name 是否被忽略
+func (m *Matcher) Match(name string, isDir bool) bool {
+	name = strings.Trim(name, "/")
+	var ignored bool
+	for _, r := range m.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+
+		rel := name
+		if r.base != "" {
+			if !strings.HasPrefix(name, r.base+"/") {
+				continue
+			}
+			rel = name[len(r.base)+1:]
+		}
+
+		var matched bool
+		if r.anchored {
+			matched = matchSegments(r.segments, strings.Split(rel, "/"))
+		} else {
+			matched, _ = path.Match(r.segments[0], path.Base(rel))
+		}
+		if matched {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			rest := pattern[1:]
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(rest, name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
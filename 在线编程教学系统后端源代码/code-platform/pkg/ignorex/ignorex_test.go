@@ -0,0 +1,39 @@
+package ignorex_test
+
+import (
+	"testing"
+
+	. "code-platform/pkg/ignorex"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	m := New("node_modules/", "*.pyc", "/build", "docs/**/*.tmp", "!keep.pyc", "# comment", "")
+	m.Add("src", "generated/", "/local.txt")
+
+	for _, c := range []struct {
+		label    string
+		name     string
+		isDir    bool
+		expected bool
+	}{
+		{label: "dir only", name: "node_modules", isDir: true, expected: true},
+		{label: "dir only nested", name: "web/node_modules", isDir: true, expected: true},
+		{label: "dir only but file", name: "node_modules", isDir: false, expected: false},
+		{label: "glob any level", name: "a/b/c.pyc", expected: true},
+		{label: "negate", name: "a/keep.pyc", expected: false},
+		{label: "anchored", name: "build", isDir: true, expected: true},
+		{label: "anchored not nested", name: "src/build", isDir: true, expected: false},
+		{label: "double star", name: "docs/x/y/z.tmp", expected: true},
+		{label: "double star zero dir", name: "docs/z.tmp", expected: true},
+		{label: "double star mismatch", name: "other/z.tmp", expected: false},
+		{label: "base rule", name: "src/a/generated", isDir: true, expected: true},
+		{label: "base rule outside", name: "generated", isDir: true, expected: false},
+		{label: "base anchored", name: "src/local.txt", expected: true},
+		{label: "base anchored nested", name: "src/a/local.txt", expected: false},
+		{label: "not ignored", name: "main.py", expected: false},
+	} {
+		require.Equal(t, c.expected, m.Match(c.name, c.isDir), c.label)
+	}
+}
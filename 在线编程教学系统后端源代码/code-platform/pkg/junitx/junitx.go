@@ -0,0 +1,154 @@
+// Package junitx 解析 pytest、Maven Surefire、gtest 等输出的 JUnit XML 测试报告
+package junitx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusError   Status = "error"
+	StatusSkipped Status = "skipped"
+)
+
+// maxDetailLen 失败详情通常包含完整的调用栈，只保留开头部分
+const maxDetailLen = 4 << 10
+
+type Case struct {
+	Suite     string  `json:"suite"`
+	ClassName string  `json:"class_name"`
+	Name      string  `json:"name"`
+	Status    Status  `json:"status"`
+	Message   string  `json:"message"`
+	Detail    string  `json:"detail"`
+	Time      float64 `json:"time"`
+}
+
+// Report 多个报告文件合并后的结果
+type Report struct {
+	Cases   []*Case `json:"cases"`
+	Passed  int     `json:"passed"`
+	Failed  int     `json:"failed"`
+	Errors  int     `json:"errors"`
+	Skipped int     `json:"skipped"`
+}
+
+func (r *Report) Total() int {
+	return len(r.Cases)
+}
+
+// Add 解析一个报告文件并合并进 r，解析失败时 r 不变
+func (r *Report) Add(data []byte) error {
+	cases, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	for _, c := range cases {
+		switch c.Status {
+		case StatusPassed:
+			r.Passed++
+		case StatusFailed:
+			r.Failed++
+		case StatusError:
+			r.Errors++
+		case StatusSkipped:
+			r.Skipped++
+		}
+	}
+	r.Cases = append(r.Cases, cases...)
+	return nil
+}
+
+type xmlSuite struct {
+	Name   string      `xml:"name,attr"`
+	Suites []*xmlSuite `xml:"testsuite"`
+	Cases  []*xmlCase  `xml:"testcase"`
+}
+
+type xmlCase struct {
+	Name      string     `xml:"name,attr"`
+	ClassName string     `xml:"classname,attr"`
+	Time      string     `xml:"time,attr"`
+	Status    string     `xml:"status,attr"`
+	Result    string     `xml:"result,attr"`
+	Failure   *xmlResult `xml:"failure"`
+	Error     *xmlResult `xml:"error"`
+	Skipped   *xmlResult `xml:"skipped"`
+}
+
+type xmlResult struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Parse 解析根元素为 testsuites 或 testsuite 的报告，testsuite 可以嵌套
+func Parse(data []byte) ([]*Case, error) {
+	var root xmlSuite
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// 部分工具输出非 UTF-8 的声明，内容按原样读取
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	var cases []*Case
+	var walk func(suite *xmlSuite, name string)
+	walk = func(suite *xmlSuite, name string) {
+		if suite.Name != "" {
+			name = suite.Name
+		}
+		for _, c := range suite.Cases {
+			cases = append(cases, newCase(name, c))
+		}
+		for _, child := range suite.Suites {
+			walk(child, name)
+		}
+	}
+	walk(&root, "")
+	return cases, nil
+}
+
+func newCase(suite string, c *xmlCase) *Case {
+	result := &Case{
+		Suite:     suite,
+		ClassName: c.ClassName,
+		Name:      c.Name,
+		Status:    StatusPassed,
+	}
+	// Surefire 的 time 可能带千位分隔符
+	result.Time, _ = strconv.ParseFloat(strings.ReplaceAll(c.Time, ",", ""), 64)
+
+	var detail *xmlResult
+	switch {
+	case c.Error != nil:
+		result.Status, detail = StatusError, c.Error
+	case c.Failure != nil:
+		result.Status, detail = StatusFailed, c.Failure
+	// gtest 以 result="skipped" 或 status="notrun" 表示跳过
+	case c.Skipped != nil, c.Result == "skipped", c.Status == "notrun":
+		result.Status, detail = StatusSkipped, c.Skipped
+	}
+	if detail != nil {
+		result.Message = detail.Message
+		result.Detail = truncate(strings.TrimSpace(detail.Text), maxDetailLen)
+	}
+	return result
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,84 @@
+package junitx_test
+
+import (
+	"testing"
+
+	. "code-platform/pkg/junitx"
+
+	"github.com/stretchr/testify/require"
+)
+
+const pytestReport = `<?xml version="1.0" encoding="utf-8"?>
+<testsuites>
+  <testsuite name="pytest" errors="1" failures="1" skipped="1" tests="4" time="0.05">
+    <testcase classname="test_main" name="test_add" time="0.001"/>
+    <testcase classname="test_main" name="test_sub" time="0.002">
+      <failure message="assert 1 == 2">def test_sub():
+&gt;       assert sub(3, 1) == 1</failure>
+    </testcase>
+    <testcase classname="test_main" name="test_io" time="0.000">
+      <error message="fixture 'tmp' not found">E fixture 'tmp' not found</error>
+    </testcase>
+    <testcase classname="test_main" name="test_slow" time="0.000">
+      <skipped type="pytest.skip" message="slow">skipped</skipped>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+const surefireReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="lab.SolutionTest" time="1,024.5" tests="1" errors="0" skipped="0" failures="0">
+  <testcase name="testSolve" classname="lab.SolutionTest" time="1,024.5"/>
+</testsuite>`
+
+const gtestReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="2" failures="0" disabled="1" errors="0" name="AllTests">
+  <testsuite name="MathTest" tests="2" failures="0" disabled="1" errors="0">
+    <testcase name="Add" status="run" result="completed" time="0" classname="MathTest"/>
+    <testcase name="DISABLED_Div" status="notrun" result="suppressed" time="0" classname="MathTest"/>
+  </testsuite>
+</testsuites>`
+
+func TestParse(t *testing.T) {
+	cases, err := Parse([]byte(pytestReport))
+	require.NoError(t, err)
+	require.Len(t, cases, 4)
+	for i, expected := range []struct {
+		name    string
+		status  Status
+		message string
+	}{
+		{name: "test_add", status: StatusPassed},
+		{name: "test_sub", status: StatusFailed, message: "assert 1 == 2"},
+		{name: "test_io", status: StatusError, message: "fixture 'tmp' not found"},
+		{name: "test_slow", status: StatusSkipped, message: "slow"},
+	} {
+		require.Equal(t, "pytest", cases[i].Suite, expected.name)
+		require.Equal(t, expected.name, cases[i].Name)
+		require.Equal(t, expected.status, cases[i].Status, expected.name)
+		require.Equal(t, expected.message, cases[i].Message, expected.name)
+	}
+	require.Equal(t, "def test_sub():\n>       assert sub(3, 1) == 1", cases[1].Detail)
+
+	cases, err = Parse([]byte(surefireReport))
+	require.NoError(t, err)
+	require.Len(t, cases, 1)
+	require.Equal(t, "lab.SolutionTest", cases[0].Suite)
+	require.Equal(t, 1024.5, cases[0].Time)
+
+	_, err = Parse([]byte("not xml"))
+	require.Error(t, err)
+}
+
+func TestReport(t *testing.T) {
+	var report Report
+	require.NoError(t, report.Add([]byte(pytestReport)))
+	require.NoError(t, report.Add([]byte(gtestReport)))
+	require.Error(t, report.Add([]byte("<testsuite>")))
+
+	require.Equal(t, 6, report.Total())
+	require.Equal(t, 2, report.Passed)
+	require.Equal(t, 1, report.Failed)
+	require.Equal(t, 1, report.Errors)
+	require.Equal(t, 2, report.Skipped)
+	require.Equal(t, "MathTest", report.Cases[4].Suite)
+}
@@ -0,0 +1,172 @@
+// Package notebookx 从 Jupyter Notebook（nbformat 4）中提取单元格源码与输出，供教师直接查看而非原始 JSON
+package notebookx
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/bytedance/sonic"
+)
+
+const (
+	CellTypeCode     = "code"
+	CellTypeMarkdown = "markdown"
+	CellTypeRaw      = "raw"
+)
+
+const (
+	OutputTypeStream        = "stream"
+	OutputTypeExecuteResult = "execute_result"
+	OutputTypeDisplayData   = "display_data"
+	OutputTypeError         = "error"
+)
+
+// imageMIMETypes 按优先级选取一种图片输出
+var imageMIMETypes = []string{"image/png", "image/jpeg", "image/gif"}
+
+var ErrInvalidNotebook = errors.New("invalid notebook")
+
+type Output struct {
+	OutputType string `json:"output_type"`
+	// Text stream 的内容、结果的 text/plain 或错误的调用栈（已去除 ANSI 颜色）
+	Text string `json:"text"`
+	// Image base64 编码的图片
+	Image     string `json:"image"`
+	ImageMIME string `json:"image_mime"`
+}
+
+type Cell struct {
+	CellType       string    `json:"cell_type"`
+	Source         string    `json:"source"`
+	Outputs        []*Output `json:"outputs"`
+	ExecutionCount int       `json:"execution_count"`
+}
+
+type Notebook struct {
+	Language string  `json:"language"`
+	Cells    []*Cell `json:"cells"`
+}
+
+func IsNotebook(name string) bool {
+	return strings.EqualFold(path.Ext(name), ".ipynb")
+}
+
+// multiline nbformat 中多行文本可为字符串或按行拆分的字符串数组
+type multiline string
+
+func (m *multiline) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := sonic.Unmarshal(data, &s); err == nil {
+		*m = multiline(s)
+		return nil
+	}
+	var lines []string
+	if err := sonic.Unmarshal(data, &lines); err != nil {
+		return err
+	}
+	*m = multiline(strings.Join(lines, ""))
+	return nil
+}
+
+type rawOutput struct {
+	Data       map[string]multiline `json:"data"`
+	OutputType string               `json:"output_type"`
+	Text       multiline            `json:"text"`
+	EName      string               `json:"ename"`
+	EValue     string               `json:"evalue"`
+	Traceback  []string             `json:"traceback"`
+}
+
+type rawCell struct {
+	CellType       string       `json:"cell_type"`
+	Source         multiline    `json:"source"`
+	Outputs        []*rawOutput `json:"outputs"`
+	ExecutionCount *int         `json:"execution_count"`
+}
+
+type rawNotebook struct {
+	Metadata struct {
+		KernelSpec struct {
+			Language string `json:"language"`
+		} `json:"kernelspec"`
+		LanguageInfo struct {
+			Name string `json:"name"`
+		} `json:"language_info"`
+	} `json:"metadata"`
+	Cells    []*rawCell `json:"cells"`
+	NBFormat int        `json:"nbformat"`
+}
+
+// Parse 解析 nbformat 4 的 notebook，更早的版本返回 ErrInvalidNotebook
+func Parse(data []byte) (*Notebook, error) {
+	var raw rawNotebook
+	if err := sonic.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw.NBFormat != 4 {
+		return nil, ErrInvalidNotebook
+	}
+
+	nb := &Notebook{
+		Language: raw.Metadata.KernelSpec.Language,
+		Cells:    make([]*Cell, 0, len(raw.Cells)),
+	}
+	if nb.Language == "" {
+		nb.Language = raw.Metadata.LanguageInfo.Name
+	}
+
+	for _, rc := range raw.Cells {
+		if rc == nil {
+			continue
+		}
+		cell := &Cell{
+			CellType: rc.CellType,
+			Source:   string(rc.Source),
+		}
+		if rc.ExecutionCount != nil {
+			cell.ExecutionCount = *rc.ExecutionCount
+		}
+		for _, ro := range rc.Outputs {
+			if ro == nil {
+				continue
+			}
+			cell.Outputs = append(cell.Outputs, convertOutput(ro))
+		}
+		nb.Cells = append(nb.Cells, cell)
+	}
+	return nb, nil
+}
+
+func convertOutput(ro *rawOutput) *Output {
+	output := &Output{OutputType: ro.OutputType}
+	switch ro.OutputType {
+	case OutputTypeStream:
+		output.Text = string(ro.Text)
+	case OutputTypeError:
+		if len(ro.Traceback) > 0 {
+			output.Text = StripANSI(strings.Join(ro.Traceback, "\n"))
+		} else {
+			output.Text = ro.EName + ": " + ro.EValue
+		}
+	default:
+		output.Text = string(ro.Data["text/plain"])
+		for _, mime := range imageMIMETypes {
+			if image, ok := ro.Data[mime]; ok {
+				// base64 按行拆分时带有换行
+				output.Image = strings.ReplaceAll(string(image), "\n", "")
+				output.ImageMIME = mime
+				break
+			}
+		}
+	}
+	return output
+}
+
+var ansiRegexpCompiled = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// StripANSI 去除 IPython 错误调用栈中的终端颜色控制符
+func StripANSI(s string) string {
+	return ansiRegexpCompiled.ReplaceAllString(s, "")
+}
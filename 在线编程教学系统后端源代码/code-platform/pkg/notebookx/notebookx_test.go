@@ -0,0 +1,90 @@
+package notebookx_test
+
+import (
+	"testing"
+
+	. "code-platform/pkg/notebookx"
+
+	"github.com/stretchr/testify/require"
+)
+
+const notebook = `{
+ "cells": [
+  {
+   "cell_type": "markdown",
+   "metadata": {},
+   "source": ["# 实验一\n", "计算平均值"]
+  },
+  {
+   "cell_type": "code",
+   "execution_count": 1,
+   "metadata": {},
+   "outputs": [
+    {"name": "stdout", "output_type": "stream", "text": ["hello\n", "world\n"]},
+    {"data": {"text/plain": "2.5"}, "execution_count": 1, "metadata": {}, "output_type": "execute_result"}
+   ],
+   "source": "print('hello')\nprint('world')\nsum([1, 2, 3, 4]) / 4"
+  },
+  {
+   "cell_type": "code",
+   "execution_count": 2,
+   "metadata": {},
+   "outputs": [
+    {"data": {"image/png": "iVBORw0KGgo\nAAAANSUhEUg==\n", "text/plain": ["<Figure size 640x480 with 1 Axes>"]}, "metadata": {}, "output_type": "display_data"},
+    {"ename": "ZeroDivisionError", "evalue": "division by zero", "output_type": "error", "traceback": ["\u001b[0;31mZeroDivisionError\u001b[0m", "division by zero"]}
+   ],
+   "source": ["plt.plot(x)\n", "1 / 0"]
+  },
+  {
+   "cell_type": "code",
+   "execution_count": null,
+   "metadata": {},
+   "outputs": [],
+   "source": []
+  }
+ ],
+ "metadata": {
+  "kernelspec": {"display_name": "Python 3", "language": "python", "name": "python3"}
+ },
+ "nbformat": 4,
+ "nbformat_minor": 5
+}`
+
+func TestParse(t *testing.T) {
+	nb, err := Parse([]byte(notebook))
+	require.NoError(t, err)
+	require.Equal(t, "python", nb.Language)
+	require.Len(t, nb.Cells, 4)
+
+	require.Equal(t, CellTypeMarkdown, nb.Cells[0].CellType)
+	require.Equal(t, "# 实验一\n计算平均值", nb.Cells[0].Source)
+
+	cell := nb.Cells[1]
+	require.Equal(t, 1, cell.ExecutionCount)
+	require.Equal(t, "print('hello')\nprint('world')\nsum([1, 2, 3, 4]) / 4", cell.Source)
+	require.Len(t, cell.Outputs, 2)
+	require.Equal(t, &Output{OutputType: OutputTypeStream, Text: "hello\nworld\n"}, cell.Outputs[0])
+	require.Equal(t, &Output{OutputType: OutputTypeExecuteResult, Text: "2.5"}, cell.Outputs[1])
+
+	cell = nb.Cells[2]
+	require.Len(t, cell.Outputs, 2)
+	require.Equal(t, "iVBORw0KGgoAAAANSUhEUg==", cell.Outputs[0].Image)
+	require.Equal(t, "image/png", cell.Outputs[0].ImageMIME)
+	require.Equal(t, "<Figure size 640x480 with 1 Axes>", cell.Outputs[0].Text)
+	require.Equal(t, "ZeroDivisionError\ndivision by zero", cell.Outputs[1].Text)
+
+	require.Equal(t, 0, nb.Cells[3].ExecutionCount)
+	require.Empty(t, nb.Cells[3].Source)
+
+	_, err = Parse([]byte(`{"nbformat": 3, "worksheets": []}`))
+	require.ErrorIs(t, err, ErrInvalidNotebook)
+
+	_, err = Parse([]byte(`{"cells": [`))
+	require.Error(t, err)
+}
+
+func TestIsNotebook(t *testing.T) {
+	require.True(t, IsNotebook("analysis.ipynb"))
+	require.True(t, IsNotebook("dir/Analysis.IPYNB"))
+	require.False(t, IsNotebook("main.py"))
+}
@@ -0,0 +1,90 @@
+package plagiarism
+
+import "sort"
+
+// Edge 两份提交之间的相似度，A、B 为提交的标识
+type Edge struct {
+	A, B       string
+	Similarity float64
+}
+
+// Cluster 相似度不低于阈值的组合连接起来的一组提交
+type Cluster struct {
+	// Members 按标识排列
+	Members []string
+	// Edges 组内不低于阈值的组合
+	Edges          []Edge
+	MaxSimilarity  float64
+	MeanSimilarity float64
+}
+
+// ClusterEdges 以相似度不低于 threshold 的组合求连通分量，只有一份提交的分量不返回；
+// 结果按最高相似度降序，相同时按成员数降序、首个成员排列
+func ClusterEdges(edges []Edge, threshold float64) []*Cluster {
+	parent := make(map[string]string)
+	var find func(x string) string
+	find = func(x string) string {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+
+	var kept []Edge
+	for _, edge := range edges {
+		if edge.Similarity < threshold || edge.A == edge.B {
+			continue
+		}
+		kept = append(kept, edge)
+		for _, x := range []string{edge.A, edge.B} {
+			if _, ok := parent[x]; !ok {
+				parent[x] = x
+			}
+		}
+		if rootA, rootB := find(edge.A), find(edge.B); rootA != rootB {
+			// 以较小的标识为根，保证结果确定
+			if rootA < rootB {
+				parent[rootB] = rootA
+			} else {
+				parent[rootA] = rootB
+			}
+		}
+	}
+
+	clusters := make(map[string]*Cluster)
+	for _, edge := range kept {
+		root := find(edge.A)
+		cluster, ok := clusters[root]
+		if !ok {
+			cluster = &Cluster{}
+			clusters[root] = cluster
+		}
+		cluster.Edges = append(cluster.Edges, edge)
+		cluster.MeanSimilarity += edge.Similarity
+		if edge.Similarity > cluster.MaxSimilarity {
+			cluster.MaxSimilarity = edge.Similarity
+		}
+	}
+	for member := range parent {
+		cluster := clusters[find(member)]
+		cluster.Members = append(cluster.Members, member)
+	}
+
+	result := make([]*Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		sort.Strings(cluster.Members)
+		cluster.MeanSimilarity /= float64(len(cluster.Edges))
+		result = append(result, cluster)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		switch {
+		case result[i].MaxSimilarity != result[j].MaxSimilarity:
+			return result[i].MaxSimilarity > result[j].MaxSimilarity
+		case len(result[i].Members) != len(result[j].Members):
+			return len(result[i].Members) > len(result[j].Members)
+		default:
+			return result[i].Members[0] < result[j].Members[0]
+		}
+	})
+	return result
+}
@@ -0,0 +1,41 @@
+package plagiarism_test
+
+import (
+	"testing"
+
+	. "code-platform/pkg/plagiarism"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterEdges(t *testing.T) {
+	edges := []Edge{
+		// 1、2、3 相互传阅
+		{A: "1", B: "2", Similarity: 0.9},
+		{A: "2", B: "3", Similarity: 0.7},
+		{A: "1", B: "3", Similarity: 0.3},
+		// 4 与参考实验中的提交相似
+		{A: "4", B: "12/5", Similarity: 0.95},
+		{A: "3", B: "4", Similarity: 0.2},
+		{A: "6", B: "7", Similarity: 0.1},
+	}
+
+	clusters := ClusterEdges(edges, 0.5)
+	require.Len(t, clusters, 2)
+
+	require.Equal(t, []string{"12/5", "4"}, clusters[0].Members)
+	require.Equal(t, 0.95, clusters[0].MaxSimilarity)
+	require.Equal(t, 0.95, clusters[0].MeanSimilarity)
+
+	require.Equal(t, []string{"1", "2", "3"}, clusters[1].Members)
+	require.Equal(t, 0.9, clusters[1].MaxSimilarity)
+	require.InDelta(t, 0.8, clusters[1].MeanSimilarity, 1e-9)
+	require.Len(t, clusters[1].Edges, 2)
+
+	// 阈值降低后通过 3、4 连成一组
+	clusters = ClusterEdges(edges, 0.2)
+	require.Len(t, clusters, 1)
+	require.Equal(t, []string{"1", "12/5", "2", "3", "4"}, clusters[0].Members)
+
+	require.Empty(t, ClusterEdges(edges, 0.99))
+}
@@ -0,0 +1,268 @@
+package plagiarism
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+var ErrNotEnoughSubmissions = errors.New("at least two submissions with code are required")
+
+// Options 比对参数
+type Options struct {
+	// MinMatch 计入相似的最短连续词法单元数，过小时常见的语句组合也会被视为相似
+	MinMatch int
+	// Base 不为空时为实验下发的基础代码，提交中与其相同且不短于 MinMatch 的片段不参与比对与相似度计算
+	Base *Submission
+	// Progress 不为空时每完成一组比对调用一次，done 为已完成的组合数，调用是串行的
+	Progress func(done, total int)
+}
+
+// DefaultOptions 与 JPlag 各语言的默认值一致
+func DefaultOptions(language Language) Options {
+	if language == Java {
+		return Options{MinMatch: 9}
+	}
+	return Options{MinMatch: 12}
+}
+
+// File 提交中的一个源文件，Name 为相对提交根目录的路径
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Submission 一名学生的全部源文件，各文件的词法单元依次连接，文件之间以不参与匹配的分隔位置隔开
+type Submission struct {
+	Name  string
+	Files []string
+	// Reference 为参考提交（其他实验或往届的提交），只与非参考的提交比对，且在比对结果中总是作为 B
+	Reference bool
+	// Tokens 与 seq 中非分隔的位置一一对应
+	Tokens []Token
+
+	seq       []uint32
+	separator []bool
+	// positions seq 中的位置对应的 Tokens 下标，分隔位置为 -1
+	positions []int
+	// excluded 与基础代码相同的位置，未排除基础代码时为 nil
+	excluded   []bool
+	baseTokens int
+}
+
+// NewSubmission 按 files 的顺序连接词法单元，调用方应保证顺序确定
+func NewSubmission(name string, language Language, files []File) *Submission {
+	s := &Submission{Name: name, Files: make([]string, len(files))}
+	for fileIndex, file := range files {
+		s.Files[fileIndex] = file.Name
+		if fileIndex != 0 {
+			s.seq = append(s.seq, 0)
+			s.separator = append(s.separator, true)
+			s.positions = append(s.positions, -1)
+		}
+		for _, token := range Tokenize(language, file.Content) {
+			token.File = fileIndex
+			s.positions = append(s.positions, len(s.Tokens))
+			s.Tokens = append(s.Tokens, token)
+			s.seq = append(s.seq, hashString(token.Value))
+			s.separator = append(s.separator, false)
+		}
+	}
+	return s
+}
+
+// Size 不属于基础代码的词法单元数
+func (s *Submission) Size() int {
+	return len(s.Tokens) - s.baseTokens
+}
+
+// marks 文件分隔与基础代码的位置，均不参与匹配
+func (s *Submission) marks() []bool {
+	marked := make([]bool, len(s.separator))
+	copy(marked, s.separator)
+	for index, ok := range s.excluded {
+		if ok {
+			marked[index] = true
+		}
+	}
+	return marked
+}
+
+// excludeBase 以 Greedy String Tiling 找出与基础代码相同的片段，重复调用时以最后一次的基础代码为准
+func (s *Submission) excludeBase(base *Submission, minMatch int) {
+	s.excluded, s.baseTokens = nil, 0
+	if base == nil {
+		return
+	}
+	s.excluded = make([]bool, len(s.seq))
+	for _, t := range greedyStringTiling(s.seq, base.seq, s.marks(), base.marks(), minMatch) {
+		for offset := 0; offset < t.Length; offset++ {
+			s.excluded[t.StartA+offset] = true
+		}
+		s.baseTokens += t.Length
+	}
+}
+
+// Region 文件中的行范围，行号从 1 开始且包含 EndLine
+type Region struct {
+	File      string
+	StartLine int
+	EndLine   int
+}
+
+// Match 两份提交中相同的片段
+type Match struct {
+	A, B Region
+	// Tokens 片段包含的词法单元数
+	Tokens int
+}
+
+// Comparison 两份提交的比对结果
+type Comparison struct {
+	A, B    *Submission
+	Matches []Match
+	// Similarity 两份提交中被匹配的词法单元占总数的比例，0 到 1
+	Similarity float64
+	// SimilarityA、SimilarityB 分别为被匹配的词法单元占 A、B 的比例
+	SimilarityA, SimilarityB float64
+}
+
+// Compare 以 Greedy String Tiling 比对两份提交
+func Compare(a, b *Submission, options Options) *Comparison {
+	tiles := greedyStringTiling(a.seq, b.seq, a.marks(), b.marks(), options.MinMatch)
+	return newComparison(a, b, tiles)
+}
+
+func newComparison(a, b *Submission, tiles []tile) *Comparison {
+	c := &Comparison{A: a, B: b, Matches: make([]Match, 0, len(tiles))}
+	// 按在 A 中的位置排列
+	sort.Slice(tiles, func(i, j int) bool {
+		return tiles[i].StartA < tiles[j].StartA
+	})
+	matched := 0
+	for _, t := range tiles {
+		matched += t.Length
+		c.Matches = append(c.Matches, Match{
+			A:      a.region(t.StartA, t.Length),
+			B:      b.region(t.StartB, t.Length),
+			Tokens: t.Length,
+		})
+	}
+	if total := a.Size() + b.Size(); total != 0 {
+		c.Similarity = float64(2*matched) / float64(total)
+	}
+	if a.Size() != 0 {
+		c.SimilarityA = float64(matched) / float64(a.Size())
+	}
+	if b.Size() != 0 {
+		c.SimilarityB = float64(matched) / float64(b.Size())
+	}
+	return c
+}
+
+// region 片段不跨越文件分隔，首尾均为同一文件中的词法单元
+func (s *Submission) region(start, length int) Region {
+	first := s.Tokens[s.positions[start]]
+	last := s.Tokens[s.positions[start+length-1]]
+	return Region{File: s.Files[first.File], StartLine: first.Line, EndLine: last.Line}
+}
+
+// Detect 两两比对全部提交，参考提交之间不比对；结果按相似度降序，相同时按提交名排列；没有词法单元的提交不参与比对。
+// 先以 winnowing 指纹排除不可能有足够长相同片段的组合，其余再做 Greedy String Tiling
+func Detect(submissions []*Submission, options Options) ([]*Comparison, error) {
+	return DetectContext(context.Background(), submissions, options)
+}
+
+// DetectContext 同 Detect，ctx 结束时放弃尚未开始的比对并返回 ctx.Err()
+func DetectContext(ctx context.Context, submissions []*Submission, options Options) ([]*Comparison, error) {
+	valid := make([]*Submission, 0, len(submissions))
+	for _, submission := range submissions {
+		submission.excludeBase(options.Base, options.MinMatch)
+		// 只有基础代码的提交同样不参与比对
+		if submission.Size() != 0 {
+			valid = append(valid, submission)
+		}
+	}
+	// 非参考的提交在前，参考提交之间的组合位于全部组合的末尾，不参与比对
+	sort.SliceStable(valid, func(i, j int) bool {
+		if valid[i].Reference != valid[j].Reference {
+			return !valid[i].Reference
+		}
+		return valid[i].Name < valid[j].Name
+	})
+	references := 0
+	for _, submission := range valid {
+		if submission.Reference {
+			references++
+		}
+	}
+	if len(valid) < 2 || references == len(valid) {
+		return nil, ErrNotEnoughSubmissions
+	}
+
+	k := fingerprintK
+	if options.MinMatch < k {
+		k = options.MinMatch
+	}
+	fingerprints := make([]map[uint64]struct{}, len(valid))
+	for index, submission := range valid {
+		fingerprints[index] = winnow(submission.seq, submission.marks(), k, options.MinMatch-k+1)
+	}
+
+	type pair struct{ i, j int }
+	pairs := make(chan pair)
+	comparisons := make([]*Comparison, len(valid)*(len(valid)-1)/2-references*(references-1)/2)
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		done int
+	)
+	for worker := 0; worker < runtime.NumCPU(); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range pairs {
+				a, b := valid[p.i], valid[p.j]
+				comparison := newComparison(a, b, nil)
+				if shareFingerprint(fingerprints[p.i], fingerprints[p.j]) {
+					comparison = Compare(a, b, options)
+				}
+				comparisons[pairIndex(p.i, p.j, len(valid))] = comparison
+
+				if options.Progress != nil {
+					mu.Lock()
+					done++
+					options.Progress(done, len(comparisons))
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+produce:
+	for i := 0; i < len(valid)-references; i++ {
+		for j := i + 1; j < len(valid); j++ {
+			select {
+			case pairs <- pair{i, j}:
+			case <-ctx.Done():
+				break produce
+			}
+		}
+	}
+	close(pairs)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(comparisons, func(i, j int) bool {
+		return comparisons[i].Similarity > comparisons[j].Similarity
+	})
+	return comparisons, nil
+}
+
+// pairIndex i < j 的组合在按字典序排列的全部组合中的下标
+func pairIndex(i, j, n int) int {
+	return i*(2*n-i-1)/2 + j - i - 1
+}
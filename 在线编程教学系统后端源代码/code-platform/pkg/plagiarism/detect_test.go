@@ -0,0 +1,204 @@
+package plagiarism_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	. "code-platform/pkg/plagiarism"
+
+	"github.com/stretchr/testify/require"
+)
+
+// loadCorpus testdata/<dir> 下每个子目录为一份提交，文件按路径排序
+func loadCorpus(t *testing.T, dir string, language Language) []*Submission {
+	root := filepath.Join("testdata", dir)
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+
+	submissions := make([]*Submission, 0, len(entries))
+	for _, entry := range entries {
+		names, err := filepath.Glob(filepath.Join(root, entry.Name(), "*"))
+		require.NoError(t, err)
+		sort.Strings(names)
+		files := make([]File, len(names))
+		for index, name := range names {
+			content, err := os.ReadFile(name)
+			require.NoError(t, err)
+			files[index] = File{Name: filepath.Base(name), Content: content}
+		}
+		submissions = append(submissions, NewSubmission(entry.Name(), language, files))
+	}
+	return submissions
+}
+
+func TestDetect(t *testing.T) {
+	type want struct {
+		pair       string
+		similarity float64
+	}
+	tests := []struct {
+		dir      string
+		language Language
+		want     []want
+		// topMatches 相似度最高的一组的相同片段
+		topMatches []Match
+	}{
+		{
+			dir:      "python",
+			language: Python3,
+			// 5 只有导入与注释，不参与比对
+			want: []want{{"1-2", 0.9526}, {"2-3", 0.3976}, {"1-3", 0.3964}, {"1-4", 0}, {"2-4", 0}, {"3-4", 0}},
+			topMatches: []Match{
+				{A: Region{File: "main.py", StartLine: 7, EndLine: 33}, B: Region{File: "solution.py", StartLine: 8, EndLine: 34}, Tokens: 171},
+			},
+		},
+		{
+			dir:      "cpp",
+			language: Cpp,
+			want:     []want{{"1-2", 0.8}, {"1-3", 0}, {"2-3", 0}},
+			// 跨文件的提交中片段不跨越文件
+			topMatches: []Match{
+				{A: Region{File: "main.cpp", StartLine: 6, EndLine: 16}, B: Region{File: "sort.h", StartLine: 5, EndLine: 15}, Tokens: 86},
+				{A: Region{File: "main.cpp", StartLine: 16, EndLine: 23}, B: Region{File: "sort.h", StartLine: 15, EndLine: 22}, Tokens: 39},
+				{A: Region{File: "main.cpp", StartLine: 28, EndLine: 29}, B: Region{File: "main.cpp", StartLine: 7, EndLine: 8}, Tokens: 24},
+				{A: Region{File: "main.cpp", StartLine: 29, EndLine: 31}, B: Region{File: "main.cpp", StartLine: 8, EndLine: 10}, Tokens: 27},
+			},
+		},
+		{
+			dir:      "java",
+			language: Java,
+			want:     []want{{"1-2", 0.7843}, {"2-3", 0.1421}, {"1-3", 0.0952}},
+			topMatches: []Match{
+				{A: Region{File: "Solution.java", StartLine: 6, EndLine: 22}, B: Region{File: "Solution.java", StartLine: 3, EndLine: 16}, Tokens: 120},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			comparisons, err := Detect(loadCorpus(t, tt.dir, tt.language), DefaultOptions(tt.language))
+			require.NoError(t, err)
+			require.Len(t, comparisons, len(tt.want))
+			for index, comparison := range comparisons {
+				require.Equal(t, tt.want[index].pair, comparison.A.Name+"-"+comparison.B.Name)
+				require.InDelta(t, tt.want[index].similarity, comparison.Similarity, 0.0001)
+			}
+			require.Equal(t, tt.topMatches, comparisons[0].Matches)
+
+			// 结果与并发执行的顺序无关
+			again, err := Detect(loadCorpus(t, tt.dir, tt.language), DefaultOptions(tt.language))
+			require.NoError(t, err)
+			for index := range comparisons {
+				require.Equal(t, comparisons[index].Matches, again[index].Matches)
+			}
+		})
+	}
+}
+
+func TestDetectNotEnoughSubmissions(t *testing.T) {
+	submissions := []*Submission{
+		NewSubmission("1", Python3, []File{{Name: "a.py", Content: []byte("print(1)")}}),
+		NewSubmission("2", Python3, []File{{Name: "a.py", Content: []byte("# empty")}}),
+	}
+	_, err := Detect(submissions, DefaultOptions(Python3))
+	require.Equal(t, ErrNotEnoughSubmissions, err)
+}
+
+func TestDetectContext(t *testing.T) {
+	options := DefaultOptions(Python3)
+	var progress []int
+	options.Progress = func(done, total int) {
+		require.Equal(t, 6, total)
+		progress = append(progress, done)
+	}
+	_, err := DetectContext(context.Background(), loadCorpus(t, "python", Python3), options)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, progress)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = DetectContext(ctx, loadCorpus(t, "python", Python3), DefaultOptions(Python3))
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestCompare(t *testing.T) {
+	a := NewSubmission("a", Python3, []File{{Name: "a.py", Content: []byte("for i in range(10):\n    total = total + i * 2\nprint(total)\n")}})
+	// 只修改了变量名、常量与注释
+	b := NewSubmission("b", Python3, []File{{Name: "b.py", Content: []byte("# sum\nfor k in range(20):\n    s = s + k * 3\nprint(s)\n")}})
+
+	comparison := Compare(a, b, Options{MinMatch: 5})
+	require.Equal(t, 1.0, comparison.Similarity)
+	require.Equal(t, []Match{{
+		A:      Region{File: "a.py", StartLine: 1, EndLine: 3},
+		B:      Region{File: "b.py", StartLine: 2, EndLine: 4},
+		Tokens: a.Size(),
+	}}, comparison.Matches)
+
+	// 最短片段长于提交时不计入相似
+	comparison = Compare(a, b, Options{MinMatch: a.Size() + 1})
+	require.Zero(t, comparison.Similarity)
+	require.Empty(t, comparison.Matches)
+}
+
+func TestDetectBase(t *testing.T) {
+	const base = `def read_ints():
+    return [int(value) for value in input().split()]
+
+
+def write_result(result):
+    print("result:", result)
+`
+	a := NewSubmission("1", Python3, []File{
+		{Name: "helper.py", Content: []byte(base)},
+		{Name: "main.py", Content: []byte("numbers = read_ints()\nwrite_result(sum(numbers))\n")},
+	})
+	b := NewSubmission("2", Python3, []File{
+		{Name: "helper.py", Content: []byte(base)},
+		{Name: "main.py", Content: []byte("values = sorted(read_ints())\nfor v in values:\n    if v > 0:\n        write_result(v)\n")},
+	})
+
+	options := Options{MinMatch: 8}
+	comparisons, err := Detect([]*Submission{a, b}, options)
+	require.NoError(t, err)
+	require.Greater(t, comparisons[0].Similarity, 0.5)
+
+	// 相同的部分只有基础代码
+	options.Base = NewSubmission("base", Python3, []File{{Name: "helper.py", Content: []byte(base)}})
+	comparisons, err = Detect([]*Submission{a, b}, options)
+	require.NoError(t, err)
+	require.Zero(t, comparisons[0].Similarity)
+	require.Empty(t, comparisons[0].Matches)
+	require.Less(t, a.Size(), len(a.Tokens))
+
+	// 只有基础代码的提交不参与比对
+	c := NewSubmission("3", Python3, []File{{Name: "helper.py", Content: []byte(base)}})
+	_, err = Detect([]*Submission{a, c}, options)
+	require.Equal(t, ErrNotEnoughSubmissions, err)
+}
+
+func TestDetectReferences(t *testing.T) {
+	const code = "for i in range(10):\n    total = total + i * 2\nprint(total)\n"
+	newSubmission := func(name string, reference bool) *Submission {
+		s := NewSubmission(name, Python3, []File{{Name: "main.py", Content: []byte(code)}})
+		s.Reference = reference
+		return s
+	}
+
+	var total int
+	options := Options{MinMatch: 5, Progress: func(done, n int) { total = n }}
+	comparisons, err := Detect([]*Submission{newSubmission("r1", true), newSubmission("2", false), newSubmission("r2", true)}, options)
+	require.NoError(t, err)
+	// 参考提交之间不比对，且总是作为 B
+	require.Equal(t, 2, total)
+	require.Len(t, comparisons, 2)
+	for _, comparison := range comparisons {
+		require.Equal(t, "2", comparison.A.Name)
+		require.True(t, comparison.B.Reference)
+		require.Equal(t, 1.0, comparison.Similarity)
+	}
+
+	_, err = Detect([]*Submission{newSubmission("r1", true), newSubmission("r2", true)}, options)
+	require.Equal(t, ErrNotEnoughSubmissions, err)
+}
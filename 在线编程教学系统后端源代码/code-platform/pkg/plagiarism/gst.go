@@ -0,0 +1,110 @@
+package plagiarism
+
+// tile a[StartA:StartA+Length] 与 b[StartB:StartB+Length] 相同
+type tile struct {
+	StartA, StartB, Length int
+}
+
+// greedyStringTiling 以 Running-Karp-Rabin Greedy String Tiling 求两个序列中互不重叠的相同片段：
+// 每轮找出未标记部分中最长的公共片段并标记，直到最长的片段短于 minMatch。
+// markedA、markedB 中预先标记的位置（文件分隔）不参与匹配，调用后被修改
+func greedyStringTiling(a, b []uint32, markedA, markedB []bool, minMatch int) []tile {
+	var tiles []tile
+	if minMatch <= 0 || len(a) < minMatch || len(b) < minMatch {
+		return tiles
+	}
+	hashesA := windowHashes(a, minMatch)
+	hashesB := windowHashes(b, minMatch)
+
+	for {
+		// 以 b 中未标记窗口的哈希建立索引，按位置升序，保证结果确定
+		unmarkedB := unmarkedWindows(markedB, minMatch)
+		index := make(map[uint64][]int)
+		for q, ok := range unmarkedB {
+			if ok {
+				index[hashesB[q]] = append(index[hashesB[q]], q)
+			}
+		}
+		if len(index) == 0 {
+			return tiles
+		}
+
+		maxMatch := minMatch
+		var candidates []tile
+		for p, ok := range unmarkedWindows(markedA, minMatch) {
+			if !ok {
+				continue
+			}
+			for _, q := range index[hashesA[p]] {
+				length := 0
+				for p+length < len(a) && q+length < len(b) &&
+					!markedA[p+length] && !markedB[q+length] && a[p+length] == b[q+length] {
+					length++
+				}
+				switch {
+				case length < maxMatch:
+					// 哈希冲突或短于本轮最长的片段
+				case length > maxMatch:
+					maxMatch = length
+					candidates = append(candidates[:0], tile{StartA: p, StartB: q, Length: length})
+				default:
+					candidates = append(candidates, tile{StartA: p, StartB: q, Length: length})
+				}
+			}
+		}
+		if len(candidates) == 0 {
+			return tiles
+		}
+
+		for _, candidate := range candidates {
+			if occluded(markedA, candidate.StartA, candidate.Length) || occluded(markedB, candidate.StartB, candidate.Length) {
+				continue
+			}
+			for offset := 0; offset < candidate.Length; offset++ {
+				markedA[candidate.StartA+offset] = true
+				markedB[candidate.StartB+offset] = true
+			}
+			tiles = append(tiles, candidate)
+		}
+		// 长度为 minMatch 的片段已全部标记，剩余部分不会再有足够长的片段
+		if maxMatch == minMatch {
+			return tiles
+		}
+	}
+}
+
+// windowHashes 每个起始位置长度为 length 的窗口的哈希
+func windowHashes(seq []uint32, length int) []uint64 {
+	hashes := make([]uint64, len(seq)-length+1)
+	for start := range hashes {
+		hashes[start] = hashTokens(seq[start : start+length])
+	}
+	return hashes
+}
+
+// unmarkedWindows 每个起始位置长度为 length 的窗口是否全部未标记
+func unmarkedWindows(marked []bool, length int) []bool {
+	windows := make([]bool, len(marked)-length+1)
+	count := 0
+	for index, ok := range marked {
+		if ok {
+			count++
+		}
+		if index >= length && marked[index-length] {
+			count--
+		}
+		if start := index - length + 1; start >= 0 {
+			windows[start] = count == 0
+		}
+	}
+	return windows
+}
+
+func occluded(marked []bool, start, length int) bool {
+	for offset := 0; offset < length; offset++ {
+		if marked[start+offset] {
+			return true
+		}
+	}
+	return false
+}
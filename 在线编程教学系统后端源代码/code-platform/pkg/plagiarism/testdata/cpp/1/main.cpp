@@ -0,0 +1,34 @@
+#include <iostream>
+#include <vector>
+using namespace std;
+
+// 快速排序
+void quickSort(vector<int> &a, int left, int right) {
+    if (left >= right) {
+        return;
+    }
+    int pivot = a[(left + right) / 2];
+    int i = left, j = right;
+    while (i <= j) {
+        while (a[i] < pivot) i++;
+        while (a[j] > pivot) j--;
+        if (i <= j) {
+            swap(a[i], a[j]);
+            i++;
+            j--;
+        }
+    }
+    quickSort(a, left, j);
+    quickSort(a, i, right);
+}
+
+int main() {
+    int n;
+    cin >> n;
+    vector<int> a(n);
+    for (int k = 0; k < n; k++) cin >> a[k];
+    quickSort(a, 0, n - 1);
+    for (int k = 0; k < n; k++) cout << a[k] << " ";
+    cout << endl;
+    return 0;
+}
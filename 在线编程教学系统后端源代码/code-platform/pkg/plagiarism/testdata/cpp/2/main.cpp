@@ -0,0 +1,13 @@
+#include <cstdio>
+#include "sort.h"
+
+int main() {
+    int count;
+    scanf("%d", &count);
+    std::vector<int> values(count);
+    for (int t = 0; t < count; t++) scanf("%d", &values[t]);
+    sortRange(values, 0, count - 1);
+    for (int t = 0; t < count; t++) printf("%d ", values[t]);
+    printf("\n");
+    return 0;
+}
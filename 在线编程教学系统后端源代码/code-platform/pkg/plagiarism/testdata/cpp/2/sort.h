@@ -0,0 +1,22 @@
+#pragma once
+#include <vector>
+
+/* 对 [lo, hi] 排序 */
+void sortRange(std::vector<int> &v, int lo, int hi) {
+    if (lo >= hi) {
+        return;
+    }
+    int mid = v[(lo + hi) / 2];
+    int p = lo, q = hi;
+    while (p <= q) {
+        while (v[p] < mid) p++;
+        while (v[q] > mid) q--;
+        if (p <= q) {
+            std::swap(v[p], v[q]);
+            p++;
+            q--;
+        }
+    }
+    sortRange(v, lo, q);
+    sortRange(v, p, hi);
+}
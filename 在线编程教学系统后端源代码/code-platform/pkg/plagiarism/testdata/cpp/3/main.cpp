@@ -0,0 +1,13 @@
+#include <iostream>
+using namespace std;
+
+int gcd(int a, int b) {
+    return b == 0 ? a : gcd(b, a % b);
+}
+
+int main() {
+    long long x, y;
+    cin >> x >> y;
+    cout << gcd(x, y) << '\n' << R"(raw "string")" << endl;
+    return 0;
+}
@@ -0,0 +1,26 @@
+package lab;
+
+import java.util.HashMap;
+import java.util.Map;
+
+public class Solution {
+    /**
+     * 返回和为 target 的两个下标
+     */
+    public int[] twoSum(int[] nums, int target) {
+        Map<Integer, Integer> seen = new HashMap<>();
+        for (int i = 0; i < nums.length; i++) {
+            int need = target - nums[i];
+            if (seen.containsKey(need)) {
+                return new int[]{seen.get(need), i};
+            }
+            seen.put(nums[i], i);
+        }
+        return new int[0];
+    }
+
+    public static void main(String[] args) {
+        int[] result = new Solution().twoSum(new int[]{2, 7, 11, 15}, 9);
+        System.out.println(result[0] + " " + result[1]);
+    }
+}
@@ -0,0 +1,22 @@
+import java.util.*;
+
+public class Solution {
+    public int[] twoSum(int[] values, int goal) {
+        Map<Integer, Integer> index = new HashMap<>();
+        for (int k = 0; k < values.length; k++) {
+            int rest = goal - values[k];
+            if (index.containsKey(rest)) {
+                return new int[]{index.get(rest), k};
+            }
+            index.put(values[k], k);
+        }
+        return new int[0];
+    }
+
+    public static void main(String[] args) {
+        String text = """
+            two sum
+            """;
+        System.out.println(text);
+    }
+}
@@ -0,0 +1,9 @@
+public class Main {
+    public static void main(String[] args) {
+        StringBuilder builder = new StringBuilder();
+        for (char c = 'a'; c <= 'z'; c++) {
+            builder.append(c);
+        }
+        System.out.println(builder.reverse());
+    }
+}
@@ -0,0 +1,33 @@
+import sys
+from collections import deque
+
+
+def bfs(graph, start):
+    """Return the distance from start to every reachable node."""
+    distance = {start: 0}
+    queue = deque([start])
+    while queue:
+        node = queue.popleft()
+        for neighbor in graph.get(node, []):
+            if neighbor not in distance:
+                distance[neighbor] = distance[node] + 1
+                queue.append(neighbor)
+    return distance
+
+
+def read_graph(lines):
+    graph = {}
+    for line in lines:
+        parts = line.split()
+        if len(parts) != 2:
+            continue
+        u, v = parts
+        graph.setdefault(u, []).append(v)
+        graph.setdefault(v, []).append(u)
+    return graph
+
+
+if __name__ == "__main__":
+    g = read_graph(sys.stdin.readlines())
+    for node, d in sorted(bfs(g, "a").items()):
+        print(node, d)
@@ -0,0 +1,34 @@
+# 宽度优先搜索
+import sys
+from collections import deque
+
+
+def shortest(adjacency, source):
+    # 记录每个结点的距离
+    dist = {source: 0}
+    pending = deque([source])
+    while pending:
+        current = pending.popleft()
+        for nxt in adjacency.get(current, []):
+            if nxt not in dist:
+                dist[nxt] = dist[current] + 1
+                pending.append(nxt)
+    return dist
+
+
+def parse(rows):
+    adjacency = {}
+    for row in rows:
+        fields = row.split()
+        if len(fields) != 2:
+            continue
+        x, y = fields
+        adjacency.setdefault(x, []).append(y)
+        adjacency.setdefault(y, []).append(x)
+    return adjacency
+
+
+if __name__ == '__main__':
+    adj = parse(sys.stdin.readlines())
+    for key, value in sorted(shortest(adj, 'a').items()):
+        print(key, value)
@@ -0,0 +1,30 @@
+import sys
+
+
+def read_graph(lines):
+    graph = {}
+    for line in lines:
+        parts = line.split()
+        if len(parts) != 2:
+            continue
+        u, v = parts
+        graph.setdefault(u, []).append(v)
+        graph.setdefault(v, []).append(u)
+    return graph
+
+
+def dfs(graph, start):
+    seen = set()
+    stack = [start]
+    order = []
+    while stack:
+        node = stack.pop()
+        if node in seen:
+            continue
+        seen.add(node)
+        order.append(node)
+        stack.extend(reversed(graph.get(node, [])))
+    return order
+
+
+print(dfs(read_graph(sys.stdin.readlines()), "a"))
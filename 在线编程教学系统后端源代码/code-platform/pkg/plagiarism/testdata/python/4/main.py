@@ -0,0 +1,11 @@
+def fib(n):
+    a, b = 0, 1
+    for _ in range(n):
+        a, b = b, a + b
+    return a
+
+
+total = 0
+for i in range(10):
+    total += fib(i) * 3
+print(total)
@@ -0,0 +1,2 @@
+# 只有注释与导入的提交没有词法单元
+import os
@@ -0,0 +1,239 @@
+package plagiarism
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// TextOptions 报告文本比对参数
+type TextOptions struct {
+	// ShingleSize 每个 shingle 包含的连续词数，中日韩文字每个字计为一个词
+	ShingleSize int
+	// NumHashes MinHash 签名的长度，越长估计越准确
+	NumHashes int
+	// MinSimilarity 估计的 Jaccard 相似度低于该值的组合不返回
+	MinSimilarity float64
+	// MaxPassages 每组返回的相同段落数上限，优先保留较长的段落
+	MaxPassages int
+}
+
+func DefaultTextOptions() TextOptions {
+	return TextOptions{ShingleSize: 8, NumHashes: 128, MinSimilarity: 0.1, MaxPassages: 20}
+}
+
+type textWord struct {
+	// start、end 在原文中的字节范围
+	start, end int
+	hash       uint64
+}
+
+// TextDocument 一份报告的文本，忽略大小写、空白与标点
+type TextDocument struct {
+	Name string
+	Text string
+
+	words []textWord
+	// shingles 第 i 个为从第 i 个词开始的 shingle
+	shingles  []uint64
+	signature []uint64
+}
+
+func NewTextDocument(name, text string, options TextOptions) *TextDocument {
+	d := &TextDocument{Name: name, Text: text}
+	start := -1
+	flush := func(end int) {
+		if start >= 0 {
+			d.words = append(d.words, textWord{start: start, end: end, hash: hashWord(strings.ToLower(text[start:end]))})
+			start = -1
+		}
+	}
+	for index, r := range text {
+		switch {
+		case isIdeograph(r):
+			flush(index)
+			end := index + len(string(r))
+			d.words = append(d.words, textWord{start: index, end: end, hash: hashWord(text[index:end])})
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start < 0 {
+				start = index
+			}
+		default:
+			flush(index)
+		}
+	}
+	flush(len(text))
+
+	// 不足一个 shingle 的文本整体作为一个
+	size := options.ShingleSize
+	if len(d.words) < size {
+		size = len(d.words)
+	}
+	if size == 0 {
+		return d
+	}
+	d.shingles = make([]uint64, len(d.words)-size+1)
+	for index := range d.shingles {
+		hash := uint64(fnvOffset64)
+		for _, word := range d.words[index : index+size] {
+			hash ^= word.hash
+			hash *= fnvPrime64
+		}
+		d.shingles[index] = hash
+	}
+
+	d.signature = make([]uint64, options.NumHashes)
+	for index := range d.signature {
+		d.signature[index] = ^uint64(0)
+	}
+	for _, shingle := range d.shingles {
+		for index := range d.signature {
+			if hash := mix64(shingle ^ minHashSeed(index)); hash < d.signature[index] {
+				d.signature[index] = hash
+			}
+		}
+	}
+	return d
+}
+
+// Words 文本中的词数
+func (d *TextDocument) Words() int {
+	return len(d.words)
+}
+
+func isIdeograph(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+func hashWord(word string) uint64 {
+	hash := uint64(fnvOffset64)
+	for index := 0; index < len(word); index++ {
+		hash ^= uint64(word[index])
+		hash *= fnvPrime64
+	}
+	return hash
+}
+
+// mix64 splitmix64 的混合步骤，用于从一个 shingle 哈希派生出多个独立的哈希
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func minHashSeed(index int) uint64 {
+	return mix64(uint64(index+1) * 0x9e3779b97f4a7c15)
+}
+
+// Passage 两份报告中相同的段落，TextA、TextB 为各自原文中的片段
+type Passage struct {
+	TextA, TextB string
+	// Words 段落包含的词数
+	Words int
+}
+
+// TextComparison 两份报告的比对结果
+type TextComparison struct {
+	A, B *TextDocument
+	// Similarity 以 MinHash 估计的两份报告 shingle 集合的 Jaccard 相似度，0 到 1
+	Similarity float64
+	Passages   []Passage
+}
+
+// CompareText 比对两份以相同参数创建的报告
+func CompareText(a, b *TextDocument, options TextOptions) *TextComparison {
+	return &TextComparison{A: a, B: b, Similarity: estimateSimilarity(a, b), Passages: passages(a, b, options)}
+}
+
+func estimateSimilarity(a, b *TextDocument) float64 {
+	if len(a.signature) == 0 || len(a.signature) != len(b.signature) {
+		return 0
+	}
+	equal := 0
+	for index := range a.signature {
+		if a.signature[index] == b.signature[index] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a.signature))
+}
+
+// passages A 中连续相同的 shingle 且在 B 中同样连续时合并为一个段落
+func passages(a, b *TextDocument, options TextOptions) []Passage {
+	positions := make(map[uint64]int, len(b.shingles))
+	for index := len(b.shingles) - 1; index >= 0; index-- {
+		positions[b.shingles[index]] = index
+	}
+	size := len(a.words) - len(a.shingles) + 1
+
+	result := []Passage{}
+	for i := 0; i < len(a.shingles); {
+		j, ok := positions[a.shingles[i]]
+		if !ok {
+			i++
+			continue
+		}
+		length := 1
+		for i+length < len(a.shingles) && j+length < len(b.shingles) && a.shingles[i+length] == b.shingles[j+length] {
+			length++
+		}
+		lastA, lastB := i+length+size-2, j+length+size-2
+		if lastB >= len(b.words) {
+			lastB = len(b.words) - 1
+		}
+		result = append(result, Passage{
+			TextA: a.Text[a.words[i].start:a.words[lastA].end],
+			TextB: b.Text[b.words[j].start:b.words[lastB].end],
+			Words: lastA - i + 1,
+		})
+		i += length
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Words > result[j].Words
+	})
+	if options.MaxPassages > 0 && len(result) > options.MaxPassages {
+		result = result[:options.MaxPassages]
+	}
+	return result
+}
+
+// DetectText 两两比对全部报告，只返回相似度不低于 MinSimilarity 的组合并找出其中相同的段落；
+// 结果按相似度降序，相同时按报告名排列；没有词的报告不参与比对
+func DetectText(documents []*TextDocument, options TextOptions) ([]*TextComparison, error) {
+	valid := make([]*TextDocument, 0, len(documents))
+	for _, document := range documents {
+		if len(document.shingles) != 0 {
+			valid = append(valid, document)
+		}
+	}
+	if len(valid) < 2 {
+		return nil, ErrNotEnoughSubmissions
+	}
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].Name < valid[j].Name
+	})
+
+	var comparisons []*TextComparison
+	for i := 0; i < len(valid); i++ {
+		for j := i + 1; j < len(valid); j++ {
+			// 先以签名估计相似度，只为超过阈值的组合查找段落
+			if estimateSimilarity(valid[i], valid[j]) < options.MinSimilarity {
+				continue
+			}
+			comparisons = append(comparisons, CompareText(valid[i], valid[j], options))
+		}
+	}
+	sort.SliceStable(comparisons, func(i, j int) bool {
+		return comparisons[i].Similarity > comparisons[j].Similarity
+	})
+	return comparisons, nil
+}
@@ -0,0 +1,45 @@
+package plagiarism_test
+
+import (
+	"strings"
+	"testing"
+
+	. "code-platform/pkg/plagiarism"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectText(t *testing.T) {
+	const copied = "本实验使用二分查找在有序数组中定位目标元素，每次比较后将查找区间缩小一半，时间复杂度为 O(log n)。"
+	options := DefaultTextOptions()
+	documents := []*TextDocument{
+		NewTextDocument("1", "实验目的\n"+copied+"\n心得：调试边界条件花了很多时间。", options),
+		// 仅标点与大小写不同
+		NewTextDocument("2", "实验目的："+copied+"\n总结：递归写法更简洁", options),
+		NewTextDocument("3", "The binary search halves the interval after each comparison until the target is found.", options),
+		NewTextDocument("4", "", options),
+	}
+	require.Zero(t, documents[3].Words())
+
+	comparisons, err := DetectText(documents, options)
+	require.NoError(t, err)
+	require.Len(t, comparisons, 1)
+	comparison := comparisons[0]
+	require.Equal(t, "1", comparison.A.Name)
+	require.Equal(t, "2", comparison.B.Name)
+	require.Greater(t, comparison.Similarity, 0.5)
+	require.Len(t, comparison.Passages, 1)
+	// 段落以词结束，不包含末尾的标点
+	require.Equal(t, "实验目的\n"+strings.TrimSuffix(copied, ")。"), comparison.Passages[0].TextA)
+	require.Equal(t, "实验目的："+strings.TrimSuffix(copied, ")。"), comparison.Passages[0].TextB)
+
+	// 英文按单词切分，忽略大小写
+	a := NewTextDocument("a", "The Binary Search halves the interval after each comparison.", options)
+	b := NewTextDocument("b", "the binary search halves the interval after each comparison", options)
+	comparison = CompareText(a, b, options)
+	require.Equal(t, 1.0, comparison.Similarity)
+	require.Equal(t, 9, comparison.Passages[0].Words)
+
+	_, err = DetectText(documents[2:], options)
+	require.Equal(t, ErrNotEnoughSubmissions, err)
+}
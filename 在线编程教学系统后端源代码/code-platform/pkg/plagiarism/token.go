@@ -0,0 +1,417 @@
+package plagiarism
+
+import (
+	"bytes"
+	"sort"
+)
+
+// Language 与 plagiarism_detection.proto 中的 language 取值一致
+type Language int8
+
+const (
+	Python3 Language = iota
+	Cpp
+	Java
+)
+
+// 标识符与字面量归一后的值，重命名变量或修改常量不影响比对
+const (
+	TokenIdentifier = "ID"
+	TokenNumber     = "NUM"
+	TokenString     = "STR"
+)
+
+// Token 归一化后的词法单元，关键字与运算符保留原文
+type Token struct {
+	Value string
+	// File 所在文件在提交中的序号
+	File int
+	// Line 从 1 开始
+	Line int
+}
+
+type languageSpec struct {
+	keywords map[string]bool
+	// operators 按长度降序，优先匹配最长的运算符
+	operators   []string
+	lineComment string
+	// blockComment 支持 /* */ 注释
+	blockComment bool
+	// preprocessor 行首 # 开始的预处理指令整行忽略
+	preprocessor bool
+	// tripleQuote 支持 """ 或 ''' 包围的多行字符串
+	tripleQuote bool
+	// stringPrefixes 紧接引号的字符串前缀，如 Python 的 f、rb 与 C++ 的 u8、R
+	stringPrefixes map[string]bool
+}
+
+var specs = map[Language]*languageSpec{
+	Python3: {
+		keywords: newSet(
+			"False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue",
+			"def", "del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+			"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with", "yield",
+		),
+		operators: sortOperators(
+			"**=", "//=", ">>=", "<<=", "...", "->", ":=", "**", "//", "<<", ">>", "<=", ">=", "==", "!=",
+			"+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "@=",
+		),
+		lineComment:    "#",
+		tripleQuote:    true,
+		stringPrefixes: newSet("r", "u", "b", "f", "br", "rb", "fr", "rf"),
+	},
+	Cpp: {
+		keywords: newSet(
+			"alignas", "alignof", "asm", "auto", "bool", "break", "case", "catch", "char", "char16_t", "char32_t",
+			"class", "const", "constexpr", "const_cast", "continue", "decltype", "default", "delete", "do",
+			"double", "dynamic_cast", "else", "enum", "explicit", "export", "extern", "false", "float", "for",
+			"friend", "goto", "if", "inline", "int", "long", "mutable", "namespace", "new", "noexcept", "nullptr",
+			"operator", "private", "protected", "public", "register", "reinterpret_cast", "return", "short",
+			"signed", "sizeof", "static", "static_assert", "static_cast", "struct", "switch", "template", "this",
+			"thread_local", "throw", "true", "try", "typedef", "typeid", "typename", "union", "unsigned", "using",
+			"virtual", "void", "volatile", "wchar_t", "while",
+		),
+		operators: sortOperators(
+			"<<=", ">>=", "->*", "...", "<=>", "::", "->", "++", "--", "<<", ">>", "<=", ">=", "==", "!=",
+			"&&", "||", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", ".*",
+		),
+		lineComment:    "//",
+		blockComment:   true,
+		preprocessor:   true,
+		stringPrefixes: newSet("L", "u", "U", "u8", "R", "LR", "uR", "UR", "u8R"),
+	},
+	Java: {
+		keywords: newSet(
+			"abstract", "assert", "boolean", "break", "byte", "case", "catch", "char", "class", "const",
+			"continue", "default", "do", "double", "else", "enum", "extends", "final", "finally", "float", "for",
+			"goto", "if", "implements", "import", "instanceof", "int", "interface", "long", "native", "new",
+			"package", "private", "protected", "public", "return", "short", "static", "strictfp", "super",
+			"switch", "synchronized", "this", "throw", "throws", "transient", "try", "void", "volatile", "while",
+			"true", "false", "null", "var", "record", "yield",
+		),
+		operators: sortOperators(
+			">>>=", "<<=", ">>=", ">>>", "...", "::", "->", "++", "--", "<<", ">>", "<=", ">=", "==", "!=",
+			"&&", "||", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=",
+		),
+		lineComment:  "//",
+		blockComment: true,
+		tripleQuote:  true,
+	},
+}
+
+func newSet(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+func sortOperators(operators ...string) []string {
+	sort.SliceStable(operators, func(i, j int) bool {
+		return len(operators[i]) > len(operators[j])
+	})
+	return operators
+}
+
+// Tokenize 忽略空白、注释、C++ 预处理指令以及导入语句等模板代码，其余按语言的关键字与运算符切分
+func Tokenize(language Language, src []byte) []Token {
+	spec, ok := specs[language]
+	if !ok {
+		return nil
+	}
+	l := &lexer{src: src, line: 1, spec: spec, lineStart: true}
+	l.run()
+	return dropBoilerplate(language, l.tokens)
+}
+
+type lexer struct {
+	src    []byte
+	spec   *languageSpec
+	tokens []Token
+	pos    int
+	line   int
+	// lineStart 当前行尚未出现非空白字符
+	lineStart bool
+}
+
+func (l *lexer) run() {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\n':
+			l.line++
+			l.pos++
+			l.lineStart = true
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			l.pos++
+		case c == '\\':
+			// 续行符
+			l.pos++
+		case l.hasPrefix(l.spec.lineComment):
+			l.skipLine()
+		case l.spec.blockComment && l.hasPrefix("/*"):
+			l.skipBlockComment()
+		case l.spec.preprocessor && c == '#' && l.lineStart:
+			l.skipDirective()
+		case isDigit(c) || (c == '.' && l.pos+1 < len(l.src) && isDigit(l.src[l.pos+1])):
+			l.scanNumber()
+		case isIdentifierStart(c):
+			l.scanIdentifier()
+		case c == '"' || c == '\'':
+			l.scanString(l.pos, false)
+		default:
+			l.scanOperator()
+		}
+	}
+}
+
+func (l *lexer) emit(value string, line int) {
+	l.tokens = append(l.tokens, Token{Value: value, Line: line})
+	l.lineStart = false
+}
+
+func (l *lexer) hasPrefix(prefix string) bool {
+	return prefix != "" && bytes.HasPrefix(l.src[l.pos:], []byte(prefix))
+}
+
+func (l *lexer) skipLine() {
+	for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+		l.pos++
+	}
+}
+
+func (l *lexer) skipBlockComment() {
+	l.pos += 2
+	for l.pos < len(l.src) && !l.hasPrefix("*/") {
+		if l.src[l.pos] == '\n' {
+			l.line++
+		}
+		l.pos++
+	}
+	l.pos += 2
+	if l.pos > len(l.src) {
+		l.pos = len(l.src)
+	}
+}
+
+// skipDirective 跳过预处理指令，行尾的 \ 续行到下一行
+func (l *lexer) skipDirective() {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == '\\' && l.pos+1 < len(l.src) && l.src[l.pos+1] == '\n' {
+			l.pos += 2
+			l.line++
+			continue
+		}
+		if c == '\n' {
+			return
+		}
+		l.pos++
+	}
+}
+
+func (l *lexer) scanNumber() {
+	start := l.pos
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case isIdentifierPart(c) || c == '.':
+			l.pos++
+		case (c == '+' || c == '-') && isExponent(l.src[l.pos-1]) && !isHexNumber(l.src[start:l.pos]):
+			l.pos++
+		case c == '\'' && l.pos+1 < len(l.src) && isDigit(l.src[l.pos+1]):
+			// C++14 的数字分隔符
+			l.pos++
+		default:
+			l.emit(TokenNumber, l.line)
+			return
+		}
+	}
+	l.emit(TokenNumber, l.line)
+}
+
+func (l *lexer) scanIdentifier() {
+	start := l.pos
+	for l.pos < len(l.src) && isIdentifierPart(l.src[l.pos]) {
+		l.pos++
+	}
+	word := string(l.src[start:l.pos])
+	if l.pos < len(l.src) && (l.src[l.pos] == '"' || l.src[l.pos] == '\'') && l.spec.stringPrefixes[word] {
+		l.scanString(l.pos, word[len(word)-1] == 'R')
+		return
+	}
+	if l.spec.keywords[word] {
+		l.emit(word, l.line)
+		return
+	}
+	l.emit(TokenIdentifier, l.line)
+}
+
+// scanString 从 quote 处的引号开始扫描字符串或字符字面量，未闭合的单行字符串在行尾结束
+func (l *lexer) scanString(quote int, raw bool) {
+	line := l.line
+	q := l.src[quote]
+	l.pos = quote
+	defer l.emit(TokenString, line)
+
+	if raw && q == '"' {
+		l.scanRawString()
+		return
+	}
+	if l.spec.tripleQuote && bytes.HasPrefix(l.src[l.pos:], []byte{q, q, q}) {
+		l.pos += 3
+		closing := []byte{q, q, q}
+		for l.pos < len(l.src) && !bytes.HasPrefix(l.src[l.pos:], closing) {
+			switch l.src[l.pos] {
+			case '\\':
+				l.pos++
+				if l.pos < len(l.src) && l.src[l.pos] == '\n' {
+					l.line++
+				}
+			case '\n':
+				l.line++
+			}
+			l.pos++
+		}
+		l.pos += 3
+		if l.pos > len(l.src) {
+			l.pos = len(l.src)
+		}
+		return
+	}
+
+	l.pos++
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\\':
+			if l.pos+1 < len(l.src) && l.src[l.pos+1] == '\n' {
+				l.line++
+			}
+			l.pos += 2
+			continue
+		case '\n':
+			return
+		case q:
+			l.pos++
+			return
+		}
+		l.pos++
+	}
+	if l.pos > len(l.src) {
+		l.pos = len(l.src)
+	}
+}
+
+// scanRawString C++ 的 R"delim(...)delim"
+func (l *lexer) scanRawString() {
+	open := bytes.IndexByte(l.src[l.pos:], '(')
+	if open < 0 {
+		l.pos = len(l.src)
+		return
+	}
+	delimiter := l.src[l.pos+1 : l.pos+open]
+	closing := append(append([]byte{')'}, delimiter...), '"')
+	l.pos += open + 1
+	end := bytes.Index(l.src[l.pos:], closing)
+	if end < 0 {
+		end = len(l.src) - l.pos
+	} else {
+		end += len(closing)
+	}
+	l.line += bytes.Count(l.src[l.pos:l.pos+end], []byte{'\n'})
+	l.pos += end
+}
+
+func (l *lexer) scanOperator() {
+	for _, operator := range l.spec.operators {
+		if l.hasPrefix(operator) {
+			l.pos += len(operator)
+			l.emit(operator, l.line)
+			return
+		}
+	}
+	l.emit(string(l.src[l.pos]), l.line)
+	l.pos++
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// isIdentifierStart 非 ASCII 字节视为 UTF-8 编码的字母
+func isIdentifierStart(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_' || c == '$' || c >= 0x80
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
+
+func isExponent(c byte) bool {
+	return c == 'e' || c == 'E' || c == 'p' || c == 'P'
+}
+
+func isHexNumber(number []byte) bool {
+	return len(number) > 1 && number[0] == '0' && (number[1] == 'x' || number[1] == 'X') &&
+		!bytes.ContainsAny(number, "pP")
+}
+
+// dropBoilerplate 去除导入语句，几乎每份提交都相同的导入会抬高相似度：
+// Python 行首的 import 与 from 语句，Java 的 import 与 package 语句，C++ 的 using namespace 语句
+func dropBoilerplate(language Language, tokens []Token) []Token {
+	kept := tokens[:0]
+	for index := 0; index < len(tokens); {
+		token := tokens[index]
+		firstOnLine := index == 0 || tokens[index-1].Line < token.Line
+		var end int
+		switch {
+		case language == Python3 && firstOnLine && (token.Value == "import" || token.Value == "from"):
+			end = pythonStatementEnd(tokens, index)
+		case language == Java && firstOnLine && (token.Value == "import" || token.Value == "package"):
+			end = statementEnd(tokens, index)
+		case language == Cpp && token.Value == "using" && index+1 < len(tokens) && tokens[index+1].Value == "namespace":
+			end = statementEnd(tokens, index)
+		default:
+			kept = append(kept, token)
+			index++
+			continue
+		}
+		index = end
+	}
+	return kept
+}
+
+// statementEnd 返回 start 之后第一个分号的下一个位置
+func statementEnd(tokens []Token, start int) int {
+	for index := start; index < len(tokens); index++ {
+		if tokens[index].Value == ";" {
+			return index + 1
+		}
+	}
+	return len(tokens)
+}
+
+// pythonStatementEnd 语句在行尾结束，括号内可跨行
+func pythonStatementEnd(tokens []Token, start int) int {
+	depth := 0
+	line := tokens[start].Line
+	for index := start; index < len(tokens); index++ {
+		token := tokens[index]
+		if depth == 0 && token.Line != line {
+			return index
+		}
+		switch token.Value {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case ";":
+			if depth == 0 {
+				return index + 1
+			}
+		}
+		line = token.Line
+	}
+	return len(tokens)
+}
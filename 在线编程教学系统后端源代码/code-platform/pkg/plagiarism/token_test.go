@@ -0,0 +1,76 @@
+package plagiarism_test
+
+import (
+	"strings"
+	"testing"
+
+	. "code-platform/pkg/plagiarism"
+
+	"github.com/stretchr/testify/require"
+)
+
+func values(tokens []Token) string {
+	vs := make([]string, len(tokens))
+	for index, token := range tokens {
+		vs[index] = token.Value
+	}
+	return strings.Join(vs, " ")
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		language Language
+		src      string
+		want     string
+		// lines 每个词法单元所在的行
+		lines []int
+	}{
+		{
+			name:     "python normalizes identifiers and literals",
+			language: Python3,
+			src:      "import os\nx = 1.5e-3 # comment\nif x >= 0x1F:\n    print(f'{x}', \"\"\"a\nb\"\"\")\n",
+			want:     "ID = NUM if ID >= NUM : ID ( STR , STR )",
+			lines:    []int{2, 2, 2, 3, 3, 3, 3, 3, 4, 4, 4, 4, 4, 5},
+		},
+		{
+			name:     "python drops parenthesized from import",
+			language: Python3,
+			src:      "from a import (\n    b,\n    c,\n)\nyield_ = b\n",
+			want:     "ID = ID",
+			lines:    []int{5, 5, 5},
+		},
+		{
+			name:     "cpp skips directives and using namespace",
+			language: Cpp,
+			src:      "#include <vector>\n#define MAX \\\n  100\nusing namespace std;\nint main() { /* a\nb */ return a->b <<= 1'000; }\n",
+			want:     "int ID ( ) { return ID -> ID <<= NUM ; }",
+			lines:    []int{5, 5, 5, 5, 5, 6, 6, 6, 6, 6, 6, 6, 6},
+		},
+		{
+			name:     "cpp raw string spans lines",
+			language: Cpp,
+			src:      "auto s = R\"x(a\n)\"b)x\";\nchar c = u8'c';\n",
+			want:     "auto ID = STR ; char ID = STR ;",
+			lines:    []int{1, 1, 1, 1, 2, 3, 3, 3, 3, 3},
+		},
+		{
+			name:     "java drops package and imports",
+			language: Java,
+			src:      "package a.b;\nimport java.util.*;\nclass A { String s = \"\"\"\n  x\n  \"\"\"; int y = x >>> 2; }\n",
+			want:     "class ID { ID ID = STR ; int ID = ID >>> NUM ; }",
+			lines:    []int{3, 3, 3, 3, 3, 3, 3, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := Tokenize(tt.language, []byte(tt.src))
+			require.Equal(t, tt.want, values(tokens))
+			lines := make([]int, len(tokens))
+			for index, token := range tokens {
+				lines[index] = token.Line
+			}
+			require.Equal(t, tt.lines, lines)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package plagiarism
+
+// fingerprintK 计算指纹的 k-gram 长度
+const fingerprintK = 5
+
+// winnow 对 k-gram 哈希做窗口为 w 的 winnowing，返回选中的指纹集合；
+// 两份提交中长度不小于 w+k-1 的相同片段必然产生至少一个相同的指纹。
+// 含有已标记位置（文件分隔）的 k-gram 不参与
+func winnow(seq []uint32, marked []bool, k, w int) map[uint64]struct{} {
+	hashes := make([]uint64, 0, len(seq))
+	run := 0
+	for index := range seq {
+		if marked[index] {
+			run = 0
+			continue
+		}
+		run++
+		if run >= k {
+			hashes = append(hashes, hashTokens(seq[index-k+1:index+1]))
+		}
+	}
+
+	fingerprints := make(map[uint64]struct{})
+	if len(hashes) == 0 {
+		return fingerprints
+	}
+	if len(hashes) < w {
+		w = len(hashes)
+	}
+	selected := -1
+	for start := 0; start+w <= len(hashes); start++ {
+		// 窗口内取最右侧的最小值，窗口滑动时若仍在窗口内则不重复选取
+		if selected < start {
+			selected = start
+			for index := start + 1; index < start+w; index++ {
+				if hashes[index] <= hashes[selected] {
+					selected = index
+				}
+			}
+		} else if last := start + w - 1; hashes[last] <= hashes[selected] {
+			selected = last
+		}
+		fingerprints[hashes[selected]] = struct{}{}
+	}
+	return fingerprints
+}
+
+// hashTokens FNV-1a
+func hashTokens(tokens []uint32) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	hash := uint64(offset)
+	for _, token := range tokens {
+		for shift := 0; shift < 32; shift += 8 {
+			hash ^= uint64(byte(token >> uint(shift)))
+			hash *= prime
+		}
+	}
+	return hash
+}
+
+func hashString(value string) uint32 {
+	const (
+		offset = 2166136261
+		prime  = 16777619
+	)
+	hash := uint32(offset)
+	for index := 0; index < len(value); index++ {
+		hash ^= uint32(value[index])
+		hash *= prime
+	}
+	return hash
+}
+
+// shareFingerprint 两个指纹集合是否有交集
+func shareFingerprint(a, b map[uint64]struct{}) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	for hash := range a {
+		if _, ok := b[hash]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package rediskey
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// 租约的值为 token:owner，token 由 fencing key 自增得到，每次重新获得租约时递增
+var (
+	// acquireLeaseScript 租约空闲时获得并分配新的 token，已由自己持有时续期并返回原 token，被他人持有时返回 0
+	acquireLeaseScript = redigo.NewScript(2, `
+local value = redis.call('GET', KEYS[1])
+if value then
+	local token, owner = string.match(value, '^(%d+):(.*)$')
+	if owner == ARGV[1] then
+		redis.call('PEXPIRE', KEYS[1], ARGV[2])
+		return tonumber(token)
+	end
+	return 0
+end
+local token = redis.call('INCR', KEYS[2])
+redis.call('SET', KEYS[1], token .. ':' .. ARGV[1], 'PX', ARGV[2])
+return token
+`)
+
+	renewLeaseScript = redigo.NewScript(1, `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+	releaseLeaseScript = redigo.NewScript(1, `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+)
+
+var errInvalidLeaseValue = errors.New("invalid lease value")
+
+// Lease 基于 Redis 的租约，租期内只有一个持有者；持有者在执行有副作用的操作前应以 token 调用 Check，
+// 暂停后恢复的旧持有者因 token 已变化而放弃执行
+type Lease struct {
+	pool       *redigo.Pool
+	key        string
+	fencingKey string
+	owner      string
+	ttl        time.Duration
+}
+
+func NewLease(pool *redigo.Pool, name, owner string, ttl time.Duration) *Lease {
+	return &Lease{
+		pool:       pool,
+		key:        "lease:" + name,
+		fencingKey: "lease:" + name + ":fencing",
+		owner:      owner,
+		ttl:        ttl,
+	}
+}
+
+func (l *Lease) Owner() string {
+	return l.owner
+}
+
+// Acquire 尝试获得租约，已持有时续期；返回 fencing token，租约被他人持有时为 0
+func (l *Lease) Acquire(ctx context.Context) (uint64, error) {
+	return redigo.Uint64(l.eval(ctx, acquireLeaseScript, l.key, l.fencingKey, l.owner, l.ttl.Milliseconds()))
+}
+
+// Renew 续期以 token 持有的租约，租约已过期或被他人获得时返回 false
+func (l *Lease) Renew(ctx context.Context, token uint64) (bool, error) {
+	return redigo.Bool(l.eval(ctx, renewLeaseScript, l.key, l.value(token), l.ttl.Milliseconds()))
+}
+
+// Check 检查租约仍以 token 持有
+func (l *Lease) Check(ctx context.Context, token uint64) (bool, error) {
+	value, err := redigo.String(l.do(ctx, "GET", l.key))
+	switch err {
+	case nil:
+		return value == l.value(token), nil
+	case redigo.ErrNil:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Release 释放以 token 持有的租约，其他实例无需等待租约过期即可获得
+func (l *Lease) Release(ctx context.Context, token uint64) (bool, error) {
+	return redigo.Bool(l.eval(ctx, releaseLeaseScript, l.key, l.value(token)))
+}
+
+// Holder 返回当前持有者与其 token，租约空闲时返回 redigo.ErrNil
+func (l *Lease) Holder(ctx context.Context) (owner string, token uint64, err error) {
+	value, err := redigo.String(l.do(ctx, "GET", l.key))
+	if err != nil {
+		return "", 0, err
+	}
+	index := strings.IndexByte(value, ':')
+	if index < 0 {
+		return "", 0, errInvalidLeaseValue
+	}
+	token, err = strconv.ParseUint(value[:index], 10, 64)
+	if err != nil {
+		return "", 0, errInvalidLeaseValue
+	}
+	return value[index+1:], token, nil
+}
+
+func (l *Lease) value(token uint64) string {
+	return strconv.FormatUint(token, 10) + ":" + l.owner
+}
+
+func (l *Lease) do(ctx context.Context, commandName string, args ...interface{}) (interface{}, error) {
+	conn, err := l.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return redigo.DoContext(conn, ctx, commandName, args...)
+}
+
+func (l *Lease) eval(ctx context.Context, script *redigo.Script, keysAndArgs ...interface{}) (interface{}, error) {
+	conn, err := l.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return script.DoContext(ctx, conn, keysAndArgs...)
+}
@@ -0,0 +1,70 @@
+package rediskey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "code-platform/pkg/rediskey"
+	"code-platform/pkg/testx"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLease(t *testing.T) {
+	testStorage := testx.NewStorage()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustFlushDB(ctx, testStorage.Pool())
+
+	a := NewLease(testStorage.Pool(), "test", "a", time.Minute)
+	b := NewLease(testStorage.Pool(), "test", "b", time.Minute)
+
+	_, _, err := a.Holder(ctx)
+	require.Equal(t, redigo.ErrNil, err)
+
+	tokenA, err := a.Acquire(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, tokenA)
+
+	// 已持有时续期并返回原 token
+	token, err := a.Acquire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, tokenA, token)
+
+	token, err = b.Acquire(ctx)
+	require.NoError(t, err)
+	require.Zero(t, token)
+
+	owner, token, err := b.Holder(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", owner)
+	require.Equal(t, tokenA, token)
+
+	ok, err := a.Renew(ctx, tokenA)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = b.Release(ctx, tokenA)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = a.Release(ctx, tokenA)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// 新持有者的 token 更大，旧持有者校验失败
+	tokenB, err := b.Acquire(ctx)
+	require.NoError(t, err)
+	require.Greater(t, tokenB, tokenA)
+
+	ok, err = a.Check(ctx, tokenA)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = a.Renew(ctx, tokenA)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = b.Check(ctx, tokenB)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -48,6 +48,15 @@ func (e *EntityKey) do(ctx context.Context, commandName string, args ...interfac
 	return redigo.DoContext(conn, ctx, commandName, args...)
 }
 
+func (e *EntityKey) eval(ctx context.Context, script *redigo.Script, args ...interface{}) (interface{}, error) {
+	conn, err := e.pool.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return script.DoContext(ctx, conn, append([]interface{}{e.key}, args...)...)
+}
+
 func (e *EntityKey) Pool(pool *redigo.Pool) *EntityKey {
 	e.pool = pool
 	return e
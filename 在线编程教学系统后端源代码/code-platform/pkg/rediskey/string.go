@@ -7,6 +7,39 @@ import (
 	redigo "github.com/gomodule/redigo/redis"
 )
 
+// getDelScript 兼容 6.2 之前不支持 GETDEL 的 Redis
+var getDelScript = redigo.NewScript(1, `
+local value = redis.call('GET', KEYS[1])
+if value then
+	redis.call('DEL', KEYS[1])
+end
+return value
+`)
+
+// compareAndSetScript 值未被修改时写入新值并保留剩余的过期时间
+var compareAndSetScript = redigo.NewScript(1, `
+if redis.call('GET', KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl > 0 then
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ttl)
+else
+	redis.call('SET', KEYS[1], ARGV[2])
+end
+return 1
+`)
+
+// CompareAndSet key 的值仍为 old 时写入 value，返回是否写入；key 不存在时返回 false
+func (e *EntityKey) CompareAndSet(ctx context.Context, old, value interface{}) (bool, error) {
+	return redigo.Bool(e.eval(ctx, compareAndSetScript, old, value))
+}
+
+// GetDel 读取并删除 key，key 不存在时返回 redigo.ErrNil
+func (e *EntityKey) GetDel(ctx context.Context) (string, error) {
+	return redigo.String(e.eval(ctx, getDelScript))
+}
+
 func (e *EntityKey) Get(ctx context.Context) (string, error) {
 	return redigo.String(e.do(ctx, "GET", e.key))
 }
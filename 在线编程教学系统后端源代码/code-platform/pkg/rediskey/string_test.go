@@ -0,0 +1,42 @@
+package rediskey_test
+
+import (
+	"context"
+	"testing"
+
+	. "code-platform/pkg/rediskey"
+	"code-platform/pkg/testx"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareAndSet(t *testing.T) {
+	testStorage := testx.NewStorage()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustFlushDB(ctx, testStorage.Pool())
+
+	key := Newkey("cas").Pool(testStorage.Pool())
+	ok, err := key.CompareAndSet(ctx, "a", "b")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = key.SetEX(ctx, "a", 60)
+	require.NoError(t, err)
+	ok, err = key.CompareAndSet(ctx, "b", "c")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = key.CompareAndSet(ctx, "a", "b")
+	require.NoError(t, err)
+	require.True(t, ok)
+	value, err := key.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "b", value)
+
+	// 写入后保留原有的过期时间
+	ttl, err := key.TTL(ctx)
+	require.NoError(t, err)
+	require.Greater(t, ttl, 0)
+}
@@ -0,0 +1,4 @@
+-- 同一课程的不同教学班由管理员设置相同的编号，查重时可以互相作为参考实验
+ALTER TABLE `course`
+    ADD COLUMN `family_code` VARCHAR(50) DEFAULT NULL COMMENT '课程编号，同一课程的不同教学班相同，由管理员设置' AFTER `ide_kind`,
+    ADD KEY `idx_familycode`(`family_code`);
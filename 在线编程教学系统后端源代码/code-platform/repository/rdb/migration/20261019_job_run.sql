@@ -0,0 +1,21 @@
+-- 后台任务统一由调度器执行，每次执行记录在 job_run 中，延时任务在执行前同样保存在该表
+CREATE TABLE IF NOT EXISTS `job_run` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `name` VARCHAR(64) NOT NULL COMMENT '任务名，对应注册的处理函数',
+    `kind` TINYINT NOT NULL COMMENT '0: 周期任务, 1: 延时任务',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0: 等待执行, 1: 执行中, 2: 成功, 3: 失败',
+    `payload` MEDIUMTEXT NOT NULL,
+    `dedup_key` VARCHAR(128) DEFAULT NULL COMMENT '仅由等待或执行中的记录占用，结束后置空；周期任务为 periodic:任务名，同一任务同时只有一条未结束的记录',
+    `attempt` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '已开始执行的次数',
+    `max_attempts` INT UNSIGNED NOT NULL,
+    `fencing_token` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最近一次执行时持有者的租约 token',
+    `error` TEXT NOT NULL COMMENT '最近一次失败的原因，panic 时包含调用栈',
+    `run_at` DATETIME(3) NOT NULL COMMENT '计划执行时间，重试时为退避后的时间',
+    `started_at` DATETIME(3) DEFAULT NULL,
+    `finished_at` DATETIME(3) DEFAULT NULL,
+    `created_at` DATETIME(3) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_dedup_key` (`dedup_key`),
+    KEY `idx_status_run_at` (`status`, `run_at`),
+    KEY `idx_name_id` (`name`, `id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
@@ -0,0 +1,4 @@
+-- 保留上一版初始代码模板，更新后发现问题时可以恢复
+ALTER TABLE `lab`
+    ADD COLUMN `previous_template_object` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '上一版初始代码模板的对象名，用于恢复' AFTER `template_hash`,
+    ADD COLUMN `previous_template_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '上一版初始代码模板 zip 的 sha256' AFTER `previous_template_object`;
@@ -0,0 +1,3 @@
+-- 记录每个学生最近一次冻结失败的原因，实验未能全部冻结时可以看出是哪些学生
+ALTER TABLE `lab_submit`
+    ADD COLUMN `archive_error` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最近一次冻结失败的原因，冻结成功后清空' AFTER `archived_at`;
@@ -0,0 +1,11 @@
+-- 教师评定的成绩不被自动评分覆盖；同一工作区同时只允许一个执行中的测试运行
+ALTER TABLE `lab_submit`
+    ADD COLUMN `manual_score` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '成绩由教师评定，自动评分不再覆盖' AFTER `score`;
+
+ALTER TABLE `lab_test_run`
+    ADD COLUMN `active_lab_submit_id` BIGINT UNSIGNED DEFAULT NULL COMMENT '仅由执行中的记录占用，结束后置空；同一工作区同时只有一个执行中的运行' AFTER `lab_submit_id`,
+    ADD UNIQUE KEY `uk_active_lab_submit_id` (`active_lab_submit_id`);
+
+UPDATE `lab_test_run` SET `active_lab_submit_id` = `lab_submit_id` WHERE `finished_at` IS NULL
+AND `created_at` > NOW() - INTERVAL 15 MINUTE
+AND `id` IN (SELECT `latest_id` FROM (SELECT MAX(`id`) AS `latest_id` FROM `lab_test_run` WHERE `finished_at` IS NULL GROUP BY `lab_submit_id`) AS r);
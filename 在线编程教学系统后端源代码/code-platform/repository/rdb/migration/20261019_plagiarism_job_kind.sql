@@ -0,0 +1,6 @@
+-- 实验报告的文本查重同样由调度器在后台执行，与代码查重共用 plagiarism_job；同一实验两类查重可以同时进行
+ALTER TABLE `plagiarism_job`
+    ADD COLUMN `kind` TINYINT NOT NULL DEFAULT 0 COMMENT '0: 代码查重, 1: 实验报告查重' AFTER `active_lab_id`,
+    ADD COLUMN `report_detection_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '实验报告查重完成后写入的报告查重记录' AFTER `detection_report_id`,
+    DROP INDEX `uk_active_lab_id`,
+    ADD UNIQUE KEY `uk_active_lab_id_kind` (`active_lab_id`, `kind`);
@@ -0,0 +1,3 @@
+-- 查重可附带其他实验或往届实验作为参考
+ALTER TABLE `plagiarism_job`
+    ADD COLUMN `reference_lab_ids` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT '逗号分隔的参考实验，其提交只与本实验的提交比对' AFTER `language`;
@@ -0,0 +1,9 @@
+-- 实验报告文本查重的结果，data 为 JSON，包含相似的报告组合及相同段落
+CREATE TABLE IF NOT EXISTS `report_detection` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `data` LONGBLOB NOT NULL,
+    `created_at` DATETIME(3) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uidx_labid_created_at`(`lab_id`, `created_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CodingInterval 学生在 IDE 中的一段活跃编码时间，按天汇总后计入 coding_time
+type CodingInterval struct {
+	StartedAt time.Time `db:"started_at"`
+	EndedAt   time.Time `db:"ended_at"`
+	ID        uint64    `db:"id"`
+	LabID     uint64    `db:"lab_id"`
+	UserID    uint64    `db:"user_id"`
+}
+
+func BatchInsertCodingIntervals(ctx context.Context, rdbClient storage.RDBClient, intervals []*CodingInterval) error {
+	if len(intervals) == 0 {
+		return nil
+	}
+	const sqlStr = `
+INSERT INTO coding_interval
+(lab_id, user_id, started_at, ended_at)
+VALUES (:lab_id, :user_id, :started_at, :ended_at)
+`
+	result, err := sqlx.NamedExecContext(ctx, rdbClient, sqlStr, intervals)
+	if err != nil {
+		return err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	for index := range intervals {
+		intervals[index].ID = uint64(lastID) + uint64(index)
+	}
+	return nil
+}
+
+func QueryCodingIntervalsByLabIDAndUserID(ctx context.Context, rdbClient storage.RDBClient, labID, userID uint64) ([]*CodingInterval, error) {
+	const sqlStr = `SELECT * FROM coding_interval WHERE lab_id = ? AND user_id = ? ORDER BY started_at`
+	var intervals []*CodingInterval
+	if err := sqlx.SelectContext(ctx, rdbClient, &intervals, sqlStr, labID, userID); err != nil {
+		return nil, err
+	}
+	return intervals, nil
+}
@@ -97,6 +97,23 @@ func QueryCodingTimesMapByLabIDAndUserIDs(ctx context.Context, rdbClient storage
 	return m, nil
 }
 
+// QueryCodingTimesByLabIDAndUserID 按记录时间升序
+func QueryCodingTimesByLabIDAndUserID(ctx context.Context, rdbClient storage.RDBClient, labID, userID uint64) ([]*CodingTime, error) {
+	query, args, err := squirrel.Select("*").
+		From("coding_time").
+		Where(squirrel.Eq{"lab_id": labID, "user_id": userID}).
+		OrderBy("created_at ASC", "id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var codingTimes []*CodingTime
+	if err := sqlx.SelectContext(ctx, rdbClient, &codingTimes, query, args...); err != nil {
+		return nil, err
+	}
+	return codingTimes, nil
+}
+
 func BatchInsertCodingTimes(ctx context.Context, rdbClient storage.RDBClient, codingTimes []*CodingTime) error {
 	if len(codingTimes) == 0 {
 		return nil
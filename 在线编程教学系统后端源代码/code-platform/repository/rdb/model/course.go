@@ -18,11 +18,13 @@ type Course struct {
 	Name        string         `db:"name"`
 	Description string         `db:"description"`
 	SecretKey   sql.NullString `db:"secret_key"`
+	FamilyCode  sql.NullString `db:"family_code"`
 	ID          uint64         `db:"id"`
 	TeacherID   uint64         `db:"teacher_id"`
 	NeedAudit   bool           `db:"need_audit"`
 	IsClosed    bool           `db:"is_closed"`
 	Language    int8           `db:"language"`
+	IDEKind     int8           `db:"ide_kind"`
 }
 
 func QueryTotalAmountCoursesByTeacherID(ctx context.Context, rdbClient storage.RDBClient, teacherID uint64) (int, error) {
@@ -151,6 +153,8 @@ func (c *Course) Update(ctx context.Context, rdbClient storage.RDBClient) error
 			"need_audit":  c.NeedAudit,
 			"is_closed":   c.IsClosed,
 			"language":    c.Language,
+			"ide_kind":    c.IDEKind,
+			"family_code": c.FamilyCode,
 			"created_at":  c.CreatedAt,
 			"updated_at":  c.UpdatedAt,
 		}).Where(squirrel.Eq{"id": c.ID}).
@@ -164,8 +168,8 @@ func (c *Course) Update(ctx context.Context, rdbClient storage.RDBClient) error
 
 func (c *Course) Insert(ctx context.Context, rdbClient storage.RDBClient) error {
 	sqlStr, args, err := squirrel.Insert("course").
-		Columns("teacher_id", "name", "description", "pic_url", "secret_key", "need_audit", "is_closed", "language", "created_at", "updated_at").
-		Values(c.TeacherID, c.Name, c.Description, c.PicURL, c.SecretKey, c.NeedAudit, c.IsClosed, c.Language, c.CreatedAt, c.UpdatedAt).
+		Columns("teacher_id", "name", "description", "pic_url", "secret_key", "need_audit", "is_closed", "language", "ide_kind", "family_code", "created_at", "updated_at").
+		Values(c.TeacherID, c.Name, c.Description, c.PicURL, c.SecretKey, c.NeedAudit, c.IsClosed, c.Language, c.IDEKind, c.FamilyCode, c.CreatedAt, c.UpdatedAt).
 		ToSql()
 	if err != nil {
 		return err
@@ -221,8 +225,8 @@ func BatchInsertCourses(ctx context.Context, rdbClient storage.RDBClient, course
 	}
 	const sqlStr = `
 INSERT INTO course
-(teacher_id, name, description, pic_url, secret_key, need_audit, is_closed, language, created_at, updated_at)
-VALUES (:teacher_id, :name, :description, :pic_url, :secret_key, :need_audit, :is_closed, :language, :created_at, :updated_at)
+(teacher_id, name, description, pic_url, secret_key, need_audit, is_closed, language, ide_kind, created_at, updated_at)
+VALUES (:teacher_id, :name, :description, :pic_url, :secret_key, :need_audit, :is_closed, :language, :ide_kind, :created_at, :updated_at)
 `
 	result, err := sqlx.NamedExecContext(ctx, rdbClient, sqlStr, courses)
 	if err != nil {
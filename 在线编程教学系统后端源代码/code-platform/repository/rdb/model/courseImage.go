@@ -0,0 +1,158 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// 课程自定义镜像的状态
+const (
+	CourseImageStatusPending int8 = iota
+	CourseImageStatusBuilding
+	CourseImageStatusReady
+	CourseImageStatusFailed
+	CourseImageStatusRejected
+)
+
+type CourseImage struct {
+	CreatedAt     time.Time    `db:"created_at"`
+	ReviewedAt    sql.NullTime `db:"reviewed_at"`
+	BuiltAt       sql.NullTime `db:"built_at"`
+	Base          string       `db:"base"`
+	AptPackages   string       `db:"apt_packages"`
+	PipPackages   string       `db:"pip_packages"`
+	Dockerfile    string       `db:"dockerfile"`
+	ImageTag      string       `db:"image_tag"`
+	BuildLog      string       `db:"build_log"`
+	ReviewComment string       `db:"review_comment"`
+	ID            uint64       `db:"id"`
+	CourseID      uint64       `db:"course_id"`
+	SubmittedBy   uint64       `db:"submitted_by"`
+	ReviewedBy    uint64       `db:"reviewed_by"`
+	Language      int8         `db:"language"`
+	Status        int8         `db:"status"`
+	Cached        bool         `db:"cached"`
+}
+
+// courseImageSummaryColumns 列表中不返回构建日志
+const courseImageSummaryColumns = `id, course_id, base, language, apt_packages, pip_packages, dockerfile, status, image_tag, cached, '' AS build_log,
+submitted_by, reviewed_by, review_comment, created_at, reviewed_at, built_at`
+
+func (c *CourseImage) Insert(ctx context.Context, rdbClient storage.RDBClient) error {
+	sqlStr, args, err := squirrel.Insert("course_image").
+		Columns("course_id", "base", "language", "apt_packages", "pip_packages", "dockerfile", "status", "build_log", "submitted_by", "created_at").
+		Values(c.CourseID, c.Base, c.Language, c.AptPackages, c.PipPackages, c.Dockerfile, c.Status, c.BuildLog, c.SubmittedBy, c.CreatedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	c.ID = uint64(lastID)
+	return nil
+}
+
+func QueryCourseImageByID(ctx context.Context, rdbClient storage.RDBClient, ID uint64) (*CourseImage, error) {
+	const sqlStr = `SELECT * FROM course_image WHERE id = ?`
+	var image CourseImage
+	if err := sqlx.GetContext(ctx, rdbClient, &image, sqlStr, ID); err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func QueryCourseImagesByCourseID(ctx context.Context, rdbClient storage.RDBClient, courseID uint64, offset, limit int) ([]*CourseImage, error) {
+	const sqlStr = `SELECT ` + courseImageSummaryColumns + ` FROM course_image WHERE course_id = ? ORDER BY id DESC LIMIT ?, ?`
+	var images []*CourseImage
+	if err := sqlx.SelectContext(ctx, rdbClient, &images, sqlStr, courseID, offset, limit); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func QueryTotalAmountCourseImagesByCourseID(ctx context.Context, rdbClient storage.RDBClient, courseID uint64) (int, error) {
+	const sqlStr = `SELECT COUNT(1) FROM course_image WHERE course_id = ?`
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, courseID); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func QueryCourseImagesByStatus(ctx context.Context, rdbClient storage.RDBClient, status int8, offset, limit int) ([]*CourseImage, error) {
+	const sqlStr = `SELECT ` + courseImageSummaryColumns + ` FROM course_image WHERE status = ? ORDER BY id DESC LIMIT ?, ?`
+	var images []*CourseImage
+	if err := sqlx.SelectContext(ctx, rdbClient, &images, sqlStr, status, offset, limit); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func QueryTotalAmountCourseImagesByStatus(ctx context.Context, rdbClient storage.RDBClient, status int8) (int, error) {
+	const sqlStr = `SELECT COUNT(1) FROM course_image WHERE status = ?`
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, status); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// QueryReadyCourseImageTag 返回课程在该语言下最近构建完成的镜像，没有时返回空字符串
+func QueryReadyCourseImageTag(ctx context.Context, rdbClient storage.RDBClient, courseID uint64, base string, language int8) (string, error) {
+	const sqlStr = `SELECT image_tag FROM course_image WHERE course_id = ? AND base = ? AND language = ? AND status = ? ORDER BY built_at DESC, id DESC LIMIT 1`
+	var tag string
+	err := sqlx.GetContext(ctx, rdbClient, &tag, sqlStr, courseID, base, language, CourseImageStatusReady)
+	if err != nil && err != sql.ErrNoRows {
+		return "", err
+	}
+	return tag, nil
+}
+
+// StartCourseImageBuild 审核通过并标记为构建中，返回是否由本次调用标记；
+// 待审核与构建失败的镜像可以开始构建，构建中的镜像在 staleBefore 之前开始的视为已中断，可重新构建
+func StartCourseImageBuild(ctx context.Context, rdbClient storage.RDBClient, ID, reviewerID uint64, reviewedAt, staleBefore time.Time) (bool, error) {
+	const sqlStr = `UPDATE course_image SET status = ?, reviewed_by = ?, reviewed_at = ?, review_comment = '', build_log = ''
+WHERE id = ? AND (status IN (?, ?) OR (status = ? AND reviewed_at < ?))`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, CourseImageStatusBuilding, reviewerID, reviewedAt,
+		ID, CourseImageStatusPending, CourseImageStatusFailed, CourseImageStatusBuilding, staleBefore)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+// RejectCourseImage 仅驳回待审核的镜像
+func RejectCourseImage(ctx context.Context, rdbClient storage.RDBClient, ID, reviewerID uint64, comment string, reviewedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE course_image SET status = ?, reviewed_by = ?, reviewed_at = ?, review_comment = ? WHERE id = ? AND status = ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, CourseImageStatusRejected, reviewerID, reviewedAt, comment, ID, CourseImageStatusPending)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+func FinishCourseImageBuild(ctx context.Context, rdbClient storage.RDBClient, ID uint64, status int8, imageTag string, cached bool, buildLog string, builtAt time.Time) error {
+	const sqlStr = `UPDATE course_image SET status = ?, image_tag = ?, cached = ?, build_log = ?, built_at = ? WHERE id = ? AND status = ?`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, status, imageTag, cached, buildLog, builtAt, ID, CourseImageStatusBuilding)
+	return err
+}
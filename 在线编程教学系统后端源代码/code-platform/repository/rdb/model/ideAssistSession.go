@@ -0,0 +1,111 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type IDEAssistSession struct {
+	CreatedAt  time.Time    `db:"created_at"`
+	JoinedAt   sql.NullTime `db:"joined_at"`
+	EndedAt    sql.NullTime `db:"ended_at"`
+	Permission string       `db:"permission"`
+	EndReason  string       `db:"end_reason"`
+	ID         uint64       `db:"id"`
+	LabID      uint64       `db:"lab_id"`
+	StudentID  uint64       `db:"student_id"`
+	TeacherID  uint64       `db:"teacher_id"`
+	EndedBy    uint64       `db:"ended_by"`
+}
+
+func (i *IDEAssistSession) Insert(ctx context.Context, rdbClient storage.RDBClient) error {
+	sqlStr, args, err := squirrel.Insert("ide_assist_session").
+		Columns("lab_id", "student_id", "teacher_id", "permission", "created_at").
+		Values(i.LabID, i.StudentID, i.TeacherID, i.Permission, i.CreatedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	i.ID = uint64(lastID)
+	return nil
+}
+
+func QueryIDEAssistSessionByID(ctx context.Context, rdbClient storage.RDBClient, ID uint64) (*IDEAssistSession, error) {
+	const sqlStr = `SELECT * FROM ide_assist_session WHERE id = ?`
+	var session IDEAssistSession
+	if err := sqlx.GetContext(ctx, rdbClient, &session, sqlStr, ID); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// UpdateIDEAssistSessionJoinedAt 仅记录首次加入的时间
+func UpdateIDEAssistSessionJoinedAt(ctx context.Context, rdbClient storage.RDBClient, ID uint64, joinedAt time.Time) error {
+	const sqlStr = `UPDATE ide_assist_session SET joined_at = ? WHERE id = ? AND joined_at IS NULL`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, joinedAt, ID)
+	return err
+}
+
+// EndIDEAssistSession 返回是否由本次调用结束，已结束的会话不会被重复结束
+func EndIDEAssistSession(ctx context.Context, rdbClient storage.RDBClient, ID, endedBy uint64, endReason string, endedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE ide_assist_session SET ended_at = ?, ended_by = ?, end_reason = ? WHERE id = ? AND ended_at IS NULL`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, endedAt, endedBy, endReason, ID)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+func QueryOpenIDEAssistSessions(ctx context.Context, rdbClient storage.RDBClient) ([]*IDEAssistSession, error) {
+	const sqlStr = `SELECT * FROM ide_assist_session WHERE ended_at IS NULL`
+	var sessions []*IDEAssistSession
+	if err := sqlx.SelectContext(ctx, rdbClient, &sessions, sqlStr); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func QueryOpenIDEAssistSessionsByLabIDAndStudentID(ctx context.Context, rdbClient storage.RDBClient, labID, studentID uint64) ([]*IDEAssistSession, error) {
+	const sqlStr = `SELECT * FROM ide_assist_session WHERE lab_id = ? AND student_id = ? AND ended_at IS NULL`
+	var sessions []*IDEAssistSession
+	if err := sqlx.SelectContext(ctx, rdbClient, &sessions, sqlStr, labID, studentID); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func QueryIDEAssistSessionsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64, offset, limit int) ([]*IDEAssistSession, error) {
+	const sqlStr = `SELECT * FROM ide_assist_session WHERE lab_id = ? ORDER BY id DESC LIMIT ?, ?`
+	var sessions []*IDEAssistSession
+	if err := sqlx.SelectContext(ctx, rdbClient, &sessions, sqlStr, labID, offset, limit); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func QueryTotalAmountIDEAssistSessionsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (int, error) {
+	const sqlStr = `SELECT COUNT(1) FROM ide_assist_session WHERE lab_id = ?`
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, labID); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
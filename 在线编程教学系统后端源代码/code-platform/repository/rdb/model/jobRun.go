@@ -0,0 +1,226 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// 任务种类
+const (
+	JobKindPeriodic int8 = iota
+	JobKindDelayed
+)
+
+// 任务执行状态
+const (
+	JobRunStatusPending int8 = iota
+	JobRunStatusRunning
+	JobRunStatusSucceeded
+	JobRunStatusFailed
+)
+
+type JobRun struct {
+	RunAt        time.Time      `db:"run_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+	StartedAt    sql.NullTime   `db:"started_at"`
+	FinishedAt   sql.NullTime   `db:"finished_at"`
+	DedupKey     sql.NullString `db:"dedup_key"`
+	Name         string         `db:"name"`
+	Payload      string         `db:"payload"`
+	Error        string         `db:"error"`
+	ID           uint64         `db:"id"`
+	FencingToken uint64         `db:"fencing_token"`
+	Attempt      uint32         `db:"attempt"`
+	MaxAttempts  uint32         `db:"max_attempts"`
+	Kind         int8           `db:"kind"`
+	Status       int8           `db:"status"`
+}
+
+// Insert 去重键已被等待或执行中的记录占用时不插入并返回 false
+func (j *JobRun) Insert(ctx context.Context, rdbClient storage.RDBClient) (bool, error) {
+	sqlStr, args, err := squirrel.Insert("job_run").
+		Options("IGNORE").
+		Columns("name", "kind", "status", "payload", "dedup_key", "max_attempts", "error", "run_at", "created_at").
+		Values(j.Name, j.Kind, j.Status, j.Payload, j.DedupKey, j.MaxAttempts, j.Error, j.RunAt, j.CreatedAt).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+	j.ID = uint64(lastID)
+	return true, nil
+}
+
+func QueryJobRunByID(ctx context.Context, rdbClient storage.RDBClient, ID uint64) (*JobRun, error) {
+	const sqlStr = `SELECT * FROM job_run WHERE id = ?`
+	var run JobRun
+	if err := sqlx.GetContext(ctx, rdbClient, &run, sqlStr, ID); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// QueryDueJobRuns 指定任务中已到计划时间的等待执行的记录，按计划时间先后
+func QueryDueJobRuns(ctx context.Context, rdbClient storage.RDBClient, names []string, now time.Time, limit int) ([]*JobRun, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	sqlStr, args, err := squirrel.Select("*").
+		From("job_run").
+		Where(squirrel.Eq{"status": JobRunStatusPending, "name": names}).
+		Where(squirrel.LtOrEq{"run_at": now}).
+		OrderBy("run_at").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var runs []*JobRun
+	if err := sqlx.SelectContext(ctx, rdbClient, &runs, sqlStr, args...); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// QueryRunningJobRunsAfterID 按 id 分批查询执行中的记录
+func QueryRunningJobRunsAfterID(ctx context.Context, rdbClient storage.RDBClient, afterID uint64, limit int) ([]*JobRun, error) {
+	const sqlStr = `SELECT * FROM job_run WHERE status = ? AND id > ? ORDER BY id LIMIT ?`
+	var runs []*JobRun
+	if err := sqlx.SelectContext(ctx, rdbClient, &runs, sqlStr, JobRunStatusRunning, afterID, limit); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// ClaimJobRun 将等待执行的任务标记为执行中并增加执行次数，返回是否由本次调用标记；
+// 已由更大的 fencingToken 执行过的记录不会被旧持有者领取
+func ClaimJobRun(ctx context.Context, rdbClient storage.RDBClient, ID, fencingToken uint64, startedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE job_run SET status = ?, attempt = attempt + 1, fencing_token = ?, started_at = ?, finished_at = NULL
+WHERE id = ? AND status = ? AND fencing_token <= ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, JobRunStatusRunning, fencingToken, startedAt, ID, JobRunStatusPending, fencingToken)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+// FinishJobRun 结束执行中的任务并释放去重键，attempt 与 fencingToken 为领取时的值，用于避免覆盖之后的重新执行
+func FinishJobRun(ctx context.Context, rdbClient storage.RDBClient, ID uint64, attempt uint32, fencingToken uint64, status int8, errMsg string, finishedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE job_run SET status = ?, error = ?, finished_at = ?, dedup_key = NULL
+WHERE id = ? AND attempt = ? AND fencing_token = ? AND status = ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, status, errMsg, finishedAt, ID, attempt, fencingToken, JobRunStatusRunning)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+// RetryJobRun 执行失败后重新等待，到 runAt 后再次执行
+func RetryJobRun(ctx context.Context, rdbClient storage.RDBClient, ID uint64, attempt uint32, fencingToken uint64, errMsg string, runAt, finishedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE job_run SET status = ?, error = ?, run_at = ?, finished_at = ?
+WHERE id = ? AND attempt = ? AND fencing_token = ? AND status = ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, JobRunStatusPending, errMsg, runAt, finishedAt, ID, attempt, fencingToken, JobRunStatusRunning)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+// RequeueFailedJobRun 管理员重新执行失败的任务，执行次数重新计算，不再占用去重键
+func RequeueFailedJobRun(ctx context.Context, rdbClient storage.RDBClient, ID uint64, runAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE job_run SET status = ?, attempt = 0, run_at = ?, started_at = NULL, finished_at = NULL WHERE id = ? AND status = ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, JobRunStatusPending, runAt, ID, JobRunStatusFailed)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
+
+func jobRunsFilter(name string, status int8) squirrel.Sqlizer {
+	and := squirrel.And{}
+	if name != "" {
+		and = append(and, squirrel.Eq{"name": name})
+	}
+	if status >= 0 {
+		and = append(and, squirrel.Eq{"status": status})
+	}
+	return and
+}
+
+// QueryJobRuns name 为空时不限任务，status 小于 0 时不限状态，最近创建的在前
+func QueryJobRuns(ctx context.Context, rdbClient storage.RDBClient, name string, status int8, offset, limit int) ([]*JobRun, error) {
+	sqlStr, args, err := squirrel.Select("*").
+		From("job_run").
+		Where(jobRunsFilter(name, status)).
+		OrderBy("id DESC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var runs []*JobRun
+	if err := sqlx.SelectContext(ctx, rdbClient, &runs, sqlStr, args...); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func QueryTotalAmountJobRuns(ctx context.Context, rdbClient storage.RDBClient, name string, status int8) (int, error) {
+	sqlStr, args, err := squirrel.Select("COUNT(1)").
+		From("job_run").
+		Where(jobRunsFilter(name, status)).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, args...); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// DeleteSucceededJobRunsBefore 清理早于 before 完成的成功记录，失败记录保留以便排查
+func DeleteSucceededJobRunsBefore(ctx context.Context, rdbClient storage.RDBClient, before time.Time, limit int) (int, error) {
+	const sqlStr = `DELETE FROM job_run WHERE status = ? AND finished_at < ? ORDER BY id LIMIT ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr, JobRunStatusSucceeded, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
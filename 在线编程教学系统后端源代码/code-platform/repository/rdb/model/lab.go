@@ -12,14 +12,19 @@ import (
 )
 
 type Lab struct {
-	CreatedAt     time.Time    `db:"created_at"`
-	UpdatedAt     time.Time    `db:"updated_at"`
-	DeadLine      sql.NullTime `db:"dead_line"`
-	Title         string       `db:"title"`
-	Content       string       `db:"content"`
-	AttachMentURL string       `db:"attachment_url"`
-	ID            uint64       `db:"id"`
-	CourseID      uint64       `db:"course_id"`
+	CreatedAt              time.Time    `db:"created_at"`
+	UpdatedAt              time.Time    `db:"updated_at"`
+	DeadLine               sql.NullTime `db:"dead_line"`
+	ArchivedAt             sql.NullTime `db:"archived_at"`
+	Title                  string       `db:"title"`
+	Content                string       `db:"content"`
+	AttachMentURL          string       `db:"attachment_url"`
+	TemplateObject         string       `db:"template_object"`
+	TemplateHash           string       `db:"template_hash"`
+	PreviousTemplateObject string       `db:"previous_template_object"`
+	PreviousTemplateHash   string       `db:"previous_template_hash"`
+	ID                     uint64       `db:"id"`
+	CourseID               uint64       `db:"course_id"`
 }
 
 func (l *Lab) Insert(ctx context.Context, rdbClient storage.RDBClient) error {
@@ -322,3 +327,40 @@ func QueryLabIDToDeadlineMapAfterDeadline(ctx context.Context, rdbClient storage
 	}
 	return m, nil
 }
+
+// QueryLabIDsToArchive 截止时间已过但工作区尚未全部冻结的实验
+func QueryLabIDsToArchive(ctx context.Context, rdbClient storage.RDBClient, now time.Time) ([]uint64, error) {
+	const sqlStr = `SELECT id FROM lab WHERE dead_line <= ? AND archived_at IS NULL`
+	var labIDs []uint64
+	if err := sqlx.SelectContext(ctx, rdbClient, &labIDs, sqlStr, now); err != nil {
+		return nil, err
+	}
+	return labIDs, nil
+}
+
+func UpdateLabArchivedAt(ctx context.Context, rdbClient storage.RDBClient, labID uint64, archivedAt time.Time) error {
+	const sqlStr = `UPDATE lab SET archived_at = ? WHERE id = ?`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, archivedAt, labID)
+	return err
+}
+
+// LabTemplate 实验当前与上一版初始代码模板
+type LabTemplate struct {
+	Object         string
+	Hash           string
+	PreviousObject string
+	PreviousHash   string
+}
+
+// UpdateLabTemplate 仅在当前模板仍为 expectedHash 时更新，并发更新时返回 false
+func UpdateLabTemplate(ctx context.Context, rdbClient storage.RDBClient, labID uint64, expectedHash string, template *LabTemplate) (bool, error) {
+	const sqlStr = `UPDATE lab SET template_object = ?, template_hash = ?, previous_template_object = ?, previous_template_hash = ?
+WHERE id = ? AND template_hash = ?`
+	result, err := rdbClient.ExecContext(ctx, sqlStr,
+		template.Object, template.Hash, template.PreviousObject, template.PreviousHash, labID, expectedHash)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected != 0, err
+}
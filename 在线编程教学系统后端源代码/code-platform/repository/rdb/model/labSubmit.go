@@ -12,15 +12,20 @@ import (
 )
 
 type LabSubmit struct {
-	CreatedAt time.Time     `db:"created_at"`
-	UpdatedAt time.Time     `db:"updated_at"`
-	Comment   string        `db:"comment"`
-	ReportURL string        `db:"report_url"`
-	ID        uint64        `db:"id"`
-	LabID     uint64        `db:"lab_id"`
-	UserID    uint64        `db:"user_id"`
-	Score     sql.NullInt32 `db:"score"`
-	IsFinish  bool          `db:"is_finish"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+	ArchivedAt    sql.NullTime  `db:"archived_at"`
+	Comment       string        `db:"comment"`
+	ReportURL     string        `db:"report_url"`
+	ArchiveObject string        `db:"archive_object"`
+	ArchiveHash   string        `db:"archive_hash"`
+	ArchiveError  string        `db:"archive_error"`
+	ID            uint64        `db:"id"`
+	LabID         uint64        `db:"lab_id"`
+	UserID        uint64        `db:"user_id"`
+	Score         sql.NullInt32 `db:"score"`
+	ManualScore   bool          `db:"manual_score"`
+	IsFinish      bool          `db:"is_finish"`
 }
 
 func (l *LabSubmit) Insert(ctx context.Context, rdbClient storage.RDBClient) error {
@@ -46,14 +51,15 @@ func (l *LabSubmit) Insert(ctx context.Context, rdbClient storage.RDBClient) err
 func (l *LabSubmit) Update(ctx context.Context, rdbClient storage.RDBClient) error {
 	sqlStr, args, err := squirrel.Update("lab_submit").
 		SetMap(map[string]interface{}{
-			"lab_id":     l.LabID,
-			"user_id":    l.UserID,
-			"report_url": l.ReportURL,
-			"score":      l.Score,
-			"is_finish":  l.IsFinish,
-			"comment":    l.Comment,
-			"created_at": l.CreatedAt,
-			"updated_at": l.UpdatedAt,
+			"lab_id":       l.LabID,
+			"user_id":      l.UserID,
+			"report_url":   l.ReportURL,
+			"score":        l.Score,
+			"manual_score": l.ManualScore,
+			"is_finish":    l.IsFinish,
+			"comment":      l.Comment,
+			"created_at":   l.CreatedAt,
+			"updated_at":   l.UpdatedAt,
 		}).Where(squirrel.Eq{"id": l.ID}).
 		ToSql()
 	if err != nil {
@@ -206,3 +212,57 @@ AND lab_submit.id = lu.id
 	}
 	return infos[:len(infos):len(infos)], nil
 }
+
+func QueryUnarchivedLabSubmitsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) ([]*LabSubmit, error) {
+	const sqlStr = `SELECT * FROM lab_submit WHERE lab_id = ? AND archived_at IS NULL`
+	var labSubmits []*LabSubmit
+	if err := sqlx.SelectContext(ctx, rdbClient, &labSubmits, sqlStr, labID); err != nil {
+		return nil, err
+	}
+	return labSubmits, nil
+}
+
+func UpdateLabSubmitArchive(ctx context.Context, rdbClient storage.RDBClient, ID uint64, archiveObject, archiveHash string, archivedAt time.Time) error {
+	const sqlStr = `UPDATE lab_submit SET archive_object = ?, archive_hash = ?, archived_at = ?, archive_error = '' WHERE id = ?`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, archiveObject, archiveHash, archivedAt, ID)
+	return err
+}
+
+// UpdateLabSubmitArchiveError archiveError 超过列宽时截断
+func UpdateLabSubmitArchiveError(ctx context.Context, rdbClient storage.RDBClient, ID uint64, archiveError string) error {
+	const sqlStr = `UPDATE lab_submit SET archive_error = LEFT(?, 255) WHERE id = ?`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, archiveError, ID)
+	return err
+}
+
+// ExistsArchivedLabSubmitByLabID 实验是否已有学生的工作区被冻结
+func ExistsArchivedLabSubmitByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (bool, error) {
+	const sqlStr = `SELECT EXISTS(SELECT 1 FROM lab_submit WHERE lab_id = ? AND archived_at IS NOT NULL)`
+	var exists bool
+	if err := sqlx.GetContext(ctx, rdbClient, &exists, sqlStr, labID); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func QueryAllLabSubmitInfosByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) ([]*LabSubmitInfoByLabID, error) {
+	const sqlStr = `
+SELECT lab_submit.*, user.name, user.number
+FROM lab_submit INNER JOIN user
+ON lab_submit.lab_id = ?
+AND lab_submit.user_id = user.id
+ORDER BY user.number
+`
+	var infos []*LabSubmitInfoByLabID
+	if err := sqlx.SelectContext(ctx, rdbClient, &infos, sqlStr, labID); err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+// UpdateLabSubmitAutoScore 写入自动评分，教师已评定成绩时不覆盖
+func UpdateLabSubmitAutoScore(ctx context.Context, rdbClient storage.RDBClient, ID uint64, score int32, updatedAt time.Time) error {
+	const sqlStr = `UPDATE lab_submit SET score = ?, updated_at = ? WHERE id = ? AND manual_score = 0`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, score, updatedAt, ID)
+	return err
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type LabTest struct {
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	Command        string    `db:"command"`
+	TestsObject    string    `db:"tests_object"`
+	TestsHash      string    `db:"tests_hash"`
+	LabID          uint64    `db:"lab_id"`
+	TimeoutSeconds int32     `db:"timeout_seconds"`
+	FullScore      int32     `db:"full_score"`
+	Language       int8      `db:"language"`
+	AutoScore      bool      `db:"auto_score"`
+}
+
+// Upsert 新建或更新测试配置，不改变已上传的隐藏测试文件
+func (l *LabTest) Upsert(ctx context.Context, rdbClient storage.RDBClient) error {
+	const sqlStr = `
+INSERT INTO lab_test (lab_id, command, language, timeout_seconds, auto_score, full_score, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+command = VALUES(command), language = VALUES(language), timeout_seconds = VALUES(timeout_seconds),
+auto_score = VALUES(auto_score), full_score = VALUES(full_score), updated_at = VALUES(updated_at)
+`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, l.LabID, l.Command, l.Language, l.TimeoutSeconds, l.AutoScore, l.FullScore, l.CreatedAt, l.UpdatedAt)
+	return err
+}
+
+// UpsertLabTestFiles 更新隐藏测试文件，尚无测试配置时以默认配置新建
+func UpsertLabTestFiles(ctx context.Context, rdbClient storage.RDBClient, labID uint64, testsObject, testsHash string, now time.Time) error {
+	const sqlStr = `
+INSERT INTO lab_test (lab_id, tests_object, tests_hash, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+tests_object = VALUES(tests_object), tests_hash = VALUES(tests_hash), updated_at = VALUES(updated_at)
+`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, labID, testsObject, testsHash, now, now)
+	return err
+}
+
+func QueryLabTestByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (*LabTest, error) {
+	const sqlStr = `SELECT * FROM lab_test WHERE lab_id = ?`
+	var labTest LabTest
+	if err := sqlx.GetContext(ctx, rdbClient, &labTest, sqlStr, labID); err != nil {
+		return nil, err
+	}
+	return &labTest, nil
+}
@@ -0,0 +1,150 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type LabTestRun struct {
+	CreatedAt         time.Time     `db:"created_at"`
+	FinishedAt        sql.NullTime  `db:"finished_at"`
+	Score             sql.NullInt32 `db:"score"`
+	Status            string        `db:"status"`
+	TestsHash         string        `db:"tests_hash"`
+	Output            string        `db:"output"`
+	Cases             string        `db:"cases"`
+	ActiveLabSubmitID sql.NullInt64 `db:"active_lab_submit_id"`
+	ID                uint64        `db:"id"`
+	LabSubmitID       uint64        `db:"lab_submit_id"`
+	LabID             uint64        `db:"lab_id"`
+	UserID            uint64        `db:"user_id"`
+	TriggeredBy       uint64        `db:"triggered_by"`
+	DurationMs        int64         `db:"duration_ms"`
+	Total             int32         `db:"total"`
+	Passed            int32         `db:"passed"`
+	Failed            int32         `db:"failed"`
+	Errors            int32         `db:"errors"`
+	Skipped           int32         `db:"skipped"`
+	ExitCode          int32         `db:"exit_code"`
+	TimedOut          bool          `db:"timed_out"`
+}
+
+// labTestRunSummaryColumns 列表中不返回输出与用例详情
+const labTestRunSummaryColumns = `id, lab_submit_id, lab_id, user_id, triggered_by, status, tests_hash, total, passed, failed, errors, skipped,
+score, exit_code, timed_out, duration_ms, created_at, finished_at`
+
+// Insert 同一工作区已有执行中的运行时不插入并返回 false
+func (l *LabTestRun) Insert(ctx context.Context, rdbClient storage.RDBClient) (bool, error) {
+	sqlStr, args, err := squirrel.Insert("lab_test_run").
+		Options("IGNORE").
+		Columns("lab_submit_id", "active_lab_submit_id", "lab_id", "user_id", "triggered_by", "status", "tests_hash", "output", "cases", "created_at").
+		Values(l.LabSubmitID, l.LabSubmitID, l.LabID, l.UserID, l.TriggeredBy, l.Status, l.TestsHash, l.Output, l.Cases, l.CreatedAt).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+	l.ID = uint64(lastID)
+	l.ActiveLabSubmitID = sql.NullInt64{Int64: int64(l.LabSubmitID), Valid: true}
+	return true, nil
+}
+
+// Finish 记录运行结果
+func (l *LabTestRun) Finish(ctx context.Context, rdbClient storage.RDBClient) error {
+	sqlStr, args, err := squirrel.Update("lab_test_run").SetMap(squirrel.Eq{
+		"status":      l.Status,
+		"tests_hash":  l.TestsHash,
+		"total":       l.Total,
+		"passed":      l.Passed,
+		"failed":      l.Failed,
+		"errors":      l.Errors,
+		"skipped":     l.Skipped,
+		"score":       l.Score,
+		"exit_code":   l.ExitCode,
+		"timed_out":   l.TimedOut,
+		"duration_ms": l.DurationMs,
+		"output":      l.Output,
+		"cases":       l.Cases,
+		"finished_at": l.FinishedAt,
+		// 结束后释放工作区，允许发起下一次运行
+		"active_lab_submit_id": nil,
+	}).Where(squirrel.Eq{"id": l.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = rdbClient.ExecContext(ctx, sqlStr, args...)
+	return err
+}
+
+func QueryLabTestRunByID(ctx context.Context, rdbClient storage.RDBClient, ID uint64) (*LabTestRun, error) {
+	const sqlStr = `SELECT * FROM lab_test_run WHERE id = ?`
+	var run LabTestRun
+	if err := sqlx.GetContext(ctx, rdbClient, &run, sqlStr, ID); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func QueryLabTestRunsByLabSubmitID(ctx context.Context, rdbClient storage.RDBClient, labSubmitID uint64, offset, limit int) ([]*LabTestRun, error) {
+	const sqlStr = `SELECT ` + labTestRunSummaryColumns + ` FROM lab_test_run WHERE lab_submit_id = ? ORDER BY id DESC LIMIT ?, ?`
+	var runs []*LabTestRun
+	if err := sqlx.SelectContext(ctx, rdbClient, &runs, sqlStr, labSubmitID, offset, limit); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func QueryTotalAmountLabTestRunsByLabSubmitID(ctx context.Context, rdbClient storage.RDBClient, labSubmitID uint64) (int, error) {
+	const sqlStr = `SELECT COUNT(1) FROM lab_test_run WHERE lab_submit_id = ?`
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, labSubmitID); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// QueryLatestLabTestRunsByLabID 实验下每个学生最近一次运行
+func QueryLatestLabTestRunsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) ([]*LabTestRun, error) {
+	const sqlStr = `
+SELECT ` + labTestRunSummaryColumns + `
+FROM lab_test_run INNER JOIN
+(SELECT MAX(id) AS latest_id
+FROM lab_test_run
+WHERE lab_id = ?
+GROUP BY user_id
+) AS r
+ON r.latest_id = lab_test_run.id
+ORDER BY user_id
+`
+	var runs []*LabTestRun
+	if err := sqlx.SelectContext(ctx, rdbClient, &runs, sqlStr, labID); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// ReleaseStaleLabTestRun before 之前发起仍未结束的运行视为服务重启后遗留，标记为出错并释放工作区
+func ReleaseStaleLabTestRun(ctx context.Context, rdbClient storage.RDBClient, labSubmitID uint64, before time.Time, status, output string) error {
+	const sqlStr = `UPDATE lab_test_run SET status = ?, output = ?, finished_at = ?, active_lab_submit_id = NULL
+WHERE active_lab_submit_id = ? AND created_at <= ?`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, status, output, time.Now(), labSubmitID, before)
+	return err
+}
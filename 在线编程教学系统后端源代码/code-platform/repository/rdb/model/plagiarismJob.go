@@ -0,0 +1,154 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// 查重任务的状态
+const (
+	PlagiarismJobStatusQueued int8 = iota
+	PlagiarismJobStatusRunning
+	PlagiarismJobStatusDone
+	PlagiarismJobStatusFailed
+	PlagiarismJobStatusCanceled
+)
+
+// 查重任务的类型，同一实验每类同时只有一个未结束的任务
+const (
+	PlagiarismJobKindCode int8 = iota
+	PlagiarismJobKindReport
+)
+
+type PlagiarismJob struct {
+	CreatedAt         time.Time     `db:"created_at"`
+	StartedAt         sql.NullTime  `db:"started_at"`
+	FinishedAt        sql.NullTime  `db:"finished_at"`
+	ActiveLabID       sql.NullInt64 `db:"active_lab_id"`
+	Error             string        `db:"error"`
+	ReferenceLabIDs   string        `db:"reference_lab_ids"`
+	ID                uint64        `db:"id"`
+	LabID             uint64        `db:"lab_id"`
+	DetectionReportID uint64        `db:"detection_report_id"`
+	ReportDetectionID uint64        `db:"report_detection_id"`
+	CreatedBy         uint64        `db:"created_by"`
+	Kind              int8          `db:"kind"`
+	Language          int8          `db:"language"`
+	Status            int8          `db:"status"`
+	Progress          int8          `db:"progress"`
+}
+
+// Insert 同一实验已有同类排队或执行中的任务时不插入并返回 false
+func (p *PlagiarismJob) Insert(ctx context.Context, rdbClient storage.RDBClient) (bool, error) {
+	sqlStr, args, err := squirrel.Insert("plagiarism_job").
+		Options("IGNORE").
+		Columns("lab_id", "active_lab_id", "kind", "language", "reference_lab_ids", "status", "error", "created_by", "created_at").
+		Values(p.LabID, p.LabID, p.Kind, p.Language, p.ReferenceLabIDs, PlagiarismJobStatusQueued, "", p.CreatedBy, p.CreatedAt).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+	p.ID = uint64(lastID)
+	p.ActiveLabID = sql.NullInt64{Int64: int64(p.LabID), Valid: true}
+	p.Status = PlagiarismJobStatusQueued
+	return true, nil
+}
+
+func QueryPlagiarismJobByID(ctx context.Context, rdbClient storage.RDBClient, ID uint64) (*PlagiarismJob, error) {
+	const sqlStr = `SELECT * FROM plagiarism_job WHERE id = ?`
+	var job PlagiarismJob
+	if err := sqlx.GetContext(ctx, rdbClient, &job, sqlStr, ID); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// QueryActivePlagiarismJobByLabID 实验该类排队或执行中的任务
+func QueryActivePlagiarismJobByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64, kind int8) (*PlagiarismJob, error) {
+	const sqlStr = `SELECT * FROM plagiarism_job WHERE active_lab_id = ? AND kind = ?`
+	var job PlagiarismJob
+	if err := sqlx.GetContext(ctx, rdbClient, &job, sqlStr, labID, kind); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func QueryPlagiarismJobsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64, offset, limit int) ([]*PlagiarismJob, error) {
+	const sqlStr = `SELECT * FROM plagiarism_job WHERE lab_id = ? ORDER BY id DESC LIMIT ?, ?`
+	var jobs []*PlagiarismJob
+	if err := sqlx.SelectContext(ctx, rdbClient, &jobs, sqlStr, labID, offset, limit); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func QueryTotalAmountPlagiarismJobsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (int, error) {
+	const sqlStr = `SELECT COUNT(1) FROM plagiarism_job WHERE lab_id = ?`
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, labID); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// StartPlagiarismJob 排队中的任务开始执行，中断后重新执行的任务重置进度，已结束的任务返回 false
+func StartPlagiarismJob(ctx context.Context, rdbClient storage.RDBClient, ID uint64, startedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE plagiarism_job SET status = ?, progress = 0, started_at = ? WHERE id = ? AND status IN (?, ?)`
+	return execAffected(ctx, rdbClient, sqlStr, PlagiarismJobStatusRunning, startedAt, ID, PlagiarismJobStatusQueued, PlagiarismJobStatusRunning)
+}
+
+// UpdatePlagiarismJobProgress 任务已不在执行中（如被取消）时返回 false；进度未变化时影响行数同样为 0，调用方仅在变化时更新
+func UpdatePlagiarismJobProgress(ctx context.Context, rdbClient storage.RDBClient, ID uint64, progress int8) (bool, error) {
+	const sqlStr = `UPDATE plagiarism_job SET progress = ? WHERE id = ? AND status = ?`
+	return execAffected(ctx, rdbClient, sqlStr, progress, ID, PlagiarismJobStatusRunning)
+}
+
+// RequeuePlagiarismJob 执行失败等待重试，记录失败原因
+func RequeuePlagiarismJob(ctx context.Context, rdbClient storage.RDBClient, ID uint64, errMsg string) (bool, error) {
+	const sqlStr = `UPDATE plagiarism_job SET status = ?, progress = 0, error = ? WHERE id = ? AND status = ?`
+	return execAffected(ctx, rdbClient, sqlStr, PlagiarismJobStatusQueued, errMsg, ID, PlagiarismJobStatusRunning)
+}
+
+// FinishPlagiarismJob 结束排队或执行中的任务并释放实验，已结束的任务返回 false
+func FinishPlagiarismJob(ctx context.Context, rdbClient storage.RDBClient, ID uint64, status int8, detectionReportID uint64, errMsg string, finishedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE plagiarism_job SET status = ?, detection_report_id = ?, error = ?, finished_at = ?, active_lab_id = NULL
+WHERE id = ? AND status IN (?, ?)`
+	return execAffected(ctx, rdbClient, sqlStr, status, detectionReportID, errMsg, finishedAt, ID, PlagiarismJobStatusQueued, PlagiarismJobStatusRunning)
+}
+
+// FinishReportPlagiarismJob 实验报告查重完成并释放实验，已结束的任务返回 false
+func FinishReportPlagiarismJob(ctx context.Context, rdbClient storage.RDBClient, ID, reportDetectionID uint64, finishedAt time.Time) (bool, error) {
+	const sqlStr = `UPDATE plagiarism_job SET status = ?, report_detection_id = ?, error = '', finished_at = ?, active_lab_id = NULL
+WHERE id = ? AND status IN (?, ?)`
+	return execAffected(ctx, rdbClient, sqlStr, PlagiarismJobStatusDone, reportDetectionID, finishedAt, ID, PlagiarismJobStatusQueued, PlagiarismJobStatusRunning)
+}
+
+func execAffected(ctx context.Context, rdbClient storage.RDBClient, sqlStr string, args ...interface{}) (bool, error) {
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected != 0, nil
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type PlagiarismSetting struct {
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	IgnorePatterns string    `db:"ignore_patterns"`
+	BaseObject     string    `db:"base_object"`
+	BaseName       string    `db:"base_name"`
+	BaseHash       string    `db:"base_hash"`
+	LabID          uint64    `db:"lab_id"`
+	MinMatch       int32     `db:"min_match"`
+}
+
+// Upsert 新建或更新比对参数，不改变已上传的基础代码
+func (p *PlagiarismSetting) Upsert(ctx context.Context, rdbClient storage.RDBClient) error {
+	const sqlStr = `
+INSERT INTO plagiarism_setting (lab_id, min_match, ignore_patterns, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+min_match = VALUES(min_match), ignore_patterns = VALUES(ignore_patterns), updated_at = VALUES(updated_at)
+`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, p.LabID, p.MinMatch, p.IgnorePatterns, p.CreatedAt, p.UpdatedAt)
+	return err
+}
+
+// UpsertPlagiarismBase 更新基础代码，object 为空时表示移除；尚无配置时以默认参数新建
+func UpsertPlagiarismBase(ctx context.Context, rdbClient storage.RDBClient, labID uint64, baseObject, baseName, baseHash string, now time.Time) error {
+	const sqlStr = `
+INSERT INTO plagiarism_setting (lab_id, ignore_patterns, base_object, base_name, base_hash, created_at, updated_at)
+VALUES (?, '', ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+base_object = VALUES(base_object), base_name = VALUES(base_name), base_hash = VALUES(base_hash), updated_at = VALUES(updated_at)
+`
+	_, err := rdbClient.ExecContext(ctx, sqlStr, labID, baseObject, baseName, baseHash, now, now)
+	return err
+}
+
+func QueryPlagiarismSettingByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (*PlagiarismSetting, error) {
+	const sqlStr = `SELECT * FROM plagiarism_setting WHERE lab_id = ?`
+	var setting PlagiarismSetting
+	if err := sqlx.GetContext(ctx, rdbClient, &setting, sqlStr, labID); err != nil {
+		return nil, err
+	}
+	return &setting, nil
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"code-platform/storage"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type ReportDetection struct {
+	CreatedAt time.Time `db:"created_at"`
+	Data      []byte    `db:"data"`
+	ID        uint64    `db:"id"`
+	LabID     uint64    `db:"lab_id"`
+}
+
+func (r *ReportDetection) Insert(ctx context.Context, rdbClient storage.RDBClient) error {
+	sqlStr, args, err := squirrel.Insert("report_detection").
+		Columns("lab_id", "data", "created_at").
+		Values(r.LabID, r.Data, r.CreatedAt).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	result, err := rdbClient.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	r.ID = uint64(lastID)
+	return nil
+}
+
+func QueryReportDetectionsByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64, offset, limit int) ([]*DetectionReportIDWithCreatedAt, error) {
+	const sqlStr = `
+SELECT report_detection.id, report_detection.created_at
+FROM report_detection INNER JOIN
+(SELECT id
+FROM report_detection
+WHERE lab_id = ?
+ORDER BY id DESC
+LIMIT ?, ?
+) AS d
+ON d.id = report_detection.id
+`
+	var reportDetections []*DetectionReportIDWithCreatedAt
+	if err := sqlx.SelectContext(ctx, rdbClient, &reportDetections, sqlStr, labID, offset, limit); err != nil {
+		return nil, err
+	}
+	return reportDetections, nil
+}
+
+// QueryLatestReportDetectionByLabID 实验最近一次报告查重，没有时返回 sql.ErrNoRows
+func QueryLatestReportDetectionByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (*DetectionReportIDWithCreatedAt, error) {
+	const sqlStr = `SELECT id, created_at FROM report_detection WHERE lab_id = ? ORDER BY id DESC LIMIT 1`
+	var reportDetection DetectionReportIDWithCreatedAt
+	if err := sqlx.GetContext(ctx, rdbClient, &reportDetection, sqlStr, labID); err != nil {
+		return nil, err
+	}
+	return &reportDetection, nil
+}
+
+func QueryTotalAmountReportDetectionByLabID(ctx context.Context, rdbClient storage.RDBClient, labID uint64) (int, error) {
+	const sqlStr = `SELECT COUNT(1) FROM report_detection WHERE lab_id = ?`
+	var total int
+	if err := sqlx.GetContext(ctx, rdbClient, &total, sqlStr, labID); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func QueryReportDetectionByID(ctx context.Context, rdbClient storage.RDBClient, ID uint64) (*ReportDetection, error) {
+	const sqlStr = `SELECT * FROM report_detection WHERE id = ?`
+	var info ReportDetection
+	if err := sqlx.GetContext(ctx, rdbClient, &info, sqlStr, ID); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
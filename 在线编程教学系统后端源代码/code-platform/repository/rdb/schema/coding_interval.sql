@@ -0,0 +1,10 @@
+CREATE TABLE `coding_interval` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `user_id` BIGINT UNSIGNED NOT NULL,
+    `started_at` DATETIME NOT NULL COMMENT '活跃区间开始于首次活跃心跳之前的一次心跳',
+    `ended_at` DATETIME NOT NULL COMMENT '区间内最后一次活跃心跳，之后的空闲不计入',
+    PRIMARY KEY (`id`),
+    KEY `idx_labid_userid_startedat` (`lab_id`, `user_id`, `started_at`),
+    KEY `idx_userid_startedat` (`user_id`, `started_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
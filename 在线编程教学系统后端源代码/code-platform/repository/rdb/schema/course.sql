@@ -8,9 +8,12 @@ CREATE TABLE `course` (
     `need_audit` TINYINT(1) NOT NULL DEFAULT 0,
     `is_closed` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '结课标志',
     `language` TINYINT NOT NULL DEFAULT 0,
+    `ide_kind` TINYINT NOT NULL DEFAULT 0 COMMENT '0: Theia, 1: 终端, 2: Jupyter',
+    `family_code` VARCHAR(50) DEFAULT NULL COMMENT '课程编号，同一课程的不同教学班相同，由管理员设置',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
     KEY `idx_teacherid`(`teacher_id`),
+    KEY `idx_familycode`(`family_code`),
     FULLTEXT KEY `fidx_name_description` (`name`, `description`) WITH PARSER NGRAM
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
\ No newline at end of file
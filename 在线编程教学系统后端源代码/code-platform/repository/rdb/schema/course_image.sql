@@ -0,0 +1,22 @@
+CREATE TABLE `course_image` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `course_id` BIGINT UNSIGNED NOT NULL,
+    `base` VARCHAR(10) NOT NULL COMMENT 'theia或monaco',
+    `language` TINYINT NOT NULL COMMENT '提交时课程的语言，课程更换语言后不再使用',
+    `apt_packages` TEXT NOT NULL COMMENT '空格分隔',
+    `pip_packages` TEXT NOT NULL COMMENT '空格分隔',
+    `dockerfile` TEXT NOT NULL COMMENT '追加在软件包安装之后的Dockerfile片段',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0: 待审核, 1: 构建中, 2: 可用, 3: 构建失败, 4: 已驳回',
+    `image_tag` VARCHAR(100) NOT NULL DEFAULT '',
+    `cached` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '相同内容的镜像已存在，未重新构建',
+    `build_log` MEDIUMTEXT NOT NULL COMMENT '构建日志，超出上限时仅保留末尾',
+    `submitted_by` BIGINT UNSIGNED NOT NULL,
+    `reviewed_by` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    `review_comment` VARCHAR(500) NOT NULL DEFAULT '',
+    `created_at` DATETIME NOT NULL,
+    `reviewed_at` DATETIME DEFAULT NULL,
+    `built_at` DATETIME DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_course_id_base` (`course_id`, `base`, `status`),
+    KEY `idx_status` (`status`, `id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
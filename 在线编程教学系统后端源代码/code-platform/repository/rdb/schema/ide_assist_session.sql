@@ -0,0 +1,15 @@
+CREATE TABLE `ide_assist_session` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `student_id` BIGINT UNSIGNED NOT NULL,
+    `teacher_id` BIGINT UNSIGNED NOT NULL COMMENT '受邀加入的课程教师',
+    `permission` VARCHAR(10) NOT NULL COMMENT '由学生选择，view或edit',
+    `created_at` DATETIME NOT NULL,
+    `joined_at` DATETIME DEFAULT NULL COMMENT '教师首次加入的时间',
+    `ended_at` DATETIME DEFAULT NULL,
+    `ended_by` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '结束会话的用户，过期或被替换时为0',
+    `end_reason` VARCHAR(20) NOT NULL DEFAULT '' COMMENT 'student、teacher、replaced、expired',
+    PRIMARY KEY (`id`),
+    KEY `idx_lab_id_student_id` (`lab_id`, `student_id`, `id`),
+    KEY `idx_ended_at` (`ended_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
@@ -5,6 +5,11 @@ CREATE TABLE `lab` (
     `content` TEXT NOT NULL COMMENT '实验内容描述',
     `attachment_url` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '实验附件url',
     `dead_line` DATETIME DEFAULT NULL COMMENT '截止时间',
+    `template_object` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '初始代码模板在 template 桶中的对象名',
+    `template_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '初始代码模板 zip 的 sha256',
+    `previous_template_object` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '上一版初始代码模板的对象名，用于恢复',
+    `previous_template_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '上一版初始代码模板 zip 的 sha256',
+    `archived_at` DATETIME DEFAULT NULL COMMENT '全部工作区冻结完成的时间',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
@@ -4,8 +4,13 @@ CREATE TABLE `lab_submit` (
     `user_id` BIGINT UNSIGNED NOT NULL,
     `report_url` VARCHAR(200) NOT NULL COMMENT '存放实验报告pdf的url',
     `score` INT DEFAULT NULL,
+    `manual_score` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '成绩由教师评定，自动评分不再覆盖',
     `is_finish` TINYINT(1) NOT NULL,
     `comment` TEXT NOT NULL,
+    `archive_object` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '截止时冻结的工作区归档在submissions桶中的对象名，学生没有工作区时为空',
+    `archive_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '归档的sha256',
+    `archived_at` DATETIME DEFAULT NULL COMMENT '冻结时间',
+    `archive_error` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最近一次冻结失败的原因，冻结成功后清空',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (`id`),
@@ -0,0 +1,13 @@
+CREATE TABLE `lab_test` (
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `command` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT '在工作区副本根目录执行的测试命令，JUnit XML需写入$JUNIT_DIR',
+    `language` TINYINT NOT NULL DEFAULT 0 COMMENT '运行测试所用镜像的语言',
+    `timeout_seconds` INT NOT NULL DEFAULT 60,
+    `tests_object` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '隐藏测试文件zip在template桶中的对象名',
+    `tests_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '隐藏测试文件zip的sha256',
+    `auto_score` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否按用例通过比例自动评分',
+    `full_score` INT NOT NULL DEFAULT 100,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`lab_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
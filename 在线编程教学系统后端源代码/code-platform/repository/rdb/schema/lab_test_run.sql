@@ -0,0 +1,27 @@
+CREATE TABLE `lab_test_run` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `lab_submit_id` BIGINT UNSIGNED NOT NULL,
+    `active_lab_submit_id` BIGINT UNSIGNED DEFAULT NULL COMMENT '仅由执行中的记录占用，结束后置空；同一工作区同时只有一个执行中的运行',
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `user_id` BIGINT UNSIGNED NOT NULL COMMENT '被测试工作区所属的学生',
+    `triggered_by` BIGINT UNSIGNED NOT NULL COMMENT '发起运行的学生或教师',
+    `status` VARCHAR(20) NOT NULL COMMENT 'running、passed、failed、error',
+    `tests_hash` CHAR(64) NOT NULL DEFAULT '',
+    `total` INT NOT NULL DEFAULT 0,
+    `passed` INT NOT NULL DEFAULT 0,
+    `failed` INT NOT NULL DEFAULT 0,
+    `errors` INT NOT NULL DEFAULT 0,
+    `skipped` INT NOT NULL DEFAULT 0,
+    `score` INT DEFAULT NULL COMMENT '开启自动评分时按通过比例折算的分数',
+    `exit_code` INT NOT NULL DEFAULT 0,
+    `timed_out` TINYINT(1) NOT NULL DEFAULT 0,
+    `duration_ms` BIGINT NOT NULL DEFAULT 0,
+    `output` MEDIUMTEXT NOT NULL COMMENT '测试命令的输出，超出上限时截断',
+    `cases` MEDIUMTEXT NOT NULL COMMENT 'JSON格式的用例结果',
+    `created_at` DATETIME(3) NOT NULL,
+    `finished_at` DATETIME(3) DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_active_lab_submit_id` (`active_lab_submit_id`),
+    KEY `idx_lab_submit_id` (`lab_submit_id`, `id`),
+    KEY `idx_lab_id_user_id` (`lab_id`, `user_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
@@ -0,0 +1,20 @@
+CREATE TABLE `plagiarism_job` (
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `active_lab_id` BIGINT UNSIGNED DEFAULT NULL COMMENT '仅由排队或执行中的记录占用，结束后置空；同一实验同类查重同时只有一个未结束',
+    `kind` TINYINT NOT NULL DEFAULT 0 COMMENT '0: 代码查重, 1: 实验报告查重',
+    `language` TINYINT NOT NULL COMMENT '提交时课程的语言',
+    `reference_lab_ids` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT '逗号分隔的参考实验，其提交只与本实验的提交比对',
+    `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0: 排队中, 1: 执行中, 2: 完成, 3: 失败, 4: 已取消',
+    `progress` TINYINT NOT NULL DEFAULT 0 COMMENT '已完成的百分比',
+    `error` TEXT NOT NULL COMMENT '最近一次失败的原因',
+    `detection_report_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '完成后写入的查重报告',
+    `report_detection_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '实验报告查重完成后写入的报告查重记录',
+    `created_by` BIGINT UNSIGNED NOT NULL,
+    `created_at` DATETIME(3) NOT NULL,
+    `started_at` DATETIME(3) DEFAULT NULL,
+    `finished_at` DATETIME(3) DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_active_lab_id_kind` (`active_lab_id`, `kind`),
+    KEY `idx_lab_id` (`lab_id`, `id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
@@ -0,0 +1,11 @@
+CREATE TABLE `plagiarism_setting` (
+    `lab_id` BIGINT UNSIGNED NOT NULL,
+    `min_match` INT NOT NULL DEFAULT 0 COMMENT '最短匹配的词法单元数，0 表示使用语言的默认值',
+    `ignore_patterns` TEXT NOT NULL COMMENT '换行分隔的 .gitignore 规则，追加在查重服务的配置之后',
+    `base_object` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '基础代码在template桶中的对象名',
+    `base_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '上传的文件名，zip 时解压全部文件作为基础代码',
+    `base_hash` CHAR(64) NOT NULL DEFAULT '' COMMENT '基础代码文件的sha256',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`lab_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_0900_ai_ci;
@@ -18,8 +18,10 @@ func coursesToCourseInfos(courses []*model.Course) []*CourseInfo {
 			CourseDescription: course.Description,
 			PictureURL:        course.PicURL,
 			SecretKey:         course.SecretKey.String,
+			FamilyCode:        course.FamilyCode.String,
 			IsClose:           course.IsClosed,
 			Language:          course.Language,
+			IDEKind:           course.IDEKind,
 			NeedAudit:         course.NeedAudit,
 			CreatedAt:         course.CreatedAt,
 			UpdatedAt:         course.UpdatedAt,
@@ -72,7 +74,8 @@ func (c *CourseService) ListAllCoursesByAdmin(ctx context.Context, offset, limit
 	}, nil
 }
 
-func (c *CourseService) UpdateCourseByAdmin(ctx context.Context, id uint64, name string, description string, picURL string, secretKey sql.NullString, isClosed, needAudit bool) error {
+// UpdateCourseByAdmin familyCode 为空时课程不属于任何课程编号
+func (c *CourseService) UpdateCourseByAdmin(ctx context.Context, id uint64, name string, description string, picURL string, secretKey sql.NullString, familyCode string, isClosed, needAudit bool) error {
 	course, err := model.QueryCourseByID(ctx, c.Dao.Storage.RDB, id)
 	switch err {
 	case nil:
@@ -91,6 +94,10 @@ func (c *CourseService) UpdateCourseByAdmin(ctx context.Context, id uint64, name
 		Valid:  secretKey.String != "",
 		String: secretKey.String,
 	}
+	course.FamilyCode = sql.NullString{
+		Valid:  familyCode != "",
+		String: familyCode,
+	}
 	course.IsClosed = isClosed
 	course.NeedAudit = needAudit
 
@@ -78,7 +78,7 @@ func TestUpdateCourseByAdmin(t *testing.T) {
 		{label: "normal", courseID: course.ID, expectedError: nil},
 		{label: "not found", courseID: 10, expectedError: errorx.ErrIsNotFound},
 	} {
-		err = courseService.UpdateCourseByAdmin(ctx, c.courseID, "", "", "", sql.NullString{}, false, false)
+		err = courseService.UpdateCourseByAdmin(ctx, c.courseID, "", "", "", sql.NullString{}, "", false, false)
 		assert.Equal(t, c.expectedError, err, c.label)
 	}
 }
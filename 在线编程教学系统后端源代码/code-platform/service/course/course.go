@@ -65,6 +65,7 @@ func (c *CourseService) courseInfosPacking(courses []*model.Course, teachersMap
 			PicURL:        course.PicURL,
 			IsClose:       course.IsClosed,
 			Language:      course.Language,
+			IDEKind:       course.IDEKind,
 			NeedAudit:     course.NeedAudit,
 			CreatedAt:     course.CreatedAt,
 		}
@@ -723,6 +724,7 @@ func (c *CourseService) GetCourseInfoByUserIDAndCourseID(ctx context.Context, us
 			PicURL:        course.PicURL,
 			IsClose:       course.IsClosed,
 			Language:      course.Language,
+			IDEKind:       course.IDEKind,
 			NeedAudit:     course.NeedAudit,
 			CreatedAt:     course.CreatedAt,
 		},
@@ -1147,7 +1149,7 @@ func (c *CourseService) ListCoursesScore(ctx context.Context, courseID uint64, o
 	}, nil
 }
 
-func (c *CourseService) AddCourse(ctx context.Context, teacherID uint64, courseName, courseDescription string, isClosed bool, picURL, secretKey string, language int8, needAudit bool) error {
+func (c *CourseService) AddCourse(ctx context.Context, teacherID uint64, courseName, courseDescription string, isClosed bool, picURL, secretKey string, language, ideKind int8, needAudit bool) error {
 	now := time.Now()
 	course := &model.Course{
 		TeacherID:   teacherID,
@@ -1161,6 +1163,7 @@ func (c *CourseService) AddCourse(ctx context.Context, teacherID uint64, courseN
 		NeedAudit: needAudit,
 		IsClosed:  isClosed,
 		Language:  language,
+		IDEKind:   ideKind,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
@@ -1172,7 +1175,7 @@ func (c *CourseService) AddCourse(ctx context.Context, teacherID uint64, courseN
 	return nil
 }
 
-func (c *CourseService) UpdateCourse(ctx context.Context, courseID uint64, name, description, secretKey, picURL string, language int8, needAudit bool) error {
+func (c *CourseService) UpdateCourse(ctx context.Context, courseID uint64, name, description, secretKey, picURL string, language, ideKind int8, needAudit bool) error {
 	course, err := model.QueryCourseByID(ctx, c.Dao.Storage.RDB, courseID)
 	switch err {
 	case nil:
@@ -1192,6 +1195,7 @@ func (c *CourseService) UpdateCourse(ctx context.Context, courseID uint64, name,
 	}
 	course.PicURL = picURL
 	course.Language = language
+	course.IDEKind = ideKind
 	course.NeedAudit = needAudit
 
 	if err := course.Update(ctx, c.Dao.Storage.RDB); err != nil {
@@ -1108,7 +1108,7 @@ func TestAddCourse(t *testing.T) {
 	err := teacher.Insert(ctx, testStorage.RDB)
 	require.NoError(t, err)
 
-	err = courseService.AddCourse(ctx, teacher.ID, "第一个课堂", "没有描述", false, "", "", 0, false)
+	err = courseService.AddCourse(ctx, teacher.ID, "第一个课堂", "没有描述", false, "", "", 0, 0, false)
 	require.NoError(t, err)
 }
 
@@ -1137,7 +1137,7 @@ func TestUpdateCourse(t *testing.T) {
 		{label: "normal", courseID: course.ID, expectedError: nil},
 		{label: "not found", courseID: 10, expectedError: errorx.ErrIsNotFound},
 	} {
-		err = courseService.UpdateCourse(ctx, c.courseID, "", "", "", "", 0, false)
+		err = courseService.UpdateCourse(ctx, c.courseID, "", "", "", "", 0, 0, false)
 		assert.Equal(t, c.expectedError, err, c.label)
 	}
 }
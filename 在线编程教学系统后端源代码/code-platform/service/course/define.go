@@ -41,6 +41,7 @@ type CourseWithTeacherInfo struct {
 	IsClose       bool      `json:"is_close"`
 	NeedAudit     bool      `json:"need_audit"`
 	Language      int8      `json:"language"`
+	IDEKind       int8      `json:"ide_kind"`
 }
 
 type CourseWithTeacherInfoAndIsEnroll struct {
@@ -55,8 +56,10 @@ type CourseInfo struct {
 	SecretKey         string    `json:"secret_key"`
 	CourseName        string    `json:"name"`
 	CourseDescription string    `json:"description"`
+	FamilyCode        string    `json:"family_code"`
 	CourseID          uint64    `json:"course_id"`
 	IsClose           bool      `json:"is_close"`
 	NeedAudit         bool      `json:"need_audit"`
 	Language          int8      `json:"language"`
+	IDEKind           int8      `json:"ide_kind"`
 }
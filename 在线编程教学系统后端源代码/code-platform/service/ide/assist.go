@@ -0,0 +1,313 @@
+package ide
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/rediskey"
+	"code-platform/pkg/transactionx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+	"code-platform/service/ide/monitor"
+	"code-platform/storage"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartAssistSession 学生邀请课程教师协助，并选择教师的权限；同一工作区同时只有一个会话，旧会话被替换
+func (i *IDEService) StartAssistSession(ctx context.Context, labID, studentID uint64, permission string) (*define.AssistSession, error) {
+	lab, err := model.QueryLabByID(ctx, i.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("lab is not found by id[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query lab by id[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	if permission == define.AssistPermissionEdit && lab.DeadLine.Valid && time.Since(lab.DeadLine.Time) > 0 {
+		return nil, errorx.ErrLabHasEnded
+	}
+
+	course, err := model.QueryCourseByID(ctx, i.Dao.Storage.RDB, lab.CourseID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("Query Course By ID[%d] failed", lab.CourseID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query course by id[%d] failed", lab.CourseID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	previous, err := model.QueryOpenIDEAssistSessionsByLabIDAndStudentID(ctx, i.Dao.Storage.RDB, labID, studentID)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryOpenIDEAssistSessions by labID[%d] and studentID[%d] failed", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
+	for _, session := range previous {
+		if _, err := monitor.EndAssistSession(ctx, i.Dao.Storage, i.IDEClient, session, 0, define.AssistEndReplaced); err != nil {
+			i.Logger.Errorf(err, "end assist session[%d] failed", session.ID)
+			return nil, errorx.InternalErr(err)
+		}
+	}
+
+	session := &model.IDEAssistSession{
+		CreatedAt:  time.Now(),
+		Permission: permission,
+		LabID:      labID,
+		StudentID:  studentID,
+		TeacherID:  course.TeacherID,
+	}
+	key := rediskey.NewkeyFormat(define.AssistSessionTagFormat, labID, studentID).Pool(i.Dao.Storage.Pool())
+	// 提交前写入 key，过期检查看到会话时 key 已存在，不会将刚创建的会话当作已离线结束
+	task := func(ctx context.Context, tx storage.RDBClient) error {
+		if err := session.Insert(ctx, tx); err != nil {
+			i.Logger.Errorf(err, "insert assist session %+v failed", session)
+			return errorx.InternalErr(err)
+		}
+		if _, err := key.SetEX(ctx, session.ID, int(define.HeartBeatDuration/time.Second)); err != nil {
+			i.Logger.Errorf(err, "set ex for key %q failed", key.String())
+			return errorx.InternalErr(err)
+		}
+		return nil
+	}
+	if err := transactionx.DoTransaction(ctx, i.Dao.Storage, i.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted}); err != nil {
+		return nil, err
+	}
+	if err := i.touchAssistPresence(ctx, session, studentID); err != nil {
+		return nil, err
+	}
+	return assistSessionModelToDefine(session), nil
+}
+
+// currentAssistSession 工作区进行中的协助会话，不存在时返回 ErrIsNotFound
+func (i *IDEService) currentAssistSession(ctx context.Context, labID, studentID uint64) (*model.IDEAssistSession, error) {
+	key := rediskey.NewkeyFormat(define.AssistSessionTagFormat, labID, studentID).Pool(i.Dao.Storage.Pool())
+	sessionID, err := key.GetUint64(ctx)
+	switch err {
+	case nil:
+	case redigo.ErrNil:
+		i.Logger.Debugf("assist session is not found by labID[%d] and studentID[%d]", labID, studentID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "get redis key %q failed", key.String())
+		return nil, errorx.InternalErr(err)
+	}
+
+	session, err := model.QueryIDEAssistSessionByID(ctx, i.Dao.Storage.RDB, sessionID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("assist session is not found by id[%d]", sessionID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "QueryIDEAssistSessionByID by id[%d] failed", sessionID)
+		return nil, errorx.InternalErr(err)
+	}
+	if session.EndedAt.Valid {
+		return nil, errorx.ErrIsNotFound
+	}
+	return session, nil
+}
+
+// JoinAssistSession 受邀教师加入学生正在运行的 IDE，与学生共享同一会话；Theia 的认证代理只有一个 token，
+// view 权限由前端以只读方式展示。以 view 权限加入且学生的 IDE 未运行时，改为打开挂载同一工作区的只读容器
+func (i *IDEService) JoinAssistSession(ctx context.Context, labID, studentID, teacherID uint64) (*define.AssistIDE, error) {
+	session, err := i.currentAssistSession(ctx, labID, studentID)
+	if err != nil {
+		return nil, err
+	}
+	if session.TeacherID != teacherID {
+		i.Logger.Debugf("teacher[%d] want to join the assist session[%d] of teacher[%d]", teacherID, session.ID, session.TeacherID)
+		return nil, errorx.ErrFailToAuth
+	}
+
+	result := &define.AssistIDE{Permission: session.Permission, SessionID: session.ID}
+	resp, err := i.IDEClient.JoinStudentIDE(ctx, &pb.StudentIDERequest{LabId: labID, StudentId: studentID})
+	switch status.Code(err) {
+	case codes.OK:
+		result.Port, result.Token, result.Path, result.Live = resp.Port, resp.Token, resp.Path, true
+	case codes.FailedPrecondition:
+		i.Logger.Debugf("ide of labID[%d] and studentID[%d] is not running: %v", labID, studentID, err)
+		if session.Permission == define.AssistPermissionEdit {
+			return nil, errorx.ErrIDENotRunning
+		}
+		if result.Port, result.Token, err = i.CheckCode(ctx, labID, studentID, teacherID); err != nil {
+			return nil, err
+		}
+	default:
+		i.Logger.Errorf(err, "join ide with labID[%d] and studentID[%d] failed", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	if err := model.UpdateIDEAssistSessionJoinedAt(ctx, i.Dao.Storage.RDB, session.ID, time.Now()); err != nil {
+		i.Logger.Errorf(err, "update joined_at of assist session[%d] failed", session.ID)
+		return nil, errorx.InternalErr(err)
+	}
+	if err := i.touchAssistPresence(ctx, session, teacherID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReportAssistPresence 学生与教师定时上报在线状态并获取会话中的在线成员，学生的上报为会话续期
+func (i *IDEService) ReportAssistPresence(ctx context.Context, labID, studentID, userID uint64) (*define.AssistPresence, error) {
+	session, err := i.currentAssistSession(ctx, labID, studentID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != session.StudentID && userID != session.TeacherID {
+		return nil, errorx.ErrFailToAuth
+	}
+
+	if err := i.touchAssistPresence(ctx, session, userID); err != nil {
+		return nil, err
+	}
+	if userID == session.StudentID {
+		key := rediskey.NewkeyFormat(define.AssistSessionTagFormat, labID, studentID).Pool(i.Dao.Storage.Pool())
+		if _, err := key.Expire(ctx, int(define.HeartBeatDuration/time.Second)); err != nil {
+			i.Logger.Errorf(err, "expire for key %q failed", key.String())
+			return nil, errorx.InternalErr(err)
+		}
+	} else if session.Permission == define.AssistPermissionView {
+		// 学生的 IDE 未运行时打开的只读容器同样依赖心跳存活
+		if err := i.HeartBeatForTeacher(ctx, labID, studentID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	key := rediskey.NewkeyFormat(define.AssistPresenceTagFormat, session.ID).Pool(i.Dao.Storage.Pool())
+	values, err := key.HGetAll(ctx)
+	if err != nil {
+		i.Logger.Errorf(err, "hgetall for key %q failed", key.String())
+		return nil, errorx.InternalErr(err)
+	}
+	return &define.AssistPresence{
+		Participants: parseAssistPresence(values, time.Now()),
+		Permission:   session.Permission,
+		SessionID:    session.ID,
+	}, nil
+}
+
+func (i *IDEService) touchAssistPresence(ctx context.Context, session *model.IDEAssistSession, userID uint64) error {
+	field := "s:"
+	if userID != session.StudentID {
+		field = "t:"
+	}
+	key := rediskey.NewkeyFormat(define.AssistPresenceTagFormat, session.ID).Pool(i.Dao.Storage.Pool())
+	if _, err := key.HSet(ctx, field+strconv.FormatUint(userID, 10), time.Now().Unix()); err != nil {
+		i.Logger.Errorf(err, "hset for key %q failed", key.String())
+		return errorx.InternalErr(err)
+	}
+	if _, err := key.Expire(ctx, int(define.HeartBeatDuration/time.Second)); err != nil {
+		i.Logger.Errorf(err, "expire for key %q failed", key.String())
+		return errorx.InternalErr(err)
+	}
+	return nil
+}
+
+// parseAssistPresence 解析 HGETALL 的结果，忽略超过心跳周期未上报的成员
+func parseAssistPresence(values []string, now time.Time) []*define.AssistParticipant {
+	participants := make([]*define.AssistParticipant, 0, len(values)/2)
+	for index := 0; index+1 < len(values); index += 2 {
+		field := values[index]
+		var isTeacher bool
+		switch {
+		case strings.HasPrefix(field, "s:"):
+		case strings.HasPrefix(field, "t:"):
+			isTeacher = true
+		default:
+			continue
+		}
+		userID, err := strconv.ParseUint(field[2:], 10, 64)
+		if err != nil {
+			continue
+		}
+		lastSeen, err := strconv.ParseInt(values[index+1], 10, 64)
+		if err != nil || now.Sub(time.Unix(lastSeen, 0)) > define.HeartBeatDuration {
+			continue
+		}
+		participants = append(participants, &define.AssistParticipant{
+			LastSeenAt: time.Unix(lastSeen, 0),
+			UserID:     userID,
+			IsTeacher:  isTeacher,
+		})
+	}
+	sort.Slice(participants, func(a, b int) bool {
+		if participants[a].IsTeacher != participants[b].IsTeacher {
+			return !participants[a].IsTeacher
+		}
+		return participants[a].UserID < participants[b].UserID
+	})
+	return participants
+}
+
+// EndAssistSession 学生或受邀教师结束协助会话
+func (i *IDEService) EndAssistSession(ctx context.Context, labID, studentID, userID uint64) error {
+	session, err := i.currentAssistSession(ctx, labID, studentID)
+	if err != nil {
+		return err
+	}
+
+	reason := define.AssistEndByStudent
+	switch userID {
+	case session.StudentID:
+	case session.TeacherID:
+		reason = define.AssistEndByTeacher
+	default:
+		return errorx.ErrFailToAuth
+	}
+	if _, err := monitor.EndAssistSession(ctx, i.Dao.Storage, i.IDEClient, session, userID, reason); err != nil {
+		i.Logger.Errorf(err, "end assist session[%d] failed", session.ID)
+		return errorx.InternalErr(err)
+	}
+	return nil
+}
+
+// ListAssistSessions 实验下的协助会话记录，供教师审计
+func (i *IDEService) ListAssistSessions(ctx context.Context, labID uint64, offset, limit int) (*define.PageResponse, error) {
+	total, err := model.QueryTotalAmountIDEAssistSessionsByLabID(ctx, i.Dao.Storage.RDB, labID)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryTotalAmountIDEAssistSessionsByLabID by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	sessions, err := model.QueryIDEAssistSessionsByLabID(ctx, i.Dao.Storage.RDB, labID, offset, limit)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryIDEAssistSessionsByLabID by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	records := make([]*define.AssistSession, len(sessions))
+	for index, session := range sessions {
+		records[index] = assistSessionModelToDefine(session)
+	}
+	return &define.PageResponse{
+		Records:  records,
+		PageInfo: &define.PageInfo{Total: total},
+	}, nil
+}
+
+func assistSessionModelToDefine(session *model.IDEAssistSession) *define.AssistSession {
+	return &define.AssistSession{
+		CreatedAt:  session.CreatedAt,
+		JoinedAt:   session.JoinedAt.Time,
+		EndedAt:    session.EndedAt.Time,
+		Permission: session.Permission,
+		EndReason:  session.EndReason,
+		ID:         session.ID,
+		LabID:      session.LabID,
+		StudentID:  session.StudentID,
+		TeacherID:  session.TeacherID,
+		EndedBy:    session.EndedBy,
+	}
+}
@@ -0,0 +1,81 @@
+package ide_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssistSession(t *testing.T) {
+	testStorage, ideService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustFlushDB(ctx, testStorage.Pool())
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "ide_assist_session")
+	now := time.Now()
+
+	const (
+		teacherID = 1
+		studentID = 2
+		otherID   = 3
+	)
+
+	course := &model.Course{TeacherID: teacherID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, course.Insert(ctx, testStorage.RDB))
+	lab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	require.NoError(t, lab.Insert(ctx, testStorage.RDB))
+
+	_, err := ideService.JoinAssistSession(ctx, lab.ID, studentID, teacherID)
+	assert.Equal(t, errorx.ErrIsNotFound, err)
+
+	first, err := ideService.StartAssistSession(ctx, lab.ID, studentID, define.AssistPermissionView)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(teacherID), first.TeacherID)
+
+	// 新会话替换旧会话
+	session, err := ideService.StartAssistSession(ctx, lab.ID, studentID, define.AssistPermissionEdit)
+	require.NoError(t, err)
+
+	presence, err := ideService.ReportAssistPresence(ctx, lab.ID, studentID, studentID)
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, presence.SessionID)
+	assert.Equal(t, define.AssistPermissionEdit, presence.Permission)
+	require.Len(t, presence.Participants, 1)
+	assert.False(t, presence.Participants[0].IsTeacher)
+
+	_, err = ideService.ReportAssistPresence(ctx, lab.ID, studentID, otherID)
+	assert.Equal(t, errorx.ErrFailToAuth, err)
+	_, err = ideService.JoinAssistSession(ctx, lab.ID, studentID, otherID)
+	assert.Equal(t, errorx.ErrFailToAuth, err)
+
+	// 学生的 IDE 未运行时无法以 edit 权限加入
+	_, err = ideService.JoinAssistSession(ctx, lab.ID, studentID, teacherID)
+	assert.Equal(t, errorx.ErrIDENotRunning, err)
+
+	presence, err = ideService.ReportAssistPresence(ctx, lab.ID, studentID, teacherID)
+	require.NoError(t, err)
+	require.Len(t, presence.Participants, 2)
+	assert.True(t, presence.Participants[1].IsTeacher)
+
+	require.NoError(t, ideService.EndAssistSession(ctx, lab.ID, studentID, teacherID))
+	_, err = ideService.ReportAssistPresence(ctx, lab.ID, studentID, studentID)
+	assert.Equal(t, errorx.ErrIsNotFound, err)
+
+	page, err := ideService.ListAssistSessions(ctx, lab.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, page.PageInfo.Total)
+	records := page.Records.([]*define.AssistSession)
+	assert.Equal(t, define.AssistEndByTeacher, records[0].EndReason)
+	assert.Equal(t, uint64(teacherID), records[0].EndedBy)
+	assert.Equal(t, define.AssistEndReplaced, records[1].EndReason)
+}
@@ -0,0 +1,66 @@
+package define
+
+import (
+	"io"
+
+	"code-platform/api/grpc/ide/pb"
+)
+
+// ArchiveStream ArchiveWorkspace 与 ExportWorkspace 的客户端流
+type ArchiveStream interface {
+	Recv() (*pb.ArchiveWorkspaceChunk, error)
+}
+
+// ArchiveReader 将归档分块流读取为连续的 tar.gz 数据
+type ArchiveReader struct {
+	stream ArchiveStream
+	last   *pb.ArchiveWorkspaceChunk
+	buf    []byte
+}
+
+// NewArchiveReader 同步接收第一块，工作区不存在等错误在此返回
+func NewArchiveReader(stream ArchiveStream) (*ArchiveReader, error) {
+	r := &ArchiveReader{stream: stream}
+	if err := r.recv(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *ArchiveReader) recv() error {
+	chunk, err := r.stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			// 最后一块必然携带 sha256，提前结束说明服务端出错
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	r.buf = chunk.Data
+	if chunk.Sha256 != "" {
+		r.last = chunk
+	}
+	return nil
+}
+
+func (r *ArchiveReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.last != nil {
+			return 0, io.EOF
+		}
+		if err := r.recv(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Summary 读取完毕后返回携带 sha256、大小与文件数的最后一块，未读完时返回 nil
+func (r *ArchiveReader) Summary() *pb.ArchiveWorkspaceChunk {
+	if len(r.buf) != 0 {
+		return nil
+	}
+	return r.last
+}
@@ -0,0 +1,59 @@
+package define
+
+import "time"
+
+const (
+	// AssistSessionTagFormat labID:studentID，值为进行中的协助会话 ID，随学生的在线上报续期
+	AssistSessionTagFormat = "assist:%d:%d"
+	// AssistPresenceTagFormat sessionID，hash 中 s:userID 与 t:userID 分别为学生与教师最后一次上报的时间戳
+	AssistPresenceTagFormat = "assistp:%d"
+)
+
+const (
+	AssistPermissionView = "view"
+	AssistPermissionEdit = "edit"
+)
+
+// 协助会话的结束原因
+const (
+	AssistEndByStudent = "student"
+	AssistEndByTeacher = "teacher"
+	AssistEndReplaced  = "replaced"
+	AssistEndExpired   = "expired"
+)
+
+// AssistSession 教师以 edit 权限加入时共享学生的 IDE 容器，以 view 权限加入时打开同一工作区的只读容器
+type AssistSession struct {
+	CreatedAt  time.Time `json:"created_at"`
+	JoinedAt   time.Time `json:"joined_at"`
+	EndedAt    time.Time `json:"ended_at"`
+	Permission string    `json:"permission"`
+	EndReason  string    `json:"end_reason"`
+	ID         uint64    `json:"id"`
+	LabID      uint64    `json:"lab_id"`
+	StudentID  uint64    `json:"student_id"`
+	TeacherID  uint64    `json:"teacher_id"`
+	EndedBy    uint64    `json:"ended_by"`
+}
+
+type AssistIDE struct {
+	Token      string `json:"token"`
+	Path       string `json:"path"`
+	Permission string `json:"permission"`
+	SessionID  uint64 `json:"session_id"`
+	Port       uint32 `json:"port"`
+	// Live 是否加入了学生正在运行的 IDE，否则为只读容器
+	Live bool `json:"live"`
+}
+
+type AssistParticipant struct {
+	LastSeenAt time.Time `json:"last_seen_at"`
+	UserID     uint64    `json:"user_id"`
+	IsTeacher  bool      `json:"is_teacher"`
+}
+
+type AssistPresence struct {
+	Participants []*AssistParticipant `json:"participants"`
+	Permission   string               `json:"permission"`
+	SessionID    uint64               `json:"session_id"`
+}
@@ -10,6 +10,23 @@ import (
 
 const ContainerNamePrefix = "mytheia-"
 
+// 课程使用的 IDE 类型
+const (
+	IDEKindTheia int8 = iota
+	// IDEKindTerminal 仅提供编辑器与 shell 的轻量终端
+	IDEKindTerminal
+	// IDEKindJupyter 仅用于 Python 课程
+	IDEKindJupyter
+)
+
+// 工作区快照的触发原因，记录在快照清单中
+const (
+	SnapshotReasonPeriodic   = "periodic"
+	SnapshotReasonHibernate  = "hibernate"
+	SnapshotReasonPreRestore = "pre_restore"
+	SnapshotReasonHeartbeat  = "heartbeat"
+)
+
 const (
 	HeartBeatTagFormatPrefixForStudent = "hbs:"
 	// HeartBeatTagFormatForTeacher labID:studentID:teacherID
@@ -19,6 +36,15 @@ const (
 // HeartBeatTagFormatForStudent labID:studentID
 var HeartBeatTagFormatForStudent = HeartBeatTagFormatPrefixForStudent + "%d:%d"
 
+// SnapshotThrottleTagFormat labID:studentID，存在期间心跳不再触发快照
+const SnapshotThrottleTagFormat = "snap:%d:%d"
+
+// TerminalTicketTagFormat ticket，值为 labID:studentID，握手时读取后即删除
+const TerminalTicketTagFormat = "tt:%s"
+
+// TerminalTicketDuration 终端票据的有效期，只需覆盖从获取票据到发起 WebSocket 握手的时间
+const TerminalTicketDuration = 30 * time.Second
+
 var InitBasePath = define.InitBasePath()
 
 func GetContainerNameForStudent(labID, studentID uint64) string {
@@ -29,7 +55,31 @@ func GetContainerNameForTeacher(labID, studentID, teacherID uint64) string {
 	return fmt.Sprintf(ContainerNamePrefix+"%d-%d-%d", labID, studentID, teacherID)
 }
 
-func GetImageName(language int8) string {
+// GetArchiveObjectName 截止时冻结的工作区归档在 submissions 桶中的对象名
+func GetArchiveObjectName(labID, studentID uint64) string {
+	return fmt.Sprintf("lab-%d/%d.tar.gz", labID, studentID)
+}
+
+// GetTemplateObjectName 实验初始代码模板在 template 桶中的对象名，按内容摘要区分版本
+func GetTemplateObjectName(labID uint64, hash string) string {
+	return fmt.Sprintf("lab-%d/%s.zip", labID, hash)
+}
+
+// GetTestsObjectName 实验隐藏测试文件在 template 桶中的对象名
+func GetTestsObjectName(labID uint64, hash string) string {
+	return fmt.Sprintf("lab-%d/tests-%s.zip", labID, hash)
+}
+
+// GetPlagiarismBaseObjectName 查重基础代码在 template 桶中的对象名，ext 为上传文件的扩展名
+func GetPlagiarismBaseObjectName(labID uint64, hash, ext string) string {
+	return fmt.Sprintf("lab-%d/plagiarism-base-%s%s", labID, hash, ext)
+}
+
+// GetImageName 课程有已构建的自定义镜像时使用该镜像，否则按语言使用默认的 Theia 镜像
+func GetImageName(language int8, courseImage string) string {
+	if courseImage != "" {
+		return courseImage
+	}
 	languageMap := config.Theia.GetStringMapString("imageName")
 	switch language {
 	case 0:
@@ -41,6 +91,32 @@ func GetImageName(language int8) string {
 	}
 }
 
+// GetMonacoImageName 在线运行代码使用的默认镜像
+func GetMonacoImageName(language int8) string {
+	languageMap := config.Monaco.GetStringMapString("imageName")
+	switch language {
+	case 0:
+		return languageMap["python3"]
+	case 1:
+		return languageMap["cpp"]
+	default:
+		return languageMap["java"]
+	}
+}
+
+// GetTerminalImageName 终端模式 IDE 使用的轻量镜像
+func GetTerminalImageName(language int8) string {
+	languageMap := config.IDEServer.GetStringMapString("terminal.image_name")
+	switch language {
+	case 0:
+		return languageMap["python3"]
+	case 1:
+		return languageMap["cpp"]
+	default:
+		return languageMap["java"]
+	}
+}
+
 type TeacherInfo struct {
 	TeacherName string `json:"teacher_name"`
 	TeacherID   uint64 `json:"teacher_id"`
@@ -71,3 +147,6 @@ type (
 // 假设前端 30 秒发送轮询，则 64 秒至少有两次机会可以命中
 // 64 秒内无命中，则自动删除key
 const HeartBeatDuration = 64 * time.Second
+
+// CodingIdleGap 活跃心跳之间的间隔超过该值时结束当前活跃区间，之间的空闲不计入编码时间
+const CodingIdleGap = 5 * time.Minute
@@ -0,0 +1,92 @@
+package define
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HeartBeatExpiryTag 有序集合，成员为 HeartBeatMember，分数为最后访问时间，清扫时只取出已过期的成员
+	HeartBeatExpiryTag = "hb:expiry"
+	// HeartBeatDeadlineTag 有序集合，成员为学生的 HeartBeatMember，分数为打开 IDE 时实验的截止时间
+	HeartBeatDeadlineTag = "hb:deadline"
+)
+
+const (
+	heartBeatMemberPrefixForStudent = "s:"
+	heartBeatMemberPrefixForTeacher = "t:"
+)
+
+// HeartBeatMember 心跳有序集合中的一个 IDE 会话，TeacherID 不为 0 时为教师查看学生代码的会话
+type HeartBeatMember struct {
+	LabID     uint64
+	StudentID uint64
+	TeacherID uint64
+}
+
+func NewHeartBeatMemberForStudent(labID, studentID uint64) HeartBeatMember {
+	return HeartBeatMember{LabID: labID, StudentID: studentID}
+}
+
+func NewHeartBeatMemberForTeacher(labID, studentID, teacherID uint64) HeartBeatMember {
+	return HeartBeatMember{LabID: labID, StudentID: studentID, TeacherID: teacherID}
+}
+
+func (m HeartBeatMember) IsTeacher() bool {
+	return m.TeacherID != 0
+}
+
+// String s:labID:studentID 或 t:labID:studentID:teacherID
+func (m HeartBeatMember) String() string {
+	if m.IsTeacher() {
+		return fmt.Sprintf(heartBeatMemberPrefixForTeacher+"%d:%d:%d", m.LabID, m.StudentID, m.TeacherID)
+	}
+	return fmt.Sprintf(heartBeatMemberPrefixForStudent+"%d:%d", m.LabID, m.StudentID)
+}
+
+// Key 保存心跳状态的 key
+func (m HeartBeatMember) Key() string {
+	if m.IsTeacher() {
+		return fmt.Sprintf(HeartBeatTagFormatForTeacher, m.LabID, m.StudentID, m.TeacherID)
+	}
+	return fmt.Sprintf(HeartBeatTagFormatForStudent, m.LabID, m.StudentID)
+}
+
+func (m HeartBeatMember) ContainerName() string {
+	if m.IsTeacher() {
+		return GetContainerNameForTeacher(m.LabID, m.StudentID, m.TeacherID)
+	}
+	return GetContainerNameForStudent(m.LabID, m.StudentID)
+}
+
+func ParseHeartBeatMember(s string) (HeartBeatMember, error) {
+	var idCount int
+	switch {
+	case strings.HasPrefix(s, heartBeatMemberPrefixForStudent):
+		idCount = 2
+	case strings.HasPrefix(s, heartBeatMemberPrefixForTeacher):
+		idCount = 3
+	default:
+		return HeartBeatMember{}, fmt.Errorf("unknown heart beat member %q", s)
+	}
+
+	fields := strings.Split(s[2:], ":")
+	if len(fields) != idCount {
+		return HeartBeatMember{}, fmt.Errorf("invalid heart beat member %q", s)
+	}
+	IDs := make([]uint64, idCount)
+	for index, field := range fields {
+		ID, err := strconv.ParseUint(field, 10, 64)
+		if err != nil || ID == 0 {
+			return HeartBeatMember{}, fmt.Errorf("invalid heart beat member %q", s)
+		}
+		IDs[index] = ID
+	}
+
+	member := HeartBeatMember{LabID: IDs[0], StudentID: IDs[1]}
+	if idCount == 3 {
+		member.TeacherID = IDs[2]
+	}
+	return member, nil
+}
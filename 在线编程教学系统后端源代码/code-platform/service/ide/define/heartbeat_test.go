@@ -0,0 +1,34 @@
+package define_test
+
+import (
+	"testing"
+
+	. "code-platform/service/ide/define"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartBeatMember(t *testing.T) {
+	student := NewHeartBeatMemberForStudent(1, 2)
+	require.False(t, student.IsTeacher())
+	require.Equal(t, "s:1:2", student.String())
+	require.Equal(t, "hbs:1:2", student.Key())
+	require.Equal(t, GetContainerNameForStudent(1, 2), student.ContainerName())
+
+	teacher := NewHeartBeatMemberForTeacher(1, 2, 3)
+	require.True(t, teacher.IsTeacher())
+	require.Equal(t, "t:1:2:3", teacher.String())
+	require.Equal(t, "hbt:1:2:3", teacher.Key())
+	require.Equal(t, GetContainerNameForTeacher(1, 2, 3), teacher.ContainerName())
+
+	for _, member := range []HeartBeatMember{student, teacher} {
+		parsed, err := ParseHeartBeatMember(member.String())
+		require.NoError(t, err)
+		require.Equal(t, member, parsed)
+	}
+
+	for _, s := range []string{"", "0", "x:1:2", "s:1", "s:1:2:3", "t:1:2", "s:a:2", "s:0:2", "t:1:2:-3"} {
+		_, err := ParseHeartBeatMember(s)
+		require.Error(t, err, s)
+	}
+}
@@ -0,0 +1,146 @@
+package define
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 课程自定义镜像所基于的镜像种类
+const (
+	ImageBaseTheia  = "theia"
+	ImageBaseMonaco = "monaco"
+)
+
+// CourseImageRepository 课程自定义镜像的仓库名，标签为生成的 Dockerfile 的内容摘要
+const CourseImageRepository = "code-platform/course"
+
+// CourseImage 教师为课程提交的自定义镜像，列表中不返回构建日志
+type CourseImage struct {
+	CreatedAt     time.Time `json:"created_at"`
+	ReviewedAt    time.Time `json:"reviewed_at"`
+	BuiltAt       time.Time `json:"built_at"`
+	Base          string    `json:"base"`
+	AptPackages   []string  `json:"apt_packages"`
+	PipPackages   []string  `json:"pip_packages"`
+	Dockerfile    string    `json:"dockerfile"`
+	ImageTag      string    `json:"image_tag"`
+	BuildLog      string    `json:"build_log,omitempty"`
+	ReviewComment string    `json:"review_comment"`
+	ID            uint64    `json:"id"`
+	CourseID      uint64    `json:"course_id"`
+	SubmittedBy   uint64    `json:"submitted_by"`
+	ReviewedBy    uint64    `json:"reviewed_by"`
+	Language      int8      `json:"language"`
+	// Status 0: 待审核, 1: 构建中, 2: 可用, 3: 构建失败, 4: 已驳回
+	Status int8 `json:"status"`
+	Cached bool `json:"cached"`
+}
+
+const (
+	MaxImagePackages          = 50
+	MaxDockerfileFragmentSize = 8 << 10
+)
+
+// allowedFragmentInstructions Dockerfile 片段只能安装软件与设置环境，不能更换基础镜像、引入构建上下文或改变 IDE 的启动方式
+var allowedFragmentInstructions = map[string]bool{
+	"RUN":   true,
+	"ENV":   true,
+	"ARG":   true,
+	"LABEL": true,
+}
+
+// packageNameRegexpCompiled apt 的 name=version 与 pip 的 name[extra]==version 等写法，不含空白与引号
+var packageNameRegexpCompiled = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+-]*(\[[A-Za-z0-9._,-]+\])?((==|>=|<=|~=|!=|=)[A-Za-z0-9._+*:~-]+)?$`)
+
+func IsValidImagePackage(name string) bool {
+	return len(name) <= 100 && packageNameRegexpCompiled.MatchString(name)
+}
+
+// NormalizeImagePackages 去除空白与重复并排序，内容相同的软件包列表生成相同的镜像
+func NormalizeImagePackages(packages []string) []string {
+	set := make(map[string]struct{}, len(packages))
+	normalized := make([]string, 0, len(packages))
+	for _, p := range packages {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, ok := set[p]; ok {
+			continue
+		}
+		set[p] = struct{}{}
+		normalized = append(normalized, p)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// CheckDockerfileFragment 检查片段中每条指令（续行合并后）是否允许，RUN 不允许携带 --mount 等选项
+func CheckDockerfileFragment(fragment string) error {
+	if len(fragment) > MaxDockerfileFragmentSize {
+		return fmt.Errorf("dockerfile fragment exceeds %d bytes", MaxDockerfileFragmentSize)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(fragment, "\r\n", "\n"), "\n")
+	continued := false
+	for n, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		isContinuation := continued
+		continued = strings.HasSuffix(trimmed, `\`)
+		if isContinuation || trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		fields := strings.Fields(trimmed)
+		instruction := strings.ToUpper(fields[0])
+		if !allowedFragmentInstructions[instruction] {
+			return fmt.Errorf("line %d: instruction %s is not allowed", n+1, instruction)
+		}
+		if instruction == "RUN" && len(fields) > 1 && strings.HasPrefix(fields[1], "--") {
+			return fmt.Errorf("line %d: options of RUN are not allowed", n+1)
+		}
+	}
+	if continued {
+		return fmt.Errorf("dockerfile fragment ends with a line continuation")
+	}
+	return nil
+}
+
+// RenderCourseDockerfile 以 root 安装软件包并执行片段，最后恢复基础镜像原有的用户
+func RenderCourseDockerfile(baseImage, baseUser string, aptPackages, pipPackages []string, fragment string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "FROM %s\nUSER root\n", baseImage)
+	if len(aptPackages) > 0 {
+		fmt.Fprintf(&b, "RUN apt-get update && apt-get install -y --no-install-recommends %s && rm -rf /var/lib/apt/lists/*\n", quotePackages(aptPackages))
+	}
+	if len(pipPackages) > 0 {
+		fmt.Fprintf(&b, "RUN pip3 install --no-cache-dir %s\n", quotePackages(pipPackages))
+	}
+	if fragment = strings.TrimSpace(fragment); fragment != "" {
+		b.WriteString(fragment)
+		b.WriteString("\n")
+	}
+	if baseUser != "" {
+		fmt.Fprintf(&b, "USER %s\n", baseUser)
+	}
+	return b.String()
+}
+
+func quotePackages(packages []string) string {
+	quoted := make([]string, len(packages))
+	for i, p := range packages {
+		quoted[i] = "'" + p + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// GetCourseImageTag 内容相同的 Dockerfile 对应同一标签，已存在时无需重新构建
+func GetCourseImageTag(dockerfile string) string {
+	sum := sha256.Sum256([]byte(dockerfile))
+	return CourseImageRepository + ":" + hex.EncodeToString(sum[:])[:16]
+}
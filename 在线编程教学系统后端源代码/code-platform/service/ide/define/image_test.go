@@ -0,0 +1,62 @@
+package define_test
+
+import (
+	"testing"
+
+	. "code-platform/service/ide/define"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDockerfileFragment(t *testing.T) {
+	for _, c := range []struct {
+		label    string
+		fragment string
+		valid    bool
+	}{
+		{label: "empty", fragment: "", valid: true},
+		{label: "run and env", fragment: "# 安装 OpenCV\nRUN apt-get update && \\\n    apt-get install -y libgl1\nENV OPENCV=1\n", valid: true},
+		{label: "lower case", fragment: "run echo ok", valid: true},
+		{label: "continuation looks like instruction", fragment: "RUN echo \\\n  FROM", valid: true},
+		{label: "from", fragment: "FROM ubuntu", valid: false},
+		{label: "copy", fragment: "RUN echo ok\nCOPY . /app", valid: false},
+		{label: "entrypoint", fragment: "ENTRYPOINT [\"sh\"]", valid: false},
+		{label: "run mount", fragment: "RUN --mount=type=bind,target=/host cat /host", valid: false},
+		{label: "dangling continuation", fragment: "RUN echo \\", valid: false},
+	} {
+		err := CheckDockerfileFragment(c.fragment)
+		if c.valid {
+			require.NoError(t, err, c.label)
+		} else {
+			require.Error(t, err, c.label)
+		}
+	}
+}
+
+func TestIsValidImagePackage(t *testing.T) {
+	for _, name := range []string{"libopencv-dev", "numpy", "numpy==1.24.2", "requests[socks]>=2.0", "g++", "openjdk-17-jdk=17.0.6+10-1"} {
+		require.True(t, IsValidImagePackage(name), name)
+	}
+	for _, name := range []string{"", "-y", "numpy; rm -rf /", "a b", "'quoted'", "$(id)"} {
+		require.False(t, IsValidImagePackage(name), name)
+	}
+}
+
+func TestRenderCourseDockerfile(t *testing.T) {
+	packages := NormalizeImagePackages([]string{"six", "numpy", " six ", ""})
+	require.Equal(t, []string{"numpy", "six"}, packages)
+
+	dockerfile := RenderCourseDockerfile("lgbgbl/theia-python-auth", "theia", []string{"libgl1"}, packages, "ENV A=1\n")
+	require.Equal(t, `FROM lgbgbl/theia-python-auth
+USER root
+RUN apt-get update && apt-get install -y --no-install-recommends 'libgl1' && rm -rf /var/lib/apt/lists/*
+RUN pip3 install --no-cache-dir 'numpy' 'six'
+ENV A=1
+USER theia
+`, dockerfile)
+
+	tag := GetCourseImageTag(dockerfile)
+	require.Equal(t, tag, GetCourseImageTag(dockerfile))
+	require.NotEqual(t, tag, GetCourseImageTag(RenderCourseDockerfile("lgbgbl/theia-python-auth", "", nil, packages, "")))
+	require.Len(t, tag, len(CourseImageRepository)+1+16)
+}
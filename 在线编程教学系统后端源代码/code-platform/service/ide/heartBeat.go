@@ -2,18 +2,29 @@ package ide
 
 import (
 	"context"
+	"database/sql"
 	"sync"
 	"time"
 
 	"code-platform/api/grpc/ide/pb"
+	"code-platform/config"
 	"code-platform/pkg/errorx"
 	"code-platform/pkg/rediskey"
+	"code-platform/pkg/transactionx"
+	"code-platform/repository/rdb/model"
 	"code-platform/service/ide/define"
+	"code-platform/service/ide/monitor"
+	"code-platform/storage"
 
 	redigo "github.com/gomodule/redigo/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
+// maxHeartBeatRefreshAttempts 心跳被并发修改时最多重新读取的次数，仍冲突时由另一次心跳完成更新
+const maxHeartBeatRefreshAttempts = 3
+
 var (
 	heartBeatPoolForStudent = &sync.Pool{
 		New: func() interface{} {
@@ -28,7 +39,8 @@ var (
 	}
 )
 
-func (i *IDEService) HeartBeatForStudent(ctx context.Context, labID, studentID uint64) error {
+// HeartBeatForStudent active 表示上次心跳以来学生有编辑或按键，仅活跃区间计入编码时间
+func (i *IDEService) HeartBeatForStudent(ctx context.Context, labID, studentID uint64, active bool) error {
 	key := heartBeatPoolForStudent.Get().(*rediskey.EntityKey).
 		Pool(i.Dao.Storage.Pool()).
 		Replace(define.HeartBeatTagFormatForStudent, labID, studentID)
@@ -37,17 +49,41 @@ func (i *IDEService) HeartBeatForStudent(ctx context.Context, labID, studentID u
 		heartBeatPoolForStudent.Put(key)
 	}()
 
-	value, err := i.refreshHeartBeatStat(ctx, key)
-	if err != nil {
+	if err := i.refreshHeartBeatStat(ctx, key, labID, studentID, active); err != nil {
 		return err
 	}
-	if _, err := key.Set(ctx, value); err != nil {
-		i.Logger.Errorf(err, "set for key %q with value %v failed", key.String(), value)
-		return errorx.InternalErr(err)
-	}
+
+	i.snapshotOnHeartBeat(ctx, labID, studentID)
 	return nil
 }
 
+// snapshotOnHeartBeat 心跳时异步保存工作区快照，同一工作区在 snapshot.heartbeat_interval 内至多触发一次
+func (i *IDEService) snapshotOnHeartBeat(ctx context.Context, labID, studentID uint64) {
+	key := rediskey.NewkeyFormat(define.SnapshotThrottleTagFormat, labID, studentID).Pool(i.Dao.Storage.Pool())
+	switch _, err := key.SetEXNX(ctx, 0, int(config.IDEServer.GetDuration("snapshot.heartbeat_interval")/time.Second)); err {
+	case nil:
+	case redigo.ErrNil:
+		return
+	default:
+		i.Logger.Errorf(err, "set ex nx for key %q failed", key.String())
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		_, err := i.IDEClient.SnapshotWorkspace(ctx, &pb.SnapshotWorkspaceRequest{
+			LabId:     labID,
+			StudentId: studentID,
+			Reason:    define.SnapshotReasonHeartbeat,
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			i.Logger.Errorf(err, "snapshot workspace of labID[%d] and studentID[%d] on heartbeat failed", labID, studentID)
+		}
+	}()
+}
+
+// HeartBeatForTeacher 教师会话不统计编码时间，只记录最后访问时间，key 过期后由清扫按有序集合关闭容器
 func (i *IDEService) HeartBeatForTeacher(ctx context.Context, labID, studentID, teacherID uint64) error {
 	key := heartBeatPoolForTeacher.Get().(*rediskey.EntityKey).
 		Pool(i.Dao.Storage.Pool()).
@@ -57,39 +93,102 @@ func (i *IDEService) HeartBeatForTeacher(ctx context.Context, labID, studentID,
 		heartBeatPoolForTeacher.Put(key)
 	}()
 
-	_, err := key.SetEX(ctx, 0, int(define.HeartBeatDuration/time.Second))
+	now := time.Now()
+	stat := &pb.HeartBeatStat{LastVisitedAt: now.Unix()}
+	value, err := proto.Marshal(stat)
 	if err != nil {
+		i.Logger.Errorf(err, "proto marshal for heartbeatstat %+v failed", stat)
+		return errorx.InternalErr(err)
+	}
+	if _, err := key.SetEX(ctx, value, int(define.HeartBeatDuration/time.Second)); err != nil {
 		i.Logger.Errorf(err, "set ex for key %q with duration %v failed", key.String(), define.HeartBeatDuration)
 		return errorx.InternalErr(err)
 	}
-	return nil
+	return i.touchHeartBeat(ctx, define.NewHeartBeatMemberForTeacher(labID, studentID, teacherID), now)
 }
 
-func (i *IDEService) refreshHeartBeatStat(ctx context.Context, key *rediskey.EntityKey) ([]byte, error) {
-	value, err := key.GetBytes(ctx)
-	switch err {
-	case nil:
-	case redigo.ErrNil:
-		return nil, errorx.ErrRedisKeyNil
-	default:
-		i.Logger.Errorf(err, "get redis key %q failed", key.String())
-		return nil, errorx.InternalErr(err)
+// refreshHeartBeatStat 更新最后访问时间并推进活跃区间，同时更新心跳有序集合中的分数；
+// 心跳以比较后写入的方式更新，并发的心跳或清扫修改了心跳时重新读取，写回成功后才记录结束的区间，
+// 同一区间不会被重复记录
+func (i *IDEService) refreshHeartBeatStat(ctx context.Context, key *rediskey.EntityKey, labID, studentID uint64, active bool) error {
+	for attempt := 1; ; attempt++ {
+		old, err := key.GetBytes(ctx)
+		switch err {
+		case nil:
+		case redigo.ErrNil:
+			return errorx.ErrRedisKeyNil
+		default:
+			i.Logger.Errorf(err, "get redis key %q failed", key.String())
+			return errorx.InternalErr(err)
+		}
+		var stat pb.HeartBeatStat
+		if err := proto.Unmarshal(old, &stat); err != nil {
+			i.Logger.Errorf(err, "proto unmarshal %v for heart beat stat failed", old)
+			return errorx.InternalErr(err)
+		}
+
+		now := time.Now()
+		interval := monitor.AdvanceCodingInterval(&stat, labID, studentID, now, active)
+		stat.LastVisitedAt = now.Unix()
+		value, err := proto.Marshal(&stat)
+		if err != nil {
+			i.Logger.Errorf(err, "proto marshal for heartbeatstat %+v failed", &stat)
+			return errorx.InternalErr(err)
+		}
+		ok, err := key.CompareAndSet(ctx, old, value)
+		if err != nil {
+			i.Logger.Errorf(err, "compare and set for key %q with value %v failed", key.String(), value)
+			return errorx.InternalErr(err)
+		}
+		if !ok {
+			if attempt < maxHeartBeatRefreshAttempts {
+				continue
+			}
+			i.Logger.Debugf("heart beat key %q is modified concurrently", key.String())
+			return nil
+		}
+
+		if interval != nil {
+			if err := i.saveCodingInterval(ctx, interval); err != nil {
+				return err
+			}
+		}
+		return i.touchHeartBeat(ctx, define.NewHeartBeatMemberForStudent(labID, studentID), now)
 	}
-	var stat pb.HeartBeatStat
-	if err := proto.Unmarshal(value, &stat); err != nil {
-		i.Logger.Errorf(err, "proto unmarshal %v for heart beat stat failed", value)
-		return nil, errorx.InternalErr(err)
+}
+
+// touchHeartBeat 更新心跳有序集合中的最后访问时间
+func (i *IDEService) touchHeartBeat(ctx context.Context, member define.HeartBeatMember, now time.Time) error {
+	key := rediskey.Newkey(define.HeartBeatExpiryTag).Pool(i.Dao.Storage.Pool())
+	if _, err := key.ZAdd(ctx, now.Unix(), member.String()); err != nil {
+		i.Logger.Errorf(err, "zadd %q to key %q failed", member.String(), key.String())
+		return errorx.InternalErr(err)
 	}
-	stat.LastVisitedAt = time.Now().Unix()
-	value, err = proto.Marshal(&stat)
-	if err != nil {
-		i.Logger.Errorf(err, "proto marshal for value %v failed", value)
-		return nil, errorx.InternalErr(err)
+	return nil
+}
+
+// saveCodingInterval 记录活跃区间并按天计入 coding_time
+func (i *IDEService) saveCodingInterval(ctx context.Context, interval *model.CodingInterval) error {
+	task := func(ctx context.Context, tx storage.RDBClient) error {
+		if err := model.BatchInsertCodingIntervals(ctx, tx, []*model.CodingInterval{interval}); err != nil {
+			i.Logger.Errorf(err, "insert coding_interval %+v failed", interval)
+			return err
+		}
+		codingTimes := monitor.SplitCodingInterval(interval)
+		if err := model.BatchInsertCodingTimes(ctx, tx, codingTimes); err != nil {
+			i.Logger.Errorf(err, "batch insert coding_time %+v failed", codingTimes)
+			return err
+		}
+		return nil
+	}
+	if err := transactionx.DoTransaction(ctx, i.Dao.Storage, i.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted}); err != nil {
+		return errorx.InternalErr(err)
 	}
-	return value, nil
+	return nil
 }
 
-func (i *IDEService) HeartBeatWhenStartingForStudent(ctx context.Context, labID, studentID uint64) error {
+// HeartBeatWhenStartingForStudent deadline 为实验截止时间，打开时尚未截止的容器在截止后由清扫关闭
+func (i *IDEService) HeartBeatWhenStartingForStudent(ctx context.Context, labID, studentID uint64, deadline sql.NullTime) error {
 	key := rediskey.NewkeyFormat(define.HeartBeatTagFormatForStudent, labID, studentID).Pool(i.Dao.Storage.Pool())
 	now := time.Now()
 	stat := &pb.HeartBeatStat{
@@ -111,5 +210,18 @@ func (i *IDEService) HeartBeatWhenStartingForStudent(ctx context.Context, labID,
 		i.Logger.Errorf(err, "set nx for key %q failed", key.String())
 		return errorx.InternalErr(err)
 	}
+
+	member := define.NewHeartBeatMemberForStudent(labID, studentID)
+	if err := i.touchHeartBeat(ctx, member, now); err != nil {
+		return err
+	}
+	if !deadline.Valid || !deadline.Time.After(now) {
+		return nil
+	}
+	deadlineKey := rediskey.Newkey(define.HeartBeatDeadlineTag).Pool(i.Dao.Storage.Pool())
+	if _, err := deadlineKey.ZAdd(ctx, deadline.Time.Unix(), member.String()); err != nil {
+		i.Logger.Errorf(err, "zadd %q to key %q failed", member.String(), deadlineKey.String())
+		return errorx.InternalErr(err)
+	}
 	return nil
 }
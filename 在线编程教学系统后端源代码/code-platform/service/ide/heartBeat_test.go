@@ -2,10 +2,13 @@ package ide_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"code-platform/pkg/errorx"
+	"code-platform/pkg/rediskey"
 	"code-platform/pkg/testx"
+	"code-platform/service/ide/define"
 
 	"github.com/stretchr/testify/require"
 )
@@ -22,7 +25,7 @@ func TestHeartBeatWhenStartingForStudent(t *testing.T) {
 	)
 
 	for i := 0; i < 3; i++ {
-		err := ideService.HeartBeatWhenStartingForStudent(ctx, labID, studentID)
+		err := ideService.HeartBeatWhenStartingForStudent(ctx, labID, studentID, sql.NullTime{})
 		require.NoError(t, err)
 	}
 }
@@ -37,14 +40,18 @@ func TestHeartBeatForStudent(t *testing.T) {
 		labID     = 1
 		studentID = 1
 	)
-	err := ideService.HeartBeatForStudent(ctx, labID, studentID)
+	err := ideService.HeartBeatForStudent(ctx, labID, studentID, true)
 	require.Equal(t, errorx.ErrRedisKeyNil, err)
 
-	err = ideService.HeartBeatWhenStartingForStudent(ctx, labID, studentID)
+	err = ideService.HeartBeatWhenStartingForStudent(ctx, labID, studentID, sql.NullTime{})
 	require.NoError(t, err)
 
-	err = ideService.HeartBeatForStudent(ctx, labID, studentID)
+	err = ideService.HeartBeatForStudent(ctx, labID, studentID, true)
 	require.NoError(t, err)
+
+	members, err := rediskey.Newkey(define.HeartBeatExpiryTag).Pool(testStorage.Pool()).ZRange(ctx, 0, -1, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{define.NewHeartBeatMemberForStudent(labID, studentID).String()}, members)
 }
 
 func TestHeartBeatForTeacher(t *testing.T) {
@@ -60,4 +67,8 @@ func TestHeartBeatForTeacher(t *testing.T) {
 	)
 	err := ideService.HeartBeatForTeacher(ctx, labID, studentID, teacherID)
 	require.NoError(t, err)
+
+	members, err := rediskey.Newkey(define.HeartBeatExpiryTag).Pool(testStorage.Pool()).ZRange(ctx, 0, -1, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{define.NewHeartBeatMemberForTeacher(labID, studentID, teacherID).String()}, members)
 }
@@ -14,14 +14,13 @@ import (
 	"code-platform/repository/rdb/model"
 	"code-platform/service/ide/define"
 	"code-platform/service/ide/monitor"
+	"code-platform/service/scheduler"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-var RunSweaterOpt = true
-
 func NewIDEClient() pb.IDEServerServiceClient {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -45,31 +44,77 @@ type IDEService struct {
 	Dao       *repository.Dao
 	Logger    *log.Logger
 	IDEClient pb.IDEServerServiceClient
+	// Scheduler 由 RegisterJobs 设置，用于提交课程镜像的构建
+	Scheduler *scheduler.SchedulerService
 }
 
 func NewIDEService(dao *repository.Dao, logger *log.Logger, ideClient pb.IDEServerServiceClient) *IDEService {
-	ideService := &IDEService{
+	return &IDEService{
 		Dao:       dao,
 		Logger:    logger,
 		IDEClient: ideClient,
 	}
-	if RunSweaterOpt {
-		// 启动Worker，清理过期容器
-		monitor.HeartBeatSweaping(dao.Storage, logger, ideClient)
-	}
-	return ideService
 }
 
-func (i *IDEService) OpenIDE(ctx context.Context, labID, studentID uint64) (port uint32, token string, err error) {
+// RegisterJobs 注册 IDE 的后台任务，由调度器在持有租约的实例上执行
+func (i *IDEService) RegisterJobs(s *scheduler.SchedulerService) {
+	i.Scheduler = s
+	// 清理过期容器
+	s.Register(scheduler.Job{
+		Name:    "ide.heartbeat_sweep",
+		Spec:    "@every 5m",
+		Timeout: 200 * time.Second,
+		Handler: func(ctx context.Context, _ []byte) error {
+			return monitor.SweepHeartBeats(ctx, i.Dao.Storage, i.Logger, i.IDEClient)
+		},
+	})
+	// 对账需列出全部容器，间隔远长于清扫
+	s.Register(scheduler.Job{
+		Name:    "ide.heartbeat_reconcile",
+		Spec:    "@every 30m",
+		Timeout: 200 * time.Second,
+		Handler: func(ctx context.Context, _ []byte) error {
+			return monitor.ReconcileHeartBeats(ctx, i.Dao.Storage, i.Logger, i.IDEClient)
+		},
+	})
+	// 冻结已截止实验的工作区，单个工作区的归档最长 10 分钟
+	s.Register(scheduler.Job{
+		Name:    "ide.deadline_freeze",
+		Spec:    "@every " + config.Workspace.GetDuration("freeze_interval").String(),
+		Timeout: time.Hour,
+		Handler: func(ctx context.Context, _ []byte) error {
+			return monitor.FreezeLabs(ctx, i.Dao.Storage, i.Logger, i.IDEClient)
+		},
+	})
+	// 结束学生已离线的协助会话
+	s.Register(scheduler.Job{
+		Name:    "ide.assist_expire",
+		Spec:    "@every " + (define.HeartBeatDuration / 2).String(),
+		Timeout: define.HeartBeatDuration / 2,
+		Handler: func(ctx context.Context, _ []byte) error {
+			return monitor.ExpireAssistSessions(ctx, i.Dao.Storage, i.Logger, i.IDEClient)
+		},
+	})
+	// 构建课程镜像，失败后由管理员重新审核通过，不自动重试
+	s.Register(scheduler.Job{
+		Name:      courseImageBuildJobName,
+		Timeout:   courseImageBuildTimeout,
+		Handler:   i.runCourseImageBuild,
+		OnFailure: i.failCourseImageBuild,
+	})
+}
+
+// OpenIDE 打开学生的 Theia 或 Jupyter，path 为浏览器打开 IDE 的入口路径
+func (i *IDEService) OpenIDE(ctx context.Context, labID, studentID uint64) (port uint32, token, path string, err error) {
 	lab, err := model.QueryLabByID(ctx, i.Dao.Storage.RDB, labID)
 	switch err {
 	case nil:
 	case sql.ErrNoRows:
 		i.Logger.Debugf("lab is not found by id[%d]", labID)
-		return 0, "", errorx.ErrIsNotFound
+		return 0, "", "", errorx.ErrIsNotFound
 	default:
 		i.Logger.Errorf(err, "Query lab by id[%d] failed", labID)
-		return 0, "", errorx.InternalErr(err)
+		return 0, "", "", errorx.InternalErr(err)
 	}
 
 	course, err := model.QueryCourseByID(ctx, i.Dao.Storage.RDB, lab.CourseID)
@@ -77,10 +122,23 @@ func (i *IDEService) OpenIDE(ctx context.Context, labID, studentID uint64) (port
 	case nil:
 	case sql.ErrNoRows:
 		i.Logger.Debugf("Query Course By ID[%d] failed", lab.CourseID)
-		return 0, "", errorx.ErrIsNotFound
+		return 0, "", "", errorx.ErrIsNotFound
 	default:
 		i.Logger.Errorf(err, "Query course by id[%d] failed", lab.CourseID)
-		return 0, "", errorx.InternalErr(err)
+		return 0, "", "", errorx.InternalErr(err)
+	}
+
+	if course.IDEKind == define.IDEKindTerminal {
+		i.Logger.Debugf("course[%d] uses terminal ide", course.ID)
+		return 0, "", "", errorx.ErrWrongIDEKind
+	}
+
+	// Jupyter 使用固定的镜像
+	var image string
+	if course.IDEKind == define.IDEKindTheia {
+		if image, err = i.courseImageName(ctx, course); err != nil {
+			return 0, "", "", err
+		}
 	}
 
 	var canEdit bool
@@ -95,19 +153,25 @@ func (i *IDEService) OpenIDE(ctx context.Context, labID, studentID uint64) (port
 		StudentId: studentID,
 		Language:  uint32(course.Language),
 		CanEdit:   canEdit,
+		IdeKind:   int32(course.IDEKind),
+		Image:     image,
 	})
 	if err != nil {
 		i.Logger.Errorf(err, "get IDE failed with labID[%d] and studentID[%d]", labID, studentID)
-		return 0, "", errorx.InternalErr(err)
+		return 0, "", "", errorx.InternalErr(err)
+	}
+
+	if resp.IsResumed {
+		i.Logger.Debugf("resume hibernated IDE with labID[%d] and studentID[%d]", labID, studentID)
 	}
 
 	if !resp.IsReused {
 		// 第一次启动前手动 heart beat 一次
-		if err := i.HeartBeatWhenStartingForStudent(ctx, labID, studentID); err != nil {
-			return 0, "", err
+		if err := i.HeartBeatWhenStartingForStudent(ctx, labID, studentID, lab.DeadLine); err != nil {
+			return 0, "", "", err
 		}
 	}
-	return resp.Port, resp.Token, nil
+	return resp.Port, resp.Token, resp.Path, nil
 }
 
 func (i *IDEService) CheckCode(ctx context.Context, labID, studentID, teacherID uint64) (url uint32, token string, err error) {
@@ -138,11 +202,17 @@ func (i *IDEService) CheckCode(ctx context.Context, labID, studentID, teacherID
 		return 0, "", errorx.ErrFailToAuth
 	}
 
+	image, err := i.courseImageName(ctx, course)
+	if err != nil {
+		return 0, "", err
+	}
+
 	resp, err := i.IDEClient.GetIDEForTeacher(ctx, &pb.GetIDEForTeacherRequest{
 		LabId:     labID,
 		StudentId: studentID,
 		TeacherId: teacherID,
 		Language:  uint32(course.Language),
+		Image:     image,
 	})
 	if err != nil {
 		i.Logger.Errorf(err, "get IDE failed with labID[%d] and studentID[%d] and teacherID[%d]", labID, studentID, teacherID)
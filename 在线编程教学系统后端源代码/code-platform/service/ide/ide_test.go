@@ -23,7 +23,6 @@ import (
 func testHelper() (*storage.Storage, *IDEService) {
 	testStorage := testx.NewStorage()
 	dao := &repository.Dao{Storage: testStorage}
-	RunSweaterOpt = false
 	ideService := NewIDEService(dao, log.Sub("lab"), NewIDEClient())
 	return testStorage, ideService
 }
@@ -71,7 +70,7 @@ func TestOpenIDE(t *testing.T) {
 		{label: "cpp student", labID: 2, expectedError: nil},
 		{label: "java student", labID: 3, expectedError: nil},
 	} {
-		url, _, err := ideService.OpenIDE(ctx, c.labID, user.ID)
+		url, _, _, err := ideService.OpenIDE(ctx, c.labID, user.ID)
 		require.Equal(t, c.expectedError, err, c.label)
 		if err == nil {
 			fmt.Println(c.label, url)
@@ -82,6 +81,42 @@ func TestOpenIDE(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOpenJupyter(t *testing.T) {
+	testStorage, ideService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "user", "course", "lab")
+	testx.MustFlushDB(ctx, testStorage.Pool())
+	now := time.Now()
+
+	user := &model.User{Role: 0, Number: "1", CreatedAt: now, UpdatedAt: now}
+	err := user.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	course := &model.Course{Language: 0, IDEKind: define.IDEKindJupyter, CreatedAt: now, UpdatedAt: now}
+	err = course.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	lab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now}
+	err = lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	port, token, path, err := ideService.OpenIDE(ctx, lab.ID, user.ID)
+	require.NoError(t, err)
+	require.NotZero(t, port)
+	require.Equal(t, "/lab?token="+token, path)
+
+	// 再次打开复用同一容器与 token
+	_, token2, path2, err := ideService.OpenIDE(ctx, lab.ID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, token, token2)
+	require.Equal(t, path, path2)
+
+	_, err = ideService.IDEClient.StopAllIDE(ctx, &pb.Empty{})
+	require.NoError(t, err)
+}
+
 func TestCheckCode(t *testing.T) {
 	testStorage, ideService := testHelper()
 	defer testStorage.Close()
@@ -183,7 +218,7 @@ func TestListContainers(t *testing.T) {
 	err = lab.Insert(ctx, testStorage.RDB)
 	require.NoError(t, err)
 
-	url, _, err := ideService.OpenIDE(ctx, lab.ID, studentID)
+	url, _, _, err := ideService.OpenIDE(ctx, lab.ID, studentID)
 	require.NoError(t, err)
 	fmt.Println(url)
 
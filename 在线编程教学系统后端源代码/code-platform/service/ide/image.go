@@ -0,0 +1,308 @@
+package ide
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/transactionx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+	"code-platform/storage"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	courseImageBuildJobName = "ide.build_course_image"
+	// courseImageBuildTimeout 长于 IDE 服务上单次构建的最长时间，超过后仍为构建中的记录视为已中断
+	courseImageBuildTimeout = 40 * time.Minute
+	// maxCourseImageBuildLog 构建日志只保留末尾，失败原因通常在最后
+	maxCourseImageBuildLog = 256 << 10
+)
+
+// SubmitCourseImage 教师提交课程镜像的软件包与 Dockerfile 片段，经管理员审核后构建；
+// 镜像按提交时课程的语言构建，课程更换语言后需重新提交
+func (i *IDEService) SubmitCourseImage(ctx context.Context, courseID, teacherID uint64, base string, aptPackages, pipPackages []string, dockerfile string) (*define.CourseImage, error) {
+	course, err := model.QueryCourseByID(ctx, i.Dao.Storage.RDB, courseID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("course is not found by id[%d]", courseID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query course by id[%d] failed", courseID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	image := &model.CourseImage{
+		CreatedAt:   time.Now(),
+		Base:        base,
+		AptPackages: strings.Join(define.NormalizeImagePackages(aptPackages), " "),
+		PipPackages: strings.Join(define.NormalizeImagePackages(pipPackages), " "),
+		Dockerfile:  strings.TrimSpace(dockerfile),
+		CourseID:    courseID,
+		SubmittedBy: teacherID,
+		Language:    course.Language,
+		Status:      model.CourseImageStatusPending,
+	}
+	if err := image.Insert(ctx, i.Dao.Storage.RDB); err != nil {
+		i.Logger.Errorf(err, "insert course image %+v failed", image)
+		return nil, errorx.InternalErr(err)
+	}
+	return courseImageModelToDefine(image), nil
+}
+
+func (i *IDEService) ListCourseImages(ctx context.Context, courseID uint64, offset, limit int) (*define.PageResponse, error) {
+	total, err := model.QueryTotalAmountCourseImagesByCourseID(ctx, i.Dao.Storage.RDB, courseID)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryTotalAmountCourseImagesByCourseID by courseID[%d] failed", courseID)
+		return nil, errorx.InternalErr(err)
+	}
+	images, err := model.QueryCourseImagesByCourseID(ctx, i.Dao.Storage.RDB, courseID, offset, limit)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryCourseImagesByCourseID by courseID[%d] failed", courseID)
+		return nil, errorx.InternalErr(err)
+	}
+	return courseImagesPage(images, total), nil
+}
+
+// ListCourseImagesByStatus 供管理员按状态查看，如待审核的镜像
+func (i *IDEService) ListCourseImagesByStatus(ctx context.Context, imageStatus int8, offset, limit int) (*define.PageResponse, error) {
+	total, err := model.QueryTotalAmountCourseImagesByStatus(ctx, i.Dao.Storage.RDB, imageStatus)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryTotalAmountCourseImagesByStatus by status[%d] failed", imageStatus)
+		return nil, errorx.InternalErr(err)
+	}
+	images, err := model.QueryCourseImagesByStatus(ctx, i.Dao.Storage.RDB, imageStatus, offset, limit)
+	if err != nil {
+		i.Logger.Errorf(err, "QueryCourseImagesByStatus by status[%d] failed", imageStatus)
+		return nil, errorx.InternalErr(err)
+	}
+	return courseImagesPage(images, total), nil
+}
+
+// GetCourseImage 返回包含构建日志的镜像详情，courseID 为 0 时不校验所属课程
+func (i *IDEService) GetCourseImage(ctx context.Context, courseID, imageID uint64) (*define.CourseImage, error) {
+	image, err := model.QueryCourseImageByID(ctx, i.Dao.Storage.RDB, imageID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("course image is not found by id[%d]", imageID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query course image by id[%d] failed", imageID)
+		return nil, errorx.InternalErr(err)
+	}
+	if courseID != 0 && image.CourseID != courseID {
+		i.Logger.Debugf("course image[%d] does not belong to course[%d]", imageID, courseID)
+		return nil, errorx.ErrIsNotFound
+	}
+	return courseImageModelToDefine(image), nil
+}
+
+// ApproveCourseImage 审核通过并在后台构建，构建失败或中断的镜像可再次通过以重新构建
+func (i *IDEService) ApproveCourseImage(ctx context.Context, imageID, adminID uint64) error {
+	image, err := model.QueryCourseImageByID(ctx, i.Dao.Storage.RDB, imageID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("course image is not found by id[%d]", imageID)
+		return errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query course image by id[%d] failed", imageID)
+		return errorx.InternalErr(err)
+	}
+
+	now := time.Now()
+	task := func(ctx context.Context, tx storage.RDBClient) error {
+		ok, err := model.StartCourseImageBuild(ctx, tx, imageID, adminID, now, now.Add(-courseImageBuildTimeout))
+		if err != nil {
+			i.Logger.Errorf(err, "start building course image[%d] failed", imageID)
+			return errorx.InternalErr(err)
+		}
+		if !ok {
+			i.Logger.Debugf("course image[%d] with status[%d] can not be approved", imageID, image.Status)
+			return errorx.ErrImageNotReviewable
+		}
+		// 构建可能长达数十分钟，由调度器在后台执行，同一镜像只保留一次等待或执行中的构建
+		idStr := strconv.FormatUint(imageID, 10)
+		if _, err := i.Scheduler.Enqueue(ctx, tx, courseImageBuildJobName, []byte(idStr), now, courseImageBuildJobName+":"+idStr); err != nil {
+			i.Logger.Errorf(err, "enqueue building course image[%d] failed", imageID)
+			return errorx.InternalErr(err)
+		}
+		return nil
+	}
+	return transactionx.DoTransaction(ctx, i.Dao.Storage, i.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+}
+
+func (i *IDEService) RejectCourseImage(ctx context.Context, imageID, adminID uint64, comment string) error {
+	ok, err := model.RejectCourseImage(ctx, i.Dao.Storage.RDB, imageID, adminID, comment, time.Now())
+	if err != nil {
+		i.Logger.Errorf(err, "reject course image[%d] failed", imageID)
+		return errorx.InternalErr(err)
+	}
+	if ok {
+		return nil
+	}
+
+	_, err = model.QueryCourseImageByID(ctx, i.Dao.Storage.RDB, imageID)
+	switch err {
+	case nil:
+		i.Logger.Debugf("course image[%d] is not pending", imageID)
+		return errorx.ErrImageNotReviewable
+	case sql.ErrNoRows:
+		i.Logger.Debugf("course image is not found by id[%d]", imageID)
+		return errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query course image by id[%d] failed", imageID)
+		return errorx.InternalErr(err)
+	}
+}
+
+// runCourseImageBuild 构建结果写入镜像记录后返回构建的错误，由调度器记录执行失败
+func (i *IDEService) runCourseImageBuild(ctx context.Context, payload []byte) error {
+	imageID, err := strconv.ParseUint(string(payload), 10, 64)
+	if err != nil {
+		return err
+	}
+	image, err := model.QueryCourseImageByID(ctx, i.Dao.Storage.RDB, imageID)
+	if err != nil {
+		i.Logger.Errorf(err, "Query course image by id[%d] failed", imageID)
+		return err
+	}
+	if image.Status != model.CourseImageStatusBuilding {
+		i.Logger.Debugf("course image[%d] with status[%d] is not building", imageID, image.Status)
+		return nil
+	}
+
+	buildLog := &tailBuffer{limit: maxCourseImageBuildLog}
+	tag, cached, buildErr := i.runImageBuild(ctx, image, buildLog)
+	imageStatus := model.CourseImageStatusReady
+	if buildErr != nil {
+		i.Logger.Errorf(buildErr, "build course image[%d] failed", image.ID)
+		imageStatus = model.CourseImageStatusFailed
+		buildLog.WriteString("\n构建失败: " + status.Convert(buildErr).Message() + "\n")
+	}
+
+	// 构建超时后 ctx 已结束，仍需写入构建结果
+	finishCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := model.FinishCourseImageBuild(finishCtx, i.Dao.Storage.RDB, image.ID, imageStatus, tag, cached, buildLog.String(), time.Now()); err != nil {
+		i.Logger.Errorf(err, "finish building course image[%d] failed", image.ID)
+		return err
+	}
+	return buildErr
+}
+
+// failCourseImageBuild 构建被中断时仍为构建中的镜像标记为失败，已写入构建结果的不受影响
+func (i *IDEService) failCourseImageBuild(ctx context.Context, payload []byte, err error) {
+	imageID, parseErr := strconv.ParseUint(string(payload), 10, 64)
+	if parseErr != nil {
+		return
+	}
+	buildLog := "构建中断: " + err.Error() + "\n"
+	if finishErr := model.FinishCourseImageBuild(ctx, i.Dao.Storage.RDB, imageID, model.CourseImageStatusFailed, "", false, buildLog, time.Now()); finishErr != nil {
+		i.Logger.Errorf(finishErr, "finish building course image[%d] failed", imageID)
+	}
+}
+
+func (i *IDEService) runImageBuild(ctx context.Context, image *model.CourseImage, buildLog *tailBuffer) (tag string, cached bool, err error) {
+	stream, err := i.IDEClient.BuildImage(ctx, &pb.BuildImageRequest{
+		Base:        image.Base,
+		Language:    uint32(image.Language),
+		AptPackages: strings.Fields(image.AptPackages),
+		PipPackages: strings.Fields(image.PipPackages),
+		Dockerfile:  image.Dockerfile,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	for {
+		output, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) && tag != "" {
+				return tag, cached, nil
+			}
+			if errors.Is(err, io.EOF) {
+				err = errors.New("build finished without image tag")
+			}
+			return "", false, err
+		}
+		buildLog.WriteString(output.Log)
+		if output.Tag != "" {
+			tag, cached = output.Tag, output.Cached
+		}
+	}
+}
+
+// courseImageName 课程最近构建完成的自定义镜像，没有时为空，由 IDE 服务按语言使用默认镜像
+func (i *IDEService) courseImageName(ctx context.Context, course *model.Course) (string, error) {
+	tag, err := model.QueryReadyCourseImageTag(ctx, i.Dao.Storage.RDB, course.ID, define.ImageBaseTheia, course.Language)
+	if err != nil {
+		i.Logger.Errorf(err, "query ready image of course[%d] failed", course.ID)
+		return "", errorx.InternalErr(err)
+	}
+	return tag, nil
+}
+
+func courseImagesPage(images []*model.CourseImage, total int) *define.PageResponse {
+	records := make([]*define.CourseImage, len(images))
+	for index, image := range images {
+		records[index] = courseImageModelToDefine(image)
+	}
+	return &define.PageResponse{
+		Records:  records,
+		PageInfo: &define.PageInfo{Total: total},
+	}
+}
+
+func courseImageModelToDefine(image *model.CourseImage) *define.CourseImage {
+	return &define.CourseImage{
+		CreatedAt:     image.CreatedAt,
+		ReviewedAt:    image.ReviewedAt.Time,
+		BuiltAt:       image.BuiltAt.Time,
+		Base:          image.Base,
+		AptPackages:   strings.Fields(image.AptPackages),
+		PipPackages:   strings.Fields(image.PipPackages),
+		Dockerfile:    image.Dockerfile,
+		ImageTag:      image.ImageTag,
+		BuildLog:      image.BuildLog,
+		ReviewComment: image.ReviewComment,
+		ID:            image.ID,
+		CourseID:      image.CourseID,
+		SubmittedBy:   image.SubmittedBy,
+		ReviewedBy:    image.ReviewedBy,
+		Language:      image.Language,
+		Status:        image.Status,
+		Cached:        image.Cached,
+	}
+}
+
+// tailBuffer 超出上限时丢弃最早的内容
+type tailBuffer struct {
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+func (t *tailBuffer) WriteString(s string) {
+	t.buf = append(t.buf, s...)
+	if over := len(t.buf) - t.limit; over > 0 {
+		t.buf = append(t.buf[:0], t.buf[over:]...)
+		t.truncated = true
+	}
+}
+
+func (t *tailBuffer) String() string {
+	if t.truncated {
+		return "...\n" + string(t.buf)
+	}
+	return string(t.buf)
+}
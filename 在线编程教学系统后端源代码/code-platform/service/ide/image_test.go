@@ -0,0 +1,74 @@
+package ide_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code-platform/log"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+	"code-platform/service/scheduler"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCourseImage(t *testing.T) {
+	testStorage, ideService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "course_image", "job_run")
+	schedulerService := scheduler.NewSchedulerService(ideService.Dao, log.Sub("scheduler"))
+	ideService.RegisterJobs(schedulerService)
+	now := time.Now()
+
+	course := &model.Course{Language: 0, TeacherID: 1, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, course.Insert(ctx, testStorage.RDB))
+
+	_, err := ideService.SubmitCourseImage(ctx, 100, 1, define.ImageBaseTheia, nil, nil, "ENV A=1")
+	require.Equal(t, errorx.ErrIsNotFound, err)
+
+	rejected, err := ideService.SubmitCourseImage(ctx, course.ID, 1, define.ImageBaseTheia, []string{"libgl1"}, nil, "")
+	require.NoError(t, err)
+	image, err := ideService.SubmitCourseImage(ctx, course.ID, 1, define.ImageBaseTheia, nil, []string{"six", " six"}, "ENV COURSE_IMAGE_TEST=1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"six"}, image.PipPackages)
+	require.Equal(t, model.CourseImageStatusPending, image.Status)
+
+	resp, err := ideService.ListCourseImagesByStatus(ctx, model.CourseImageStatusPending, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.PageInfo.Total)
+
+	require.NoError(t, ideService.RejectCourseImage(ctx, rejected.ID, 2, "请改用 pip 安装"))
+	require.Equal(t, errorx.ErrImageNotReviewable, ideService.RejectCourseImage(ctx, rejected.ID, 2, ""))
+	require.Equal(t, errorx.ErrImageNotReviewable, ideService.ApproveCourseImage(ctx, rejected.ID, 2))
+	require.Equal(t, errorx.ErrIsNotFound, ideService.ApproveCourseImage(ctx, 100, 2))
+
+	require.NoError(t, ideService.ApproveCourseImage(ctx, image.ID, 2))
+	require.Equal(t, errorx.ErrImageNotReviewable, ideService.ApproveCourseImage(ctx, image.ID, 2))
+
+	// 调度器执行构建，等待构建结束
+	schedulerService.Start()
+	deadline := time.Now().Add(10 * time.Minute)
+	for {
+		image, err = ideService.GetCourseImage(ctx, course.ID, image.ID)
+		require.NoError(t, err)
+		if image.Status != model.CourseImageStatusBuilding || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(2 * time.Second)
+	}
+	require.Equal(t, model.CourseImageStatusReady, image.Status, image.BuildLog)
+	require.Contains(t, image.ImageTag, define.CourseImageRepository+":")
+	require.NotEmpty(t, image.BuildLog)
+
+	tag, err := model.QueryReadyCourseImageTag(ctx, testStorage.RDB, course.ID, define.ImageBaseTheia, course.Language)
+	require.NoError(t, err)
+	require.Equal(t, image.ImageTag, tag)
+
+	_, err = ideService.GetCourseImage(ctx, course.ID+1, image.ID)
+	require.Equal(t, errorx.ErrIsNotFound, err)
+}
@@ -0,0 +1,80 @@
+package monitor
+
+import (
+	"context"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/log"
+	"code-platform/pkg/rediskey"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+	"code-platform/service/scheduler"
+	"code-platform/storage"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// ExpireAssistSessions 结束学生已离线（在线上报停止续期）的协助会话
+func ExpireAssistSessions(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) error {
+	sessions, err := model.QueryOpenIDEAssistSessions(ctx, st.RDB)
+	if err != nil {
+		logger.Error(err, "QueryOpenIDEAssistSessions failed")
+		return err
+	}
+	for _, session := range sessions {
+		key := rediskey.NewkeyFormat(define.AssistSessionTagFormat, session.LabID, session.StudentID).Pool(st.Pool())
+		sessionID, err := key.GetUint64(ctx)
+		switch err {
+		case nil, redigo.ErrNil:
+		default:
+			logger.Errorf(err, "get redis key %q failed", key.String())
+			continue
+		}
+		if sessionID == session.ID {
+			continue
+		}
+		if err := scheduler.CheckFencing(ctx); err != nil {
+			return err
+		}
+		if _, err := EndAssistSession(ctx, st, ideClient, session, 0, define.AssistEndExpired); err != nil {
+			logger.Errorf(err, "expire assist session[%d] failed", session.ID)
+		}
+	}
+	return nil
+}
+
+// EndAssistSession 结束协助会话并清除在线状态，教师曾加入学生的 IDE 时轮换学生容器的 token；
+// 会话已被结束时返回 false
+func EndAssistSession(ctx context.Context, st *storage.Storage, ideClient pb.IDEServerServiceClient, session *model.IDEAssistSession, endedBy uint64, reason string) (bool, error) {
+	ended, err := model.EndIDEAssistSession(ctx, st.RDB, session.ID, endedBy, reason, time.Now())
+	if err != nil || !ended {
+		return false, err
+	}
+
+	// 新会话可能已覆盖同一工作区的 key
+	key := rediskey.NewkeyFormat(define.AssistSessionTagFormat, session.LabID, session.StudentID).Pool(st.Pool())
+	switch sessionID, err := key.GetUint64(ctx); {
+	case err == nil && sessionID == session.ID:
+		if _, err := key.Del(ctx); err != nil {
+			return true, err
+		}
+	case err != nil && err != redigo.ErrNil:
+		return true, err
+	}
+	if _, err := rediskey.NewkeyFormat(define.AssistPresenceTagFormat, session.ID).Pool(st.Pool()).Del(ctx); err != nil {
+		return true, err
+	}
+
+	// 加入时间可能晚于调用方读取会话的时间
+	session, err = model.QueryIDEAssistSessionByID(ctx, st.RDB, session.ID)
+	if err != nil {
+		return true, err
+	}
+	if session.JoinedAt.Valid {
+		if _, err := ideClient.RotateIDEToken(ctx, &pb.StudentIDERequest{LabId: session.LabID, StudentId: session.StudentID}); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
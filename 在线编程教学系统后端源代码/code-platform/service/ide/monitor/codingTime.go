@@ -0,0 +1,92 @@
+package monitor
+
+import (
+	"math"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/timex"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+)
+
+// AdvanceCodingInterval 根据一次心跳推进活跃区间，返回因空闲而结束的区间，没有时为 nil；
+// 需在更新 LastVisitedAt 前调用。活跃心跳表示上次心跳以来有编辑或按键，
+// 新区间从上一次心跳开始，上一次心跳过久时从本次心跳开始
+func AdvanceCodingInterval(stat *pb.HeartBeatStat, labID, studentID uint64, now time.Time, active bool) *model.CodingInterval {
+	var closed *model.CodingInterval
+	if stat.ActiveStartedAt != 0 && now.Sub(time.Unix(stat.LastActiveAt, 0)) > define.CodingIdleGap {
+		closed = CloseCodingInterval(stat, labID, studentID, now)
+	}
+	if !active {
+		return closed
+	}
+
+	if stat.ActiveStartedAt == 0 {
+		startedAt := now
+		if lastVisitedAt := time.Unix(stat.LastVisitedAt, 0); stat.LastVisitedAt != 0 &&
+			!lastVisitedAt.After(now) && now.Sub(lastVisitedAt) <= define.HeartBeatDuration {
+			startedAt = lastVisitedAt
+		}
+		stat.ActiveStartedAt = startedAt.Unix()
+	}
+	stat.LastActiveAt = now.Unix()
+	return closed
+}
+
+// CloseCodingInterval 结束进行中的活跃区间，区间截止于最后一次活跃心跳且不晚于 endAt，长度为 0 时返回 nil
+func CloseCodingInterval(stat *pb.HeartBeatStat, labID, studentID uint64, endAt time.Time) *model.CodingInterval {
+	if stat.ActiveStartedAt == 0 {
+		return nil
+	}
+	startedAt, lastActiveAt := time.Unix(stat.ActiveStartedAt, 0), time.Unix(stat.LastActiveAt, 0)
+	stat.ActiveStartedAt, stat.LastActiveAt = 0, 0
+
+	if lastActiveAt.Before(endAt) {
+		endAt = lastActiveAt
+	}
+	if !endAt.After(startedAt) {
+		return nil
+	}
+	return &model.CodingInterval{
+		LabID:     labID,
+		UserID:    studentID,
+		StartedAt: startedAt,
+		EndedAt:   endAt,
+	}
+}
+
+// SplitCodingInterval 将活跃区间按天拆分为 coding_time 记录，分钟数四舍五入，不足半分钟的部分忽略
+func SplitCodingInterval(interval *model.CodingInterval) []*model.CodingTime {
+	startedAt := interval.StartedAt.In(timex.ShanghaiLocation)
+	endedAt := interval.EndedAt.In(timex.ShanghaiLocation)
+
+	var codingTimes []*model.CodingTime
+	for start := startedAt; start.Before(endedAt); {
+		dayStart := timex.StartOfDay(start)
+		end := dayStart.AddDate(0, 0, 1)
+		if end.After(endedAt) {
+			end = endedAt
+		}
+		if duration := uint32(math.Round(end.Sub(start).Minutes())); duration != 0 {
+			codingTimes = append(codingTimes, &model.CodingTime{
+				LabID:         interval.LabID,
+				UserID:        interval.UserID,
+				Duration:      duration,
+				CreatedAt:     start,
+				CreatedAtDate: dayStart,
+			})
+		}
+		start = end
+	}
+	return codingTimes
+}
+
+// SplitCodingIntervals 拆分多个活跃区间
+func SplitCodingIntervals(intervals []*model.CodingInterval) []*model.CodingTime {
+	codingTimes := make([]*model.CodingTime, 0, len(intervals))
+	for _, interval := range intervals {
+		codingTimes = append(codingTimes, SplitCodingInterval(interval)...)
+	}
+	return codingTimes
+}
@@ -0,0 +1,95 @@
+package monitor_test
+
+import (
+	"testing"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/timex"
+	"code-platform/repository/rdb/model"
+	. "code-platform/service/ide/monitor"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvanceCodingInterval(t *testing.T) {
+	const (
+		labID     = 1
+		studentID = 2
+	)
+	start := time.Date(2022, 3, 1, 10, 0, 0, 0, timex.ShanghaiLocation)
+	stat := &pb.HeartBeatStat{CreatedAt: start.Unix(), LastVisitedAt: start.Unix()}
+
+	heartBeat := func(now time.Time, active bool) *model.CodingInterval {
+		interval := AdvanceCodingInterval(stat, labID, studentID, now, active)
+		stat.LastVisitedAt = now.Unix()
+		return interval
+	}
+
+	// 未活跃时不开始区间
+	require.Nil(t, heartBeat(start.Add(30*time.Second), false))
+	require.Zero(t, stat.ActiveStartedAt)
+
+	// 首次活跃从上一次心跳开始
+	require.Nil(t, heartBeat(start.Add(time.Minute), true))
+	require.Equal(t, start.Add(30*time.Second).Unix(), stat.ActiveStartedAt)
+
+	// 短暂停顿不结束区间
+	require.Nil(t, heartBeat(start.Add(3*time.Minute), false))
+	require.Nil(t, heartBeat(start.Add(5*time.Minute), true))
+
+	// 空闲超过间隔后结束区间，截止于最后一次活跃心跳
+	require.Nil(t, heartBeat(start.Add(8*time.Minute), false))
+	interval := heartBeat(start.Add(11*time.Minute), false)
+	require.NotNil(t, interval)
+	require.Equal(t, start.Add(30*time.Second).Unix(), interval.StartedAt.Unix())
+	require.Equal(t, start.Add(5*time.Minute).Unix(), interval.EndedAt.Unix())
+	require.EqualValues(t, labID, interval.LabID)
+	require.EqualValues(t, studentID, interval.UserID)
+	require.Zero(t, stat.ActiveStartedAt)
+
+	// 长时间无心跳后再活跃，从本次心跳开始
+	now := start.Add(3 * time.Hour)
+	require.Nil(t, heartBeat(now, true))
+	require.Equal(t, now.Unix(), stat.ActiveStartedAt)
+}
+
+func TestCloseCodingInterval(t *testing.T) {
+	start := time.Date(2022, 3, 1, 10, 0, 0, 0, timex.ShanghaiLocation)
+
+	require.Nil(t, CloseCodingInterval(&pb.HeartBeatStat{}, 1, 1, start))
+
+	stat := &pb.HeartBeatStat{ActiveStartedAt: start.Unix(), LastActiveAt: start.Add(time.Hour).Unix()}
+	interval := CloseCodingInterval(stat, 1, 1, start.Add(20*time.Minute))
+	require.NotNil(t, interval)
+	require.Equal(t, start.Add(20*time.Minute).Unix(), interval.EndedAt.Unix())
+	require.Zero(t, stat.ActiveStartedAt)
+	require.Zero(t, stat.LastActiveAt)
+
+	// 区间开始于截止时间之后
+	stat = &pb.HeartBeatStat{ActiveStartedAt: start.Unix(), LastActiveAt: start.Add(time.Hour).Unix()}
+	require.Nil(t, CloseCodingInterval(stat, 1, 1, start.Add(-time.Minute)))
+}
+
+func TestSplitCodingInterval(t *testing.T) {
+	start := time.Date(2022, 3, 1, 23, 30, 0, 0, timex.ShanghaiLocation)
+	codingTimes := SplitCodingInterval(&model.CodingInterval{
+		StartedAt: start,
+		EndedAt:   start.Add(25*time.Hour + 10*time.Minute + 40*time.Second),
+		LabID:     1,
+		UserID:    2,
+	})
+	require.Len(t, codingTimes, 3)
+
+	durations := []uint32{30, 24 * 60, 41}
+	for index, codingTime := range codingTimes {
+		require.Equal(t, durations[index], codingTime.Duration)
+		require.True(t, timex.StartOfDay(codingTime.CreatedAt).Equal(codingTime.CreatedAtDate))
+		require.EqualValues(t, 1, codingTime.LabID)
+		require.EqualValues(t, 2, codingTime.UserID)
+	}
+	require.True(t, start.Equal(codingTimes[0].CreatedAt))
+
+	// 不足半分钟的区间不计入
+	require.Empty(t, SplitCodingInterval(&model.CodingInterval{StartedAt: start, EndedAt: start.Add(20 * time.Second)}))
+}
@@ -0,0 +1,113 @@
+package monitor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/log"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+	"code-platform/service/scheduler"
+	"code-platform/storage"
+
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// archiveTimeout 单个工作区归档并上传的最长时间
+const archiveTimeout = 10 * time.Minute
+
+// FreezeLabs 冻结已截止实验的工作区，归档上传至 submissions 桶并记录到 lab_submit；
+// 未能全部归档的实验留待下一次执行重试
+func FreezeLabs(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) error {
+	labIDs, err := model.QueryLabIDsToArchive(ctx, st.RDB, time.Now())
+	if err != nil {
+		logger.Error(err, "QueryLabIDsToArchive failed")
+		return err
+	}
+	for _, labID := range labIDs {
+		// 归档耗时较长，每个实验开始前确认仍是持有者
+		if err := scheduler.CheckFencing(ctx); err != nil {
+			return err
+		}
+		freezeLab(ctx, st, logger, ideClient, labID)
+	}
+	return nil
+}
+
+// freezeLab 逐个归档尚未冻结的学生工作区，全部成功后标记实验已冻结；失败的原因记录在该学生的 lab_submit 中，
+// 留待下一轮重试，已冻结但未上传的工作区重试时归档同一冻结副本，得到相同的对象
+func freezeLab(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient, labID uint64) {
+	labSubmits, err := model.QueryUnarchivedLabSubmitsByLabID(ctx, st.RDB, labID)
+	if err != nil {
+		logger.Errorf(err, "QueryUnarchivedLabSubmitsByLabID by labID[%d] failed", labID)
+		return
+	}
+
+	var failed bool
+	for _, labSubmit := range labSubmits {
+		object, hash, err := archiveWorkspace(ctx, st, ideClient, labID, labSubmit.UserID)
+		if err == nil {
+			err = model.UpdateLabSubmitArchive(ctx, st.RDB, labSubmit.ID, object, hash, time.Now())
+		}
+		if err != nil {
+			logger.Errorf(err, "archive workspace of labID[%d] and studentID[%d] failed", labID, labSubmit.UserID)
+			failed = true
+			if err := model.UpdateLabSubmitArchiveError(ctx, st.RDB, labSubmit.ID, err.Error()); err != nil {
+				logger.Errorf(err, "update archive_error of lab_submit[%d] failed", labSubmit.ID)
+			}
+		}
+	}
+	if failed {
+		return
+	}
+
+	if err := model.UpdateLabArchivedAt(ctx, st.RDB, labID, time.Now()); err != nil {
+		logger.Errorf(err, "update archived_at of lab[%d] failed", labID)
+	}
+}
+
+// archiveWorkspace 将 IDE 服务返回的归档流式上传至 MinIO，学生没有工作区时返回空对象名
+func archiveWorkspace(ctx context.Context, st *storage.Storage, ideClient pb.IDEServerServiceClient, labID, studentID uint64) (object, hash string, err error) {
+	ctx, cancel := context.WithTimeout(ctx, archiveTimeout)
+	defer cancel()
+
+	stream, err := ideClient.ArchiveWorkspace(ctx, &pb.ArchiveWorkspaceRequest{LabId: labID, StudentId: studentID})
+	if err != nil {
+		return "", "", err
+	}
+	reader, err := define.NewArchiveReader(stream)
+	switch {
+	case err == nil:
+	case status.Code(err) == codes.NotFound:
+		return "", "", nil
+	default:
+		return "", "", err
+	}
+
+	object = define.GetArchiveObjectName(labID, studentID)
+	bucketName := st.Minio.SubmissionBucketName()
+	hasher := sha256.New()
+	if _, err := st.Minio.PutObject(ctx, bucketName, object, io.TeeReader(reader, hasher), -1, minio.PutObjectOptions{
+		ContentType: "application/gzip",
+		// 归档大小未知，限制分块大小以免一次缓冲过多内存
+		PartSize: 16 << 20,
+	}); err != nil {
+		return "", "", err
+	}
+
+	hash = hex.EncodeToString(hasher.Sum(nil))
+	if summary := reader.Summary(); summary == nil || summary.Sha256 != hash {
+		if err := st.Minio.RemoveObject(ctx, bucketName, object, minio.RemoveObjectOptions{}); err != nil {
+			return "", "", err
+		}
+		return "", "", fmt.Errorf("sha256 of uploaded archive %q mismatched", object)
+	}
+	return object, hash, nil
+}
@@ -1,279 +1,260 @@
 package monitor
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
-	"fmt"
-	"regexp"
-	"strconv"
 	"time"
 
 	"code-platform/api/grpc/ide/pb"
 	"code-platform/log"
 	"code-platform/monitor"
-	"code-platform/pkg/errorx"
-	"code-platform/pkg/parallelx"
 	"code-platform/pkg/rediskey"
 	"code-platform/pkg/slicex"
-	"code-platform/pkg/timex"
 	"code-platform/pkg/transactionx"
 	"code-platform/repository/rdb/model"
 	"code-platform/service/ide/define"
+	"code-platform/service/scheduler"
 	"code-platform/storage"
 
 	"google.golang.org/protobuf/proto"
 )
 
-var heartBeatTagRegexp = regexp.MustCompile("^" + define.HeartBeatTagFormatPrefixForStudent + `(\d+):(\d+)$`)
+// sweepBatchSize 每次从有序集合中取出的成员数
+const sweepBatchSize = 500
 
-func HeartBeatSweaping(storage *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) {
-	parentCtx := context.TODO()
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				err := fmt.Errorf("%v", r)
-				logger.Errorf(err, "heart beat sweaper panic")
-			}
-			// 5分钟后恢复重试
-			time.Sleep(time.Minute * 5)
-			HeartBeatSweaping(storage, logger, ideClient)
-		}()
-
-		for {
-			// 定义最大超时时间200秒
-			startTime := time.Now()
-			ctx, cancel := context.WithTimeout(parentCtx, 200*time.Second)
-			resp, err := ideClient.GetContainerNames(ctx, &pb.Empty{})
-			if err != nil {
-				logger.Error(err, "GetContainerNames failed")
-				cancel()
-				break
-				// 等待下一次再重试
-			}
-			keys := make([]interface{}, len(resp.Infos))
-			containerNames := make([]string, len(resp.Infos))
-			labIDs := make([]uint64, len(resp.Infos))
-			for index, info := range resp.Infos {
-				// not teacher
-				if info.TeacherInfo == nil {
-					keys[index] = fmt.Sprintf(define.HeartBeatTagFormatForStudent, info.LabId, info.StudentId)
-					containerNames[index] = define.GetContainerNameForStudent(info.LabId, info.StudentId)
-					labIDs[index] = info.LabId
-				} else {
-					keys[index] = fmt.Sprintf(define.HeartBeatTagFormatForTeacher, info.LabId, info.StudentId, info.TeacherInfo.TeacherId)
-					containerNames[index] = define.GetContainerNameForTeacher(info.LabId, info.StudentId, info.TeacherInfo.TeacherId)
-				}
-			}
-			// 检查key与清扫容器任务
-			if len(keys) != 0 {
-				newSweaterTask(ctx, storage, logger, ideClient, keys, containerNames, slicex.DistinctUint64Slice(labIDs))
-			}
-			cancel()
-			// 间隔周期为300秒
-			monitor.IDESweaterCollector.Set(float64(time.Since(startTime) / time.Microsecond))
-			time.Sleep(time.Second * 300)
-		}
+// SweepHeartBeats 从心跳有序集合中取出已过期与实验已截止的成员并关闭对应容器，耗时只与过期的成员数有关
+func SweepHeartBeats(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) error {
+	startTime := time.Now()
+	defer func() {
+		monitor.IDESweaterCollector.Set(float64(time.Since(startTime) / time.Microsecond))
 	}()
-}
 
-func calculateCodingTime(createdAt, endAt time.Time, labID, studentID uint64) []*model.CodingTime {
-	createdAt = createdAt.In(timex.ShanghaiLocation)
-	endAt = endAt.In(timex.ShanghaiLocation)
-	var codingTimes []*model.CodingTime
-	if endAt.Day() == createdAt.Day() {
-		codingTimes = append(codingTimes, &model.CodingTime{
-			LabID:         labID,
-			UserID:        studentID,
-			Duration:      uint32(endAt.Sub(createdAt).Minutes()),
-			CreatedAt:     createdAt,
-			CreatedAtDate: timex.StartOfDay(createdAt),
-		})
-	} else {
-		// 不在同一天
-		// createdAt 到 当天晚上十二点
-		codingTimes = append(codingTimes, &model.CodingTime{
-			LabID:         labID,
-			UserID:        studentID,
-			Duration:      uint32(timex.EndOfDay(createdAt).Sub(createdAt).Minutes()),
-			CreatedAt:     createdAt,
-			CreatedAtDate: timex.StartOfDay(createdAt),
-		})
+	if err := sweepExpiredHeartBeats(ctx, st, logger, ideClient); err != nil {
+		return err
+	}
+	return sweepDeadlineHeartBeats(ctx, st, logger, ideClient)
+}
 
-		for t := createdAt; t.Day() != endAt.Day(); t = t.Add(24 * time.Hour) {
-			c := timex.StartOfDay(t)
-			codingTimes = append(codingTimes, &model.CodingTime{
-				LabID:         labID,
-				UserID:        studentID,
-				Duration:      24 * 60,
-				CreatedAt:     c,
-				CreatedAtDate: c,
-			})
+// sweepExpiredHeartBeats 分批处理最后访问时间早于 HeartBeatDuration 之前的成员，每批处理后成员被移除或更新分数
+func sweepExpiredHeartBeats(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) error {
+	expiryKey := rediskey.Newkey(define.HeartBeatExpiryTag).Pool(st.Pool())
+	expiredBefore := time.Now().Add(-define.HeartBeatDuration)
+	for {
+		members, err := expiryKey.ZRangeByScore(ctx, 0, int(expiredBefore.Unix()), false, 0, sweepBatchSize)
+		if err != nil {
+			logger.Errorf(err, "zrangebyscore for key %q failed", expiryKey.String())
+			return err
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		if err := sweepExpiredBatch(ctx, st, logger, ideClient, members, expiredBefore); err != nil {
+			return err
+		}
+		if len(members) < sweepBatchSize {
+			return nil
 		}
-
-		// 当日凌晨 12 点到 endAt
-		s := timex.StartOfDay(endAt)
-		codingTimes = append(codingTimes, &model.CodingTime{
-			LabID:         labID,
-			UserID:        studentID,
-			Duration:      uint32(endAt.Sub(s).Minutes()),
-			CreatedAt:     s,
-			CreatedAtDate: s,
-		})
 	}
-	return codingTimes
 }
 
-func newSweaterTask(
+func sweepExpiredBatch(
 	ctx context.Context,
 	st *storage.Storage,
 	logger *log.Logger,
 	ideClient pb.IDEServerServiceClient,
-	keys []interface{},
-	containerNames []string,
-	labIDs []uint64,
-) {
-	var (
-		resp             [][]byte
-		labIsDeadlineMap map[uint64]time.Time
-	)
-	tasks := []func() error{
-		func() (err error) {
-			key := rediskey.NewEmptyKey().Pool(st.Pool())
-			resp, err = key.MGet(ctx, keys...)
-			switch err {
-			case nil:
-			case context.Canceled:
-				logger.Debug("MGet keys is canceled")
-				return err
-			default:
-				logger.Errorf(err, "mget keys %v failed", keys)
-				return errorx.InternalErr(err)
-			}
-			return nil
-		},
-		func() (err error) {
-			labIsDeadlineMap, err = model.QueryLabIDToDeadlineMapAfterDeadline(ctx, st.RDB, labIDs)
-			switch err {
-			case nil:
-			case context.Canceled:
-				logger.Debug("QueryLabIsDeadlineMap is canceled")
-				return err
-			default:
-				logger.Errorf(err, "QueryLabIsDeadlineMap by labIDs %v failed", labIDs)
-				return errorx.InternalErr(err)
-			}
-			return nil
-		},
-	}
-	if err := parallelx.Do(logger, tasks...); err != nil {
-		return
+	rawMembers []string,
+	expiredBefore time.Time,
+) error {
+	members, membersToRem := parseHeartBeatMembers(logger, rawMembers)
+	stats, err := getHeartBeatStats(ctx, st, logger, members)
+	if err != nil {
+		return err
 	}
 
-	containersNeedToStop := make([]string, 0, len(containerNames))
-	codingTimes := make([]*model.CodingTime, 0, len(containerNames))
-	keysNeedToDel := make([]interface{}, 0, len(containerNames))
-
-	ignoreMap := make(map[int]struct{})
-	for index, v := range resp {
-		// key 已被删除
-		if len(v) == 0 {
-			ignoreMap[index] = struct{}{}
-			containersNeedToStop = append(containersNeedToStop, containerNames[index])
+	containersNeedToStop := make([]string, 0, len(members))
+	containersNeedToHibernate := make([]string, 0, len(members))
+	codingIntervals := make([]*model.CodingInterval, 0, len(members))
+	keysNeedToDel := make([]interface{}, 0, len(members))
+	refreshedScoreMembers := make([]interface{}, 0)
+	for index, member := range members {
+		stat := stats[index]
+		switch {
+		case stat == nil:
+			// 教师 key 到期自动删除，容器仍需关闭；学生的容器已被休眠或关闭
+			if member.IsTeacher() {
+				containersNeedToStop = append(containersNeedToStop, member.ContainerName())
+			}
+		case stat.LastVisitedAt > expiredBefore.Unix():
+			// 心跳已续期但分数未更新
+			refreshedScoreMembers = append(refreshedScoreMembers, stat.LastVisitedAt, member.String())
 			continue
+		case member.IsTeacher():
+			containersNeedToStop = append(containersNeedToStop, member.ContainerName())
+			keysNeedToDel = append(keysNeedToDel, member.Key())
+		default:
+			if interval := CloseCodingInterval(stat, member.LabID, member.StudentID, time.Unix(stat.LastVisitedAt, 0)); interval != nil {
+				codingIntervals = append(codingIntervals, interval)
+			}
+			// 空闲容器休眠而非销毁，下次打开时恢复
+			containersNeedToHibernate = append(containersNeedToHibernate, member.ContainerName())
+			keysNeedToDel = append(keysNeedToDel, member.Key())
 		}
-		// 教师key，无法 unmarshal，故直接忽略
-		if bytes.Equal(v, []byte("0")) {
-			ignoreMap[index] = struct{}{}
-			continue
+		membersToRem = append(membersToRem, member.String())
+	}
+
+	if len(refreshedScoreMembers) != 0 {
+		expiryKey := rediskey.Newkey(define.HeartBeatExpiryTag).Pool(st.Pool())
+		if _, err := expiryKey.ZAdd(ctx, refreshedScoreMembers...); err != nil {
+			logger.Errorf(err, "zadd %v to key %q failed", refreshedScoreMembers, expiryKey.String())
+			return err
 		}
+	}
+	return closeHeartBeats(ctx, st, logger, ideClient, codingIntervals, containersNeedToStop, containersNeedToHibernate, keysNeedToDel, membersToRem, membersToRem)
+}
 
-		key := keys[index].(string)
-		IDs := heartBeatTagRegexp.FindStringSubmatch(key)
-		labID, err := strconv.ParseUint(IDs[1], 10, 64)
-		// 避免后续数据不一致性，先直接退出返回
+// sweepDeadlineHeartBeats 分批处理打开时记录的实验截止时间已到的成员，截止时间被推迟的重新记录
+func sweepDeadlineHeartBeats(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) error {
+	deadlineKey := rediskey.Newkey(define.HeartBeatDeadlineTag).Pool(st.Pool())
+	now := time.Now()
+	for {
+		members, err := deadlineKey.ZRangeByScore(ctx, 0, int(now.Unix()), false, 0, sweepBatchSize)
 		if err != nil {
-			logger.Errorf(err, "parse uint for %v failed", IDs[1])
-			return
+			logger.Errorf(err, "zrangebyscore for key %q failed", deadlineKey.String())
+			return err
 		}
-
-		deadline, ok := labIsDeadlineMap[labID]
-		if !ok {
-			continue
+		if len(members) == 0 {
+			return nil
 		}
-
-		var stat pb.HeartBeatStat
-		if err := proto.Unmarshal(v, &stat); err != nil {
-			logger.Errorf(err, "proto unmarshal data %v for heartbeatstat failed", v)
-			return
+		if err := sweepDeadlineBatch(ctx, st, logger, ideClient, members); err != nil {
+			return err
 		}
-		createdAt := time.Unix(stat.CreatedAt, 0)
-		if deadline.Before(createdAt) {
-			continue
+		if len(members) < sweepBatchSize {
+			return nil
 		}
+	}
+}
 
-		// 实验过期但IDE仍存在，则可认为该容器应被销毁
-		containersNeedToStop = append(containersNeedToStop, containerNames[index])
-		ignoreMap[index] = struct{}{}
-
-		keysNeedToDel = append(keysNeedToDel, keys[index])
+func sweepDeadlineBatch(
+	ctx context.Context,
+	st *storage.Storage,
+	logger *log.Logger,
+	ideClient pb.IDEServerServiceClient,
+	rawMembers []string,
+) error {
+	members, membersToRem := parseHeartBeatMembers(logger, rawMembers)
+	labIDs := make([]uint64, len(members))
+	for index, member := range members {
+		labIDs[index] = member.LabID
+	}
+	labIDs = slicex.DistinctUint64Slice(labIDs)
 
-		// 处理 coding_time 数据
-		if duration := uint32(deadline.Sub(createdAt).Minutes()); duration != 0 {
-			studentID, err := strconv.ParseUint(IDs[2], 10, 64)
-			if err != nil {
-				logger.Errorf(err, "parse uint for %v failed", IDs[2])
-				return
-			}
-			codingTimes = append(codingTimes, calculateCodingTime(createdAt, deadline, labID, studentID)...)
+	labIDToDeadline, err := model.QueryLabIDToDeadlineMapAfterDeadline(ctx, st.RDB, labIDs)
+	if err != nil {
+		logger.Errorf(err, "QueryLabIDToDeadlineMapAfterDeadline by labIDs %v failed", labIDs)
+		return err
+	}
+	// 截止时间被推迟、取消或实验已删除
+	postponedLabIDs := make([]uint64, 0)
+	for _, labID := range labIDs {
+		if _, ok := labIDToDeadline[labID]; !ok {
+			postponedLabIDs = append(postponedLabIDs, labID)
 		}
 	}
+	postponedLabs, err := model.QueryLabMapsByIDs(ctx, st.RDB, postponedLabIDs)
+	if err != nil {
+		logger.Errorf(err, "QueryLabMapsByIDs by labIDs %v failed", postponedLabIDs)
+		return err
+	}
 
-	for index, v := range resp {
-		if _, ok := ignoreMap[index]; ok {
+	stats, err := getHeartBeatStats(ctx, st, logger, members)
+	if err != nil {
+		return err
+	}
+
+	containersNeedToStop := make([]string, 0, len(members))
+	codingIntervals := make([]*model.CodingInterval, 0, len(members))
+	keysNeedToDel := make([]interface{}, 0, len(members))
+	expiryMembersToRem := make([]interface{}, 0, len(members))
+	rescheduledScoreMembers := make([]interface{}, 0)
+	for index, member := range members {
+		stat := stats[index]
+		deadline, ok := labIDToDeadline[member.LabID]
+		if !ok {
+			if lab, ok := postponedLabs[member.LabID]; ok && lab.DeadLine.Valid && stat != nil {
+				rescheduledScoreMembers = append(rescheduledScoreMembers, lab.DeadLine.Time.Unix(), member.String())
+				continue
+			}
+			membersToRem = append(membersToRem, member.String())
 			continue
 		}
-		var stat pb.HeartBeatStat
-		if err := proto.Unmarshal(v, &stat); err != nil {
-			logger.Errorf(err, "proto unmarshal data %v for heartbeatstat failed", v)
+		membersToRem = append(membersToRem, member.String())
+		if member.IsTeacher() || stat == nil || deadline.Before(time.Unix(stat.CreatedAt, 0)) {
 			continue
 		}
-		if time.Since(time.Unix(stat.LastVisitedAt, 0)) > define.HeartBeatDuration {
-			createdAt := time.Unix(stat.CreatedAt, 0)
-			lastVisitedAt := time.Unix(stat.LastVisitedAt, 0)
-			if duration := uint32(lastVisitedAt.Sub(createdAt).Minutes()); duration != 0 {
-				key := keys[index].(string)
-				IDs := heartBeatTagRegexp.FindStringSubmatch(key)
-				labID, err := strconv.ParseUint(IDs[1], 10, 64)
-				if err != nil {
-					logger.Errorf(err, "parse uint for %v failed", IDs[1])
-					continue
-				}
-				studentID, err := strconv.ParseUint(IDs[2], 10, 64)
-				if err != nil {
-					logger.Errorf(err, "parse uint for %v failed", IDs[2])
-					continue
-				}
-				codingTimes = append(codingTimes, calculateCodingTime(createdAt, lastVisitedAt, labID, studentID)...)
-			}
-			containersNeedToStop = append(containersNeedToStop, containerNames[index])
-			keysNeedToDel = append(keysNeedToDel, keys[index])
+
+		// 实验过期但IDE仍存在，则可认为该容器应被销毁
+		containersNeedToStop = append(containersNeedToStop, member.ContainerName())
+		keysNeedToDel = append(keysNeedToDel, member.Key())
+		expiryMembersToRem = append(expiryMembersToRem, member.String())
+		// 进行中的活跃区间截止到实验结束
+		if interval := CloseCodingInterval(stat, member.LabID, member.StudentID, deadline); interval != nil {
+			codingIntervals = append(codingIntervals, interval)
 		}
 	}
 
-	if len(containersNeedToStop) == 0 {
-		return
+	if len(rescheduledScoreMembers) != 0 {
+		deadlineKey := rediskey.Newkey(define.HeartBeatDeadlineTag).Pool(st.Pool())
+		if _, err := deadlineKey.ZAdd(ctx, rescheduledScoreMembers...); err != nil {
+			logger.Errorf(err, "zadd %v to key %q failed", rescheduledScoreMembers, deadlineKey.String())
+			return err
+		}
+	}
+	return closeHeartBeats(ctx, st, logger, ideClient, codingIntervals, containersNeedToStop, nil, keysNeedToDel, expiryMembersToRem, membersToRem)
+}
+
+// closeHeartBeats 记录编码时间、关闭或休眠容器并从两个有序集合中移除成员，任一步失败时回滚编码时间，成员留待下一轮处理
+func closeHeartBeats(
+	ctx context.Context,
+	st *storage.Storage,
+	logger *log.Logger,
+	ideClient pb.IDEServerServiceClient,
+	codingIntervals []*model.CodingInterval,
+	containersNeedToStop []string,
+	containersNeedToHibernate []string,
+	keysNeedToDel []interface{},
+	expiryMembersToRem []interface{},
+	deadlineMembersToRem []interface{},
+) error {
+	if len(expiryMembersToRem) == 0 && len(deadlineMembersToRem) == 0 {
+		return nil
 	}
 
-	transactionx.DoTransaction(ctx, st, logger, func(ctx context.Context, tx storage.RDBClient) (err error) {
+	return transactionx.DoTransaction(ctx, st, logger, func(ctx context.Context, tx storage.RDBClient) (err error) {
+		// 暂停后恢复的旧持有者不再写入，以免与新持有者重复记录编码时间
+		if err := scheduler.CheckFencing(ctx); err != nil {
+			return err
+		}
+		if err := model.BatchInsertCodingIntervals(ctx, tx, codingIntervals); err != nil {
+			logger.Errorf(err, "batch insert coding_interval %+v failed", codingIntervals)
+			return err
+		}
+		codingTimes := SplitCodingIntervals(codingIntervals)
 		if err := model.BatchInsertCodingTimes(ctx, tx, codingTimes); err != nil {
 			logger.Errorf(err, "batch insert coding_time %+v failed", codingTimes)
 			return err
 		}
 		// 关闭指定docker容器
-		if _, err = ideClient.RemoveContainer(ctx, &pb.RemoveContainerRequest{ContainerNames: containersNeedToStop}); err != nil {
-			logger.Errorf(err, "remove container %v failed", containersNeedToStop)
-			return err
+		if len(containersNeedToStop) != 0 {
+			if _, err = ideClient.RemoveContainer(ctx, &pb.RemoveContainerRequest{ContainerNames: containersNeedToStop}); err != nil {
+				logger.Errorf(err, "remove container %v failed", containersNeedToStop)
+				return err
+			}
+		}
+		if len(containersNeedToHibernate) != 0 {
+			if _, err = ideClient.HibernateContainer(ctx, &pb.HibernateContainerRequest{ContainerNames: containersNeedToHibernate}); err != nil {
+				logger.Errorf(err, "hibernate container %v failed", containersNeedToHibernate)
+				return err
+			}
 		}
 		if len(keysNeedToDel) != 0 {
 			emptyKey := rediskey.NewEmptyKey().Pool(st.Pool())
@@ -282,8 +263,169 @@ func newSweaterTask(
 				return err
 			}
 		}
+		for tag, membersToRem := range map[string][]interface{}{
+			define.HeartBeatExpiryTag:   expiryMembersToRem,
+			define.HeartBeatDeadlineTag: deadlineMembersToRem,
+		} {
+			if len(membersToRem) == 0 {
+				continue
+			}
+			key := rediskey.Newkey(tag).Pool(st.Pool())
+			if _, err := key.ZRem(ctx, membersToRem...); err != nil {
+				logger.Errorf(err, "zrem %v from key %q failed", membersToRem, key.String())
+				return err
+			}
+		}
 		return nil
 	},
 		&sql.TxOptions{Isolation: sql.LevelReadCommitted},
 	)
 }
+
+// ReconcileHeartBeats 与 IDE 服务上的容器对账，为运行中但不在有序集合中的容器补充成员，已有的成员不受影响；
+// 心跳 key 已不存在的容器直接关闭
+func ReconcileHeartBeats(ctx context.Context, st *storage.Storage, logger *log.Logger, ideClient pb.IDEServerServiceClient) error {
+	resp, err := ideClient.GetContainerNames(ctx, &pb.Empty{})
+	if err != nil {
+		logger.Error(err, "GetContainerNames failed")
+		return err
+	}
+	if len(resp.Infos) == 0 {
+		return nil
+	}
+
+	members := make([]define.HeartBeatMember, len(resp.Infos))
+	for index, info := range resp.Infos {
+		if info.TeacherInfo == nil {
+			members[index] = define.NewHeartBeatMemberForStudent(info.LabId, info.StudentId)
+		} else {
+			members[index] = define.NewHeartBeatMemberForTeacher(info.LabId, info.StudentId, info.TeacherInfo.TeacherId)
+		}
+	}
+	stats, err := getHeartBeatStats(ctx, st, logger, members)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	containersNeedToStop := make([]string, 0)
+	expiryScoreMembers := []interface{}{"NX"}
+	labIDs := make([]uint64, 0, len(members))
+	for index, member := range members {
+		stat := stats[index]
+		if stat == nil {
+			containersNeedToStop = append(containersNeedToStop, member.ContainerName())
+			continue
+		}
+		lastVisitedAt := stat.LastVisitedAt
+		if lastVisitedAt == 0 {
+			lastVisitedAt = now.Unix()
+		}
+		expiryScoreMembers = append(expiryScoreMembers, lastVisitedAt, member.String())
+		if !member.IsTeacher() {
+			labIDs = append(labIDs, member.LabID)
+		}
+	}
+
+	if len(expiryScoreMembers) > 1 {
+		expiryKey := rediskey.Newkey(define.HeartBeatExpiryTag).Pool(st.Pool())
+		if _, err := expiryKey.ZAdd(ctx, expiryScoreMembers...); err != nil {
+			logger.Errorf(err, "zadd nx to key %q failed", expiryKey.String())
+			return err
+		}
+	}
+
+	labIDs = slicex.DistinctUint64Slice(labIDs)
+	labs, err := model.QueryLabMapsByIDs(ctx, st.RDB, labIDs)
+	if err != nil {
+		logger.Errorf(err, "QueryLabMapsByIDs by labIDs %v failed", labIDs)
+		return err
+	}
+	deadlineScoreMembers := []interface{}{"NX"}
+	for index, member := range members {
+		stat := stats[index]
+		if member.IsTeacher() || stat == nil {
+			continue
+		}
+		lab, ok := labs[member.LabID]
+		if !ok || !lab.DeadLine.Valid || lab.DeadLine.Time.Before(time.Unix(stat.CreatedAt, 0)) {
+			continue
+		}
+		deadlineScoreMembers = append(deadlineScoreMembers, lab.DeadLine.Time.Unix(), member.String())
+	}
+	if len(deadlineScoreMembers) > 1 {
+		deadlineKey := rediskey.Newkey(define.HeartBeatDeadlineTag).Pool(st.Pool())
+		if _, err := deadlineKey.ZAdd(ctx, deadlineScoreMembers...); err != nil {
+			logger.Errorf(err, "zadd nx to key %q failed", deadlineKey.String())
+			return err
+		}
+	}
+
+	if len(containersNeedToStop) != 0 {
+		if err := scheduler.CheckFencing(ctx); err != nil {
+			return err
+		}
+		if _, err := ideClient.RemoveContainer(ctx, &pb.RemoveContainerRequest{ContainerNames: containersNeedToStop}); err != nil {
+			logger.Errorf(err, "remove container %v failed", containersNeedToStop)
+			return err
+		}
+	}
+	return nil
+}
+
+// parseHeartBeatMembers 无法解析的成员直接放入待移除列表
+func parseHeartBeatMembers(logger *log.Logger, rawMembers []string) (members []define.HeartBeatMember, membersToRem []interface{}) {
+	members = make([]define.HeartBeatMember, 0, len(rawMembers))
+	membersToRem = make([]interface{}, 0, len(rawMembers))
+	for _, rawMember := range rawMembers {
+		member, err := define.ParseHeartBeatMember(rawMember)
+		if err != nil {
+			logger.Errorf(err, "parse heart beat member %q failed", rawMember)
+			membersToRem = append(membersToRem, rawMember)
+			continue
+		}
+		members = append(members, member)
+	}
+	return members, membersToRem
+}
+
+// getHeartBeatStats 按成员顺序返回心跳状态，key 不存在或无法解析时为 nil
+func getHeartBeatStats(ctx context.Context, st *storage.Storage, logger *log.Logger, members []define.HeartBeatMember) ([]*pb.HeartBeatStat, error) {
+	stats := make([]*pb.HeartBeatStat, len(members))
+	if len(members) == 0 {
+		return stats, nil
+	}
+	keys := make([]interface{}, len(members))
+	for index, member := range members {
+		keys[index] = member.Key()
+	}
+
+	values, err := rediskey.NewEmptyKey().Pool(st.Pool()).MGet(ctx, keys...)
+	switch err {
+	case nil:
+	case context.Canceled:
+		logger.Debug("MGet keys is canceled")
+		return nil, err
+	default:
+		logger.Errorf(err, "mget keys %v failed", keys)
+		return nil, err
+	}
+
+	for index, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		var stat pb.HeartBeatStat
+		if err := proto.Unmarshal(value, &stat); err != nil {
+			// 升级前教师 key 的值为 "0"，视为无访问记录；无法解析的学生心跳视为不存在
+			if members[index].IsTeacher() {
+				stats[index] = &pb.HeartBeatStat{}
+				continue
+			}
+			logger.Errorf(err, "proto unmarshal data %v for heartbeatstat failed", value)
+			continue
+		}
+		stats[index] = &stat
+	}
+	return stats, nil
+}
@@ -0,0 +1,126 @@
+package ide
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/randx"
+	"code-platform/pkg/rediskey"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+// CreateTerminalTicket 浏览器无法为 WebSocket 握手设置请求头，学生先以常规请求换取短期一次性票据，握手时放在查询参数中，
+// 避免长期有效的访问令牌出现在 URL 与访问日志中
+func (i *IDEService) CreateTerminalTicket(ctx context.Context, labID, studentID uint64) (string, error) {
+	if _, _, err := i.queryTerminalLab(ctx, labID); err != nil {
+		return "", err
+	}
+
+	ticket, err := randx.NewRandCode(32)
+	if err != nil {
+		i.Logger.Error(err, "generate terminal ticket failed")
+		return "", errorx.InternalErr(err)
+	}
+	key := rediskey.NewkeyFormat(define.TerminalTicketTagFormat, ticket).Pool(i.Dao.Storage.Pool())
+	if _, err := key.SetEX(ctx, fmt.Sprintf("%d:%d", labID, studentID), int(define.TerminalTicketDuration/time.Second)); err != nil {
+		i.Logger.Errorf(err, "set ex for key %q failed", key.String())
+		return "", errorx.InternalErr(err)
+	}
+	return ticket, nil
+}
+
+// RedeemTerminalTicket 使用票据，票据不存在、已过期或已被使用时返回 ErrFailToAuth
+func (i *IDEService) RedeemTerminalTicket(ctx context.Context, ticket string) (labID, studentID uint64, err error) {
+	if ticket == "" {
+		return 0, 0, errorx.ErrFailToAuth
+	}
+	key := rediskey.NewkeyFormat(define.TerminalTicketTagFormat, ticket).Pool(i.Dao.Storage.Pool())
+	value, err := key.GetDel(ctx)
+	switch err {
+	case nil:
+	case redigo.ErrNil:
+		i.Logger.Debug("terminal ticket is not found")
+		return 0, 0, errorx.ErrFailToAuth
+	default:
+		i.Logger.Errorf(err, "getdel for key %q failed", key.String())
+		return 0, 0, errorx.InternalErr(err)
+	}
+	if _, err := fmt.Sscanf(value, "%d:%d", &labID, &studentID); err != nil {
+		i.Logger.Errorf(err, "parse terminal ticket value %q failed", value)
+		return 0, 0, errorx.InternalErr(err)
+	}
+	return labID, studentID, nil
+}
+
+// OpenTerminal 打开终端模式 IDE，返回的流已发送打开参数与初始窗口大小，调用方继续在流上转发输入并接收输出，
+// 流随 ctx 取消而关闭；心跳与 OpenIDE 一致。容器在此时创建，调用方应在 WebSocket 握手成功后再调用
+func (i *IDEService) OpenTerminal(ctx context.Context, labID, studentID uint64, size *pb.TerminalSize) (pb.IDEServerService_AttachTerminalClient, error) {
+	lab, course, err := i.queryTerminalLab(ctx, labID)
+	if err != nil {
+		return nil, err
+	}
+
+	canEdit := !lab.DeadLine.Valid || time.Since(lab.DeadLine.Time) <= 0
+
+	stream, err := i.IDEClient.AttachTerminal(ctx)
+	if err != nil {
+		i.Logger.Errorf(err, "attach terminal failed with labID[%d] and studentID[%d]", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
+	if err := stream.Send(&pb.TerminalInput{
+		Open: &pb.GetIDEForStudentRequest{
+			LabId:     labID,
+			StudentId: studentID,
+			Language:  uint32(course.Language),
+			CanEdit:   canEdit,
+		},
+		Resize: size,
+	}); err != nil {
+		i.Logger.Errorf(err, "open terminal failed with labID[%d] and studentID[%d]", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	// 容器已存在时心跳 key 同样存在，set nx 不会覆盖
+	if err := i.HeartBeatWhenStartingForStudent(ctx, labID, studentID, lab.DeadLine); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// queryTerminalLab 实验所属课程须使用终端模式 IDE
+func (i *IDEService) queryTerminalLab(ctx context.Context, labID uint64) (*model.Lab, *model.Course, error) {
+	lab, err := model.QueryLabByID(ctx, i.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("lab is not found by id[%d]", labID)
+		return nil, nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query lab by id[%d] failed", labID)
+		return nil, nil, errorx.InternalErr(err)
+	}
+
+	course, err := model.QueryCourseByID(ctx, i.Dao.Storage.RDB, lab.CourseID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		i.Logger.Debugf("Query Course By ID[%d] failed", lab.CourseID)
+		return nil, nil, errorx.ErrIsNotFound
+	default:
+		i.Logger.Errorf(err, "Query course by id[%d] failed", lab.CourseID)
+		return nil, nil, errorx.InternalErr(err)
+	}
+
+	if course.IDEKind != define.IDEKindTerminal {
+		i.Logger.Debugf("course[%d] uses ide kind[%d] instead of terminal", course.ID, course.IDEKind)
+		return nil, nil, errorx.ErrWrongIDEKind
+	}
+	return lab, course, nil
+}
@@ -0,0 +1,87 @@
+package ide_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenTerminal(t *testing.T) {
+	testStorage, ideService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "user", "course", "lab")
+	testx.MustFlushDB(ctx, testStorage.Pool())
+	now := time.Now()
+
+	user := &model.User{Role: 0, Number: "1", CreatedAt: now, UpdatedAt: now}
+	err := user.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	courses := []*model.Course{
+		{Language: 0, IDEKind: define.IDEKindTerminal, CreatedAt: now, UpdatedAt: now},
+		{Language: 0, IDEKind: define.IDEKindTheia, CreatedAt: now, UpdatedAt: now},
+	}
+	err = model.BatchInsertCourses(ctx, testStorage.RDB, courses)
+	require.NoError(t, err)
+
+	labs := []*model.Lab{
+		{CourseID: 1, CreatedAt: now, UpdatedAt: now},
+		{CourseID: 2, CreatedAt: now, UpdatedAt: now},
+	}
+	err = model.BatchInsertLabs(ctx, testStorage.RDB, labs)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	_, err = ideService.OpenTerminal(ctx, 0, user.ID, nil)
+	require.Equal(t, errorx.ErrIsNotFound, err)
+
+	_, err = ideService.OpenTerminal(ctx, 2, user.ID, nil)
+	require.Equal(t, errorx.ErrWrongIDEKind, err)
+
+	_, _, _, err = ideService.OpenIDE(ctx, 1, user.ID)
+	require.Equal(t, errorx.ErrWrongIDEKind, err)
+
+	_, err = ideService.CreateTerminalTicket(ctx, 2, user.ID)
+	require.Equal(t, errorx.ErrWrongIDEKind, err)
+	ticket, err := ideService.CreateTerminalTicket(ctx, 1, user.ID)
+	require.NoError(t, err)
+	labID, studentID, err := ideService.RedeemTerminalTicket(ctx, ticket)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), labID)
+	require.Equal(t, user.ID, studentID)
+	// 票据只能使用一次
+	_, _, err = ideService.RedeemTerminalTicket(ctx, ticket)
+	require.Equal(t, errorx.ErrFailToAuth, err)
+
+	stream, err := ideService.OpenTerminal(ctx, 1, user.ID, &pb.TerminalSize{Cols: 80, Rows: 24})
+	require.NoError(t, err)
+
+	err = stream.Send(&pb.TerminalInput{Data: []byte("echo terminal-ok\nexit\n")})
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	for {
+		out, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		output.Write(out.Data)
+	}
+	require.Contains(t, output.String(), "terminal-ok")
+
+	_, err = ideService.IDEClient.StopAllIDE(context.Background(), &pb.Empty{})
+	require.NoError(t, err)
+}
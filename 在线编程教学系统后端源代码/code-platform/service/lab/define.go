@@ -1,8 +1,10 @@
 package lab
 
 import (
+	"io"
 	"time"
 
+	"code-platform/pkg/junitx"
 	"code-platform/service/define"
 )
 
@@ -12,19 +14,21 @@ type (
 )
 
 type LabInfo struct {
-	DeadLine      time.Time `json:"dead_line"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	ReportURL     string    `json:"report_url"`
-	Content       string    `json:"content"`
-	Title         string    `json:"title"`
-	CourseName    string    `json:"course_name"`
-	AttachmentURL string    `json:"attachment_url"`
-	Comment       string    `json:"comment"`
-	CourseID      uint64    `json:"course_id"`
-	LabID         uint64    `json:"lab_id"`
-	Score         int32     `json:"score"`
-	IsFinish      bool      `json:"is_finish"`
+	DeadLine             time.Time `json:"dead_line"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+	ReportURL            string    `json:"report_url"`
+	Content              string    `json:"content"`
+	Title                string    `json:"title"`
+	CourseName           string    `json:"course_name"`
+	AttachmentURL        string    `json:"attachment_url"`
+	Comment              string    `json:"comment"`
+	TemplateHash         string    `json:"template_hash"`
+	PreviousTemplateHash string    `json:"previous_template_hash"`
+	CourseID             uint64    `json:"course_id"`
+	LabID                uint64    `json:"lab_id"`
+	Score                int32     `json:"score"`
+	IsFinish             bool      `json:"is_finish"`
 }
 
 type LabScore struct {
@@ -41,23 +45,114 @@ type LabCodingTimeData struct {
 	UserName    string    `json:"Name"`
 	Number      string    `json:"Number"`
 	ReportURL   string    `json:"ReportURL"`
-	LabSubmitID uint64    `json:"ID"`
-	LabID       uint64    `json:"LabID"`
-	UserID      uint64    `json:"UserID"`
-	CodingTime  uint64    `json:"CodingTime"`
-	Score       int32     `json:"Score"`
-	IsFinish    bool      `json:"IsFinish"`
+	ArchiveHash string    `json:"ArchiveHash"`
+	// ArchiveError 最近一次冻结失败的原因
+	ArchiveError string `json:"ArchiveError"`
+	LabSubmitID  uint64 `json:"ID"`
+	LabID        uint64 `json:"LabID"`
+	UserID       uint64 `json:"UserID"`
+	CodingTime   uint64 `json:"CodingTime"`
+	Score        int32  `json:"Score"`
+	IsFinish     bool   `json:"IsFinish"`
+	Archived     bool   `json:"Archived"`
 }
 
+// PlagiarismCheckResponse Reference 为 true 时 UserID2 为参考实验 ReferenceLabID 中的提交
 type PlagiarismCheckResponse struct {
-	URL        string `json:"url"`
-	Similarity string `json:"similarity"`
-	RealName1  string `json:"real_name_1"`
-	RealName2  string `json:"real_name_2"`
-	Num1       string `json:"num_1"`
-	Num2       string `json:"num_2"`
-	UserID1    uint64 `json:"user_id_1"`
-	UserID2    uint64 `json:"user_id_2"`
+	URL            string `json:"url"`
+	Similarity     string `json:"similarity"`
+	RealName1      string `json:"real_name_1"`
+	RealName2      string `json:"real_name_2"`
+	Num1           string `json:"num_1"`
+	Num2           string `json:"num_2"`
+	UserID1        uint64 `json:"user_id_1"`
+	UserID2        uint64 `json:"user_id_2"`
+	ReferenceLabID uint64 `json:"reference_lab_id"`
+	Reference      bool   `json:"reference"`
+
+	similarity   float64
+	htmlFileName string
+}
+
+// PlagiarismReport 相似度不低于 Threshold 的组合连成的分组与关系图；
+// ReferenceSources 为各参考实验的提交来源，早于记录来源的报告为空
+type PlagiarismReport struct {
+	Clusters         []*PlagiarismCluster         `json:"clusters"`
+	ReferenceSources []*PlagiarismReferenceSource `json:"reference_sources"`
+	Graph            *PlagiarismGraph             `json:"graph"`
+	Threshold        float64                      `json:"threshold"`
+}
+
+// PlagiarismReferenceSource Source 为 frozen（截止时冻结的副本）、live（查重时的工作区，截止前仍会变化）或 missing（没有提交）
+type PlagiarismReferenceSource struct {
+	Source string `json:"source"`
+	LabID  uint64 `json:"lab_id"`
+}
+
+// PlagiarismCluster Members 为关系图中节点的 ID
+type PlagiarismCluster struct {
+	Members        []string `json:"members"`
+	MaxSimilarity  float64  `json:"max_similarity"`
+	MeanSimilarity float64  `json:"mean_similarity"`
+}
+
+type PlagiarismGraph struct {
+	Nodes []*PlagiarismGraphNode `json:"nodes"`
+	Edges []*PlagiarismGraphEdge `json:"edges"`
+}
+
+// PlagiarismGraphNode ID 为用户 ID，参考实验中的提交为 <实验 ID>/<用户 ID>；Cluster 为所在分组的下标
+type PlagiarismGraphNode struct {
+	ID             string `json:"id"`
+	RealName       string `json:"real_name"`
+	Num            string `json:"num"`
+	UserID         uint64 `json:"user_id"`
+	ReferenceLabID uint64 `json:"reference_lab_id"`
+	Cluster        int    `json:"cluster"`
+}
+
+type PlagiarismGraphEdge struct {
+	Source     string  `json:"source"`
+	Target     string  `json:"target"`
+	URL        string  `json:"url"`
+	Similarity float64 `json:"similarity"`
+}
+
+// ReportPlagiarism 实验报告的文本查重结果，Checked 为参与比对的报告数，Skipped 为提交了报告但未参与比对的学生
+type ReportPlagiarism struct {
+	CreatedAt   string              `json:"created_at"`
+	ID          uint64              `json:"id"`
+	LabID       uint64              `json:"lab_id"`
+	Checked     int                 `json:"checked"`
+	Comparisons []*ReportComparison `json:"comparisons"`
+	Skipped     []*SkippedReport    `json:"skipped"`
+}
+
+// ReportComparison Similarity 为 0 到 1 之间的估计值，Passages 为两份报告中相同的段落，较长的在前
+type ReportComparison struct {
+	RealName1  string           `json:"real_name_1"`
+	RealName2  string           `json:"real_name_2"`
+	Num1       string           `json:"num_1"`
+	Num2       string           `json:"num_2"`
+	UserID1    uint64           `json:"user_id_1"`
+	UserID2    uint64           `json:"user_id_2"`
+	Similarity float64          `json:"similarity"`
+	Passages   []*ReportPassage `json:"passages"`
+}
+
+// ReportPassage Text1、Text2 分别为两份报告原文中的片段，Words 为包含的词数，中文每个字计为一个词
+type ReportPassage struct {
+	Text1 string `json:"text_1"`
+	Text2 string `json:"text_2"`
+	Words int    `json:"words"`
+}
+
+// SkippedReport Reason 为 unsupported_format、too_large、invalid_document、read_failed 或 no_text
+type SkippedReport struct {
+	RealName string `json:"real_name"`
+	Num      string `json:"num"`
+	Reason   string `json:"reason"`
+	UserID   uint64 `json:"user_id"`
 }
 
 type Lab struct {
@@ -74,3 +169,148 @@ type DetectionReportResponse struct {
 	CreatedAt string `json:"created_at"`
 	ID        uint64 `json:"id"`
 }
+
+// DetectionReportPage 代码查重记录的分页，LatestReportDetection 为实验最近一次的报告查重，没有时为 null
+type DetectionReportPage struct {
+	*PageResponse
+	LatestReportDetection *DetectionReportResponse `json:"latest_report_detection"`
+}
+
+// PlagiarismJob 后台执行的查重，kind 0: 代码查重, 1: 实验报告查重；status 0: 排队中, 1: 执行中, 2: 完成, 3: 失败, 4: 已取消；
+// 完成后代码查重的结果保存为 DetectionReportID 对应的查重报告，实验报告查重的结果保存为 ReportDetectionID 对应的报告查重记录
+type PlagiarismJob struct {
+	CreatedAt         time.Time `json:"created_at"`
+	StartedAt         time.Time `json:"started_at"`
+	FinishedAt        time.Time `json:"finished_at"`
+	Error             string    `json:"error"`
+	ReferenceLabIDs   []uint64  `json:"reference_lab_ids"`
+	ID                uint64    `json:"id"`
+	LabID             uint64    `json:"lab_id"`
+	DetectionReportID uint64    `json:"detection_report_id"`
+	ReportDetectionID uint64    `json:"report_detection_id"`
+	CreatedBy         uint64    `json:"created_by"`
+	Kind              int8      `json:"kind"`
+	Status            int8      `json:"status"`
+	Progress          int8      `json:"progress"`
+}
+
+// PlagiarismSetting 未上传基础代码时 BaseName 为空，MinMatch 为 0 时使用语言的默认值
+type PlagiarismSetting struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	BaseName  string    `json:"base_name"`
+	BaseHash  string    `json:"base_hash"`
+	Ignore    []string  `json:"ignore"`
+	LabID     uint64    `json:"lab_id"`
+	MinMatch  int32     `json:"min_match"`
+}
+
+type WorkspaceEntry struct {
+	ModifiedAt time.Time `json:"modified_at"`
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	IsDir      bool      `json:"is_dir"`
+}
+
+type WorkspaceFile struct {
+	ModifiedAt time.Time `json:"modified_at"`
+	Content    string    `json:"content"`
+	Size       int64     `json:"size"`
+	IsBinary   bool      `json:"is_binary"`
+}
+
+// SubmitArchive 截止时冻结的工作区归档，调用方负责关闭 Reader
+type SubmitArchive struct {
+	Reader io.ReadCloser
+	Name   string
+	Hash   string
+	Size   int64
+}
+
+// LabTemplateResult 更新初始代码模板后各工作区的合并结果
+type LabTemplateResult struct {
+	Hash           string `json:"hash"`
+	FileCount      int32  `json:"file_count"`
+	WorkspaceCount int32  `json:"workspace_count"`
+	Updated        int32  `json:"updated"`
+	Conflicts      int32  `json:"conflicts"`
+	Failed         int32  `json:"failed"`
+}
+
+type WorkspaceSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+	Reason    string    `json:"reason"`
+	ID        int64     `json:"id"`
+	TotalSize int64     `json:"total_size"`
+	FileCount int32     `json:"file_count"`
+	Skipped   int32     `json:"skipped"`
+}
+
+type WorkspaceDiff struct {
+	Files     []*FileDiff `json:"files"`
+	Truncated bool        `json:"truncated"`
+}
+
+type FileDiff struct {
+	OldPath   string      `json:"old_path"`
+	NewPath   string      `json:"new_path"`
+	Status    string      `json:"status"`
+	Hunks     []*DiffHunk `json:"hunks"`
+	Additions int32       `json:"additions"`
+	Deletions int32       `json:"deletions"`
+	IsBinary  bool        `json:"is_binary"`
+	Truncated bool        `json:"truncated"`
+}
+
+type DiffHunk struct {
+	Lines    []*DiffLine `json:"lines"`
+	OldStart int32       `json:"old_start"`
+	OldLines int32       `json:"old_lines"`
+	NewStart int32       `json:"new_start"`
+	NewLines int32       `json:"new_lines"`
+}
+
+type DiffLine struct {
+	Kind    string `json:"kind"`
+	Text    string `json:"text"`
+	OldLine int32  `json:"old_line"`
+	NewLine int32  `json:"new_line"`
+}
+
+type LabTest struct {
+	UpdatedAt      time.Time `json:"updated_at"`
+	Command        string    `json:"command"`
+	TestsHash      string    `json:"tests_hash"`
+	LabID          uint64    `json:"lab_id"`
+	TimeoutSeconds int32     `json:"timeout_seconds"`
+	FullScore      int32     `json:"full_score"`
+	Language       int8      `json:"language"`
+	AutoScore      bool      `json:"auto_score"`
+}
+
+// LabTestRun 列表中不返回 Output 与 Cases，运行中时 FinishedAt 为零值
+type LabTestRun struct {
+	CreatedAt   time.Time      `json:"created_at"`
+	FinishedAt  time.Time      `json:"finished_at"`
+	Status      string         `json:"status"`
+	Output      string         `json:"output,omitempty"`
+	Cases       []*junitx.Case `json:"cases,omitempty"`
+	ID          uint64         `json:"id"`
+	LabID       uint64         `json:"lab_id"`
+	UserID      uint64         `json:"user_id"`
+	TriggeredBy uint64         `json:"triggered_by"`
+	DurationMs  int64          `json:"duration_ms"`
+	Total       int32          `json:"total"`
+	Passed      int32          `json:"passed"`
+	Failed      int32          `json:"failed"`
+	Errors      int32          `json:"errors"`
+	Skipped     int32          `json:"skipped"`
+	Score       int32          `json:"score"`
+	ExitCode    int32          `json:"exit_code"`
+	Scored      bool           `json:"scored"`
+	TimedOut    bool           `json:"timed_out"`
+}
+
+type LabTestFilesResult struct {
+	Hash      string `json:"hash"`
+	FileCount int32  `json:"file_count"`
+}
@@ -0,0 +1,266 @@
+package lab
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	idepb "code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var manifestHeader = []string{"number", "name", "folder", "score", "comment", "is_finish", "files", "report", "archive_sha256", "error"}
+
+// WriteLabSubmitsZip 将实验全部学生的代码、实验报告与成绩清单以 zip 写入 w，每个学生一个以“学号_姓名”命名的目录。
+// 已冻结的学生读取截止时的归档，其余读取工作区当前内容；单个学生失败时记录在清单中并继续，仅写入 w 失败或 ctx 结束时返回错误
+func (l *LabService) WriteLabSubmitsZip(ctx context.Context, labID uint64, w io.Writer) error {
+	infos, err := model.QueryAllLabSubmitInfosByLabID(ctx, l.Dao.Storage.RDB, labID)
+	if err != nil {
+		l.Logger.Errorf(err, "QueryAllLabSubmitInfosByLabID by labID[%d] failed", labID)
+		return errorx.InternalErr(err)
+	}
+
+	out := &stickyErrWriter{w: w}
+	zw := zip.NewWriter(out)
+	rows := make([][]string, 0, len(infos)+1)
+	rows = append(rows, manifestHeader)
+	for _, info := range infos {
+		row := l.writeSubmitToZip(ctx, zw, info)
+		if out.err != nil {
+			return out.err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	fw, err := zw.Create("manifest.csv")
+	if err != nil {
+		return err
+	}
+	// 带 BOM 以便 Excel 正确识别中文
+	if _, err := io.WriteString(fw, "\ufeff"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(fw)
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func (l *LabService) writeSubmitToZip(ctx context.Context, zw *zip.Writer, info *model.LabSubmitInfoByLabID) []string {
+	folder := submitFolderName(info)
+	var (
+		score  string
+		errMsg []string
+	)
+	if info.Score.Valid {
+		score = strconv.Itoa(int(info.Score.Int32))
+	}
+
+	files, hash, err := l.writeCodeToZip(ctx, zw, folder, info)
+	if err != nil {
+		l.Logger.Errorf(err, "write code of labID[%d] and studentID[%d] to zip failed", info.LabID, info.UserID)
+		errMsg = append(errMsg, "code: "+err.Error())
+	}
+	report, err := l.writeReportToZip(ctx, zw, folder, info.ReportURL)
+	if err != nil {
+		l.Logger.Errorf(err, "write report %q of labID[%d] and studentID[%d] to zip failed", info.ReportURL, info.LabID, info.UserID)
+		errMsg = append(errMsg, "report: "+err.Error())
+	}
+
+	return []string{
+		info.Number,
+		info.Name,
+		folder,
+		score,
+		info.Comment,
+		strconv.FormatBool(info.IsFinish),
+		strconv.Itoa(files),
+		report,
+		hash,
+		strings.Join(errMsg, "; "),
+	}
+}
+
+// writeCodeToZip 将学生代码写入 folder/code 下，学生没有工作区时不写入任何文件
+func (l *LabService) writeCodeToZip(ctx context.Context, zw *zip.Writer, folder string, info *model.LabSubmitInfoByLabID) (files int, hash string, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		r      io.Reader
+		reader *define.ArchiveReader
+	)
+	if info.ArchiveObject != "" {
+		object, err := l.Dao.Storage.Minio.GetObject(ctx, l.Dao.Storage.Minio.SubmissionBucketName(), info.ArchiveObject, minio.GetObjectOptions{})
+		if err != nil {
+			return 0, "", err
+		}
+		defer object.Close()
+		r, hash = object, info.ArchiveHash
+	} else {
+		stream, err := l.IDEClient.ExportWorkspace(ctx, &idepb.ArchiveWorkspaceRequest{LabId: info.LabID, StudentId: info.UserID})
+		if err != nil {
+			return 0, "", err
+		}
+		reader, err = define.NewArchiveReader(stream)
+		switch {
+		case err == nil:
+		case status.Code(err) == codes.NotFound:
+			return 0, "", nil
+		default:
+			return 0, "", err
+		}
+		r = reader
+	}
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, "", err
+	}
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return files, "", err
+		}
+		// 归档中的路径限定在学生目录内
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		if header.Typeflag != tar.TypeReg || name == "" {
+			continue
+		}
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     path.Join(folder, "code", name),
+			Method:   zip.Deflate,
+			Modified: header.ModTime,
+		})
+		if err != nil {
+			return files, "", err
+		}
+		if _, err := io.Copy(fw, tr); err != nil {
+			return files, "", err
+		}
+		files++
+	}
+	// 读完 gzip 尾部，使 ArchiveReader 收到携带 sha256 的最后一块
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return files, "", err
+	}
+	if reader != nil {
+		if summary := reader.Summary(); summary != nil {
+			hash = summary.Sha256
+		}
+	}
+	return files, hash, nil
+}
+
+// writeReportToZip 将上传到 report 桶的实验报告写入 folder 下，返回其在 zip 中的文件名
+func (l *LabService) writeReportToZip(ctx context.Context, zw *zip.Writer, folder, reportURL string) (string, error) {
+	if reportURL == "" {
+		return "", nil
+	}
+	bucketName := l.Dao.Storage.Minio.ReportBucketName()
+	objectName, err := reportObjectName(bucketName, reportURL)
+	if err != nil {
+		return "", err
+	}
+
+	object, err := l.Dao.Storage.Minio.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+	info, err := object.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	ext := path.Ext(objectName)
+	if ext == "" {
+		ext = ".pdf"
+	}
+	name := "report" + ext
+	fw, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     path.Join(folder, name),
+		Method:   zip.Deflate,
+		Modified: info.LastModified,
+	})
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(fw, object); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// reportObjectName 上传的实验报告在 report 桶中的对象名
+func reportObjectName(bucketName, reportURL string) (string, error) {
+	u, err := url.Parse(reportURL)
+	if err != nil {
+		return "", err
+	}
+	objectName := strings.TrimPrefix(u.Path, "/"+bucketName+"/")
+	if objectName == u.Path || objectName == "" {
+		return "", errors.New("report is not in report bucket")
+	}
+	return objectName, nil
+}
+
+// submitFolderName 学号_姓名
+func submitFolderName(info *model.LabSubmitInfoByLabID) string {
+	number := info.Number
+	if number == "" {
+		number = strconv.FormatUint(info.UserID, 10)
+	}
+	return safeFileName(fmt.Sprintf("%s_%s", number, info.Name))
+}
+
+// safeFileName 去除不能用于文件名的字符
+func safeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// stickyErrWriter 记录第一次写入错误，用于区分客户端断开与单个学生的读取失败
+type stickyErrWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (s *stickyErrWriter) Write(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	n, err := s.w.Write(p)
+	if err != nil {
+		s.err = err
+	}
+	return n, err
+}
@@ -11,16 +11,20 @@ import (
 	idepb "code-platform/api/grpc/ide/pb"
 	monacopb "code-platform/api/grpc/monaco/pb"
 	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/config"
 	"code-platform/log"
 	"code-platform/pkg/errorx"
 	"code-platform/pkg/parallelx"
+	"code-platform/pkg/slicex"
 	"code-platform/pkg/strconvx"
 	"code-platform/pkg/timex"
 	"code-platform/pkg/transactionx"
 	"code-platform/repository"
 	"code-platform/repository/rdb/model"
+	"code-platform/service/scheduler"
 	"code-platform/storage"
 
+	"github.com/minio/minio-go/v7"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,7 +32,7 @@ import (
 )
 
 func NewPlagiarismDetectionClient() pb.PlagiarismDetectionClient {
-	const port = "8086"
+	port := config.PlagiarismDetectionServer.GetString("port")
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -51,6 +55,8 @@ type LabService struct {
 	PlagiarismDetectionClient pb.PlagiarismDetectionClient
 	IDEClient                 idepb.IDEServerServiceClient
 	MonacoClient              monacopb.MonacoServerServiceClient
+	// Scheduler 由 RegisterJobs 设置，用于提交后台查重
+	Scheduler *scheduler.SchedulerService
 }
 
 func NewLabService(
@@ -234,6 +240,21 @@ func (l *LabService) UpdateLab(ctx context.Context, labID uint64, title, content
 		return errorx.InternalErr(err)
 	}
 
+	// 已有工作区冻结的实验不能再修改截止时间，包括部分学生冻结失败、实验尚未标记为已冻结的情况
+	if !deadLine.Truncate(time.Second).Equal(lab.DeadLine.Time) {
+		archived := lab.ArchivedAt.Valid
+		if !archived {
+			archived, err = model.ExistsArchivedLabSubmitByLabID(ctx, l.Dao.Storage.RDB, labID)
+			if err != nil {
+				l.Logger.Errorf(err, "ExistsArchivedLabSubmitByLabID by labID[%d] failed", labID)
+				return errorx.InternalErr(err)
+			}
+		}
+		if archived {
+			return errorx.ErrLabHasEnded
+		}
+	}
+
 	lab.AttachMentURL = attachmentURL
 	lab.Content = content
 	lab.DeadLine = sql.NullTime{
@@ -260,14 +281,16 @@ func (l *LabService) GetLab(ctx context.Context, labID uint64) (*LabInfo, error)
 		return nil, errorx.InternalErr(err)
 	}
 	return &LabInfo{
-		LabID:         lab.ID,
-		CourseID:      lab.CourseID,
-		Title:         lab.Title,
-		Content:       lab.Content,
-		AttachmentURL: lab.AttachMentURL,
-		DeadLine:      lab.DeadLine.Time,
-		CreatedAt:     lab.CreatedAt,
-		UpdatedAt:     lab.UpdatedAt,
+		LabID:                lab.ID,
+		CourseID:             lab.CourseID,
+		Title:                lab.Title,
+		Content:              lab.Content,
+		AttachmentURL:        lab.AttachMentURL,
+		TemplateHash:         lab.TemplateHash,
+		PreviousTemplateHash: lab.PreviousTemplateHash,
+		DeadLine:             lab.DeadLine.Time,
+		CreatedAt:            lab.CreatedAt,
+		UpdatedAt:            lab.UpdatedAt,
 	}, nil
 }
 
@@ -500,6 +523,7 @@ func (l *LabService) UpdateScore(ctx context.Context, userID, labID uint64, scor
 	}
 
 	labSubmit.Score = sql.NullInt32{Valid: true, Int32: score}
+	labSubmit.ManualScore = true
 	if err := labSubmit.Update(ctx, l.Dao.Storage.RDB); err != nil {
 		l.Logger.Errorf(err, "update for lab submit %+v failed", labSubmit)
 		return errorx.InternalErr(err)
@@ -651,18 +675,21 @@ func (l *LabService) ListLabSubmitsByLabID(ctx context.Context, labID uint64, of
 	records := make([]*LabCodingTimeData, len(infos))
 	for index, info := range infos {
 		records[index] = &LabCodingTimeData{
-			LabSubmitID: info.ID,
-			LabID:       info.LabID,
-			UserID:      info.UserID,
-			UserName:    info.Name,
-			Number:      info.Number,
-			ReportURL:   info.ReportURL,
-			IsFinish:    info.IsFinish,
-			Score:       info.Score.Int32,
-			Comment:     info.Comment,
-			CodingTime:  info.CodingTime,
-			CreatedTime: info.CreatedAt,
-			UpdatedTime: info.UpdatedAt,
+			LabSubmitID:  info.ID,
+			LabID:        info.LabID,
+			UserID:       info.UserID,
+			UserName:     info.Name,
+			Number:       info.Number,
+			ReportURL:    info.ReportURL,
+			ArchiveHash:  info.ArchiveHash,
+			IsFinish:     info.IsFinish,
+			Archived:     info.ArchivedAt.Valid,
+			ArchiveError: info.ArchiveError,
+			Score:        info.Score.Int32,
+			Comment:      info.Comment,
+			CodingTime:   info.CodingTime,
+			CreatedTime:  info.CreatedAt,
+			UpdatedTime:  info.UpdatedAt,
 		}
 	}
 
@@ -672,143 +699,66 @@ func (l *LabService) ListLabSubmitsByLabID(ctx context.Context, labID uint64, of
 	}, nil
 }
 
-func (l *LabService) QuickCheckCode(ctx context.Context, labID, studentID uint64) (*idepb.QuickViewCodeResponse_FileNode, error) {
-	resp, err := l.IDEClient.QuickViewCode(ctx, &idepb.QuickViewCodeRequest{
-		LabId:  labID,
-		UserId: studentID,
-	})
-	if err != nil {
-		l.Logger.Errorf(err, "QuickCheckCode for userID[%d] and studentID[%d] failed", labID, studentID)
-		return nil, errorx.InternalErr(err)
-	}
-
-	return resp.RootNode, nil
-}
-
-func (l *LabService) PlagiarismCheck(ctx context.Context, labID uint64) ([]*PlagiarismCheckResponse, error) {
-	courseID, err := model.QueryCourseIDByLabID(ctx, l.Dao.Storage.RDB, labID)
+// GetSubmitArchive 获取学生截止时冻结的工作区归档，尚未冻结或学生没有工作区时返回 ErrIsNotFound
+func (l *LabService) GetSubmitArchive(ctx context.Context, labID, studentID uint64) (*SubmitArchive, error) {
+	labSubmit, err := model.QueryLabSubmitByLabIDAndUserID(ctx, l.Dao.Storage.RDB, labID, studentID)
 	switch err {
 	case nil:
 	case sql.ErrNoRows:
-		l.Logger.Debugf("lab is not found by id(%d) failed", labID)
+		l.Logger.Debugf("lab submit is not found by labID[%d] and studentID[%d]", labID, studentID)
 		return nil, errorx.ErrIsNotFound
 	default:
-		l.Logger.Errorf(err, "query lab by labID(%d) failed", labID)
+		l.Logger.Errorf(err, "query lab submit by labID[%d] and studentID[%d] failed", labID, studentID)
 		return nil, errorx.InternalErr(err)
 	}
+	if labSubmit.ArchiveObject == "" {
+		return nil, errorx.ErrIsNotFound
+	}
 
-	var (
-		resp     []*PlagiarismCheckResponse
-		usersMap map[uint64]*model.User
-	)
-
-	tasks := []func() error{
-		func() (err error) {
-			course, err := model.QueryCourseByID(ctx, l.Dao.Storage.RDB, courseID)
-			switch err {
-			case nil:
-			case context.Canceled:
-				l.Logger.Debug("QueryCourseByID is canceled")
-				return err
-			case sql.ErrNoRows:
-				l.Logger.Debugf("course is not found by courseID(%d) failed", courseID)
-				return errorx.ErrIsNotFound
-			default:
-				l.Logger.Errorf(err, "query course by courseID(%d) failed", courseID)
-				return errorx.InternalErr(err)
-			}
-			var lan pb.Language
-			switch course.Language {
-			case 0:
-				lan = pb.Language_python3
-			case 1:
-				lan = pb.Language_cpp
-			default:
-				lan = pb.Language_java
-			}
-
-			jplagResp, err := l.PlagiarismDetectionClient.DuplicateCheck(ctx, &pb.DuplicateCheckRequest{
-				LabID: labID,
-				Lan:   lan,
-			})
-			switch err {
-			case nil:
-			case context.Canceled:
-				l.Logger.Debug("DuplicateCheck is canceled")
-				return err
-			default:
-				// 不合理代码，无合法提交
-				switch status.Code(err) {
-				case codes.DataLoss:
-					l.Logger.Debugf("DuplicateCheck for language[%d] and labID[%d] failed for error %s", lan, labID, err.Error())
-					return errorx.ErrWrongCode
-				case codes.NotFound:
-					l.Logger.Debugf("DuplicateCheck for labID[%d] is not found", labID)
-					return errorx.ErrIsNotFound
-				}
-				l.Logger.Errorf(err, "plagiarismCheck for labID(%d) failed", labID)
-				return errorx.InternalErr(err)
-			}
-
-			if jplagResp.GetComparision() == nil {
-				return nil
-			}
-
-			timeStampStr := jplagResp.GetTimeStamp()
-			data, err := proto.Marshal(jplagResp.GetComparision())
-			if err != nil {
-				l.Logger.Errorf(err, "marshal for resp %v failed", jplagResp)
-				return errorx.InternalErr(err)
-			}
-			timeStamp, err := strconv.ParseInt(timeStampStr, 10, 64)
-			if err != nil {
-				l.Logger.Errorf(err, "parse to int64 for %s failed", timeStampStr)
-				return errorx.InternalErr(err)
-			}
-
-			detectionReport := &model.DetectionReport{
-				LabID:     labID,
-				Data:      data,
-				CreatedAt: time.UnixMilli(timeStamp),
-			}
+	object, err := l.Dao.Storage.Minio.GetObject(ctx, l.Dao.Storage.Minio.SubmissionBucketName(), labSubmit.ArchiveObject, minio.GetObjectOptions{})
+	if err != nil {
+		l.Logger.Errorf(err, "get archive object %q failed", labSubmit.ArchiveObject)
+		return nil, errorx.InternalErr(err)
+	}
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+		l.Logger.Errorf(err, "stat archive object %q failed", labSubmit.ArchiveObject)
+		return nil, errorx.InternalErr(err)
+	}
+	return &SubmitArchive{
+		Reader: object,
+		Name:   fmt.Sprintf("%d-%d.tar.gz", labID, studentID),
+		Hash:   labSubmit.ArchiveHash,
+		Size:   info.Size,
+	}, nil
+}
 
-			if err := detectionReport.Insert(ctx, l.Dao.Storage.RDB); err != nil {
-				l.Logger.Errorf(err, "insert detection_report %+v failed")
-				return errorx.InternalErr(err)
-			}
+// QuickCheckCode 按条目数与大小上限截断的目录树，withContent 为 false 时不含文件内容，通过 QuickCheckFile 按需获取
+func (l *LabService) QuickCheckCode(ctx context.Context, labID, studentID uint64, withContent bool) (*idepb.QuickViewCodeResponse, error) {
+	resp, err := l.IDEClient.QuickViewCode(ctx, &idepb.QuickViewCodeRequest{
+		LabId:       labID,
+		UserId:      studentID,
+		WithContent: withContent,
+	})
+	if err != nil {
+		l.Logger.Errorf(err, "QuickCheckCode for labID[%d] and studentID[%d] failed", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
 
-			resp = comparisionToPlagiarismCheckResponse(jplagResp.GetComparision(), timeStampStr)
-			return nil
-		},
-		func() (err error) {
-			userIDs, err := model.QueryUserIDsInArrangeCourseByCourseID(ctx, l.Dao.Storage.RDB, courseID)
-			switch err {
-			case nil:
-			case context.Canceled:
-				l.Logger.Debug("QueryUserIDsInArrangeCourseByCourseID is canceled")
-				return err
-			default:
-				l.Logger.Errorf(err, "QueryUserIDsInArrangeCourseByCourseID by courseID(%d) failed", courseID)
-				return errorx.InternalErr(err)
-			}
+	return resp, nil
+}
 
-			usersMap, err = model.QueryUserMapByIDs(ctx, l.Dao.Storage.RDB, userIDs)
-			switch err {
-			case nil:
-			case context.Canceled:
-				l.Logger.Debug("QueryUserMapByIDs is canceled")
-				return err
-			default:
-				l.Logger.Errorf(err, "QueryUserMapByIDs by userIDs(%v) failed", userIDs)
-				return errorx.InternalErr(err)
-			}
-			return nil
-		},
-	}
-	if err := parallelx.Do(l.Logger, tasks...); err != nil {
-		return nil, err
+func (l *LabService) QuickCheckFile(ctx context.Context, labID, studentID uint64, path string) (*idepb.QuickViewFileResponse, error) {
+	resp, err := l.IDEClient.QuickViewFile(ctx, &idepb.QuickViewFileRequest{
+		LabId:  labID,
+		UserId: studentID,
+		Path:   path,
+	})
+	if err != nil {
+		return nil, l.workspaceErr(err, "QuickCheckFile %q for labID[%d] and studentID[%d] failed", path, labID, studentID)
 	}
-	return boxForPlagiarismCheckResponse(resp, usersMap), nil
+	return resp, nil
 }
 
 func comparisionToPlagiarismCheckResponse(
@@ -819,6 +769,8 @@ func comparisionToPlagiarismCheckResponse(
 	b := &strings.Builder{}
 	const connectSymbol = "?ts="
 	for i, v := range comparision.Comparisions {
+		// 相似度为百分比乘以 100，页面显示百分比，分组使用 0 到 1 之间的比例
+		similarity := float64(v.GetSimilarity()) / 10000
 		b.Reset()
 		b.Grow(len(v.GetHtmlFileName()) + len(timeStampStr) + len(connectSymbol))
 		b.WriteString(v.GetHtmlFileName())
@@ -826,15 +778,43 @@ func comparisionToPlagiarismCheckResponse(
 		b.WriteString(timeStampStr)
 
 		resp[i] = &PlagiarismCheckResponse{
-			UserID1:    v.GetUserId(),
-			UserID2:    v.GetAnotherUserId(),
-			Similarity: fmt.Sprintf("%.2f", float64(v.GetSimilarity()/100)),
-			URL:        b.String(),
+			UserID1:        v.GetUserId(),
+			UserID2:        v.GetAnotherUserId(),
+			Similarity:     fmt.Sprintf("%.2f", float64(v.GetSimilarity())/100),
+			URL:            b.String(),
+			ReferenceLabID: v.GetReferenceLabId(),
+			Reference:      v.GetReferenceLabId() != 0,
+			similarity:     similarity,
+			htmlFileName:   v.GetHtmlFileName(),
 		}
 	}
 	return resp
 }
 
+// addReferenceUsers 参考实验的学生可能不在本课程中，补充查询其信息
+func (l *LabService) addReferenceUsers(ctx context.Context, resp []*PlagiarismCheckResponse, usersMap map[uint64]*model.User) error {
+	var userIDs []uint64
+	for _, v := range resp {
+		if _, ok := usersMap[v.UserID2]; v.Reference && !ok {
+			userIDs = append(userIDs, v.UserID2)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	userIDs = slicex.DistinctUint64Slice(userIDs)
+	referenceUsers, err := model.QueryUserMapByIDs(ctx, l.Dao.Storage.RDB, userIDs)
+	if err != nil {
+		l.Logger.Errorf(err, "QueryUserMapByIDs by userIDs(%v) failed", userIDs)
+		return errorx.InternalErr(err)
+	}
+	for userID, user := range referenceUsers {
+		usersMap[userID] = user
+	}
+	return nil
+}
+
 func boxForPlagiarismCheckResponse(resp []*PlagiarismCheckResponse, usersMap map[uint64]*model.User) []*PlagiarismCheckResponse {
 	for _, v := range resp {
 		user1 := usersMap[v.UserID1]
@@ -884,10 +864,12 @@ func (l *LabService) GetCourseIDByLabID(ctx context.Context, labID uint64) (uint
 	return courseID, nil
 }
 
-func (l *LabService) ListDetectionReportsByLabID(ctx context.Context, labID uint64, offset, limit int) (*PageResponse, error) {
+// ListDetectionReportsByLabID 同时返回实验最近一次的报告查重，与代码查重一同查看
+func (l *LabService) ListDetectionReportsByLabID(ctx context.Context, labID uint64, offset, limit int) (*DetectionReportPage, error) {
 	var (
 		total            int
 		detectionReports []*model.DetectionReportIDWithCreatedAt
+		latest           *model.DetectionReportIDWithCreatedAt
 	)
 
 	tasks := []func() error{
@@ -917,6 +899,19 @@ func (l *LabService) ListDetectionReportsByLabID(ctx context.Context, labID uint
 			}
 			return nil
 		},
+		func() (err error) {
+			latest, err = model.QueryLatestReportDetectionByLabID(ctx, l.Dao.Storage.RDB, labID)
+			switch err {
+			case nil, sql.ErrNoRows:
+			case context.Canceled:
+				l.Logger.Debug("QueryLatestReportDetectionByLabID is canceled")
+				return err
+			default:
+				l.Logger.Errorf(err, "QueryLatestReportDetection by labID[%d] failed", labID)
+				return errorx.InternalErr(err)
+			}
+			return nil
+		},
 	}
 
 	if err := parallelx.Do(l.Logger, tasks...); err != nil {
@@ -931,26 +926,85 @@ func (l *LabService) ListDetectionReportsByLabID(ctx context.Context, labID uint
 		}
 	}
 
-	return &PageResponse{
-		Records:  resp,
-		PageInfo: &PageInfo{Total: total},
-	}, nil
+	page := &DetectionReportPage{
+		PageResponse: &PageResponse{
+			Records:  resp,
+			PageInfo: &PageInfo{Total: total},
+		},
+	}
+	if latest != nil {
+		page.LatestReportDetection = &DetectionReportResponse{
+			ID:        latest.ID,
+			CreatedAt: latest.CreatedAt.In(timex.ShanghaiLocation).Format("2006-01-02 15:04:05"),
+		}
+	}
+	return page, nil
 }
 
 func (l *LabService) ViewPerviousDetection(ctx context.Context, detectionReportID, teacherID uint64, host string) ([]*PlagiarismCheckResponse, error) {
+	_, resp, err := l.viewDetection(ctx, detectionReportID, teacherID, host)
+	return resp, err
+}
+
+// ViewDetectionGraph 相似度不低于 threshold 的组合连成分组与关系图
+func (l *LabService) ViewDetectionGraph(ctx context.Context, detectionReportID, teacherID uint64, host string, threshold float64) (*PlagiarismReport, error) {
+	detectionReport, resp, err := l.viewDetection(ctx, detectionReportID, teacherID, host)
+	if err != nil {
+		return nil, err
+	}
+	report := newPlagiarismReport(resp, threshold)
+	report.ReferenceSources, err = l.referenceSources(detectionReport)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// viewDetection 比对结果的 URL 补全为可访问的报告地址
+func (l *LabService) viewDetection(ctx context.Context, detectionReportID, teacherID uint64, host string) (*model.DetectionReport, []*PlagiarismCheckResponse, error) {
+	detectionReport, resp, err := l.queryDetection(ctx, detectionReportID, teacherID)
+	if err != nil {
+		return nil, nil, err
+	}
+	baseURL := "http://" + host + fmt.Sprintf("/web/lab/summit/plagiarism/%d/", detectionReport.LabID)
+	for _, v := range resp {
+		v.URL = baseURL + v.URL
+	}
+	return detectionReport, resp, nil
+}
+
+// referenceSources 查重记录中各参考实验使用的提交来源
+func (l *LabService) referenceSources(detectionReport *model.DetectionReport) ([]*PlagiarismReferenceSource, error) {
+	var v pb.DuplicateCheckResponse_DuplicateCheckResponseValue
+	if err := proto.Unmarshal(detectionReport.Data, &v); err != nil {
+		l.Logger.Errorf(err, "unmarshal for %v failed", detectionReport.Data)
+		return nil, errorx.InternalErr(err)
+	}
+	sources := make([]*PlagiarismReferenceSource, len(v.GetReferenceSources()))
+	for index, source := range v.GetReferenceSources() {
+		sources[index] = &PlagiarismReferenceSource{
+			Source: source.GetSource(),
+			LabID:  source.GetLabId(),
+		}
+	}
+	return sources, nil
+}
+
+// queryDetection 返回查重记录与填充了学生信息的比对结果，仅实验所属课程的教师可以查看
+func (l *LabService) queryDetection(ctx context.Context, detectionReportID, teacherID uint64) (*model.DetectionReport, []*PlagiarismCheckResponse, error) {
 	detectionReport, err := model.QueryDetectionReportByID(ctx, l.Dao.Storage.RDB, detectionReportID)
 	switch err {
 	case nil:
 	case sql.ErrNoRows:
-		return nil, errorx.ErrIsNotFound
+		return nil, nil, errorx.ErrIsNotFound
 	default:
 		l.Logger.Errorf(err, "QueryDetectionReportData by ID[%d] failed", detectionReportID)
-		return nil, errorx.InternalErr(err)
+		return nil, nil, errorx.InternalErr(err)
 	}
 	var v pb.DuplicateCheckResponse_DuplicateCheckResponseValue
 	if err := proto.Unmarshal(detectionReport.Data, &v); err != nil {
 		l.Logger.Errorf(err, "unmarshal for %v failed", detectionReport.Data)
-		return nil, errorx.InternalErr(err)
+		return nil, nil, errorx.InternalErr(err)
 	}
 
 	resp := comparisionToPlagiarismCheckResponse(&v, strconv.FormatInt(detectionReport.CreatedAt.UnixMilli(), 10))
@@ -959,10 +1013,10 @@ func (l *LabService) ViewPerviousDetection(ctx context.Context, detectionReportI
 	case nil:
 	case sql.ErrNoRows:
 		l.Logger.Debugf("lab is not found by id(%d) failed", detectionReport.LabID)
-		return nil, errorx.ErrIsNotFound
+		return nil, nil, errorx.ErrIsNotFound
 	default:
 		l.Logger.Errorf(err, "query lab by labID(%d) failed", detectionReport.LabID)
-		return nil, errorx.InternalErr(err)
+		return nil, nil, errorx.InternalErr(err)
 	}
 
 	var usersMap map[uint64]*model.User
@@ -1010,12 +1064,10 @@ func (l *LabService) ViewPerviousDetection(ctx context.Context, detectionReportI
 	}
 
 	if err := parallelx.Do(l.Logger, tasks...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	resp = boxForPlagiarismCheckResponse(resp, usersMap)
-	baseURL := "http://" + host + fmt.Sprintf("/web/lab/summit/plagiarism/%d/", detectionReport.LabID)
-	for _, v := range resp {
-		v.URL = baseURL + v.URL
+	if err := l.addReferenceUsers(ctx, resp, usersMap); err != nil {
+		return nil, nil, err
 	}
-	return resp, nil
+	return detectionReport, boxForPlagiarismCheckResponse(resp, usersMap), nil
 }
@@ -12,6 +12,7 @@ import (
 	"code-platform/service/ide"
 	. "code-platform/service/lab"
 	"code-platform/service/monaco"
+	"code-platform/service/scheduler"
 	"code-platform/storage"
 	"context"
 	"database/sql"
@@ -745,9 +746,33 @@ func TestQuickCheckCode(t *testing.T) {
 	_, err := labService.IDEClient.GenerateTestFileForViewCode(ctx, &idepb.Empty{})
 	require.NoError(t, err)
 
-	resp, err := labService.QuickCheckCode(ctx, 0, 0)
+	// 默认只返回目录树，文件内容按需获取
+	resp, err := labService.QuickCheckCode(ctx, 0, 0, false)
 	require.NoError(t, err)
 	log.Infof("%+v", resp)
+	require.False(t, resp.Truncated)
+	require.Len(t, resp.RootNode.ChildNodes, 3)
+	for _, node := range resp.RootNode.ChildNodes {
+		assert.Empty(t, node.Content, node.Name)
+		assert.NotZero(t, node.Size, node.Name)
+	}
+
+	resp, err = labService.QuickCheckCode(ctx, 0, 0, true)
+	require.NoError(t, err)
+	require.False(t, resp.Truncated)
+	for _, node := range resp.RootNode.ChildNodes {
+		assert.NotEmpty(t, node.Content, node.Name)
+		assert.Equal(t, "utf-8", node.Encoding, node.Name)
+		assert.False(t, node.Truncated, node.Name)
+	}
+
+	file, err := labService.QuickCheckFile(ctx, 0, 0, "1.py")
+	require.NoError(t, err)
+	assert.Contains(t, file.Content, "hello world")
+	assert.False(t, file.IsBinary)
+
+	_, err = labService.QuickCheckFile(ctx, 0, 0, "not_exist.py")
+	require.Equal(t, errorx.ErrIsNotFound, err)
 
 	_, err = labService.IDEClient.RemoveGenerateTestFileForViewCode(ctx, &idepb.Empty{})
 	require.NoError(t, err)
@@ -763,7 +788,11 @@ func TestPlagiarismCheck(t *testing.T) {
 
 	now := time.Now()
 
-	testx.MustTruncateTable(ctx, testStorage.RDB, "user", "lab", "arrange_course", "course", "detection_report")
+	testx.MustTruncateTable(ctx, testStorage.RDB, "user", "lab", "arrange_course", "course", "detection_report", "plagiarism_job", "job_run")
+	schedulerService := scheduler.NewSchedulerService(labService.Dao, log.Sub("scheduler"))
+	labService.RegisterJobs(schedulerService)
+	schedulerService.Start()
+
 	const teacherID = 0
 	course := &model.Course{
 		TeacherID: teacherID,
@@ -832,25 +861,27 @@ int main(){
 		label         string
 		labID         uint64
 		language      pb.Language
+		// expectedStatus 为查重任务结束时的状态
+		expectedStatus int8
 	}{
 
 		{
-			label:         "fake python",
-			language:      pb.Language_python3,
-			buf:           fakePythonBuf,
-			expectedError: errorx.ErrWrongCode,
+			label:          "fake python",
+			language:       pb.Language_python3,
+			buf:            fakePythonBuf,
+			expectedStatus: model.PlagiarismJobStatusFailed,
 		},
 		{
-			label:         "normal python",
-			language:      pb.Language_python3,
-			buf:           pythonBuf,
-			expectedError: nil,
+			label:          "normal python",
+			language:       pb.Language_python3,
+			buf:            pythonBuf,
+			expectedStatus: model.PlagiarismJobStatusDone,
 		},
 		{
-			label:         "normal cpp",
-			language:      pb.Language_cpp,
-			buf:           cppBuf,
-			expectedError: nil,
+			label:          "normal cpp",
+			language:       pb.Language_cpp,
+			buf:            cppBuf,
+			expectedStatus: model.PlagiarismJobStatusDone,
 			f: func() error {
 				course.Language = 1
 				return course.Update(ctx, testStorage.RDB)
@@ -865,7 +896,7 @@ int main(){
 				course.Language = 2
 				return course.Update(ctx, testStorage.RDB)
 			},
-			expectedError: nil,
+			expectedStatus: model.PlagiarismJobStatusDone,
 		},
 		{
 			label:         "not found",
@@ -883,20 +914,28 @@ int main(){
 			require.NoError(t, err)
 		}
 		if c.buf != nil {
-			for j := 1; j <= 2; j++ {
-				_, err := labService.PlagiarismDetectionClient.GenerateTestFilesForDuplicateCheck(ctx, &pb.GenerateTestFilesForDuplicateCheckRequest{
-					CodeContent: c.buf.String(),
-					Lan:         c.language,
-				})
-				require.NoError(t, err)
-			}
+			_, err := labService.PlagiarismDetectionClient.GenerateTestFilesForDuplicateCheck(ctx, &pb.GenerateTestFilesForDuplicateCheckRequest{
+				CodeContent: c.buf.String(),
+				Lan:         c.language,
+			})
+			require.NoError(t, err)
 		}
-		_, err := labService.PlagiarismCheck(ctx, c.labID)
+		job, err := labService.StartPlagiarismCheck(ctx, c.labID, teacherID, nil)
 		require.Equal(t, c.expectedError, err, c.label)
-
 		if err != nil {
 			continue
 		}
+
+		require.Eventually(t, func() bool {
+			job, err = labService.GetPlagiarismJob(ctx, job.ID)
+			require.NoError(t, err)
+			return job.Status != model.PlagiarismJobStatusQueued && job.Status != model.PlagiarismJobStatusRunning
+		}, time.Minute, time.Second, c.label)
+		require.Equal(t, c.expectedStatus, job.Status, "%s: %s", c.label, job.Error)
+		if job.Status == model.PlagiarismJobStatusDone {
+			require.NotZero(t, job.DetectionReportID, c.label)
+		}
+
 		_, err = labService.PlagiarismDetectionClient.RemoveTestFilesForDuplicateCheck(ctx, &pb.Empty{})
 		require.NoError(t, err)
 	}
@@ -1045,10 +1084,11 @@ func TestViewPerviousDetection(t *testing.T) {
 	require.NoError(t, err)
 
 	var comparision = pb.DuplicateCheckResponse_DuplicateCheckResponseValue{
-		Comparisions: []*pb.DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion{{
-			UserId:        1,
-			AnotherUserId: 2,
-		}},
+		// 相似度为百分比乘以 100
+		Comparisions: []*pb.DuplicateCheckResponse_DuplicateCheckResponseValue_Comparsion{
+			{UserId: 1, AnotherUserId: 2, Similarity: 5700},
+			{UserId: 1, AnotherUserId: 3, Similarity: 3000},
+		},
 	}
 	data, err := proto.Marshal(&comparision)
 	require.NoError(t, err)
@@ -1069,7 +1109,19 @@ func TestViewPerviousDetection(t *testing.T) {
 		resp, err := labService.ViewPerviousDetection(ctx, c.detectionReportID, c.teacherID, "")
 		require.Equal(t, c.expectedError, err, c.label)
 		if err == nil {
-			require.Len(t, resp, 1, c.label)
+			require.Len(t, resp, 2, c.label)
+			require.Equal(t, "57.00", resp[0].Similarity, c.label)
+			require.Equal(t, "30.00", resp[1].Similarity, c.label)
+		}
+
+		graph, err := labService.ViewDetectionGraph(ctx, c.detectionReportID, c.teacherID, "", DefaultPlagiarismClusterThreshold)
+		require.Equal(t, c.expectedError, err, c.label)
+		if err == nil {
+			// 只有相似度不低于 0.5 的组合连成一组
+			require.Len(t, graph.Clusters, 1, c.label)
+			require.Equal(t, []string{"1", "2"}, graph.Clusters[0].Members, c.label)
+			require.Equal(t, 0.57, graph.Clusters[0].MaxSimilarity, c.label)
+			require.Len(t, graph.Graph.Edges, 1, c.label)
 		}
 	}
 }
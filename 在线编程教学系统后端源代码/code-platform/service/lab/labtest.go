@@ -0,0 +1,448 @@
+package lab
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	idepb "code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/junitx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/bytedance/sonic"
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	LabTestRunStatusRunning = "running"
+	LabTestRunStatusPassed  = "passed"
+	LabTestRunStatusFailed  = "failed"
+	LabTestRunStatusError   = "error"
+)
+
+// labTestRunTimeout 包括在 IDE 服务排队等待的时间，超过后仍未结束的运行视为服务重启后的遗留记录
+const labTestRunTimeout = 15 * time.Minute
+
+func (l *LabService) GetLabTest(ctx context.Context, labID uint64) (*LabTest, error) {
+	labTest, err := model.QueryLabTestByLabID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab test is not found by labID[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab test by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	return &LabTest{
+		UpdatedAt:      labTest.UpdatedAt,
+		Command:        labTest.Command,
+		TestsHash:      labTest.TestsHash,
+		LabID:          labTest.LabID,
+		TimeoutSeconds: labTest.TimeoutSeconds,
+		FullScore:      labTest.FullScore,
+		Language:       labTest.Language,
+		AutoScore:      labTest.AutoScore,
+	}, nil
+}
+
+// UpdateLabTest 更新测试命令与评分方式，command 为空时不允许运行测试
+func (l *LabService) UpdateLabTest(ctx context.Context, labID uint64, command string, language int8, timeoutSeconds, fullScore int32, autoScore bool) error {
+	switch _, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID); err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return errorx.InternalErr(err)
+	}
+
+	now := time.Now()
+	labTest := &model.LabTest{
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		Command:        command,
+		LabID:          labID,
+		TimeoutSeconds: timeoutSeconds,
+		FullScore:      fullScore,
+		Language:       language,
+		AutoScore:      autoScore,
+	}
+	if err := labTest.Upsert(ctx, l.Dao.Storage.RDB); err != nil {
+		l.Logger.Errorf(err, "upsert lab test %+v failed", labTest)
+		return errorx.InternalErr(err)
+	}
+	return nil
+}
+
+// UpdateLabTestFiles 更新实验的隐藏测试文件，由 IDE 服务校验解压后再保存至 MinIO，
+// 运行测试时覆盖到工作区副本上，学生无法查看
+func (l *LabService) UpdateLabTestFiles(ctx context.Context, labID uint64, file io.ReadSeeker, size int64) (*LabTestFilesResult, error) {
+	switch _, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID); err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	var previousObject string
+	switch labTest, err := model.QueryLabTestByLabID(ctx, l.Dao.Storage.RDB, labID); err {
+	case nil:
+		previousObject = labTest.TestsObject
+	case sql.ErrNoRows:
+	default:
+		l.Logger.Errorf(err, "query lab test by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	resp, err := l.putLabTests(ctx, labID, file)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		l.Logger.Debugf("tests of lab[%d] are invalid: %v", labID, err)
+		return nil, errorx.ErrUnsupportFileType
+	case codes.FailedPrecondition:
+		return nil, errorx.ErrFileTooLarge
+	case codes.Canceled:
+		return nil, context.Canceled
+	default:
+		l.Logger.Errorf(err, "put tests of lab[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	object := define.GetTestsObjectName(labID, resp.Sha256)
+	bucketName := l.Dao.Storage.Minio.TemplateBucketName()
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		l.Logger.Errorf(err, "seek tests of lab[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	if _, err := l.Dao.Storage.Minio.PutObject(ctx, bucketName, object, file, size, minio.PutObjectOptions{ContentType: "application/zip"}); err != nil {
+		l.Logger.Errorf(err, "put tests object %q failed", object)
+		return nil, errorx.InternalErr(err)
+	}
+	if err := model.UpsertLabTestFiles(ctx, l.Dao.Storage.RDB, labID, object, resp.Sha256, time.Now()); err != nil {
+		l.Logger.Errorf(err, "update tests of lab[%d] to %q failed", labID, object)
+		return nil, errorx.InternalErr(err)
+	}
+	if previousObject != "" && previousObject != object {
+		if err := l.Dao.Storage.Minio.RemoveObject(ctx, bucketName, previousObject, minio.RemoveObjectOptions{}); err != nil {
+			l.Logger.Errorf(err, "remove previous tests object %q failed", previousObject)
+		}
+	}
+
+	return &LabTestFilesResult{Hash: resp.Sha256, FileCount: resp.FileCount}, nil
+}
+
+func (l *LabService) putLabTests(ctx context.Context, labID uint64, file io.Reader) (*idepb.PutLabTestsResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := l.IDEClient.PutLabTests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := sendLabZip(stream, labID, file); err != nil {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
+
+// RunLabTests 对学生工作区发起一次测试运行并立即返回运行记录，结果通过 GetLabTestRun 轮询；
+// 开启自动评分时，截止前学生发起的运行与教师发起的运行会更新实验成绩
+func (l *LabService) RunLabTests(ctx context.Context, labID, studentID, userID uint64) (*LabTestRun, error) {
+	if err := l.authWorkspace(ctx, labID, studentID, userID, false); err != nil {
+		return nil, err
+	}
+
+	labTest, err := model.QueryLabTestByLabID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab test is not found by labID[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab test by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	if labTest.Command == "" {
+		l.Logger.Debugf("command of lab test[%d] is empty", labID)
+		return nil, errorx.ErrIsNotFound
+	}
+
+	lab, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID)
+	if err != nil {
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	// 测试在课程的自定义 Theia 镜像中运行，与学生 IDE 中的环境一致
+	image, err := model.QueryReadyCourseImageTag(ctx, l.Dao.Storage.RDB, lab.CourseID, define.ImageBaseTheia, labTest.Language)
+	if err != nil {
+		l.Logger.Errorf(err, "query ready image of course[%d] failed", lab.CourseID)
+		return nil, errorx.InternalErr(err)
+	}
+	labSubmit, err := model.QueryLabSubmitByLabIDAndUserID(ctx, l.Dao.Storage.RDB, labID, studentID)
+	if err != nil {
+		l.Logger.Errorf(err, "query lab submit by labID[%d] and studentID[%d] failed", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	now := time.Now()
+	if err := model.ReleaseStaleLabTestRun(ctx, l.Dao.Storage.RDB, labSubmit.ID, now.Add(-labTestRunTimeout), LabTestRunStatusError, "测试运行超时未结束"); err != nil {
+		l.Logger.Errorf(err, "release stale lab test run by labSubmitID[%d] failed", labSubmit.ID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	run := &model.LabTestRun{
+		CreatedAt:   now,
+		Status:      LabTestRunStatusRunning,
+		TestsHash:   labTest.TestsHash,
+		LabSubmitID: labSubmit.ID,
+		LabID:       labID,
+		UserID:      studentID,
+		TriggeredBy: userID,
+	}
+	inserted, err := run.Insert(ctx, l.Dao.Storage.RDB)
+	if err != nil {
+		l.Logger.Errorf(err, "insert lab test run %+v failed", run)
+		return nil, errorx.InternalErr(err)
+	}
+	if !inserted {
+		return nil, errorx.ErrTestRunning
+	}
+
+	ended := lab.DeadLine.Valid && now.After(lab.DeadLine.Time)
+	updateScore := labTest.AutoScore && (userID != studentID || !ended)
+	// 运行可能长达数分钟，不能随请求取消
+	go l.executeTestRun(labTest, *run, image, updateScore)
+
+	return labTestRunModelToService(run, false, false), nil
+}
+
+func (l *LabService) executeTestRun(labTest *model.LabTest, run model.LabTestRun, image string, updateScore bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), labTestRunTimeout)
+	resp, err := l.runWorkspaceTests(ctx, labTest, &run, image)
+	cancel()
+
+	run.FinishedAt = sql.NullTime{Valid: true, Time: time.Now()}
+	if err != nil {
+		l.Logger.Errorf(err, "run tests of lab[%d] for student[%d] failed", run.LabID, run.UserID)
+		run.Status = LabTestRunStatusError
+		run.Output = "测试运行失败: " + status.Convert(err).Message()
+	} else {
+		l.applyTestResult(labTest, &run, resp)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := run.Finish(ctx, l.Dao.Storage.RDB); err != nil {
+		l.Logger.Errorf(err, "finish lab test run[%d] failed", run.ID)
+		return
+	}
+	if updateScore && run.Score.Valid {
+		if err := model.UpdateLabSubmitAutoScore(ctx, l.Dao.Storage.RDB, run.LabSubmitID, run.Score.Int32, run.FinishedAt.Time); err != nil {
+			l.Logger.Errorf(err, "update score of lab submit[%d] to %d failed", run.LabSubmitID, run.Score.Int32)
+		}
+	}
+}
+
+// runWorkspaceTests IDE 服务上没有对应版本的隐藏测试文件时，从 MinIO 重新上传当前版本后重试一次
+func (l *LabService) runWorkspaceTests(ctx context.Context, labTest *model.LabTest, run *model.LabTestRun, image string) (*idepb.RunWorkspaceTestsResponse, error) {
+	req := &idepb.RunWorkspaceTestsRequest{
+		LabId:          run.LabID,
+		StudentId:      run.UserID,
+		Command:        labTest.Command,
+		Language:       int32(labTest.Language),
+		TimeoutSeconds: labTest.TimeoutSeconds,
+		TestsSha256:    run.TestsHash,
+		Image:          image,
+	}
+	resp, err := l.IDEClient.RunWorkspaceTests(ctx, req)
+	if status.Code(err) != codes.FailedPrecondition {
+		return resp, err
+	}
+
+	hash, err := l.restoreLabTests(ctx, run.LabID)
+	if err != nil {
+		return nil, err
+	}
+	run.TestsHash, req.TestsSha256 = hash, hash
+	return l.IDEClient.RunWorkspaceTests(ctx, req)
+}
+
+func (l *LabService) restoreLabTests(ctx context.Context, labID uint64) (string, error) {
+	labTest, err := model.QueryLabTestByLabID(ctx, l.Dao.Storage.RDB, labID)
+	if err != nil {
+		return "", err
+	}
+	if labTest.TestsObject == "" {
+		return "", nil
+	}
+
+	object, err := l.Dao.Storage.Minio.GetObject(ctx, l.Dao.Storage.Minio.TemplateBucketName(), labTest.TestsObject, minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+	resp, err := l.putLabTests(ctx, labID, object)
+	if err != nil {
+		return "", err
+	}
+	if resp.Sha256 != labTest.TestsHash {
+		return "", fmt.Errorf("tests object %q has sha256 %s, want %s", labTest.TestsObject, resp.Sha256, labTest.TestsHash)
+	}
+	return resp.Sha256, nil
+}
+
+// applyTestResult 超时或没有可解析的报告时视为运行出错，不计分；
+// 自动评分按通过用例占非跳过用例的比例折算
+func (l *LabService) applyTestResult(labTest *model.LabTest, run *model.LabTestRun, resp *idepb.RunWorkspaceTestsResponse) {
+	var report junitx.Report
+	for _, r := range resp.Reports {
+		if err := report.Add(r.Data); err != nil {
+			l.Logger.Debugf("parse report %q of lab test run[%d] failed: %v", r.Name, run.ID, err)
+		}
+	}
+
+	run.ExitCode = resp.ExitCode
+	run.TimedOut = resp.TimedOut
+	run.DurationMs = resp.DurationMs
+	run.Output = resp.Output
+	run.Total = int32(report.Total())
+	run.Passed = int32(report.Passed)
+	run.Failed = int32(report.Failed)
+	run.Errors = int32(report.Errors)
+	run.Skipped = int32(report.Skipped)
+	if len(report.Cases) != 0 {
+		data, err := sonic.Marshal(report.Cases)
+		if err != nil {
+			l.Logger.Errorf(err, "marshal cases of lab test run[%d] failed", run.ID)
+		}
+		run.Cases = string(data)
+	}
+
+	switch {
+	case resp.TimedOut, report.Total() == 0:
+		run.Status = LabTestRunStatusError
+		return
+	case report.Failed+report.Errors != 0:
+		run.Status = LabTestRunStatusFailed
+	default:
+		run.Status = LabTestRunStatusPassed
+	}
+	if graded := report.Passed + report.Failed + report.Errors; labTest.AutoScore && graded != 0 {
+		run.Score = sql.NullInt32{Valid: true, Int32: labTest.FullScore * int32(report.Passed) / int32(graded)}
+	}
+}
+
+// ListLabTestRuns 按发起时间倒序列出学生工作区的测试运行
+func (l *LabService) ListLabTestRuns(ctx context.Context, labID, studentID, userID uint64, offset, limit int) (*PageResponse, error) {
+	if err := l.authWorkspace(ctx, labID, studentID, userID, false); err != nil {
+		return nil, err
+	}
+	labSubmit, err := model.QueryLabSubmitByLabIDAndUserID(ctx, l.Dao.Storage.RDB, labID, studentID)
+	if err != nil {
+		l.Logger.Errorf(err, "query lab submit by labID[%d] and studentID[%d] failed", labID, studentID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	total, err := model.QueryTotalAmountLabTestRunsByLabSubmitID(ctx, l.Dao.Storage.RDB, labSubmit.ID)
+	if err != nil {
+		l.Logger.Errorf(err, "query total amount of lab test runs by labSubmitID[%d] failed", labSubmit.ID)
+		return nil, errorx.InternalErr(err)
+	}
+	runs, err := model.QueryLabTestRunsByLabSubmitID(ctx, l.Dao.Storage.RDB, labSubmit.ID, offset, limit)
+	if err != nil {
+		l.Logger.Errorf(err, "query lab test runs by labSubmitID[%d] failed", labSubmit.ID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	records := make([]*LabTestRun, len(runs))
+	for index, run := range runs {
+		records[index] = labTestRunModelToService(run, false, false)
+	}
+	return &PageResponse{
+		Records:  records,
+		PageInfo: &PageInfo{Total: total},
+	}, nil
+}
+
+// GetLabTestRun 获取一次运行的输出与用例结果；测试文件对学生隐藏，学生只能看到各用例是否通过，不返回输出与失败信息
+func (l *LabService) GetLabTestRun(ctx context.Context, runID, userID uint64) (*LabTestRun, error) {
+	run, err := model.QueryLabTestRunByID(ctx, l.Dao.Storage.RDB, runID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab test run is not found by id[%d]", runID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab test run by id[%d] failed", runID)
+		return nil, errorx.InternalErr(err)
+	}
+	if err := l.authWorkspace(ctx, run.LabID, run.UserID, userID, false); err != nil {
+		return nil, err
+	}
+	return labTestRunModelToService(run, true, userID == run.UserID), nil
+}
+
+// ListLatestLabTestRuns 列出实验下每个学生最近一次测试运行
+func (l *LabService) ListLatestLabTestRuns(ctx context.Context, labID uint64) ([]*LabTestRun, error) {
+	runs, err := model.QueryLatestLabTestRunsByLabID(ctx, l.Dao.Storage.RDB, labID)
+	if err != nil {
+		l.Logger.Errorf(err, "query latest lab test runs by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	records := make([]*LabTestRun, len(runs))
+	for index, run := range runs {
+		records[index] = labTestRunModelToService(run, false, false)
+	}
+	return records, nil
+}
+
+func labTestRunModelToService(run *model.LabTestRun, withDetail, redacted bool) *LabTestRun {
+	result := &LabTestRun{
+		CreatedAt:   run.CreatedAt,
+		FinishedAt:  run.FinishedAt.Time,
+		Status:      run.Status,
+		ID:          run.ID,
+		LabID:       run.LabID,
+		UserID:      run.UserID,
+		TriggeredBy: run.TriggeredBy,
+		DurationMs:  run.DurationMs,
+		Total:       run.Total,
+		Passed:      run.Passed,
+		Failed:      run.Failed,
+		Errors:      run.Errors,
+		Skipped:     run.Skipped,
+		Score:       run.Score.Int32,
+		ExitCode:    run.ExitCode,
+		Scored:      run.Score.Valid,
+		TimedOut:    run.TimedOut,
+	}
+	if !withDetail {
+		return result
+	}
+	if run.Cases != "" {
+		// 写入时由 sonic 序列化，解析失败仅缺少用例详情
+		_ = sonic.Unmarshal([]byte(run.Cases), &result.Cases)
+	}
+	if redacted {
+		for _, c := range result.Cases {
+			c.Message, c.Detail = "", ""
+		}
+		return result
+	}
+	result.Output = run.Output
+	return result
+}
@@ -0,0 +1,115 @@
+package lab_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/lab"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLabTests(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit", "lab_test", "lab_test_run")
+	now := time.Now()
+
+	const studentID = 1
+
+	l := &model.Lab{CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err := l.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: l.ID, UserID: studentID, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	_, err = labService.RunLabTests(ctx, l.ID, studentID, studentID)
+	assert.Equal(t, errorx.ErrIsNotFound, err)
+
+	err = labService.WriteWorkspaceFile(ctx, l.ID, studentID, "main.py", "def add(a, b):\n    return a + b\n\ndef sub(a, b):\n    return a + b\n")
+	require.NoError(t, err)
+	// 学生的同名测试文件会被隐藏测试文件覆盖
+	err = labService.WriteWorkspaceFile(ctx, l.ID, studentID, "test_main.py", "def test_add():\n    pass\n")
+	require.NoError(t, err)
+
+	err = labService.UpdateLabTest(ctx, l.ID, "python3 -m pytest -q --junitxml=$JUNIT_DIR/report.xml", 0, 60, 100, true)
+	require.NoError(t, err)
+	file := newTemplateZip(t, map[string]string{
+		"test_main.py": "from main import add, sub\n\ndef test_add():\n    assert add(1, 2) == 3\n\ndef test_sub():\n    assert sub(3, 1) == 2\n",
+	})
+	files, err := labService.UpdateLabTestFiles(ctx, l.ID, file, file.Size())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), files.FileCount)
+
+	run, err := labService.RunLabTests(ctx, l.ID, studentID, studentID)
+	require.NoError(t, err)
+	assert.Equal(t, lab.LabTestRunStatusRunning, run.Status)
+	assert.Equal(t, files.Hash, func() string {
+		labTest, err := labService.GetLabTest(ctx, l.ID)
+		require.NoError(t, err)
+		return labTest.TestsHash
+	}())
+
+	_, err = labService.RunLabTests(ctx, l.ID, studentID, studentID)
+	assert.Equal(t, errorx.ErrTestRunning, err)
+
+	require.Eventually(t, func() bool {
+		run, err = labService.GetLabTestRun(ctx, run.ID, studentID)
+		require.NoError(t, err)
+		return run.Status != lab.LabTestRunStatusRunning
+	}, 2*time.Minute, time.Second)
+
+	assert.Equal(t, lab.LabTestRunStatusFailed, run.Status, run.Output)
+	assert.Equal(t, int32(2), run.Total)
+	assert.Equal(t, int32(1), run.Passed)
+	assert.Equal(t, int32(1), run.Failed)
+	assert.True(t, run.Scored)
+	assert.Equal(t, int32(50), run.Score)
+	require.Len(t, run.Cases, 2)
+	// 学生看不到隐藏测试的输出与失败信息
+	assert.Empty(t, run.Output)
+	for _, c := range run.Cases {
+		assert.Empty(t, c.Message)
+		assert.Empty(t, c.Detail)
+	}
+
+	labSubmit, err := model.QueryLabSubmitByLabIDAndUserID(ctx, testStorage.RDB, l.ID, studentID)
+	require.NoError(t, err)
+	assert.Equal(t, sql.NullInt32{Valid: true, Int32: 50}, labSubmit.Score)
+
+	// 教师评定的成绩不被之后的自动评分覆盖
+	require.NoError(t, labService.UpdateScore(ctx, studentID, l.ID, 90))
+	run, err = labService.RunLabTests(ctx, l.ID, studentID, studentID)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		run, err = labService.GetLabTestRun(ctx, run.ID, studentID)
+		require.NoError(t, err)
+		return run.Status != lab.LabTestRunStatusRunning
+	}, 2*time.Minute, time.Second)
+	labSubmit, err = model.QueryLabSubmitByLabIDAndUserID(ctx, testStorage.RDB, l.ID, studentID)
+	require.NoError(t, err)
+	assert.Equal(t, sql.NullInt32{Valid: true, Int32: 90}, labSubmit.Score)
+
+	page, err := labService.ListLabTestRuns(ctx, l.ID, studentID, studentID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, page.PageInfo.Total)
+
+	latest, err := labService.ListLatestLabTestRuns(ctx, l.ID)
+	require.NoError(t, err)
+	require.Len(t, latest, 1)
+	assert.Equal(t, run.ID, latest[0].ID)
+
+	// 其他学生无权查看
+	_, err = labService.GetLabTestRun(ctx, run.ID, studentID+1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,483 @@
+package lab
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/parallelx"
+	"code-platform/pkg/slicex"
+	"code-platform/pkg/transactionx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/scheduler"
+	"code-platform/storage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	plagiarismCheckJobName = "lab.plagiarism_check"
+	plagiarismCheckTimeout = 30 * time.Minute
+	// plagiarismCancelPollInterval 执行中检查任务是否已被取消的间隔，加载提交期间没有进度更新
+	plagiarismCancelPollInterval = 2 * time.Second
+)
+
+// errPlagiarismJobCanceled 写入结果前任务已被取消，回滚已写入的报告
+var errPlagiarismJobCanceled = errors.New("plagiarism job has been canceled")
+
+// RegisterJobs 注册实验的后台任务，由调度器在持有租约的实例上执行
+func (l *LabService) RegisterJobs(s *scheduler.SchedulerService) {
+	l.Scheduler = s
+	// 查重服务重启等暂时的失败重试两次，重试前任务回到排队中
+	s.Register(scheduler.Job{
+		Name:        plagiarismCheckJobName,
+		Timeout:     plagiarismCheckTimeout,
+		Backoff:     30 * time.Second,
+		MaxAttempts: 3,
+		Handler:     l.runPlagiarismCheck,
+		OnFailure:   l.failPlagiarismCheck,
+	})
+	s.Register(scheduler.Job{
+		Name:        reportPlagiarismJobName,
+		Timeout:     reportPlagiarismTimeout,
+		Backoff:     30 * time.Second,
+		MaxAttempts: 3,
+		Handler:     l.runReportPlagiarismCheck,
+		OnFailure:   l.failPlagiarismCheck,
+	})
+}
+
+func plagiarismLanguage(courseLanguage int8) pb.Language {
+	switch courseLanguage {
+	case 0:
+		return pb.Language_python3
+	case 1:
+		return pb.Language_cpp
+	default:
+		return pb.Language_java
+	}
+}
+
+// saveDetectionReport 以查重服务生成报告的时间作为报告的创建时间
+func (l *LabService) saveDetectionReport(ctx context.Context, rdbClient storage.RDBClient, labID uint64, resp *pb.DuplicateCheckResponse) (*model.DetectionReport, error) {
+	data, err := proto.Marshal(resp.GetComparision())
+	if err != nil {
+		l.Logger.Errorf(err, "marshal for resp %v failed", resp)
+		return nil, errorx.InternalErr(err)
+	}
+	timeStamp, err := strconv.ParseInt(resp.GetTimeStamp(), 10, 64)
+	if err != nil {
+		l.Logger.Errorf(err, "parse to int64 for %s failed", resp.GetTimeStamp())
+		return nil, errorx.InternalErr(err)
+	}
+
+	detectionReport := &model.DetectionReport{
+		LabID:     labID,
+		Data:      data,
+		CreatedAt: time.UnixMilli(timeStamp),
+	}
+	if err := detectionReport.Insert(ctx, rdbClient); err != nil {
+		l.Logger.Errorf(err, "insert detection_report of labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	return detectionReport, nil
+}
+
+// StartPlagiarismCheck 提交后台查重，referenceLabIDs 为作为参考的本教师或同一课程编号的其他实验；
+// 实验已有排队或执行中的查重时返回该任务
+func (l *LabService) StartPlagiarismCheck(ctx context.Context, labID, teacherID uint64, referenceLabIDs []uint64) (*PlagiarismJob, error) {
+	courseID, err := model.QueryCourseIDByLabID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id(%d) failed", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by labID(%d) failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	course, err := model.QueryCourseByID(ctx, l.Dao.Storage.RDB, courseID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("course is not found by courseID(%d) failed", courseID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query course by courseID(%d) failed", courseID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	referenceLabIDs, err = l.checkReferenceLabs(ctx, labID, teacherID, course, referenceLabIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &model.PlagiarismJob{
+		LabID:           labID,
+		Kind:            model.PlagiarismJobKindCode,
+		Language:        course.Language,
+		ReferenceLabIDs: joinLabIDs(referenceLabIDs),
+		CreatedBy:       teacherID,
+		CreatedAt:       time.Now(),
+	}
+	return l.enqueuePlagiarismJob(ctx, job, plagiarismCheckJobName)
+}
+
+// enqueuePlagiarismJob 插入任务并提交给调度器，实验已有同类排队或执行中的任务时返回该任务
+func (l *LabService) enqueuePlagiarismJob(ctx context.Context, job *model.PlagiarismJob, jobName string) (*PlagiarismJob, error) {
+	task := func(ctx context.Context, tx storage.RDBClient) error {
+		inserted, err := job.Insert(ctx, tx)
+		if err != nil {
+			l.Logger.Errorf(err, "insert plagiarism job of labID[%d] failed", job.LabID)
+			return errorx.InternalErr(err)
+		}
+		if !inserted {
+			active, err := model.QueryActivePlagiarismJobByLabID(ctx, tx, job.LabID, job.Kind)
+			if err != nil {
+				l.Logger.Errorf(err, "query active plagiarism job of labID[%d] failed", job.LabID)
+				return errorx.InternalErr(err)
+			}
+			job = active
+			return nil
+		}
+		payload := []byte(strconv.FormatUint(job.ID, 10))
+		if _, err := l.Scheduler.Enqueue(ctx, tx, jobName, payload, job.CreatedAt, ""); err != nil {
+			l.Logger.Errorf(err, "enqueue plagiarism job[%d] failed", job.ID)
+			return errorx.InternalErr(err)
+		}
+		return nil
+	}
+	if err := transactionx.DoTransaction(ctx, l.Dao.Storage, l.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted}); err != nil {
+		return nil, err
+	}
+	return plagiarismJobModelToDefine(job), nil
+}
+
+// checkReferenceLabs 去重并排除本实验，参考实验的课程需与本课程语言相同，
+// 且属于同一教师，或与本课程有相同的课程编号（同一课程由其他教师任教的教学班）
+func (l *LabService) checkReferenceLabs(ctx context.Context, labID, teacherID uint64, labCourse *model.Course, referenceLabIDs []uint64) ([]uint64, error) {
+	referenceLabIDs = slicex.DistinctUint64Slice(referenceLabIDs)
+	sort.Slice(referenceLabIDs, func(i, j int) bool {
+		return referenceLabIDs[i] < referenceLabIDs[j]
+	})
+
+	checked := make([]uint64, 0, len(referenceLabIDs))
+	for _, referenceLabID := range referenceLabIDs {
+		if referenceLabID == labID {
+			continue
+		}
+		courseID, err := model.QueryCourseIDByLabID(ctx, l.Dao.Storage.RDB, referenceLabID)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			l.Logger.Debugf("reference lab is not found by id(%d)", referenceLabID)
+			return nil, errorx.ErrIsNotFound
+		default:
+			l.Logger.Errorf(err, "query lab by labID(%d) failed", referenceLabID)
+			return nil, errorx.InternalErr(err)
+		}
+		course, err := model.QueryCourseByID(ctx, l.Dao.Storage.RDB, courseID)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			l.Logger.Debugf("course is not found by courseID(%d)", courseID)
+			return nil, errorx.ErrIsNotFound
+		default:
+			l.Logger.Errorf(err, "query course by courseID(%d) failed", courseID)
+			return nil, errorx.InternalErr(err)
+		}
+		sameFamily := labCourse.FamilyCode.Valid && course.FamilyCode == labCourse.FamilyCode
+		if course.TeacherID != teacherID && !sameFamily {
+			return nil, errorx.ErrFailToAuth
+		}
+		if course.Language != labCourse.Language {
+			return nil, errorx.ErrReferenceLabLanguage
+		}
+		checked = append(checked, referenceLabID)
+	}
+	return checked, nil
+}
+
+func joinLabIDs(labIDs []uint64) string {
+	values := make([]string, len(labIDs))
+	for index, labID := range labIDs {
+		values[index] = strconv.FormatUint(labID, 10)
+	}
+	return strings.Join(values, ",")
+}
+
+func splitLabIDs(labIDs string) []uint64 {
+	values := strings.Split(labIDs, ",")
+	ids := make([]uint64, 0, len(values))
+	for _, value := range values {
+		if id, err := strconv.ParseUint(value, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (l *LabService) GetPlagiarismJob(ctx context.Context, jobID uint64) (*PlagiarismJob, error) {
+	job, err := model.QueryPlagiarismJobByID(ctx, l.Dao.Storage.RDB, jobID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("plagiarism job is not found by id[%d]", jobID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query plagiarism job by id[%d] failed", jobID)
+		return nil, errorx.InternalErr(err)
+	}
+	return plagiarismJobModelToDefine(job), nil
+}
+
+func (l *LabService) ListPlagiarismJobsByLabID(ctx context.Context, labID uint64, offset, limit int) (*PageResponse, error) {
+	var (
+		total int
+		jobs  []*model.PlagiarismJob
+	)
+	tasks := []func() error{
+		func() (err error) {
+			total, err = model.QueryTotalAmountPlagiarismJobsByLabID(ctx, l.Dao.Storage.RDB, labID)
+			if err != nil {
+				l.Logger.Errorf(err, "QueryTotalAmountPlagiarismJobsByLabID by labID[%d] failed", labID)
+				return errorx.InternalErr(err)
+			}
+			return nil
+		},
+		func() (err error) {
+			jobs, err = model.QueryPlagiarismJobsByLabID(ctx, l.Dao.Storage.RDB, labID, offset, limit)
+			if err != nil {
+				l.Logger.Errorf(err, "QueryPlagiarismJobsByLabID by labID[%d] failed", labID)
+				return errorx.InternalErr(err)
+			}
+			return nil
+		},
+	}
+	if err := parallelx.Do(l.Logger, tasks...); err != nil {
+		return nil, err
+	}
+
+	records := make([]*PlagiarismJob, len(jobs))
+	for index, job := range jobs {
+		records[index] = plagiarismJobModelToDefine(job)
+	}
+	return &PageResponse{
+		Records:  records,
+		PageInfo: &PageInfo{Total: total},
+	}, nil
+}
+
+// CancelPlagiarismJob 取消排队或执行中的查重，执行中的查重在下一次检查时停止
+func (l *LabService) CancelPlagiarismJob(ctx context.Context, jobID uint64) error {
+	canceled, err := model.FinishPlagiarismJob(ctx, l.Dao.Storage.RDB, jobID, model.PlagiarismJobStatusCanceled, 0, "", time.Now())
+	if err != nil {
+		l.Logger.Errorf(err, "cancel plagiarism job[%d] failed", jobID)
+		return errorx.InternalErr(err)
+	}
+	if !canceled {
+		l.Logger.Debugf("plagiarism job[%d] has finished", jobID)
+		return errorx.ErrPlagiarismJobFinished
+	}
+	return nil
+}
+
+// runPlagiarismCheck 执行查重并随进度更新任务；没有合法提交等不会因重试改变的失败直接结束任务
+func (l *LabService) runPlagiarismCheck(ctx context.Context, payload []byte) error {
+	jobID, err := strconv.ParseUint(string(payload), 10, 64)
+	if err != nil {
+		return err
+	}
+	if err := scheduler.CheckFencing(ctx); err != nil {
+		return err
+	}
+	started, err := model.StartPlagiarismJob(ctx, l.Dao.Storage.RDB, jobID, time.Now())
+	if err != nil {
+		l.Logger.Errorf(err, "start plagiarism job[%d] failed", jobID)
+		return err
+	}
+	if !started {
+		l.Logger.Debugf("plagiarism job[%d] has been canceled", jobID)
+		return nil
+	}
+	job, err := model.QueryPlagiarismJobByID(ctx, l.Dao.Storage.RDB, jobID)
+	if err != nil {
+		l.Logger.Errorf(err, "query plagiarism job by id[%d] failed", jobID)
+		return err
+	}
+
+	request, err := l.newDuplicateCheckRequest(ctx, job.LabID, job.Language, splitLabIDs(job.ReferenceLabIDs))
+	if err != nil {
+		return l.handlePlagiarismCheckErr(ctx, job, err)
+	}
+
+	checkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go l.watchPlagiarismJob(checkCtx, cancel, jobID)
+
+	resp, err := l.duplicateCheckWithProgress(checkCtx, cancel, job, request)
+	if err != nil {
+		return l.handlePlagiarismCheckErr(ctx, job, err)
+	}
+
+	task := func(ctx context.Context, tx storage.RDBClient) error {
+		var reportID uint64
+		if resp.GetComparision() != nil {
+			detectionReport, err := l.saveDetectionReport(ctx, tx, job.LabID, resp)
+			if err != nil {
+				return err
+			}
+			reportID = detectionReport.ID
+		}
+		if _, err := model.UpdatePlagiarismJobProgress(ctx, tx, jobID, 100); err != nil {
+			l.Logger.Errorf(err, "update progress of plagiarism job[%d] failed", jobID)
+			return err
+		}
+		finished, err := model.FinishPlagiarismJob(ctx, tx, jobID, model.PlagiarismJobStatusDone, reportID, "", time.Now())
+		if err != nil {
+			l.Logger.Errorf(err, "finish plagiarism job[%d] failed", jobID)
+			return err
+		}
+		if !finished {
+			return errPlagiarismJobCanceled
+		}
+		return nil
+	}
+	err = transactionx.DoTransaction(ctx, l.Dao.Storage, l.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err == errPlagiarismJobCanceled {
+		l.Logger.Debugf("plagiarism job[%d] has been canceled", jobID)
+		return nil
+	}
+	return err
+}
+
+// duplicateCheckWithProgress 进度变化时写入任务，任务已不在执行中时取消查重
+func (l *LabService) duplicateCheckWithProgress(ctx context.Context, cancel context.CancelFunc, job *model.PlagiarismJob, request *pb.DuplicateCheckRequest) (*pb.DuplicateCheckResponse, error) {
+	stream, err := l.PlagiarismDetectionClient.DuplicateCheckWithProgress(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var progress int8
+	for {
+		output, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = errors.New("duplicate check finished without result")
+			}
+			return nil, err
+		}
+		if output.GetResult() != nil {
+			return output.GetResult(), nil
+		}
+		if int8(output.GetProgress()) == progress {
+			continue
+		}
+		progress = int8(output.GetProgress())
+		running, err := model.UpdatePlagiarismJobProgress(ctx, l.Dao.Storage.RDB, job.ID, progress)
+		if err != nil {
+			// 进度写入失败不影响查重
+			l.Logger.Errorf(err, "update progress of plagiarism job[%d] failed", job.ID)
+			continue
+		}
+		if !running {
+			cancel()
+		}
+	}
+}
+
+// watchPlagiarismJob 任务被取消后结束 ctx
+func (l *LabService) watchPlagiarismJob(ctx context.Context, cancel context.CancelFunc, jobID uint64) {
+	ticker := time.NewTicker(plagiarismCancelPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		job, err := model.QueryPlagiarismJobByID(ctx, l.Dao.Storage.RDB, jobID)
+		if err != nil {
+			if ctx.Err() == nil {
+				l.Logger.Errorf(err, "query plagiarism job by id[%d] failed", jobID)
+			}
+			continue
+		}
+		if job.Status != model.PlagiarismJobStatusRunning {
+			cancel()
+			return
+		}
+	}
+}
+
+func (l *LabService) handlePlagiarismCheckErr(ctx context.Context, job *model.PlagiarismJob, err error) error {
+	var errMsg string
+	switch {
+	case err == errorx.ErrIsNotFound:
+		errMsg = "lab is not found"
+	case err == errorx.ErrNotEnoughReports:
+		errMsg = "at least two reports with text are required"
+	case status.Code(err) == codes.DataLoss:
+		errMsg = "no valid submissions: " + status.Convert(err).Message()
+	case status.Code(err) == codes.NotFound:
+		errMsg = "no workspace of the lab is found"
+	case status.Code(err) == codes.InvalidArgument:
+		errMsg = "invalid plagiarism setting: " + status.Convert(err).Message()
+	}
+	if errMsg != "" {
+		l.Logger.Debugf("plagiarism job[%d] failed for %s", job.ID, errMsg)
+		if _, err := model.FinishPlagiarismJob(ctx, l.Dao.Storage.RDB, job.ID, model.PlagiarismJobStatusFailed, 0, errMsg, time.Now()); err != nil {
+			l.Logger.Errorf(err, "finish plagiarism job[%d] failed", job.ID)
+			return err
+		}
+		return nil
+	}
+
+	// 取消的任务已结束，不再重试
+	if latest, queryErr := model.QueryPlagiarismJobByID(ctx, l.Dao.Storage.RDB, job.ID); queryErr == nil && latest.Status == model.PlagiarismJobStatusCanceled {
+		l.Logger.Debugf("plagiarism job[%d] has been canceled", job.ID)
+		return nil
+	}
+	if _, requeueErr := model.RequeuePlagiarismJob(ctx, l.Dao.Storage.RDB, job.ID, err.Error()); requeueErr != nil {
+		l.Logger.Errorf(requeueErr, "requeue plagiarism job[%d] failed", job.ID)
+	}
+	return err
+}
+
+// failPlagiarismCheck 重试次数用尽或执行中断后结束任务，代码与实验报告查重共用
+func (l *LabService) failPlagiarismCheck(ctx context.Context, payload []byte, err error) {
+	jobID, parseErr := strconv.ParseUint(string(payload), 10, 64)
+	if parseErr != nil {
+		return
+	}
+	if _, finishErr := model.FinishPlagiarismJob(ctx, l.Dao.Storage.RDB, jobID, model.PlagiarismJobStatusFailed, 0, err.Error(), time.Now()); finishErr != nil {
+		l.Logger.Errorf(finishErr, "finish plagiarism job[%d] failed", jobID)
+	}
+}
+
+func plagiarismJobModelToDefine(job *model.PlagiarismJob) *PlagiarismJob {
+	return &PlagiarismJob{
+		CreatedAt:         job.CreatedAt,
+		StartedAt:         job.StartedAt.Time,
+		FinishedAt:        job.FinishedAt.Time,
+		Error:             job.Error,
+		ReferenceLabIDs:   splitLabIDs(job.ReferenceLabIDs),
+		ID:                job.ID,
+		LabID:             job.LabID,
+		DetectionReportID: job.DetectionReportID,
+		ReportDetectionID: job.ReportDetectionID,
+		CreatedBy:         job.CreatedBy,
+		Kind:              job.Kind,
+		Status:            job.Status,
+		Progress:          job.Progress,
+	}
+}
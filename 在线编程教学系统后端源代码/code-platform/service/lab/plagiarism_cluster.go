@@ -0,0 +1,77 @@
+package lab
+
+import (
+	"strconv"
+
+	"code-platform/pkg/plagiarism"
+)
+
+// DefaultPlagiarismClusterThreshold 未指定阈值时，相似度不低于该值的组合连成一组
+const DefaultPlagiarismClusterThreshold = 0.5
+
+// plagiarismNodeID 参考实验中的提交带上实验 ID，与本实验的同一学生区分
+func plagiarismNodeID(userID, referenceLabID uint64) string {
+	if referenceLabID == 0 {
+		return strconv.FormatUint(userID, 10)
+	}
+	return strconv.FormatUint(referenceLabID, 10) + "/" + strconv.FormatUint(userID, 10)
+}
+
+// newPlagiarismReport 应在填充学生信息与报告链接之后调用，关系图只包含不低于阈值的组合
+func newPlagiarismReport(comparisons []*PlagiarismCheckResponse, threshold float64) *PlagiarismReport {
+	graph := &PlagiarismGraph{
+		Nodes: []*PlagiarismGraphNode{},
+		Edges: []*PlagiarismGraphEdge{},
+	}
+	nodes := make(map[string]*PlagiarismGraphNode)
+	addNode := func(userID, referenceLabID uint64, realName, num string) string {
+		id := plagiarismNodeID(userID, referenceLabID)
+		if _, ok := nodes[id]; !ok {
+			node := &PlagiarismGraphNode{
+				ID:             id,
+				RealName:       realName,
+				Num:            num,
+				UserID:         userID,
+				ReferenceLabID: referenceLabID,
+				Cluster:        -1,
+			}
+			nodes[id] = node
+			graph.Nodes = append(graph.Nodes, node)
+		}
+		return id
+	}
+
+	var edges []plagiarism.Edge
+	for _, v := range comparisons {
+		if v.similarity < threshold {
+			continue
+		}
+		source := addNode(v.UserID1, 0, v.RealName1, v.Num1)
+		target := addNode(v.UserID2, v.ReferenceLabID, v.RealName2, v.Num2)
+		edges = append(edges, plagiarism.Edge{A: source, B: target, Similarity: v.similarity})
+		graph.Edges = append(graph.Edges, &PlagiarismGraphEdge{
+			Source:     source,
+			Target:     target,
+			URL:        v.URL,
+			Similarity: v.similarity,
+		})
+	}
+
+	clusters := plagiarism.ClusterEdges(edges, threshold)
+	report := &PlagiarismReport{
+		Clusters:  make([]*PlagiarismCluster, len(clusters)),
+		Graph:     graph,
+		Threshold: threshold,
+	}
+	for index, cluster := range clusters {
+		report.Clusters[index] = &PlagiarismCluster{
+			Members:        cluster.Members,
+			MaxSimilarity:  cluster.MaxSimilarity,
+			MeanSimilarity: cluster.MeanSimilarity,
+		}
+		for _, member := range cluster.Members {
+			nodes[member].Cluster = index
+		}
+	}
+	return report
+}
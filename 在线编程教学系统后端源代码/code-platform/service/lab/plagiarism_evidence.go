@@ -0,0 +1,410 @@
+package lab
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	idepb "code-platform/api/grpc/ide/pb"
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/repository/rdb/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PlagiarismEvidence 一组或一对学生的查重证据，由 WriteZip 写出
+type PlagiarismEvidence struct {
+	data plagiarismEvidenceData
+	// reports 与 data.Comparisons 一一对应的 HTML 报告
+	reports []string
+	// files 各学生查重时的代码，键为关系图中节点的 ID
+	files map[string][]*pb.SourceFile
+}
+
+type plagiarismEvidenceData struct {
+	GeneratedAt       time.Time                     `json:"generated_at"`
+	CheckedAt         time.Time                     `json:"checked_at"`
+	LabID             uint64                        `json:"lab_id"`
+	DetectionReportID uint64                        `json:"detection_report_id"`
+	Threshold         float64                       `json:"threshold"`
+	Students          []*plagiarismEvidenceStudent  `json:"students"`
+	Comparisons       []*plagiarismEvidenceCompared `json:"comparisons"`
+}
+
+type plagiarismEvidenceStudent struct {
+	ID             string `json:"id"`
+	RealName       string `json:"real_name"`
+	Num            string `json:"num"`
+	UserID         uint64 `json:"user_id"`
+	ReferenceLabID uint64 `json:"reference_lab_id"`
+	LabID          uint64 `json:"lab_id"`
+	// Files 代码在 zip 中的路径
+	Files []string `json:"files"`
+	// CodingMinutes 该实验的编码时间合计，CodingTimes 为 coding_time 中的原始记录
+	CodingMinutes uint64                          `json:"coding_minutes"`
+	CodingTimes   []*plagiarismEvidenceCodingTime `json:"coding_times"`
+	Snapshots     []*plagiarismEvidenceSnapshot   `json:"snapshots"`
+
+	folder string
+}
+
+type plagiarismEvidenceCodingTime struct {
+	CreatedAt time.Time `json:"created_at"`
+	Date      string    `json:"date"`
+	Minutes   uint32    `json:"minutes"`
+}
+
+type plagiarismEvidenceSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+	Reason    string    `json:"reason"`
+	ID        int64     `json:"id"`
+}
+
+type plagiarismEvidenceCompared struct {
+	Source      string                     `json:"source"`
+	Target      string                     `json:"target"`
+	Report      string                     `json:"report"`
+	Similarity  float64                    `json:"similarity"`
+	SimilarityA float64                    `json:"similarity_a"`
+	SimilarityB float64                    `json:"similarity_b"`
+	Matches     []*plagiarismEvidenceMatch `json:"matches"`
+}
+
+type plagiarismEvidenceMatch struct {
+	A      plagiarismEvidenceRegion `json:"a"`
+	B      plagiarismEvidenceRegion `json:"b"`
+	Tokens int32                    `json:"tokens"`
+}
+
+type plagiarismEvidenceRegion struct {
+	File      string `json:"file"`
+	StartLine int32  `json:"start_line"`
+	EndLine   int32  `json:"end_line"`
+}
+
+type plagiarismEvidenceManifest struct {
+	Algorithm string                            `json:"algorithm"`
+	Files     []*plagiarismEvidenceManifestFile `json:"files"`
+}
+
+type plagiarismEvidenceManifestFile struct {
+	Path   string `json:"path"`
+	Sha256 string `json:"sha256"`
+	Size   int    `json:"size"`
+}
+
+// GetPlagiarismEvidence 收集查重记录中 members 两两之间相似度不低于 threshold 的比对，members 为关系图中节点的 ID。
+// 没有符合条件的比对时返回 errorx.ErrIsNotFound，报告早于比对详情的保存时返回 errorx.ErrReportPredatesEvidence
+func (l *LabService) GetPlagiarismEvidence(ctx context.Context, detectionReportID, teacherID uint64, members []string, threshold float64) (*PlagiarismEvidence, error) {
+	detectionReport, resp, err := l.queryDetection(ctx, detectionReportID, teacherID)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(members))
+	for _, member := range members {
+		selected[member] = true
+	}
+	evidence := &PlagiarismEvidence{
+		data: plagiarismEvidenceData{
+			GeneratedAt:       time.Now(),
+			CheckedAt:         detectionReport.CreatedAt,
+			LabID:             detectionReport.LabID,
+			DetectionReportID: detectionReportID,
+			Threshold:         threshold,
+		},
+		files: make(map[string][]*pb.SourceFile),
+	}
+	students := make(map[string]*plagiarismEvidenceStudent)
+	addStudent := func(id string, userID, referenceLabID uint64, realName, num string, files []*pb.SourceFile) {
+		if _, ok := students[id]; ok {
+			return
+		}
+		student := &plagiarismEvidenceStudent{
+			ID:             id,
+			RealName:       realName,
+			Num:            num,
+			UserID:         userID,
+			ReferenceLabID: referenceLabID,
+			LabID:          detectionReport.LabID,
+			Files:          []string{},
+			folder:         evidenceFolderName(userID, referenceLabID, realName, num),
+		}
+		if referenceLabID != 0 {
+			student.LabID = referenceLabID
+		}
+		for _, file := range files {
+			if name := strings.TrimPrefix(path.Clean("/"+file.GetName()), "/"); name != "" {
+				student.Files = append(student.Files, path.Join("code", student.folder, name))
+			}
+		}
+		students[id] = student
+		evidence.files[id] = files
+		evidence.data.Students = append(evidence.data.Students, student)
+	}
+
+	timeStamp := strconv.FormatInt(detectionReport.CreatedAt.UnixMilli(), 10)
+	for _, v := range resp {
+		source := plagiarismNodeID(v.UserID1, 0)
+		target := plagiarismNodeID(v.UserID2, v.ReferenceLabID)
+		if !selected[source] || !selected[target] || v.similarity < threshold {
+			continue
+		}
+
+		detail, err := l.PlagiarismDetectionClient.ViewComparison(ctx, &pb.ViewReportRequest{
+			LabId:        detectionReport.LabID,
+			TimeStamp:    timeStamp,
+			HtmlFileName: v.htmlFileName,
+		})
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.NotFound:
+			l.Logger.Debugf("detail of report by labID[%d], timeStamp[%s] and fileName[%s] is not found", detectionReport.LabID, timeStamp, v.htmlFileName)
+			return nil, errorx.ErrIsNotFound
+		case codes.FailedPrecondition:
+			l.Logger.Debugf("report by labID[%d] and timeStamp[%s] has no detail", detectionReport.LabID, timeStamp)
+			return nil, errorx.ErrReportPredatesEvidence
+		default:
+			l.Logger.Errorf(err, "get detail of report by labID[%d], timeStamp[%s] and fileName[%s] failed", detectionReport.LabID, timeStamp, v.htmlFileName)
+			return nil, errorx.InternalErr(err)
+		}
+
+		addStudent(source, v.UserID1, 0, v.RealName1, v.Num1, detail.GetFilesA())
+		addStudent(target, v.UserID2, v.ReferenceLabID, v.RealName2, v.Num2, detail.GetFilesB())
+		compared := &plagiarismEvidenceCompared{
+			Source:      source,
+			Target:      target,
+			Report:      fmt.Sprintf("comparisons/%d.html", len(evidence.data.Comparisons)+1),
+			Similarity:  detail.GetSimilarity(),
+			SimilarityA: detail.GetSimilarityA(),
+			SimilarityB: detail.GetSimilarityB(),
+			Matches:     make([]*plagiarismEvidenceMatch, len(detail.GetMatches())),
+		}
+		for index, match := range detail.GetMatches() {
+			compared.Matches[index] = &plagiarismEvidenceMatch{
+				A:      plagiarismEvidenceRegion{File: match.GetA().GetFile(), StartLine: match.GetA().GetStartLine(), EndLine: match.GetA().GetEndLine()},
+				B:      plagiarismEvidenceRegion{File: match.GetB().GetFile(), StartLine: match.GetB().GetStartLine(), EndLine: match.GetB().GetEndLine()},
+				Tokens: match.GetTokens(),
+			}
+		}
+		evidence.data.Comparisons = append(evidence.data.Comparisons, compared)
+		evidence.reports = append(evidence.reports, detail.GetHtmlFileContent())
+	}
+	if len(evidence.data.Comparisons) == 0 {
+		l.Logger.Debugf("no comparison among %v is found in detection report[%d]", members, detectionReportID)
+		return nil, errorx.ErrIsNotFound
+	}
+
+	for _, student := range evidence.data.Students {
+		if err := l.addEvidenceActivity(ctx, student); err != nil {
+			return nil, err
+		}
+	}
+	return evidence, nil
+}
+
+// addEvidenceActivity 补充学生在所属实验中的编码时间与工作区快照，工作区已删除时没有快照
+func (l *LabService) addEvidenceActivity(ctx context.Context, student *plagiarismEvidenceStudent) error {
+	codingTimes, err := model.QueryCodingTimesByLabIDAndUserID(ctx, l.Dao.Storage.RDB, student.LabID, student.UserID)
+	if err != nil {
+		l.Logger.Errorf(err, "query coding times by labID[%d] and userID[%d] failed", student.LabID, student.UserID)
+		return errorx.InternalErr(err)
+	}
+	student.CodingTimes = make([]*plagiarismEvidenceCodingTime, len(codingTimes))
+	for index, codingTime := range codingTimes {
+		student.CodingMinutes += uint64(codingTime.Duration)
+		student.CodingTimes[index] = &plagiarismEvidenceCodingTime{
+			CreatedAt: codingTime.CreatedAt,
+			Date:      codingTime.CreatedAtDate.Format("2006-01-02"),
+			Minutes:   codingTime.Duration,
+		}
+	}
+
+	student.Snapshots = []*plagiarismEvidenceSnapshot{}
+	resp, err := l.IDEClient.ListWorkspaceSnapshots(ctx, &idepb.ListWorkspaceSnapshotsRequest{
+		LabId:     student.LabID,
+		StudentId: student.UserID,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return nil
+	default:
+		l.Logger.Errorf(err, "list snapshots of workspace with labID[%d] and studentID[%d] failed", student.LabID, student.UserID)
+		return errorx.InternalErr(err)
+	}
+	for _, snapshot := range resp.Snapshots {
+		student.Snapshots = append(student.Snapshots, &plagiarismEvidenceSnapshot{
+			CreatedAt: time.UnixMilli(snapshot.Id),
+			Reason:    snapshot.Reason,
+			ID:        snapshot.Id,
+		})
+	}
+	return nil
+}
+
+// evidenceFolderName 学号_姓名，参考实验中的提交以实验 ID 开头
+func evidenceFolderName(userID, referenceLabID uint64, realName, num string) string {
+	if num == "" {
+		num = strconv.FormatUint(userID, 10)
+	}
+	name := fmt.Sprintf("%s_%s", num, realName)
+	if referenceLabID != 0 {
+		name = fmt.Sprintf("lab%d_%s", referenceLabID, name)
+	}
+	return safeFileName(name)
+}
+
+// WriteZip 依次写入各组比对的 HTML 报告、双方代码、evidence.json 与 index.html，最后写入以上文件的 sha256 清单 manifest.json
+func (e *PlagiarismEvidence) WriteZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+	manifest := &plagiarismEvidenceManifest{Algorithm: "sha256"}
+	create := func(name string, data []byte, hashed bool) error {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: e.data.GeneratedAt,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(data); err != nil {
+			return err
+		}
+		if hashed {
+			sum := sha256.Sum256(data)
+			manifest.Files = append(manifest.Files, &plagiarismEvidenceManifestFile{
+				Path:   name,
+				Sha256: hex.EncodeToString(sum[:]),
+				Size:   len(data),
+			})
+		}
+		return nil
+	}
+
+	for index, compared := range e.data.Comparisons {
+		if err := create(compared.Report, []byte(e.reports[index]), true); err != nil {
+			return err
+		}
+	}
+	for _, student := range e.data.Students {
+		for _, file := range e.files[student.ID] {
+			name := strings.TrimPrefix(path.Clean("/"+file.GetName()), "/")
+			if name == "" {
+				continue
+			}
+			if err := create(path.Join("code", student.folder, name), file.GetContent(), true); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := json.MarshalIndent(&e.data, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := create("evidence.json", data, true); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := evidenceIndexTemplate.Execute(&buf, e.newIndexData()); err != nil {
+		return err
+	}
+	if err := create("index.html", buf.Bytes(), true); err != nil {
+		return err
+	}
+
+	data, err = json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := create("manifest.json", data, false); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+type evidenceIndexData struct {
+	Data *plagiarismEvidenceData
+	// Names 节点 ID 对应的学号与姓名
+	Names map[string]string
+}
+
+func (e *PlagiarismEvidence) newIndexData() *evidenceIndexData {
+	names := make(map[string]string, len(e.data.Students))
+	for _, student := range e.data.Students {
+		names[student.ID] = student.Num + " " + student.RealName
+		if student.ReferenceLabID != 0 {
+			names[student.ID] += fmt.Sprintf("（实验 %d）", student.ReferenceLabID)
+		}
+	}
+	return &evidenceIndexData{Data: &e.data, Names: names}
+}
+
+var evidenceIndexTemplate = template.Must(template.New("evidence").Funcs(template.FuncMap{
+	"percent": func(value float64) string {
+		return fmt.Sprintf("%.2f%%", value*100)
+	},
+	"time": func(t time.Time) string {
+		return t.Format("2006-01-02 15:04:05")
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>查重证据 - 实验 {{.Data.LabID}}</title>
+<style>
+body { font-family: sans-serif; margin: 12px; }
+h1 { font-size: 18px; }
+h2 { font-size: 16px; margin-top: 24px; }
+h3 { font-size: 14px; }
+table { border-collapse: collapse; font-size: 13px; }
+th, td { border: 1px solid #ddd; padding: 4px 8px; text-align: left; }
+</style>
+</head>
+<body>
+<h1>查重证据 - 实验 {{.Data.LabID}}</h1>
+<p>查重记录：{{.Data.DetectionReportID}}，查重时间：{{time .Data.CheckedAt}}，导出时间：{{time .Data.GeneratedAt}}，相似度阈值：{{percent .Data.Threshold}}</p>
+<p>文件的 sha256 见 manifest.json，结构化数据见 evidence.json。</p>
+
+<h2>比对</h2>
+<table>
+<tr><th>学生 A</th><th>学生 B</th><th>相似度</th><th>占 A</th><th>占 B</th><th>相同片段</th><th>报告</th></tr>
+{{range .Data.Comparisons}}<tr><td>{{index $.Names .Source}}</td><td>{{index $.Names .Target}}</td><td>{{percent .Similarity}}</td><td>{{percent .SimilarityA}}</td><td>{{percent .SimilarityB}}</td><td>{{len .Matches}}</td><td><a href="{{.Report}}">{{.Report}}</a></td></tr>
+{{end}}</table>
+{{range .Data.Comparisons}}
+<h3>{{index $.Names .Source}} - {{index $.Names .Target}}</h3>
+<table>
+<tr><th>A 文件</th><th>A 行</th><th>B 文件</th><th>B 行</th><th>词法单元</th></tr>
+{{range .Matches}}<tr><td>{{.A.File}}</td><td>{{.A.StartLine}}-{{.A.EndLine}}</td><td>{{.B.File}}</td><td>{{.B.StartLine}}-{{.B.EndLine}}</td><td>{{.Tokens}}</td></tr>
+{{end}}</table>
+{{end}}
+<h2>学生</h2>
+{{range .Data.Students}}
+<h3>{{index $.Names .ID}}</h3>
+<p>实验：{{.LabID}}，编码时间合计：{{.CodingMinutes}} 分钟，快照：{{len .Snapshots}} 个</p>
+<p>代码：{{range .Files}}<a href="{{.}}">{{.}}</a> {{end}}</p>
+<table>
+<tr><th>日期</th><th>编码时间（分钟）</th><th>记录时间</th></tr>
+{{range .CodingTimes}}<tr><td>{{.Date}}</td><td>{{.Minutes}}</td><td>{{time .CreatedAt}}</td></tr>
+{{end}}</table>
+<table>
+<tr><th>快照时间</th><th>原因</th></tr>
+{{range .Snapshots}}<tr><td>{{time .CreatedAt}}</td><td>{{.Reason}}</td></tr>
+{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
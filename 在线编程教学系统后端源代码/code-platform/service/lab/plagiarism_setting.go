@@ -0,0 +1,296 @@
+package lab
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/config"
+	"code-platform/pkg/errorx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/minio/minio-go/v7"
+)
+
+var errPlagiarismBaseTooLarge = errors.New("plagiarism base code is too large")
+
+func (l *LabService) GetPlagiarismSetting(ctx context.Context, labID uint64) (*PlagiarismSetting, error) {
+	setting, err := model.QueryPlagiarismSettingByLabID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		// 未设置时使用默认参数
+		return &PlagiarismSetting{LabID: labID, Ignore: []string{}}, nil
+	default:
+		l.Logger.Errorf(err, "query plagiarism setting by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	return plagiarismSettingModelToDefine(setting), nil
+}
+
+// UpdatePlagiarismSetting 更新最短匹配长度与忽略规则，之后提交的查重生效
+func (l *LabService) UpdatePlagiarismSetting(ctx context.Context, labID uint64, minMatch int32, ignore []string) error {
+	switch _, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID); err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return errorx.InternalErr(err)
+	}
+
+	now := time.Now()
+	setting := &model.PlagiarismSetting{
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		IgnorePatterns: strings.Join(ignore, "\n"),
+		LabID:          labID,
+		MinMatch:       minMatch,
+	}
+	if err := setting.Upsert(ctx, l.Dao.Storage.RDB); err != nil {
+		l.Logger.Errorf(err, "upsert plagiarism setting %+v failed", setting)
+		return errorx.InternalErr(err)
+	}
+	return nil
+}
+
+// UpdatePlagiarismBase 上传实验的基础代码，可为单个源文件或 zip；实验的初始代码模板总是作为基础代码，无需重复上传
+func (l *LabService) UpdatePlagiarismBase(ctx context.Context, labID uint64, fileName string, file io.Reader, size int64) (*PlagiarismSetting, error) {
+	maxBaseSize := config.PlagiarismDetectionServer.GetInt64("max_base_size")
+	if size > maxBaseSize {
+		return nil, errorx.ErrFileTooLarge
+	}
+	switch _, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID); err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	var previousObject string
+	switch setting, err := model.QueryPlagiarismSettingByLabID(ctx, l.Dao.Storage.RDB, labID); err {
+	case nil:
+		previousObject = setting.BaseObject
+	case sql.ErrNoRows:
+	default:
+		l.Logger.Errorf(err, "query plagiarism setting by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, maxBaseSize+1))
+	if err != nil {
+		l.Logger.Errorf(err, "read plagiarism base of lab[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	if int64(len(data)) > maxBaseSize {
+		return nil, errorx.ErrFileTooLarge
+	}
+	switch _, err := readBaseFiles(fileName, data, maxBaseSize); err {
+	case nil:
+	case errPlagiarismBaseTooLarge:
+		return nil, errorx.ErrFileTooLarge
+	default:
+		l.Logger.Debugf("plagiarism base of lab[%d] is invalid: %v", labID, err)
+		return nil, errorx.ErrUnsupportFileType
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	object := define.GetPlagiarismBaseObjectName(labID, hash, strings.ToLower(filepath.Ext(fileName)))
+	bucketName := l.Dao.Storage.Minio.TemplateBucketName()
+	if _, err := l.Dao.Storage.Minio.PutObject(ctx, bucketName, object, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{}); err != nil {
+		l.Logger.Errorf(err, "put plagiarism base object %q failed", object)
+		return nil, errorx.InternalErr(err)
+	}
+	if err := model.UpsertPlagiarismBase(ctx, l.Dao.Storage.RDB, labID, object, path.Base(filepath.ToSlash(fileName)), hash, time.Now()); err != nil {
+		l.Logger.Errorf(err, "update plagiarism base of lab[%d] to %q failed", labID, object)
+		return nil, errorx.InternalErr(err)
+	}
+	if previousObject != "" && previousObject != object {
+		if err := l.Dao.Storage.Minio.RemoveObject(ctx, bucketName, previousObject, minio.RemoveObjectOptions{}); err != nil {
+			l.Logger.Errorf(err, "remove previous plagiarism base object %q failed", previousObject)
+		}
+	}
+
+	return l.GetPlagiarismSetting(ctx, labID)
+}
+
+// RemovePlagiarismBase 移除上传的基础代码，初始代码模板仍作为基础代码
+func (l *LabService) RemovePlagiarismBase(ctx context.Context, labID uint64) error {
+	setting, err := model.QueryPlagiarismSettingByLabID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		return nil
+	default:
+		l.Logger.Errorf(err, "query plagiarism setting by labID[%d] failed", labID)
+		return errorx.InternalErr(err)
+	}
+	if setting.BaseObject == "" {
+		return nil
+	}
+
+	if err := model.UpsertPlagiarismBase(ctx, l.Dao.Storage.RDB, labID, "", "", "", time.Now()); err != nil {
+		l.Logger.Errorf(err, "remove plagiarism base of lab[%d] failed", labID)
+		return errorx.InternalErr(err)
+	}
+	if err := l.Dao.Storage.Minio.RemoveObject(ctx, l.Dao.Storage.Minio.TemplateBucketName(), setting.BaseObject, minio.RemoveObjectOptions{}); err != nil {
+		l.Logger.Errorf(err, "remove plagiarism base object %q failed", setting.BaseObject)
+	}
+	return nil
+}
+
+// newDuplicateCheckRequest 附带实验的比对参数与参考实验，以及上传的基础代码与初始代码模板；
+// 两者合计超出大小上限时不再附带初始代码模板
+func (l *LabService) newDuplicateCheckRequest(ctx context.Context, labID uint64, courseLanguage int8, referenceLabIDs []uint64) (*pb.DuplicateCheckRequest, error) {
+	request := &pb.DuplicateCheckRequest{
+		LabID:           labID,
+		Lan:             plagiarismLanguage(courseLanguage),
+		ReferenceLabIds: referenceLabIDs,
+	}
+
+	lab, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	maxBaseSize := config.PlagiarismDetectionServer.GetInt64("max_base_size")
+	setting, err := model.QueryPlagiarismSettingByLabID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+		request.MinMatch = setting.MinMatch
+		request.Ignore = splitIgnorePatterns(setting.IgnorePatterns)
+		if setting.BaseObject != "" {
+			request.BaseFiles, err = l.loadBaseFiles(ctx, setting.BaseObject, setting.BaseName, maxBaseSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case sql.ErrNoRows:
+	default:
+		l.Logger.Errorf(err, "query plagiarism setting by labID[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	if lab.TemplateObject != "" {
+		var size int64
+		for _, file := range request.BaseFiles {
+			size += int64(len(file.Content))
+		}
+		files, err := l.loadBaseFiles(ctx, lab.TemplateObject, lab.TemplateObject, maxBaseSize-size)
+		switch err {
+		case nil:
+			request.BaseFiles = append(request.BaseFiles, files...)
+		case errPlagiarismBaseTooLarge:
+			l.Logger.Debugf("template of lab[%d] is too large to be base code", labID)
+		default:
+			return nil, err
+		}
+	}
+	return request, nil
+}
+
+// loadBaseFiles 超出 maxSize 时返回 errPlagiarismBaseTooLarge
+func (l *LabService) loadBaseFiles(ctx context.Context, object, fileName string, maxSize int64) ([]*pb.SourceFile, error) {
+	reader, err := l.Dao.Storage.Minio.GetObject(ctx, l.Dao.Storage.Minio.TemplateBucketName(), object, minio.GetObjectOptions{})
+	if err != nil {
+		l.Logger.Errorf(err, "get base object %q failed", object)
+		return nil, errorx.InternalErr(err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		l.Logger.Errorf(err, "read base object %q failed", object)
+		return nil, errorx.InternalErr(err)
+	}
+	files, err := readBaseFiles(fileName, data, maxSize)
+	if err == errPlagiarismBaseTooLarge {
+		return nil, err
+	}
+	if err != nil {
+		l.Logger.Errorf(err, "read base files from %q failed", object)
+		return nil, errorx.InternalErr(err)
+	}
+	return files, nil
+}
+
+// readBaseFiles zip 时返回其中的全部文件，否则作为单个文件；语言不符的文件由查重服务跳过。
+// 文件内容合计超出 maxSize 时返回 errPlagiarismBaseTooLarge
+func readBaseFiles(fileName string, data []byte, maxSize int64) ([]*pb.SourceFile, error) {
+	if !strings.EqualFold(filepath.Ext(fileName), ".zip") {
+		if int64(len(data)) > maxSize {
+			return nil, errPlagiarismBaseTooLarge
+		}
+		return []*pb.SourceFile{{Name: path.Base(filepath.ToSlash(fileName)), Content: data}}, nil
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		files []*pb.SourceFile
+		total int64
+	)
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		// 不信任 zip 中记录的大小
+		content, err := io.ReadAll(io.LimitReader(rc, maxSize-total+1))
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		total += int64(len(content))
+		if total > maxSize {
+			return nil, errPlagiarismBaseTooLarge
+		}
+		files = append(files, &pb.SourceFile{Name: f.Name, Content: content})
+	}
+	return files, nil
+}
+
+func splitIgnorePatterns(ignorePatterns string) []string {
+	if ignorePatterns == "" {
+		return []string{}
+	}
+	return strings.Split(ignorePatterns, "\n")
+}
+
+func plagiarismSettingModelToDefine(setting *model.PlagiarismSetting) *PlagiarismSetting {
+	return &PlagiarismSetting{
+		UpdatedAt: setting.UpdatedAt,
+		BaseName:  setting.BaseName,
+		BaseHash:  setting.BaseHash,
+		Ignore:    splitIgnorePatterns(setting.IgnorePatterns),
+		LabID:     setting.LabID,
+		MinMatch:  setting.MinMatch,
+	}
+}
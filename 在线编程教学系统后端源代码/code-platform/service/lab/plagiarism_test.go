@@ -0,0 +1,221 @@
+package lab_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"code-platform/api/grpc/plagiarismDetection/pb"
+	"code-platform/log"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/scheduler"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlagiarismJob(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "lab", "course", "detection_report", "plagiarism_job", "job_run")
+	schedulerService := scheduler.NewSchedulerService(labService.Dao, log.Sub("scheduler"))
+	labService.RegisterJobs(schedulerService)
+
+	now := time.Now()
+	const teacherID = 1
+	course := &model.Course{TeacherID: teacherID, CreatedAt: now, UpdatedAt: now, Language: 0}
+	require.NoError(t, course.Insert(ctx, testStorage.RDB))
+	lab := &model.Lab{ID: 0, CourseID: course.ID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, lab.Insert(ctx, testStorage.RDB))
+
+	_, err := labService.StartPlagiarismCheck(ctx, 10, teacherID, nil)
+	require.Equal(t, errorx.ErrIsNotFound, err)
+
+	job, err := labService.StartPlagiarismCheck(ctx, 0, teacherID, nil)
+	require.NoError(t, err)
+	require.Equal(t, model.PlagiarismJobStatusQueued, job.Status)
+	// 排队中的查重不重复提交
+	queued, err := labService.StartPlagiarismCheck(ctx, 0, teacherID, nil)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, queued.ID)
+
+	require.NoError(t, labService.CancelPlagiarismJob(ctx, job.ID))
+	require.Equal(t, errorx.ErrPlagiarismJobFinished, labService.CancelPlagiarismJob(ctx, job.ID))
+
+	const code = `def fib(n):
+    if n < 2:
+        return n
+    a, b = 0, 1
+    for _ in range(n - 1):
+        a, b = b, a + b
+    return b
+
+print(fib(int(input())))
+`
+	_, err = labService.PlagiarismDetectionClient.GenerateTestFilesForDuplicateCheck(ctx, &pb.GenerateTestFilesForDuplicateCheckRequest{
+		CodeContent: code,
+		Lan:         pb.Language_python3,
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, err := labService.PlagiarismDetectionClient.RemoveTestFilesForDuplicateCheck(ctx, &pb.Empty{})
+		require.NoError(t, err)
+	}()
+
+	job, err = labService.StartPlagiarismCheck(ctx, 0, teacherID, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, queued.ID, job.ID)
+
+	// 调度器执行排队中的查重并写入报告
+	schedulerService.Start()
+	require.Eventually(t, func() bool {
+		job, err = labService.GetPlagiarismJob(ctx, job.ID)
+		require.NoError(t, err)
+		return job.Status != model.PlagiarismJobStatusQueued && job.Status != model.PlagiarismJobStatusRunning
+	}, time.Minute, time.Second)
+	require.Equal(t, model.PlagiarismJobStatusDone, job.Status, job.Error)
+	require.Equal(t, int8(100), job.Progress)
+
+	resp, err := labService.ViewPerviousDetection(ctx, job.DetectionReportID, teacherID, "")
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	require.Equal(t, "100.00", resp[0].Similarity)
+	// 两名学生的代码相同，连成一组
+	graph, err := labService.ViewDetectionGraph(ctx, job.DetectionReportID, teacherID, "", 0.5)
+	require.NoError(t, err)
+	require.Len(t, graph.Clusters, 1)
+	require.Equal(t, []string{"1", "2"}, graph.Clusters[0].Members)
+	require.Len(t, graph.Graph.Nodes, 2)
+	require.Len(t, graph.Graph.Edges, 1)
+	require.Empty(t, graph.ReferenceSources)
+
+	_, err = labService.GetPlagiarismEvidence(ctx, job.DetectionReportID, teacherID, []string{"1", "3"}, 0)
+	require.Equal(t, errorx.ErrIsNotFound, err)
+	_, err = labService.GetPlagiarismEvidence(ctx, job.DetectionReportID, 10, []string{"1", "2"}, 0)
+	require.Equal(t, errorx.ErrFailToAuth, err)
+	evidence, err := labService.GetPlagiarismEvidence(ctx, job.DetectionReportID, teacherID, []string{"1", "2"}, 0)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, evidence.WriteZip(&buf))
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	names := make([]string, len(zr.File))
+	codeFiles := 0
+	for index, file := range zr.File {
+		names[index] = file.Name
+		if strings.HasPrefix(file.Name, "code/") {
+			codeFiles++
+		}
+	}
+	require.Subset(t, names, []string{"comparisons/1.html", "evidence.json", "index.html", "manifest.json"})
+	// 每份提交只保存一次的代码在导出时补充到双方目录下
+	require.Equal(t, 2, codeFiles)
+
+	jobs, err := labService.ListPlagiarismJobsByLabID(ctx, 0, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, jobs.PageInfo.Total)
+}
+
+func TestPlagiarismSetting(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "lab", "plagiarism_setting")
+	now := time.Now()
+
+	lab := &model.Lab{CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, lab.Insert(ctx, testStorage.RDB))
+
+	// 未设置时为默认参数
+	setting, err := labService.GetPlagiarismSetting(ctx, lab.ID)
+	require.NoError(t, err)
+	require.Zero(t, setting.MinMatch)
+	require.Empty(t, setting.Ignore)
+	require.Empty(t, setting.BaseName)
+
+	require.Equal(t, errorx.ErrIsNotFound, labService.UpdatePlagiarismSetting(ctx, lab.ID+1, 10, nil))
+	require.NoError(t, labService.UpdatePlagiarismSetting(ctx, lab.ID, 10, []string{"vendor/", "*_test.py"}))
+
+	file := newTemplateZip(t, map[string]string{"helper/io.py": "def read_ints():\n    return [int(v) for v in input().split()]\n"})
+	setting, err = labService.UpdatePlagiarismBase(ctx, lab.ID, "base.zip", file, file.Size())
+	require.NoError(t, err)
+	require.Equal(t, "base.zip", setting.BaseName)
+	require.NotEmpty(t, setting.BaseHash)
+	// 上传基础代码不改变比对参数
+	require.Equal(t, int32(10), setting.MinMatch)
+	require.Equal(t, []string{"vendor/", "*_test.py"}, setting.Ignore)
+
+	invalid := bytes.NewReader([]byte("not a zip"))
+	_, err = labService.UpdatePlagiarismBase(ctx, lab.ID, "base.zip", invalid, invalid.Size())
+	require.Equal(t, errorx.ErrUnsupportFileType, err)
+
+	require.NoError(t, labService.RemovePlagiarismBase(ctx, lab.ID))
+	setting, err = labService.GetPlagiarismSetting(ctx, lab.ID)
+	require.NoError(t, err)
+	require.Empty(t, setting.BaseName)
+	require.Equal(t, int32(10), setting.MinMatch)
+}
+
+func TestStartPlagiarismCheckReferences(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "lab", "course", "plagiarism_job", "job_run")
+	schedulerService := scheduler.NewSchedulerService(labService.Dao, log.Sub("scheduler"))
+	labService.RegisterJobs(schedulerService)
+
+	now := time.Now()
+	const teacherID = 1
+	newLab := func(teacherID uint64, language int8, familyCode string) uint64 {
+		course := &model.Course{
+			TeacherID:  teacherID,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Language:   language,
+			FamilyCode: sql.NullString{String: familyCode, Valid: familyCode != ""},
+		}
+		require.NoError(t, course.Insert(ctx, testStorage.RDB))
+		lab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, lab.Insert(ctx, testStorage.RDB))
+		return lab.ID
+	}
+	labID := newLab(teacherID, 0, "")
+	lastYearLabID := newLab(teacherID, 0, "")
+	cppLabID := newLab(teacherID, 1, "")
+	otherTeacherLabID := newLab(teacherID+1, 0, "")
+
+	_, err := labService.StartPlagiarismCheck(ctx, labID, teacherID, []uint64{otherTeacherLabID})
+	require.Equal(t, errorx.ErrFailToAuth, err)
+	_, err = labService.StartPlagiarismCheck(ctx, labID, teacherID, []uint64{cppLabID})
+	require.Equal(t, errorx.ErrReferenceLabLanguage, err)
+	_, err = labService.StartPlagiarismCheck(ctx, labID, teacherID, []uint64{otherTeacherLabID + 1})
+	require.Equal(t, errorx.ErrIsNotFound, err)
+
+	// 同一课程族的其他教学班可以作为参考，其他课程族的不可以
+	familyLabID := newLab(teacherID, 0, "CS101")
+	sectionLabID := newLab(teacherID+1, 0, "CS101")
+	otherFamilyLabID := newLab(teacherID+1, 0, "CS102")
+	_, err = labService.StartPlagiarismCheck(ctx, familyLabID, teacherID, []uint64{otherFamilyLabID})
+	require.Equal(t, errorx.ErrFailToAuth, err)
+	job, err := labService.StartPlagiarismCheck(ctx, familyLabID, teacherID, []uint64{sectionLabID})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{sectionLabID}, job.ReferenceLabIDs)
+
+	// 重复与本实验的 ID 被忽略
+	job, err = labService.StartPlagiarismCheck(ctx, labID, teacherID, []uint64{lastYearLabID, labID, lastYearLabID})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{lastYearLabID}, job.ReferenceLabIDs)
+
+	job, err = labService.GetPlagiarismJob(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{lastYearLabID}, job.ReferenceLabIDs)
+}
@@ -0,0 +1,390 @@
+package lab
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"code-platform/config"
+	"code-platform/pkg/documentx"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/parallelx"
+	"code-platform/pkg/plagiarism"
+	"code-platform/pkg/timex"
+	"code-platform/pkg/transactionx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/scheduler"
+	"code-platform/storage"
+
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	reportPlagiarismJobName = "lab.report_plagiarism_check"
+	reportPlagiarismTimeout = 30 * time.Minute
+	// reportReadProgress 读取报告完成时的进度，其余为比对
+	reportReadProgress = 90
+)
+
+// 报告未参与比对的原因
+const (
+	skipUnsupportedFormat = "unsupported_format"
+	skipTooLarge          = "too_large"
+	skipInvalidDocument   = "invalid_document"
+	skipReadFailed        = "read_failed"
+	skipNoText            = "no_text"
+)
+
+// reportDetectionData 以 JSON 保存在 report_detection 中
+type reportDetectionData struct {
+	Checked     int                 `json:"checked"`
+	Comparisons []*ReportComparison `json:"comparisons"`
+	Skipped     []*SkippedReport    `json:"skipped"`
+}
+
+func reportTextOptions() plagiarism.TextOptions {
+	return plagiarism.TextOptions{
+		ShingleSize:   config.ReportPlagiarism.GetInt("shingle_size"),
+		NumHashes:     config.ReportPlagiarism.GetInt("num_hashes"),
+		MinSimilarity: config.ReportPlagiarism.GetFloat64("min_similarity"),
+		MaxPassages:   config.ReportPlagiarism.GetInt("max_passages"),
+	}
+}
+
+// StartReportPlagiarismCheck 提交后台的实验报告查重，实验已有排队或执行中的报告查重时返回该任务；
+// 进度与结果通过查重任务查询，完成后 ReportDetectionID 为报告查重记录
+func (l *LabService) StartReportPlagiarismCheck(ctx context.Context, labID, teacherID uint64) (*PlagiarismJob, error) {
+	job := &model.PlagiarismJob{
+		LabID:     labID,
+		Kind:      model.PlagiarismJobKindReport,
+		CreatedBy: teacherID,
+		CreatedAt: time.Now(),
+	}
+	return l.enqueuePlagiarismJob(ctx, job, reportPlagiarismJobName)
+}
+
+// runReportPlagiarismCheck 执行报告查重并随读取进度更新任务，结果与任务的完成在同一事务中写入
+func (l *LabService) runReportPlagiarismCheck(ctx context.Context, payload []byte) error {
+	jobID, err := strconv.ParseUint(string(payload), 10, 64)
+	if err != nil {
+		return err
+	}
+	if err := scheduler.CheckFencing(ctx); err != nil {
+		return err
+	}
+	started, err := model.StartPlagiarismJob(ctx, l.Dao.Storage.RDB, jobID, time.Now())
+	if err != nil {
+		l.Logger.Errorf(err, "start plagiarism job[%d] failed", jobID)
+		return err
+	}
+	if !started {
+		l.Logger.Debugf("plagiarism job[%d] has been canceled", jobID)
+		return nil
+	}
+	job, err := model.QueryPlagiarismJobByID(ctx, l.Dao.Storage.RDB, jobID)
+	if err != nil {
+		l.Logger.Errorf(err, "query plagiarism job by id[%d] failed", jobID)
+		return err
+	}
+
+	data, err := l.checkReportPlagiarism(ctx, job)
+	switch err {
+	case nil:
+	case errPlagiarismJobCanceled:
+		l.Logger.Debugf("plagiarism job[%d] has been canceled", jobID)
+		return nil
+	default:
+		return l.handlePlagiarismCheckErr(ctx, job, err)
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		l.Logger.Errorf(err, "marshal report detection of labID[%d] failed", job.LabID)
+		return err
+	}
+
+	task := func(ctx context.Context, tx storage.RDBClient) error {
+		reportDetection := &model.ReportDetection{
+			LabID:     job.LabID,
+			Data:      raw,
+			CreatedAt: time.Now().Truncate(time.Millisecond),
+		}
+		if err := reportDetection.Insert(ctx, tx); err != nil {
+			l.Logger.Errorf(err, "insert report_detection of labID[%d] failed", job.LabID)
+			return err
+		}
+		if _, err := model.UpdatePlagiarismJobProgress(ctx, tx, jobID, 100); err != nil {
+			l.Logger.Errorf(err, "update progress of plagiarism job[%d] failed", jobID)
+			return err
+		}
+		finished, err := model.FinishReportPlagiarismJob(ctx, tx, jobID, reportDetection.ID, time.Now())
+		if err != nil {
+			l.Logger.Errorf(err, "finish plagiarism job[%d] failed", jobID)
+			return err
+		}
+		if !finished {
+			return errPlagiarismJobCanceled
+		}
+		return nil
+	}
+	err = transactionx.DoTransaction(ctx, l.Dao.Storage, l.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err == errPlagiarismJobCanceled {
+		l.Logger.Debugf("plagiarism job[%d] has been canceled", jobID)
+		return nil
+	}
+	return err
+}
+
+// checkReportPlagiarism 提取实验已上传报告（txt、docx、pdf）的文本并两两比对；
+// 单份报告无法读取或提取时记录在 Skipped 中并继续，任务被取消时返回 errPlagiarismJobCanceled
+func (l *LabService) checkReportPlagiarism(ctx context.Context, job *model.PlagiarismJob) (*reportDetectionData, error) {
+	infos, err := model.QueryAllLabSubmitInfosByLabID(ctx, l.Dao.Storage.RDB, job.LabID)
+	if err != nil {
+		l.Logger.Errorf(err, "QueryAllLabSubmitInfosByLabID by labID[%d] failed", job.LabID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	options := reportTextOptions()
+	infosMap := make(map[string]*model.LabSubmitInfoByLabID, len(infos))
+	documents := make([]*plagiarism.TextDocument, 0, len(infos))
+	data := &reportDetectionData{Comparisons: []*ReportComparison{}, Skipped: []*SkippedReport{}}
+	var progress int8
+	for index, info := range infos {
+		// 读取与提取文本占大部分耗时，按已处理的提交计算进度
+		if next := int8(reportReadProgress * index / len(infos)); next != progress {
+			progress = next
+			running, err := model.UpdatePlagiarismJobProgress(ctx, l.Dao.Storage.RDB, job.ID, progress)
+			switch {
+			case err != nil:
+				// 进度写入失败不影响查重
+				l.Logger.Errorf(err, "update progress of plagiarism job[%d] failed", job.ID)
+			case !running:
+				return nil, errPlagiarismJobCanceled
+			}
+		}
+		if info.ReportURL == "" {
+			continue
+		}
+		text, err := l.readReportText(ctx, info)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			data.Skipped = append(data.Skipped, &SkippedReport{
+				RealName: info.Name,
+				Num:      info.Number,
+				Reason:   skipReason(err),
+				UserID:   info.UserID,
+			})
+			continue
+		}
+
+		document := plagiarism.NewTextDocument(strconv.FormatUint(info.UserID, 10), text, options)
+		// 扫描版 PDF 等提取不出文字
+		if document.Words() == 0 {
+			data.Skipped = append(data.Skipped, &SkippedReport{RealName: info.Name, Num: info.Number, Reason: skipNoText, UserID: info.UserID})
+			continue
+		}
+		infosMap[document.Name] = info
+		documents = append(documents, document)
+	}
+
+	comparisons, err := plagiarism.DetectText(documents, options)
+	if err != nil {
+		return nil, errorx.ErrNotEnoughReports
+	}
+	data.Checked = len(documents)
+	for _, comparison := range comparisons {
+		a, b := infosMap[comparison.A.Name], infosMap[comparison.B.Name]
+		passages := make([]*ReportPassage, len(comparison.Passages))
+		for i, passage := range comparison.Passages {
+			passages[i] = &ReportPassage{Text1: passage.TextA, Text2: passage.TextB, Words: passage.Words}
+		}
+		data.Comparisons = append(data.Comparisons, &ReportComparison{
+			RealName1:  a.Name,
+			RealName2:  b.Name,
+			Num1:       a.Number,
+			Num2:       b.Number,
+			UserID1:    a.UserID,
+			UserID2:    b.UserID,
+			Similarity: comparison.Similarity,
+			Passages:   passages,
+		})
+	}
+	return data, nil
+}
+
+var errReportTooLarge = errors.New("report is too large")
+
+func skipReason(err error) string {
+	switch err {
+	case documentx.ErrUnsupportedFormat:
+		return skipUnsupportedFormat
+	case documentx.ErrTooLarge, errReportTooLarge:
+		return skipTooLarge
+	case documentx.ErrInvalidDocument:
+		return skipInvalidDocument
+	default:
+		return skipReadFailed
+	}
+}
+
+// readReportText 读取 report 桶中的报告并提取文本，超过 max_text_size 的部分不参与比对
+func (l *LabService) readReportText(ctx context.Context, info *model.LabSubmitInfoByLabID) (string, error) {
+	bucketName := l.Dao.Storage.Minio.ReportBucketName()
+	objectName, err := reportObjectName(bucketName, info.ReportURL)
+	if err != nil {
+		return "", err
+	}
+	if !documentx.IsSupported(objectName) {
+		return "", documentx.ErrUnsupportedFormat
+	}
+
+	object, err := l.Dao.Storage.Minio.GetObject(ctx, bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		l.Logger.Errorf(err, "get report %q of labID[%d] and studentID[%d] failed", objectName, info.LabID, info.UserID)
+		return "", err
+	}
+	defer object.Close()
+	stat, err := object.Stat()
+	if err != nil {
+		l.Logger.Errorf(err, "stat report %q of labID[%d] and studentID[%d] failed", objectName, info.LabID, info.UserID)
+		return "", err
+	}
+	maxFileSize := config.ReportPlagiarism.GetInt64("max_file_size")
+	if stat.Size > maxFileSize {
+		return "", errReportTooLarge
+	}
+	content, err := io.ReadAll(io.LimitReader(object, maxFileSize))
+	if err != nil {
+		l.Logger.Errorf(err, "read report %q of labID[%d] and studentID[%d] failed", objectName, info.LabID, info.UserID)
+		return "", err
+	}
+
+	text, err := documentx.ExtractText(path.Base(objectName), content)
+	if err != nil {
+		l.Logger.Debugf("extract text from report %q of labID[%d] and studentID[%d] failed: %v", objectName, info.LabID, info.UserID, err)
+		return "", err
+	}
+	return truncateText(text, config.ReportPlagiarism.GetInt("max_text_size")), nil
+}
+
+// truncateText 截取不超过 size 字节的前缀，不截断多字节字符
+func truncateText(text string, size int) string {
+	if size <= 0 || len(text) <= size {
+		return text
+	}
+	for size > 0 && !utf8.RuneStart(text[size]) {
+		size--
+	}
+	return text[:size]
+}
+
+func (l *LabService) ListReportDetectionsByLabID(ctx context.Context, labID uint64, offset, limit int) (*PageResponse, error) {
+	var (
+		total            int
+		reportDetections []*model.DetectionReportIDWithCreatedAt
+	)
+
+	tasks := []func() error{
+		func() (err error) {
+			total, err = model.QueryTotalAmountReportDetectionByLabID(ctx, l.Dao.Storage.RDB, labID)
+			switch err {
+			case nil:
+			case context.Canceled:
+				l.Logger.Debug("QueryTotalAmountReportDetectionByLabID is canceled")
+				return err
+			default:
+				l.Logger.Errorf(err, "QueryTotalAmountReportDetection by labID[%d] failed", labID)
+				return errorx.InternalErr(err)
+			}
+			return nil
+		},
+		func() (err error) {
+			reportDetections, err = model.QueryReportDetectionsByLabID(ctx, l.Dao.Storage.RDB, labID, offset, limit)
+			switch err {
+			case nil:
+			case context.Canceled:
+				l.Logger.Debug("QueryReportDetectionsByLabID is canceled")
+				return err
+			default:
+				l.Logger.Errorf(err, "QueryReportDetections by labID[%d] failed", labID)
+				return errorx.InternalErr(err)
+			}
+			return nil
+		},
+	}
+
+	if err := parallelx.Do(l.Logger, tasks...); err != nil {
+		return nil, err
+	}
+
+	resp := make([]*DetectionReportResponse, len(reportDetections))
+	for i, reportDetection := range reportDetections {
+		resp[i] = &DetectionReportResponse{
+			ID:        reportDetection.ID,
+			CreatedAt: reportDetection.CreatedAt.In(timex.ShanghaiLocation).Format("2006-01-02 15:04:05"),
+		}
+	}
+
+	return &PageResponse{
+		Records:  resp,
+		PageInfo: &PageInfo{Total: total},
+	}, nil
+}
+
+// ViewReportDetection 仅实验所属课程的教师可以查看
+func (l *LabService) ViewReportDetection(ctx context.Context, reportDetectionID, teacherID uint64) (*ReportPlagiarism, error) {
+	reportDetection, err := model.QueryReportDetectionByID(ctx, l.Dao.Storage.RDB, reportDetectionID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("report_detection is not found by id(%d)", reportDetectionID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "QueryReportDetection by ID[%d] failed", reportDetectionID)
+		return nil, errorx.InternalErr(err)
+	}
+
+	courseID, err := model.QueryCourseIDByLabID(ctx, l.Dao.Storage.RDB, reportDetection.LabID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id(%d)", reportDetection.LabID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by labID(%d) failed", reportDetection.LabID)
+		return nil, errorx.InternalErr(err)
+	}
+	teacherIDQueried, err := model.QueryCourseTeacherIDByCourseID(ctx, l.Dao.Storage.RDB, courseID)
+	if err != nil {
+		l.Logger.Errorf(err, "QueryCourseTeacherID by courseID[%d] failed", courseID)
+		return nil, errorx.InternalErr(err)
+	}
+	if teacherIDQueried != teacherID {
+		return nil, errorx.ErrFailToAuth
+	}
+
+	var data reportDetectionData
+	if err := json.Unmarshal(reportDetection.Data, &data); err != nil {
+		l.Logger.Errorf(err, "unmarshal report_detection[%d] failed", reportDetectionID)
+		return nil, errorx.InternalErr(err)
+	}
+	return reportDetectionToDefine(reportDetection, &data), nil
+}
+
+func reportDetectionToDefine(reportDetection *model.ReportDetection, data *reportDetectionData) *ReportPlagiarism {
+	return &ReportPlagiarism{
+		CreatedAt:   reportDetection.CreatedAt.In(timex.ShanghaiLocation).Format("2006-01-02 15:04:05"),
+		ID:          reportDetection.ID,
+		LabID:       reportDetection.LabID,
+		Checked:     data.Checked,
+		Comparisons: data.Comparisons,
+		Skipped:     data.Skipped,
+	}
+}
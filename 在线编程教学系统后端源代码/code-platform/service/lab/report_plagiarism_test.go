@@ -0,0 +1,124 @@
+package lab_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"code-platform/log"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	. "code-platform/service/lab"
+	"code-platform/service/scheduler"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportPlagiarism(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit", "user", "report_detection", "plagiarism_job", "job_run")
+	schedulerService := scheduler.NewSchedulerService(labService.Dao, log.Sub("scheduler"))
+	labService.RegisterJobs(schedulerService)
+	now := time.Now()
+
+	const teacherID = 100
+	course := &model.Course{TeacherID: teacherID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, course.Insert(ctx, testStorage.RDB))
+	lab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, lab.Insert(ctx, testStorage.RDB))
+
+	const copied = "本实验使用二分查找在有序数组中定位目标元素，每次比较后将查找区间缩小一半，时间复杂度为对数级别。"
+	reports := map[string]string{
+		"2021001": "实验目的\n" + copied + "\n心得：调试边界条件花了很多时间。",
+		"2021002": "实验目的：" + copied + "\n总结：递归写法更简洁。",
+		"2021003": "The binary search halves the interval after each comparison until the target is found.",
+		"2021004": "旧版 Word 文档",
+	}
+	bucketName := testStorage.Minio.ReportBucketName()
+	submits := make([]*model.LabSubmit, 0, len(reports)+1)
+	for _, number := range []string{"2021001", "2021002", "2021003", "2021004", "2021005"} {
+		student := &model.User{Number: number, Name: "学生" + number, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, student.Insert(ctx, testStorage.RDB))
+		submit := &model.LabSubmit{LabID: lab.ID, UserID: student.ID, CreatedAt: now, UpdatedAt: now}
+		if content, ok := reports[number]; ok {
+			objectName := fmt.Sprintf("report_plagiarism_test/%s.txt", number)
+			if number == "2021004" {
+				objectName = fmt.Sprintf("report_plagiarism_test/%s.doc", number)
+			}
+			_, err := testStorage.Minio.PutObject(ctx, bucketName, objectName, strings.NewReader(content), int64(len(content)), minio.PutObjectOptions{})
+			require.NoError(t, err)
+			submit.ReportURL = fmt.Sprintf(testStorage.Minio.URLFormat(), bucketName, objectName)
+		}
+		submits = append(submits, submit)
+	}
+	require.NoError(t, model.BatchInsertLabSubmits(ctx, testStorage.RDB, submits))
+
+	// 没有报告的实验无法查重，任务直接失败
+	emptyLab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, emptyLab.Insert(ctx, testStorage.RDB))
+
+	job, err := labService.StartReportPlagiarismCheck(ctx, lab.ID, teacherID)
+	require.NoError(t, err)
+	require.Equal(t, model.PlagiarismJobKindReport, job.Kind)
+	// 排队中的报告查重不重复提交
+	queued, err := labService.StartReportPlagiarismCheck(ctx, lab.ID, teacherID)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, queued.ID)
+	emptyJob, err := labService.StartReportPlagiarismCheck(ctx, emptyLab.ID, teacherID)
+	require.NoError(t, err)
+
+	schedulerService.Start()
+	waitJob := func(jobID uint64) *PlagiarismJob {
+		var job *PlagiarismJob
+		require.Eventually(t, func() bool {
+			job, err = labService.GetPlagiarismJob(ctx, jobID)
+			require.NoError(t, err)
+			return job.Status != model.PlagiarismJobStatusQueued && job.Status != model.PlagiarismJobStatusRunning
+		}, time.Minute, time.Second)
+		return job
+	}
+	job = waitJob(job.ID)
+	require.Equal(t, model.PlagiarismJobStatusDone, job.Status, job.Error)
+	require.Equal(t, int8(100), job.Progress)
+	emptyJob = waitJob(emptyJob.ID)
+	require.Equal(t, model.PlagiarismJobStatusFailed, emptyJob.Status)
+	require.Equal(t, "at least two reports with text are required", emptyJob.Error)
+
+	result, err := labService.ViewReportDetection(ctx, job.ReportDetectionID, teacherID)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.Checked)
+	require.Len(t, result.Comparisons, 1)
+	comparison := result.Comparisons[0]
+	require.Equal(t, "2021001", comparison.Num1)
+	require.Equal(t, "2021002", comparison.Num2)
+	require.Greater(t, comparison.Similarity, 0.5)
+	require.NotEmpty(t, comparison.Passages)
+	require.Contains(t, comparison.Passages[0].Text1, "二分查找")
+	// 未提交报告的学生不出现在结果中
+	require.Len(t, result.Skipped, 1)
+	require.Equal(t, "2021004", result.Skipped[0].Num)
+	require.Equal(t, "unsupported_format", result.Skipped[0].Reason)
+
+	history, err := labService.ListReportDetectionsByLabID(ctx, lab.ID, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, history.PageInfo.Total)
+	// 代码查重记录中附带最近一次的报告查重
+	detections, err := labService.ListDetectionReportsByLabID(ctx, lab.ID, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, result.ID, detections.LatestReportDetection.ID)
+	detections, err = labService.ListDetectionReportsByLabID(ctx, emptyLab.ID, 0, 10)
+	require.NoError(t, err)
+	require.Nil(t, detections.LatestReportDetection)
+
+	_, err = labService.ViewReportDetection(ctx, result.ID, teacherID+1)
+	require.Equal(t, errorx.ErrFailToAuth, err)
+	_, err = labService.ViewReportDetection(ctx, result.ID+1, teacherID)
+	require.Equal(t, errorx.ErrIsNotFound, err)
+}
@@ -0,0 +1,241 @@
+package lab
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"io"
+
+	idepb "code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// templateChunkSize 向 IDE 服务发送模板时的分块大小
+const templateChunkSize = 256 << 10
+
+// UpdateLabTemplate 更新实验的初始代码模板：先保存至 MinIO 并记录到数据库，原模板保留为上一版以便恢复，
+// 再由 IDE 服务校验解压并合并进已打开过的工作区。学生已修改的文件不会被覆盖，模板内容另存为 .template-new
+func (l *LabService) UpdateLabTemplate(ctx context.Context, labID uint64, file io.ReadSeeker, size int64) (*LabTemplateResult, error) {
+	lab, err := l.queryLabForTemplate(ctx, labID)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := hashTemplate(file)
+	if err != nil {
+		l.Logger.Errorf(err, "hash template of lab[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	object := define.GetTemplateObjectName(labID, hash)
+	bucketName := l.Dao.Storage.Minio.TemplateBucketName()
+	// 对象名按内容摘要区分，当前版本与上一版本已在 MinIO 中
+	uploaded := object != lab.TemplateObject && object != lab.PreviousTemplateObject
+	if uploaded {
+		if _, err := l.Dao.Storage.Minio.PutObject(ctx, bucketName, object, file, size, minio.PutObjectOptions{ContentType: "application/zip"}); err != nil {
+			l.Logger.Errorf(err, "put template object %q failed", object)
+			return nil, errorx.InternalErr(err)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			l.Logger.Errorf(err, "seek template of lab[%d] failed", labID)
+			return nil, errorx.InternalErr(err)
+		}
+	}
+
+	template := &model.LabTemplate{Object: object, Hash: hash, PreviousObject: lab.TemplateObject, PreviousHash: lab.TemplateHash}
+	// 重复上传当前模板只重新合并，不改变上一版
+	if hash == lab.TemplateHash {
+		template.PreviousObject, template.PreviousHash = lab.PreviousTemplateObject, lab.PreviousTemplateHash
+	}
+	resp, err := l.applyLabTemplate(ctx, lab, template, file)
+	if err != nil {
+		// 模板被拒绝时记录已恢复，刚上传的对象不再被引用
+		if uploaded && (err == errorx.ErrUnsupportFileType || err == errorx.ErrFileTooLarge) {
+			l.removeTemplateObject(ctx, object)
+		}
+		return nil, err
+	}
+
+	// 只保留当前与上一版模板
+	if stale := lab.PreviousTemplateObject; hash != lab.TemplateHash && stale != "" && stale != object {
+		l.removeTemplateObject(ctx, stale)
+	}
+	return templateResult(resp), nil
+}
+
+// RestoreLabTemplate 将上一版初始代码模板恢复为当前模板并合并进已打开过的工作区，当前模板成为上一版，再次恢复即撤销
+func (l *LabService) RestoreLabTemplate(ctx context.Context, labID uint64) (*LabTemplateResult, error) {
+	lab, err := l.queryLabForTemplate(ctx, labID)
+	if err != nil {
+		return nil, err
+	}
+	if lab.PreviousTemplateObject == "" {
+		return nil, errorx.ErrNoPreviousTemplate
+	}
+
+	bucketName := l.Dao.Storage.Minio.TemplateBucketName()
+	object, err := l.Dao.Storage.Minio.GetObject(ctx, bucketName, lab.PreviousTemplateObject, minio.GetObjectOptions{})
+	if err != nil {
+		l.Logger.Errorf(err, "get template object %q failed", lab.PreviousTemplateObject)
+		return nil, errorx.InternalErr(err)
+	}
+	defer object.Close()
+	if _, err := object.Stat(); err != nil {
+		l.Logger.Errorf(err, "stat template object %q failed", lab.PreviousTemplateObject)
+		return nil, errorx.InternalErr(err)
+	}
+
+	template := &model.LabTemplate{
+		Object:         lab.PreviousTemplateObject,
+		Hash:           lab.PreviousTemplateHash,
+		PreviousObject: lab.TemplateObject,
+		PreviousHash:   lab.TemplateHash,
+	}
+	resp, err := l.applyLabTemplate(ctx, lab, template, object)
+	if err != nil {
+		return nil, err
+	}
+	return templateResult(resp), nil
+}
+
+func (l *LabService) queryLabForTemplate(ctx context.Context, labID uint64) (*model.Lab, error) {
+	lab, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return nil, errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return nil, errorx.InternalErr(err)
+	}
+	if lab.ArchivedAt.Valid {
+		return nil, errorx.ErrLabHasEnded
+	}
+	return lab, nil
+}
+
+// applyLabTemplate 先将模板记录到数据库再交给 IDE 服务合并；IDE 服务在解压前拒绝模板时工作区未被改动，恢复原记录，
+// 合并中途失败时部分工作区已合并，保留新记录与工作区一致，可重新上传或恢复上一版
+func (l *LabService) applyLabTemplate(ctx context.Context, lab *model.Lab, template *model.LabTemplate, file io.Reader) (*idepb.PutLabTemplateResponse, error) {
+	changed := template.Hash != lab.TemplateHash
+	if changed {
+		ok, err := model.UpdateLabTemplate(ctx, l.Dao.Storage.RDB, lab.ID, lab.TemplateHash, template)
+		if err != nil {
+			l.Logger.Errorf(err, "update template of lab[%d] to %q failed", lab.ID, template.Object)
+			return nil, errorx.InternalErr(err)
+		}
+		if !ok {
+			return nil, errorx.ErrTemplateChanged
+		}
+	}
+
+	resp, err := l.putLabTemplate(ctx, lab.ID, file)
+	code := status.Code(err)
+	if changed && (code == codes.InvalidArgument || code == codes.FailedPrecondition) {
+		previous := &model.LabTemplate{
+			Object:         lab.TemplateObject,
+			Hash:           lab.TemplateHash,
+			PreviousObject: lab.PreviousTemplateObject,
+			PreviousHash:   lab.PreviousTemplateHash,
+		}
+		if _, err := model.UpdateLabTemplate(ctx, l.Dao.Storage.RDB, lab.ID, template.Hash, previous); err != nil {
+			l.Logger.Errorf(err, "revert template of lab[%d] to %q failed", lab.ID, lab.TemplateObject)
+		}
+	}
+	switch code {
+	case codes.OK:
+		return resp, nil
+	case codes.InvalidArgument:
+		l.Logger.Debugf("template of lab[%d] is invalid: %v", lab.ID, err)
+		return nil, errorx.ErrUnsupportFileType
+	case codes.FailedPrecondition:
+		return nil, errorx.ErrFileTooLarge
+	case codes.Canceled:
+		return nil, context.Canceled
+	default:
+		l.Logger.Errorf(err, "put template of lab[%d] failed", lab.ID)
+		return nil, errorx.InternalErr(err)
+	}
+}
+
+func (l *LabService) removeTemplateObject(ctx context.Context, object string) {
+	bucketName := l.Dao.Storage.Minio.TemplateBucketName()
+	if err := l.Dao.Storage.Minio.RemoveObject(ctx, bucketName, object, minio.RemoveObjectOptions{}); err != nil {
+		l.Logger.Errorf(err, "remove template object %q failed", object)
+	}
+}
+
+// hashTemplate 计算模板 zip 的 sha256 作为版本，与 IDE 服务的计算方式一致
+func hashTemplate(file io.ReadSeeker) (string, error) {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func templateResult(resp *idepb.PutLabTemplateResponse) *LabTemplateResult {
+	return &LabTemplateResult{
+		Hash:           resp.Sha256,
+		FileCount:      resp.FileCount,
+		WorkspaceCount: resp.WorkspaceCount,
+		Updated:        resp.Updated,
+		Conflicts:      resp.Conflicts,
+		Failed:         resp.Failed,
+	}
+}
+
+func (l *LabService) putLabTemplate(ctx context.Context, labID uint64, file io.Reader) (*idepb.PutLabTemplateResponse, error) {
+	// 读取失败时取消调用，避免服务端把不完整的 zip 当作模板
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := l.IDEClient.PutLabTemplate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := sendLabZip(stream, labID, file); err != nil {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
+
+type labZipSender interface {
+	Send(*idepb.LabTemplateChunk) error
+}
+
+// sendLabZip 分块发送实验的 zip 文件，服务端提前结束时返回 nil，实际错误由 CloseAndRecv 返回
+func sendLabZip(stream labZipSender, labID uint64, file io.Reader) error {
+	buf := make([]byte, templateChunkSize)
+	chunk := &idepb.LabTemplateChunk{LabId: labID}
+	for {
+		n, err := io.ReadFull(file, buf)
+		// 第一块即使为空也需携带 lab_id
+		if n != 0 || chunk.LabId != 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			chunk.LabId = 0
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package lab_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplateZip(t *testing.T, files map[string]string) *bytes.Reader {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestUpdateLabTemplate(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit")
+	now := time.Now()
+
+	const studentID = 1
+
+	lab := &model.Lab{CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err := lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: lab.ID, UserID: studentID, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	// 学生已打开过工作区并修改了 main.py
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, studentID, "main.py", "print(0)")
+	require.NoError(t, err)
+
+	file := newTemplateZip(t, map[string]string{"starter/main.py": "print(1)", "starter/util.py": "pass"})
+	result, err := labService.UpdateLabTemplate(ctx, lab.ID, file, file.Size())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), result.FileCount)
+	assert.Equal(t, int32(1), result.WorkspaceCount)
+	assert.Equal(t, int32(1), result.Updated)
+	assert.Equal(t, int32(1), result.Conflicts)
+
+	for _, c := range []struct {
+		label   string
+		path    string
+		content string
+	}{
+		{label: "modified file is kept", path: "main.py", content: "print(0)"},
+		{label: "template content is saved aside", path: "main.py.template-new", content: "print(1)"},
+		{label: "new file is added", path: "util.py", content: "pass"},
+	} {
+		f, err := labService.ReadWorkspaceFile(ctx, lab.ID, studentID, studentID, c.path, 0)
+		require.NoError(t, err, c.label)
+		assert.Equal(t, c.content, f.Content, c.label)
+	}
+
+	info, err := labService.GetLab(ctx, lab.ID)
+	require.NoError(t, err)
+	assert.Equal(t, result.Hash, info.TemplateHash)
+
+	_, err = labService.RestoreLabTemplate(ctx, lab.ID)
+	assert.Equal(t, errorx.ErrNoPreviousTemplate, err)
+
+	// 被拒绝的模板不改变当前记录
+	invalid := bytes.NewReader([]byte("not a zip"))
+	_, err = labService.UpdateLabTemplate(ctx, lab.ID, invalid, invalid.Size())
+	assert.Equal(t, errorx.ErrUnsupportFileType, err)
+	info, err = labService.GetLab(ctx, lab.ID)
+	require.NoError(t, err)
+	assert.Equal(t, result.Hash, info.TemplateHash)
+	assert.Empty(t, info.PreviousTemplateHash)
+
+	second := newTemplateZip(t, map[string]string{"starter/main.py": "print(2)"})
+	secondResult, err := labService.UpdateLabTemplate(ctx, lab.ID, second, second.Size())
+	require.NoError(t, err)
+	info, err = labService.GetLab(ctx, lab.ID)
+	require.NoError(t, err)
+	assert.Equal(t, secondResult.Hash, info.TemplateHash)
+	assert.Equal(t, result.Hash, info.PreviousTemplateHash)
+
+	restored, err := labService.RestoreLabTemplate(ctx, lab.ID)
+	require.NoError(t, err)
+	assert.Equal(t, result.Hash, restored.Hash)
+	info, err = labService.GetLab(ctx, lab.ID)
+	require.NoError(t, err)
+	assert.Equal(t, result.Hash, info.TemplateHash)
+	assert.Equal(t, secondResult.Hash, info.PreviousTemplateHash)
+}
@@ -0,0 +1,296 @@
+package lab
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	idepb "code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/repository/rdb/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authWorkspace 学生仅能访问本人的工作区，截止后只读；教师仅能只读访问所授课程实验下的学生工作区
+func (l *LabService) authWorkspace(ctx context.Context, labID, studentID, userID uint64, write bool) error {
+	lab, err := model.QueryLabByID(ctx, l.Dao.Storage.RDB, labID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab is not found by id[%d]", labID)
+		return errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab by id[%d] failed", labID)
+		return errorx.InternalErr(err)
+	}
+
+	// 选课学生在实验创建时即拥有提交记录
+	switch _, err := model.QueryLabSubmitByLabIDAndUserID(ctx, l.Dao.Storage.RDB, labID, studentID); err {
+	case nil:
+	case sql.ErrNoRows:
+		l.Logger.Debugf("lab submit is not found by labID[%d] and studentID[%d]", labID, studentID)
+		return errorx.ErrIsNotFound
+	default:
+		l.Logger.Errorf(err, "query lab submit by labID[%d] and studentID[%d] failed", labID, studentID)
+		return errorx.InternalErr(err)
+	}
+
+	if userID != studentID {
+		if write {
+			return errorx.ErrFailToAuth
+		}
+		course, err := model.QueryCourseByID(ctx, l.Dao.Storage.RDB, lab.CourseID)
+		switch err {
+		case nil:
+		case sql.ErrNoRows:
+			l.Logger.Debugf("course is not found by id[%d]", lab.CourseID)
+			return errorx.ErrIsNotFound
+		default:
+			l.Logger.Errorf(err, "query course by id[%d] failed", lab.CourseID)
+			return errorx.InternalErr(err)
+		}
+		if course.TeacherID != userID {
+			l.Logger.Debugf("user[%d] want to see the workspace of lab[%d] owned by teacher[%d]", userID, labID, course.TeacherID)
+			return errorx.ErrFailToAuth
+		}
+		return nil
+	}
+
+	if write && lab.DeadLine.Valid && time.Since(lab.DeadLine.Time) > 0 {
+		return errorx.ErrLabHasEnded
+	}
+	return nil
+}
+
+func (l *LabService) workspaceErr(err error, format string, values ...interface{}) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		l.Logger.Debugf(format+" for not found", values...)
+		return errorx.ErrIsNotFound
+	case codes.AlreadyExists:
+		return errorx.ErrFileExists
+	case codes.InvalidArgument:
+		return errorx.ErrInvalidPath
+	case codes.FailedPrecondition:
+		return errorx.ErrFileTooLarge
+	case codes.PermissionDenied:
+		// 工作区已冻结
+		return errorx.ErrLabHasEnded
+	case codes.Canceled:
+		return context.Canceled
+	default:
+		l.Logger.Errorf(err, format, values...)
+		return errorx.InternalErr(err)
+	}
+}
+
+// ListWorkspaceDir snapshotID 不为 0 时列出该快照中的目录
+func (l *LabService) ListWorkspaceDir(ctx context.Context, labID, studentID, userID uint64, path string, snapshotID int64) ([]*WorkspaceEntry, error) {
+	if err := l.authWorkspace(ctx, labID, studentID, userID, false); err != nil {
+		return nil, err
+	}
+
+	resp, err := l.IDEClient.ListWorkspaceDir(ctx, &idepb.WorkspaceFileRequest{
+		LabId:      labID,
+		StudentId:  studentID,
+		Path:       path,
+		SnapshotId: snapshotID,
+	})
+	if err != nil {
+		return nil, l.workspaceErr(err, "list dir %q of workspace with labID[%d] and studentID[%d] failed", path, labID, studentID)
+	}
+
+	entries := make([]*WorkspaceEntry, len(resp.Entries))
+	for index, entry := range resp.Entries {
+		entries[index] = &WorkspaceEntry{
+			ModifiedAt: time.Unix(entry.ModifiedAt, 0),
+			Name:       entry.Name,
+			Size:       entry.Size,
+			IsDir:      entry.IsDir,
+		}
+	}
+	return entries, nil
+}
+
+// ReadWorkspaceFile snapshotID 不为 0 时读取该快照中的文件
+func (l *LabService) ReadWorkspaceFile(ctx context.Context, labID, studentID, userID uint64, path string, snapshotID int64) (*WorkspaceFile, error) {
+	if err := l.authWorkspace(ctx, labID, studentID, userID, false); err != nil {
+		return nil, err
+	}
+
+	resp, err := l.IDEClient.ReadWorkspaceFile(ctx, &idepb.WorkspaceFileRequest{
+		LabId:      labID,
+		StudentId:  studentID,
+		Path:       path,
+		SnapshotId: snapshotID,
+	})
+	if err != nil {
+		return nil, l.workspaceErr(err, "read file %q of workspace with labID[%d] and studentID[%d] failed", path, labID, studentID)
+	}
+
+	return &WorkspaceFile{
+		ModifiedAt: time.Unix(resp.ModifiedAt, 0),
+		Content:    string(resp.Content),
+		Size:       resp.Size,
+		IsBinary:   resp.IsBinary,
+	}, nil
+}
+
+func (l *LabService) WriteWorkspaceFile(ctx context.Context, labID, studentID uint64, path, content string) error {
+	if err := l.authWorkspace(ctx, labID, studentID, studentID, true); err != nil {
+		return err
+	}
+
+	if _, err := l.IDEClient.WriteWorkspaceFile(ctx, &idepb.WriteWorkspaceFileRequest{
+		LabId:     labID,
+		StudentId: studentID,
+		Path:      path,
+		Content:   []byte(content),
+	}); err != nil {
+		return l.workspaceErr(err, "write file %q of workspace with labID[%d] and studentID[%d] failed", path, labID, studentID)
+	}
+	return nil
+}
+
+func (l *LabService) CreateWorkspaceFile(ctx context.Context, labID, studentID uint64, path string, isDir bool) error {
+	if err := l.authWorkspace(ctx, labID, studentID, studentID, true); err != nil {
+		return err
+	}
+
+	if _, err := l.IDEClient.CreateWorkspaceFile(ctx, &idepb.CreateWorkspaceFileRequest{
+		LabId:     labID,
+		StudentId: studentID,
+		Path:      path,
+		IsDir:     isDir,
+	}); err != nil {
+		return l.workspaceErr(err, "create %q in workspace with labID[%d] and studentID[%d] failed", path, labID, studentID)
+	}
+	return nil
+}
+
+func (l *LabService) RenameWorkspaceFile(ctx context.Context, labID, studentID uint64, from, to string) error {
+	if err := l.authWorkspace(ctx, labID, studentID, studentID, true); err != nil {
+		return err
+	}
+
+	if _, err := l.IDEClient.RenameWorkspaceFile(ctx, &idepb.RenameWorkspaceFileRequest{
+		LabId:     labID,
+		StudentId: studentID,
+		From:      from,
+		To:        to,
+	}); err != nil {
+		return l.workspaceErr(err, "rename %q to %q in workspace with labID[%d] and studentID[%d] failed", from, to, labID, studentID)
+	}
+	return nil
+}
+
+func (l *LabService) DeleteWorkspaceFile(ctx context.Context, labID, studentID uint64, path string) error {
+	if err := l.authWorkspace(ctx, labID, studentID, studentID, true); err != nil {
+		return err
+	}
+
+	if _, err := l.IDEClient.DeleteWorkspaceFile(ctx, &idepb.WorkspaceFileRequest{
+		LabId:     labID,
+		StudentId: studentID,
+		Path:      path,
+	}); err != nil {
+		return l.workspaceErr(err, "delete %q in workspace with labID[%d] and studentID[%d] failed", path, labID, studentID)
+	}
+	return nil
+}
+
+func (l *LabService) ListWorkspaceSnapshots(ctx context.Context, labID, studentID, userID uint64) ([]*WorkspaceSnapshot, error) {
+	if err := l.authWorkspace(ctx, labID, studentID, userID, false); err != nil {
+		return nil, err
+	}
+
+	resp, err := l.IDEClient.ListWorkspaceSnapshots(ctx, &idepb.ListWorkspaceSnapshotsRequest{
+		LabId:     labID,
+		StudentId: studentID,
+	})
+	if err != nil {
+		return nil, l.workspaceErr(err, "list snapshots of workspace with labID[%d] and studentID[%d] failed", labID, studentID)
+	}
+
+	snapshots := make([]*WorkspaceSnapshot, len(resp.Snapshots))
+	for index, snapshot := range resp.Snapshots {
+		snapshots[index] = &WorkspaceSnapshot{
+			CreatedAt: time.UnixMilli(snapshot.Id),
+			Reason:    snapshot.Reason,
+			ID:        snapshot.Id,
+			TotalSize: snapshot.TotalSize,
+			FileCount: snapshot.FileCount,
+			Skipped:   snapshot.Skipped,
+		}
+	}
+	return snapshots, nil
+}
+
+// RestoreWorkspaceSnapshot 将工作区恢复为指定快照，返回恢复前自动保存的快照 ID
+func (l *LabService) RestoreWorkspaceSnapshot(ctx context.Context, labID, studentID uint64, snapshotID int64) (int64, error) {
+	if err := l.authWorkspace(ctx, labID, studentID, studentID, true); err != nil {
+		return 0, err
+	}
+
+	resp, err := l.IDEClient.RestoreWorkspaceSnapshot(ctx, &idepb.RestoreWorkspaceSnapshotRequest{
+		LabId:      labID,
+		StudentId:  studentID,
+		SnapshotId: snapshotID,
+	})
+	if err != nil {
+		return 0, l.workspaceErr(err, "restore snapshot[%d] of workspace with labID[%d] and studentID[%d] failed", snapshotID, labID, studentID)
+	}
+	return resp.SafetySnapshotId, nil
+}
+
+// DiffWorkspaces 对比同一实验下两个工作区，snapshotID 为 0 时表示当前工作区
+func (l *LabService) DiffWorkspaces(ctx context.Context, labID, oldStudentID, newStudentID uint64, oldSnapshotID, newSnapshotID int64, contextLines int32) (*WorkspaceDiff, error) {
+	resp, err := l.IDEClient.DiffWorkspaces(ctx, &idepb.DiffWorkspacesRequest{
+		Old:          &idepb.WorkspaceRef{LabId: labID, StudentId: oldStudentID, SnapshotId: oldSnapshotID},
+		New:          &idepb.WorkspaceRef{LabId: labID, StudentId: newStudentID, SnapshotId: newSnapshotID},
+		ContextLines: contextLines,
+	})
+	if err != nil {
+		return nil, l.workspaceErr(err, "diff workspaces of studentID[%d] and studentID[%d] in labID[%d] failed", oldStudentID, newStudentID, labID)
+	}
+
+	diff := &WorkspaceDiff{
+		Files:     make([]*FileDiff, len(resp.Files)),
+		Truncated: resp.Truncated,
+	}
+	for index, file := range resp.Files {
+		fileDiff := &FileDiff{
+			OldPath:   file.OldPath,
+			NewPath:   file.NewPath,
+			Status:    strings.ToLower(file.Status.String()),
+			Hunks:     make([]*DiffHunk, len(file.Hunks)),
+			Additions: file.Additions,
+			Deletions: file.Deletions,
+			IsBinary:  file.IsBinary,
+			Truncated: file.Truncated,
+		}
+		for hunkIndex, hunk := range file.Hunks {
+			diffHunk := &DiffHunk{
+				Lines:    make([]*DiffLine, len(hunk.Lines)),
+				OldStart: hunk.OldStart,
+				OldLines: hunk.OldLines,
+				NewStart: hunk.NewStart,
+				NewLines: hunk.NewLines,
+			}
+			for lineIndex, line := range hunk.Lines {
+				diffHunk.Lines[lineIndex] = &DiffLine{
+					Kind:    strings.ToLower(line.Kind.String()),
+					Text:    line.Text,
+					OldLine: line.OldLine,
+					NewLine: line.NewLine,
+				}
+			}
+			fileDiff.Hunks[hunkIndex] = diffHunk
+		}
+		diff.Files[index] = fileDiff
+	}
+	return diff, nil
+}
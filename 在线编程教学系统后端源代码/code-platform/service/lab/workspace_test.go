@@ -0,0 +1,321 @@
+package lab_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	idepb "code-platform/api/grpc/ide/pb"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceFile(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit")
+	now := time.Now()
+
+	const (
+		teacherID = 1
+		studentID = 2
+	)
+
+	course := &model.Course{TeacherID: teacherID, CreatedAt: now, UpdatedAt: now}
+	err := course.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	lab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err = lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	endedLab := &model.Lab{CourseID: course.ID, CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(-time.Hour), Valid: true}}
+	err = endedLab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: lab.ID, UserID: studentID, CreatedAt: now, UpdatedAt: now},
+		{LabID: endedLab.ID, UserID: studentID, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	for _, c := range []struct {
+		expectedError error
+		label         string
+		path          string
+		labID         uint64
+		studentID     uint64
+	}{
+		{label: "normal", labID: lab.ID, studentID: studentID, path: "src/main.py"},
+		{label: "escape is confined", labID: lab.ID, studentID: studentID, path: "../../escape.py"},
+		{label: "workspace root", labID: lab.ID, studentID: studentID, path: "..", expectedError: errorx.ErrInvalidPath},
+		{label: "lab has ended", labID: endedLab.ID, studentID: studentID, path: "main.py", expectedError: errorx.ErrLabHasEnded},
+		{label: "not in lab", labID: lab.ID, studentID: 10, path: "main.py", expectedError: errorx.ErrIsNotFound},
+	} {
+		err := labService.WriteWorkspaceFile(ctx, c.labID, c.studentID, c.path, "print(1)")
+		assert.Equal(t, c.expectedError, err, c.label)
+	}
+
+	entries, err := labService.ListWorkspaceDir(ctx, lab.ID, studentID, studentID, "", 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.True(t, entries[0].IsDir)
+	assert.Equal(t, "escape.py", entries[1].Name)
+
+	for _, c := range []struct {
+		expectedError error
+		label         string
+		userID        uint64
+	}{
+		{label: "student", userID: studentID},
+		{label: "teacher", userID: teacherID},
+		{label: "other teacher", userID: 10, expectedError: errorx.ErrFailToAuth},
+	} {
+		file, err := labService.ReadWorkspaceFile(ctx, lab.ID, studentID, c.userID, "src/main.py", 0)
+		assert.Equal(t, c.expectedError, err, c.label)
+		if err == nil {
+			assert.Equal(t, "print(1)", file.Content, c.label)
+		}
+	}
+
+	err = labService.CreateWorkspaceFile(ctx, lab.ID, studentID, "src/main.py", false)
+	assert.Equal(t, errorx.ErrFileExists, err)
+
+	err = labService.RenameWorkspaceFile(ctx, lab.ID, studentID, "src/main.py", "src/app.py")
+	require.NoError(t, err)
+
+	_, err = labService.ReadWorkspaceFile(ctx, lab.ID, studentID, studentID, "src/main.py", 0)
+	assert.Equal(t, errorx.ErrIsNotFound, err)
+
+	err = labService.DeleteWorkspaceFile(ctx, lab.ID, studentID, "src")
+	require.NoError(t, err)
+	err = labService.DeleteWorkspaceFile(ctx, lab.ID, studentID, "escape.py")
+	require.NoError(t, err)
+}
+
+func TestDiffWorkspaces(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit")
+	now := time.Now()
+
+	const (
+		studentA = 1
+		studentB = 2
+	)
+
+	lab := &model.Lab{CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err := lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: lab.ID, UserID: studentA, CreatedAt: now, UpdatedAt: now},
+		{LabID: lab.ID, UserID: studentB, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	for _, f := range []struct {
+		path      string
+		content   string
+		studentID uint64
+	}{
+		{studentID: studentA, path: "main.py", content: "a = 1\nprint(a)\n"},
+		{studentID: studentB, path: "main.py", content: "a = 2\nprint(a)\n"},
+		{studentID: studentA, path: "src/util.py", content: "pass\n"},
+		{studentID: studentB, path: "util.py", content: "pass\n"},
+		{studentID: studentB, path: "node_modules/x.js", content: "x"},
+	} {
+		err := labService.WriteWorkspaceFile(ctx, lab.ID, f.studentID, f.path, f.content)
+		require.NoError(t, err)
+	}
+
+	diff, err := labService.DiffWorkspaces(ctx, lab.ID, studentA, studentB, 0, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, diff.Files, 2)
+
+	assert.Equal(t, "modified", diff.Files[0].Status)
+	assert.Equal(t, "main.py", diff.Files[0].NewPath)
+	assert.Equal(t, int32(1), diff.Files[0].Additions)
+	assert.Equal(t, int32(1), diff.Files[0].Deletions)
+	require.Len(t, diff.Files[0].Hunks, 1)
+	assert.Equal(t, "delete", diff.Files[0].Hunks[0].Lines[0].Kind)
+	assert.Equal(t, "a = 1", diff.Files[0].Hunks[0].Lines[0].Text)
+
+	assert.Equal(t, "renamed", diff.Files[1].Status)
+	assert.Equal(t, "src/util.py", diff.Files[1].OldPath)
+	assert.Empty(t, diff.Files[1].Hunks)
+}
+
+func TestWorkspaceSnapshots(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit")
+	now := time.Now()
+
+	const studentID = 1
+
+	lab := &model.Lab{CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err := lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: lab.ID, UserID: studentID, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	snapshot := func() int64 {
+		resp, err := labService.IDEClient.SnapshotWorkspace(ctx, &idepb.SnapshotWorkspaceRequest{LabId: lab.ID, StudentId: studentID})
+		require.NoError(t, err)
+		return resp.Id
+	}
+
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, studentID, "main.py", "print(1)")
+	require.NoError(t, err)
+	first := snapshot()
+	// 内容未变化时不产生新快照
+	require.Equal(t, first, snapshot())
+
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, studentID, "main.py", "print(2)")
+	require.NoError(t, err)
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, studentID, "extra.py", "pass")
+	require.NoError(t, err)
+	second := snapshot()
+
+	snapshots, err := labService.ListWorkspaceSnapshots(ctx, lab.ID, studentID, studentID)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, second, snapshots[0].ID)
+	assert.Equal(t, int32(2), snapshots[0].FileCount)
+
+	file, err := labService.ReadWorkspaceFile(ctx, lab.ID, studentID, studentID, "main.py", first)
+	require.NoError(t, err)
+	assert.Equal(t, "print(1)", file.Content)
+
+	safety, err := labService.RestoreWorkspaceSnapshot(ctx, lab.ID, studentID, first)
+	require.NoError(t, err)
+	assert.Equal(t, second, safety)
+
+	entries, err := labService.ListWorkspaceDir(ctx, lab.ID, studentID, studentID, "", 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	file, err = labService.ReadWorkspaceFile(ctx, lab.ID, studentID, studentID, "main.py", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "print(1)", file.Content)
+
+	_, err = labService.RestoreWorkspaceSnapshot(ctx, lab.ID, studentID, 1)
+	assert.Equal(t, errorx.ErrIsNotFound, err)
+}
+
+func TestFrozenWorkspace(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit")
+	now := time.Now()
+
+	const studentID = 1
+
+	lab := &model.Lab{CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err := lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+	// 冻结副本不可撤销，测试结束后手动删除
+	defer os.RemoveAll(filepath.Join(define.InitBasePath, "frozen", fmt.Sprintf("workspace-%d", lab.ID)))
+
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: lab.ID, UserID: studentID, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, studentID, "main.py", "print(1)")
+	require.NoError(t, err)
+
+	stream, err := labService.IDEClient.ArchiveWorkspace(ctx, &idepb.ArchiveWorkspaceRequest{LabId: lab.ID, StudentId: studentID})
+	require.NoError(t, err)
+	var (
+		data []byte
+		last *idepb.ArchiveWorkspaceChunk
+	)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data = append(data, chunk.Data...)
+		last = chunk
+	}
+	require.NotNil(t, last)
+	sum := sha256.Sum256(data)
+	assert.Equal(t, hex.EncodeToString(sum[:]), last.Sha256)
+	assert.Equal(t, int64(len(data)), last.Size)
+	assert.Equal(t, int32(1), last.FileCount)
+
+	// 冻结后工作区只读，查看读取冻结副本
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, studentID, "main.py", "print(2)")
+	assert.Equal(t, errorx.ErrLabHasEnded, err)
+	file, err := labService.ReadWorkspaceFile(ctx, lab.ID, studentID, studentID, "main.py", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "print(1)", file.Content)
+
+	// 尚未记录归档
+	_, err = labService.GetSubmitArchive(ctx, lab.ID, studentID)
+	assert.Equal(t, errorx.ErrIsNotFound, err)
+}
+
+func TestWriteLabSubmitsZip(t *testing.T) {
+	testStorage, labService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "course", "lab", "lab_submit", "user")
+	now := time.Now()
+
+	student := &model.User{Number: "2021001", Name: "张三", CreatedAt: now, UpdatedAt: now}
+	err := student.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	lab := &model.Lab{CreatedAt: now, UpdatedAt: now, DeadLine: sql.NullTime{Time: now.Add(time.Hour), Valid: true}}
+	err = lab.Insert(ctx, testStorage.RDB)
+	require.NoError(t, err)
+
+	err = model.BatchInsertLabSubmits(ctx, testStorage.RDB, []*model.LabSubmit{
+		{LabID: lab.ID, UserID: student.ID, CreatedAt: now, UpdatedAt: now},
+	})
+	require.NoError(t, err)
+
+	err = labService.WriteWorkspaceFile(ctx, lab.ID, student.ID, "src/main.py", "print(1)")
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	err = labService.WriteLabSubmitsZip(ctx, lab.ID, buf)
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"2021001_张三/code/src/main.py", "manifest.csv"}, names)
+}
@@ -9,6 +9,8 @@ import (
 	"code-platform/log"
 	"code-platform/pkg/errorx"
 	"code-platform/repository"
+	"code-platform/repository/rdb/model"
+	"code-platform/service/ide/define"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -48,10 +50,22 @@ func NewMonacoService(dao *repository.Dao, logger *log.Logger, monacoClient pb.M
 	}
 }
 
-func (m *MonacoService) ExecCode(ctx context.Context, language int8, code string) (string, error) {
+// ExecCode courseID 不为 0 时使用该课程最近构建完成的自定义镜像
+func (m *MonacoService) ExecCode(ctx context.Context, courseID uint64, language int8, code string) (string, error) {
+	var image string
+	if courseID != 0 {
+		var err error
+		image, err = model.QueryReadyCourseImageTag(ctx, m.Dao.Storage.RDB, courseID, define.ImageBaseMonaco, language)
+		if err != nil {
+			m.Logger.Errorf(err, "query ready monaco image of course[%d] failed", courseID)
+			return "", errorx.InternalErr(err)
+		}
+	}
+
 	resp, err := m.MonacoClient.ExecCode(ctx, &pb.ExecCodeRequest{
 		Language: uint32(language),
 		Code:     code,
+		Image:    image,
 	})
 
 	if err == nil {
@@ -128,7 +128,7 @@ public class Solution{
 		},
 	} {
 		ctx, cancel := context.WithTimeout(context.Background(), c.maxTimeout)
-		resp, err := monacoService.ExecCode(ctx, c.language, c.code)
+		resp, err := monacoService.ExecCode(ctx, 0, c.language, c.code)
 		cancel()
 		require.Equal(t, c.expectedError, err, c.label)
 		if err == nil {
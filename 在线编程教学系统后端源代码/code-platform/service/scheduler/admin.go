@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code-platform/pkg/errorx"
+	"code-platform/repository/rdb/model"
+)
+
+// ListJobRuns 供管理员查看执行记录，name 为空时不限任务，status 小于 0 时不限状态
+func (s *SchedulerService) ListJobRuns(ctx context.Context, name string, status int8, offset, limit int) (*PageResponse, error) {
+	total, err := model.QueryTotalAmountJobRuns(ctx, s.Dao.Storage.RDB, name, status)
+	if err != nil {
+		s.Logger.Errorf(err, "QueryTotalAmountJobRuns by name %q and status[%d] failed", name, status)
+		return nil, errorx.InternalErr(err)
+	}
+	runs, err := model.QueryJobRuns(ctx, s.Dao.Storage.RDB, name, status, offset, limit)
+	if err != nil {
+		s.Logger.Errorf(err, "QueryJobRuns by name %q and status[%d] failed", name, status)
+		return nil, errorx.InternalErr(err)
+	}
+
+	records := make([]*JobRun, len(runs))
+	for index, run := range runs {
+		records[index] = jobRunModelToDefine(run)
+	}
+	return &PageResponse{
+		Records:  records,
+		PageInfo: &PageInfo{Total: total},
+	}, nil
+}
+
+// RetryJobRun 立即重新执行最终失败的记录，执行次数重新计算
+func (s *SchedulerService) RetryJobRun(ctx context.Context, runID uint64) error {
+	run, err := model.QueryJobRunByID(ctx, s.Dao.Storage.RDB, runID)
+	switch err {
+	case nil:
+	case sql.ErrNoRows:
+		s.Logger.Debugf("job run is not found by id[%d]", runID)
+		return errorx.ErrIsNotFound
+	default:
+		s.Logger.Errorf(err, "Query job run by id[%d] failed", runID)
+		return errorx.InternalErr(err)
+	}
+	// 周期任务由调度器继续按计划执行，重新执行只针对延时任务
+	if run.Kind != model.JobKindDelayed {
+		s.Logger.Debugf("job run[%d] of %q is periodic", runID, run.Name)
+		return errorx.ErrJobRunNotRetryable
+	}
+
+	ok, err := model.RequeueFailedJobRun(ctx, s.Dao.Storage.RDB, runID, time.Now())
+	if err != nil {
+		s.Logger.Errorf(err, "requeue job run[%d] failed", runID)
+		return errorx.InternalErr(err)
+	}
+	if !ok {
+		s.Logger.Debugf("job run[%d] with status[%d] is not failed", runID, run.Status)
+		return errorx.ErrJobRunNotRetryable
+	}
+	return nil
+}
+
+func jobRunModelToDefine(run *model.JobRun) *JobRun {
+	return &JobRun{
+		RunAt:        run.RunAt,
+		CreatedAt:    run.CreatedAt,
+		StartedAt:    run.StartedAt.Time,
+		FinishedAt:   run.FinishedAt.Time,
+		Name:         run.Name,
+		Payload:      run.Payload,
+		Error:        run.Error,
+		ID:           run.ID,
+		FencingToken: run.FencingToken,
+		Attempt:      run.Attempt,
+		MaxAttempts:  run.MaxAttempts,
+		Kind:         run.Kind,
+		Status:       run.Status,
+	}
+}
@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"time"
+
+	"code-platform/service/define"
+)
+
+type (
+	PageResponse = define.PageResponse
+	PageInfo     = define.PageInfo
+)
+
+// JobRun 任务的一次执行记录，重试的多次执行共用一条记录
+type JobRun struct {
+	RunAt        time.Time `json:"run_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	StartedAt    time.Time `json:"started_at"`
+	FinishedAt   time.Time `json:"finished_at"`
+	Name         string    `json:"name"`
+	Payload      string    `json:"payload"`
+	Error        string    `json:"error"`
+	ID           uint64    `json:"id"`
+	FencingToken uint64    `json:"fencing_token"`
+	Attempt      uint32    `json:"attempt"`
+	MaxAttempts  uint32    `json:"max_attempts"`
+	Kind         int8      `json:"kind"`
+	Status       int8      `json:"status"`
+}
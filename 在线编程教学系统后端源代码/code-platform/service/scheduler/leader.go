@@ -0,0 +1,157 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"code-platform/log"
+	"code-platform/monitor"
+	"code-platform/pkg/rediskey"
+	"code-platform/storage"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+const (
+	backgroundJobLeaseName = "background-jobs"
+	// backgroundJobLeaseTTL 持有者崩溃后其他实例最迟在该时间后接管
+	backgroundJobLeaseTTL = 30 * time.Second
+	// leaderPollInterval 获得或续期租约的间隔
+	leaderPollInterval = backgroundJobLeaseTTL / 3
+)
+
+var ErrNotLeader = errors.New("not the leader of background jobs")
+
+// Leader 多个后端实例通过租约选出一个执行后台任务，调度器只在持有者上执行任务；
+// fencing token 写入 job_run，记录的领取与结束以 token 为条件，旧持有者无法覆盖新持有者的执行结果；
+// 任务在有副作用的操作前通过 CheckFencing 确认仍是持有者，但业务数据的写入不以 token 为条件
+type Leader struct {
+	lease  *rediskey.Lease
+	logger *log.Logger
+	token  uint64
+}
+
+func NewLeader(st *storage.Storage, logger *log.Logger) *Leader {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	owner := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+	monitor.BackgroundJobLeaderCollector.WithLabelValues(owner).Set(0)
+	return &Leader{
+		lease:  rediskey.NewLease(st.Pool(), backgroundJobLeaseName, owner, backgroundJobLeaseTTL),
+		logger: logger,
+	}
+}
+
+// Campaigning 周期性获得或续期租约，并上报本实例与观察到的持有者
+func (l *Leader) Campaigning() {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err := fmt.Errorf("%v", r)
+				l.logger.Errorf(err, "leader campaigning panic")
+			}
+			l.stepDown(l.Token())
+			time.Sleep(backgroundJobLeaseTTL)
+			l.Campaigning()
+		}()
+
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), backgroundJobLeaseTTL/3)
+			l.campaign(ctx)
+			l.reportHolder(ctx)
+			cancel()
+			time.Sleep(leaderPollInterval)
+		}
+	}()
+}
+
+func (l *Leader) campaign(ctx context.Context) {
+	// 续期失败时无法确定租约是否仍有效，立即放弃领导权，由租约过期后重新选举
+	if token := l.Token(); token != 0 {
+		ok, err := l.lease.Renew(ctx, token)
+		if err != nil {
+			l.logger.Errorf(err, "renew lease with token[%d] failed", token)
+		}
+		if err != nil || !ok {
+			l.logger.Warnf("%s lost leadership with token[%d]", l.lease.Owner(), token)
+			l.stepDown(token)
+		}
+		return
+	}
+
+	token, err := l.lease.Acquire(ctx)
+	if err != nil {
+		l.logger.Error(err, "acquire lease failed")
+		return
+	}
+	if token == 0 {
+		return
+	}
+	l.logger.Infof("%s became leader with token[%d]", l.lease.Owner(), token)
+	atomic.StoreUint64(&l.token, token)
+	monitor.BackgroundJobLeaderCollector.WithLabelValues(l.lease.Owner()).Set(1)
+	monitor.BackgroundJobFencingTokenCollector.Set(float64(token))
+}
+
+// stepDown 放弃以 token 获得的领导权，token 已变化时不做处理
+func (l *Leader) stepDown(token uint64) {
+	if !atomic.CompareAndSwapUint64(&l.token, token, 0) {
+		return
+	}
+	monitor.BackgroundJobLeaderCollector.WithLabelValues(l.lease.Owner()).Set(0)
+	monitor.BackgroundJobFencingTokenCollector.Set(0)
+}
+
+func (l *Leader) reportHolder(ctx context.Context) {
+	holder, _, err := l.lease.Holder(ctx)
+	switch err {
+	case nil:
+	case redigo.ErrNil:
+		holder = ""
+	default:
+		l.logger.Error(err, "get lease holder failed")
+		return
+	}
+	monitor.BackgroundJobLeaseHolderCollector.Reset()
+	if holder != "" {
+		monitor.BackgroundJobLeaseHolderCollector.WithLabelValues(holder).Set(1)
+	}
+}
+
+// Token 本实例持有租约时的 fencing token，否则为 0
+func (l *Leader) Token() uint64 {
+	return atomic.LoadUint64(&l.token)
+}
+
+func (l *Leader) IsLeader() bool {
+	return l.Token() != 0
+}
+
+// Check 确认租约仍以本实例当前的 token 持有
+func (l *Leader) Check(ctx context.Context) error {
+	return l.checkToken(ctx, l.Token())
+}
+
+// checkToken 确认租约仍以 token 持有，租约已被他人获得时放弃领导权
+func (l *Leader) checkToken(ctx context.Context, token uint64) error {
+	if token == 0 {
+		return ErrNotLeader
+	}
+	ok, err := l.lease.Check(ctx, token)
+	if err != nil {
+		l.logger.Errorf(err, "check lease with token[%d] failed", token)
+		return err
+	}
+	if !ok {
+		l.logger.Warnf("%s lost leadership with token[%d]", l.lease.Owner(), token)
+		l.stepDown(token)
+		return ErrNotLeader
+	}
+	return nil
+}
@@ -0,0 +1,476 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"code-platform/log"
+	"code-platform/monitor"
+	"code-platform/pkg/cronx"
+	"code-platform/pkg/timex"
+	"code-platform/pkg/transactionx"
+	"code-platform/repository"
+	"code-platform/repository/rdb/model"
+	"code-platform/storage"
+)
+
+const (
+	// pollInterval 持有者查询到期任务的间隔，也是延时任务的最小调度精度
+	pollInterval = time.Second
+	// ensureInterval 补齐周期任务下一次执行记录的间隔，正常情况下每次执行结束时已写入
+	ensureInterval = 5 * time.Minute
+	// maxConcurrentRuns 同时执行的任务数上限
+	maxConcurrentRuns = 8
+	recoverBatchSize  = 100
+
+	defaultJobTimeout = 5 * time.Minute
+	defaultBackoff    = 10 * time.Second
+	maxBackoff        = time.Hour
+	// maxErrorLength error 列为 TEXT，调用栈过长时截断
+	maxErrorLength = 16 << 10
+
+	cleanupJobName = "scheduler.cleanup"
+	// succeededRunRetention 成功的执行记录保留时间，失败的记录一直保留
+	succeededRunRetention = 7 * 24 * time.Hour
+	cleanupBatchSize      = 1000
+)
+
+var (
+	ErrJobNotRegistered = errors.New("job is not registered")
+	// errRunInterrupted 执行的实例崩溃、失去租约或未能写入结果
+	errRunInterrupted = errors.New("run was interrupted before its result was saved")
+)
+
+// Job 注册到调度器的任务，Spec 不为空时为周期任务，否则为通过 Enqueue 提交的延时任务
+type Job struct {
+	// Handler 返回错误时按 Backoff 重试，panic 视为失败并记录调用栈；
+	// 有副作用的操作前应调用 CheckFencing，业务数据的写入本身不受 fencing token 约束，
+	// 检查与写入之间暂停的旧持有者仍可能写入，需幂等或以业务记录的状态为条件
+	Handler func(ctx context.Context, payload []byte) error
+	Name    string
+	// Spec cron 表达式或 @every <duration>，按上海时区计算，周期任务在上一次执行结束后才计算下一次的时间
+	Spec string
+	// Timeout 单次执行的最长时间，默认 5 分钟
+	Timeout time.Duration
+	// Backoff 第一次重试前的等待时间，之后每次翻倍，默认 10 秒，最长 1 小时
+	Backoff time.Duration
+	// MaxAttempts 最多执行的次数，默认为 1 即不重试
+	MaxAttempts uint32
+	// OnFailure 不为空时在不再重试的失败（包括执行中断）写入后调用，用于同步业务记录的状态
+	OnFailure func(ctx context.Context, payload []byte, err error)
+
+	schedule cronx.Schedule
+}
+
+// SchedulerService 多个实例中只有持有租约的一个执行任务，执行记录与延时任务保存在 job_run 中，
+// 切换持有者或重启后继续执行
+type SchedulerService struct {
+	Dao    *repository.Dao
+	Logger *log.Logger
+
+	leader *Leader
+	slots  chan struct{}
+	mu     sync.RWMutex
+	jobs   map[string]*Job
+	// inflight 本实例正在执行的记录
+	inflight map[uint64]struct{}
+}
+
+func NewSchedulerService(dao *repository.Dao, logger *log.Logger) *SchedulerService {
+	s := &SchedulerService{
+		Dao:    dao,
+		Logger: logger,
+		leader: NewLeader(dao.Storage, logger),
+		slots:  make(chan struct{}, maxConcurrentRuns),
+		jobs:   make(map[string]*Job),
+
+		inflight: make(map[uint64]struct{}),
+	}
+	s.Register(Job{
+		Name:    cleanupJobName,
+		Spec:    "0 4 * * *",
+		Handler: s.cleanupSucceededRuns,
+	})
+	return s
+}
+
+// Register 注册任务，须在 Start 之前调用；任务名重复或 Spec 无效时 panic
+func (s *SchedulerService) Register(job Job) {
+	if job.Name == "" || job.Handler == nil {
+		panic("job name and handler are required")
+	}
+	if job.Spec != "" {
+		schedule, err := cronx.Parse(job.Spec, timex.ShanghaiLocation)
+		if err != nil {
+			panic(fmt.Sprintf("invalid spec of job %q: %v", job.Name, err))
+		}
+		job.schedule = schedule
+	}
+	if job.Timeout <= 0 {
+		job.Timeout = defaultJobTimeout
+	}
+	if job.Backoff <= 0 {
+		job.Backoff = defaultBackoff
+	}
+	if job.MaxAttempts == 0 {
+		job.MaxAttempts = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.Name]; ok {
+		panic(fmt.Sprintf("job %q is already registered", job.Name))
+	}
+	s.jobs[job.Name] = &job
+}
+
+func (s *SchedulerService) job(name string) (*Job, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[name]
+	return job, ok
+}
+
+func (s *SchedulerService) jobNames() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Start 参与租约选举，成为持有者后执行到期的任务
+func (s *SchedulerService) Start() {
+	s.leader.Campaigning()
+	s.dispatching()
+}
+
+func (s *SchedulerService) dispatching() {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err := fmt.Errorf("%v", r)
+				s.Logger.Errorf(err, "scheduler panic")
+			}
+			time.Sleep(leaderPollInterval)
+			s.dispatching()
+		}()
+
+		// 每次成为持有者后先接管中断的记录并补齐周期任务，之后每隔 ensureInterval 检查一次
+		var (
+			lastToken     uint64
+			lastEnsuredAt time.Time
+		)
+		for {
+			time.Sleep(pollInterval)
+			token := s.leader.Token()
+			if token == 0 {
+				continue
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), leaderPollInterval)
+			if token != lastToken || time.Since(lastEnsuredAt) >= ensureInterval {
+				if err := s.recoverInterruptedRuns(ctx); err == nil {
+					if err := s.ensurePeriodicRuns(ctx); err == nil {
+						lastToken, lastEnsuredAt = token, time.Now()
+					}
+				}
+			}
+			if token == lastToken {
+				s.dispatchDueRuns(ctx, token)
+			}
+			cancel()
+		}
+	}()
+}
+
+// recoverInterruptedRuns 执行中但不在本实例执行的记录来自崩溃或失去租约的实例，
+// 原实例恢复后因记录已被修改不会再写入结果，按执行失败处理
+func (s *SchedulerService) recoverInterruptedRuns(ctx context.Context) error {
+	var afterID uint64
+	for {
+		runs, err := model.QueryRunningJobRunsAfterID(ctx, s.Dao.Storage.RDB, afterID, recoverBatchSize)
+		if err != nil {
+			s.Logger.Errorf(err, "QueryRunningJobRunsAfterID by id[%d] failed", afterID)
+			return err
+		}
+		for _, run := range runs {
+			afterID = run.ID
+			if s.isInflight(run.ID) {
+				continue
+			}
+			job, ok := s.job(run.Name)
+			if !ok {
+				// 由注册了该任务的版本接管
+				continue
+			}
+			s.Logger.Warnf("job run[%d] of %q started with token[%d] was interrupted", run.ID, run.Name, run.FencingToken)
+			if err := s.finish(ctx, job, run, errRunInterrupted); err != nil {
+				return err
+			}
+		}
+		if len(runs) < recoverBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *SchedulerService) isInflight(runID uint64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.inflight[runID]
+	return ok
+}
+
+func (s *SchedulerService) setInflight(runID uint64, inflight bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if inflight {
+		s.inflight[runID] = struct{}{}
+	} else {
+		delete(s.inflight, runID)
+	}
+}
+
+// ensurePeriodicRuns 为没有未结束记录的周期任务写入下一次执行，已有时由去重键忽略
+func (s *SchedulerService) ensurePeriodicRuns(ctx context.Context) error {
+	now := time.Now()
+	for _, name := range s.jobNames() {
+		job, _ := s.job(name)
+		if job.schedule == nil {
+			continue
+		}
+		if err := insertNextPeriodicRun(ctx, s.Dao.Storage.RDB, job, now); err != nil {
+			s.Logger.Errorf(err, "insert next run of periodic job %q failed", job.Name)
+			return err
+		}
+	}
+	return nil
+}
+
+func insertNextPeriodicRun(ctx context.Context, rdbClient storage.RDBClient, job *Job, now time.Time) error {
+	run := &model.JobRun{
+		Name:        job.Name,
+		Kind:        model.JobKindPeriodic,
+		Status:      model.JobRunStatusPending,
+		DedupKey:    sql.NullString{String: "periodic:" + job.Name, Valid: true},
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.schedule.Next(now),
+		CreatedAt:   now,
+	}
+	_, err := run.Insert(ctx, rdbClient)
+	return err
+}
+
+func (s *SchedulerService) dispatchDueRuns(ctx context.Context, token uint64) {
+	free := cap(s.slots) - len(s.slots)
+	if free == 0 {
+		return
+	}
+	runs, err := model.QueryDueJobRuns(ctx, s.Dao.Storage.RDB, s.jobNames(), time.Now(), free)
+	if err != nil {
+		s.Logger.Error(err, "QueryDueJobRuns failed")
+		return
+	}
+	if len(runs) == 0 {
+		return
+	}
+	// 暂停后恢复的旧持有者不再领取任务
+	if err := s.leader.checkToken(ctx, token); err != nil {
+		return
+	}
+
+	for _, run := range runs {
+		job, ok := s.job(run.Name)
+		if !ok {
+			continue
+		}
+		now := time.Now()
+		claimed, err := model.ClaimJobRun(ctx, s.Dao.Storage.RDB, run.ID, token, now)
+		if err != nil {
+			s.Logger.Errorf(err, "claim job run[%d] failed", run.ID)
+			return
+		}
+		if !claimed {
+			continue
+		}
+		run.Status = model.JobRunStatusRunning
+		run.Attempt++
+		run.FencingToken = token
+		run.StartedAt = sql.NullTime{Time: now, Valid: true}
+
+		s.slots <- struct{}{}
+		s.setInflight(run.ID, true)
+		go s.execute(job, run)
+	}
+}
+
+func (s *SchedulerService) execute(job *Job, run *model.JobRun) {
+	defer func() { <-s.slots }()
+	// 写入结果失败时记录保持执行中，移出 inflight 后按中断的记录处理
+	defer s.setInflight(run.ID, false)
+
+	startTime := time.Now()
+	runErr := s.invoke(job, run)
+	if runErr != nil {
+		s.Logger.Errorf(runErr, "job run[%d] of %q failed at attempt %d/%d", run.ID, run.Name, run.Attempt, run.MaxAttempts)
+	}
+
+	// 执行超时后仍需写入结果
+	ctx, cancel := context.WithTimeout(context.Background(), leaderPollInterval)
+	defer cancel()
+	if err := s.finish(ctx, job, run, runErr); err != nil {
+		return
+	}
+
+	result := "succeeded"
+	if runErr != nil {
+		result = "failed"
+	}
+	monitor.JobRunCounterCollector.WithLabelValues(job.Name, result).Inc()
+	monitor.JobRunDurationCollector.WithLabelValues(job.Name).Observe(time.Since(startTime).Seconds())
+}
+
+// invoke 以带超时与 fencing token 的 ctx 执行任务，panic 转为包含调用栈的错误
+func (s *SchedulerService) invoke(job *Job, run *model.JobRun) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+	defer cancel()
+	ctx = context.WithValue(ctx, fencingKey{}, fencing{leader: s.leader, token: run.FencingToken})
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, string(debug.Stack()))
+		}
+	}()
+	err = job.Handler(ctx, []byte(run.Payload))
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timeout after %s: %w", job.Timeout, err)
+	}
+	return err
+}
+
+// finish 写入执行结果，仍有剩余次数的失败记录退避后重新等待；周期任务结束时在同一事务中写入下一次执行
+func (s *SchedulerService) finish(ctx context.Context, job *Job, run *model.JobRun, runErr error) error {
+	now := time.Now()
+	if runErr != nil && run.Attempt < run.MaxAttempts {
+		runAt := now.Add(backoff(job.Backoff, run.Attempt))
+		if _, err := model.RetryJobRun(ctx, s.Dao.Storage.RDB, run.ID, run.Attempt, run.FencingToken, errorMessage(runErr), runAt, now); err != nil {
+			s.Logger.Errorf(err, "retry job run[%d] at %s failed", run.ID, runAt)
+			return err
+		}
+		return nil
+	}
+
+	status, errMsg := model.JobRunStatusSucceeded, ""
+	if runErr != nil {
+		status, errMsg = model.JobRunStatusFailed, errorMessage(runErr)
+	}
+	var finished bool
+	task := func(ctx context.Context, tx storage.RDBClient) (err error) {
+		finished, err = model.FinishJobRun(ctx, tx, run.ID, run.Attempt, run.FencingToken, status, errMsg, now)
+		if err != nil {
+			s.Logger.Errorf(err, "finish job run[%d] with status[%d] failed", run.ID, status)
+			return err
+		}
+		if !finished || run.Kind != model.JobKindPeriodic || job.schedule == nil {
+			return nil
+		}
+		if err := insertNextPeriodicRun(ctx, tx, job, now); err != nil {
+			s.Logger.Errorf(err, "insert next run of periodic job %q failed", job.Name)
+			return err
+		}
+		return nil
+	}
+	if err := transactionx.DoTransaction(ctx, s.Dao.Storage, s.Logger, task, &sql.TxOptions{Isolation: sql.LevelReadCommitted}); err != nil {
+		return err
+	}
+	if finished && runErr != nil && job.OnFailure != nil {
+		job.OnFailure(ctx, []byte(run.Payload), runErr)
+	}
+	return nil
+}
+
+// backoff 第 attempt 次执行失败后的等待时间
+func backoff(base time.Duration, attempt uint32) time.Duration {
+	delay := base
+	for i := uint32(1); i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+func errorMessage(err error) string {
+	msg := err.Error()
+	if len(msg) > maxErrorLength {
+		msg = strings.ToValidUTF8(msg[:maxErrorLength], "")
+	}
+	return msg
+}
+
+// Enqueue 提交延时任务，到 runAt 后由持有者执行；dedupKey 不为空时，
+// 同一 dedupKey 已有等待或执行中的记录则不再提交并返回 0
+func (s *SchedulerService) Enqueue(ctx context.Context, rdbClient storage.RDBClient, name string, payload []byte, runAt time.Time, dedupKey string) (uint64, error) {
+	job, ok := s.job(name)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrJobNotRegistered, name)
+	}
+	run := &model.JobRun{
+		Name:        name,
+		Kind:        model.JobKindDelayed,
+		Status:      model.JobRunStatusPending,
+		Payload:     string(payload),
+		DedupKey:    sql.NullString{String: dedupKey, Valid: dedupKey != ""},
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       runAt,
+		CreatedAt:   time.Now(),
+	}
+	inserted, err := run.Insert(ctx, rdbClient)
+	if err != nil || !inserted {
+		return 0, err
+	}
+	return run.ID, nil
+}
+
+func (s *SchedulerService) cleanupSucceededRuns(ctx context.Context, _ []byte) error {
+	before := time.Now().Add(-succeededRunRetention)
+	for {
+		if err := CheckFencing(ctx); err != nil {
+			return err
+		}
+		deleted, err := model.DeleteSucceededJobRunsBefore(ctx, s.Dao.Storage.RDB, before, cleanupBatchSize)
+		if err != nil {
+			return err
+		}
+		if deleted < cleanupBatchSize {
+			return nil
+		}
+	}
+}
+
+type fencingKey struct{}
+
+type fencing struct {
+	leader *Leader
+	token  uint64
+}
+
+// CheckFencing 确认执行任务的实例仍以开始执行时的 token 持有租约，暂停后恢复的旧持有者返回 ErrNotLeader；
+// 只缩小而不能消除旧持有者写入的窗口，job_run 的状态写入另以 token 为条件；ctx 不是由调度器创建时返回 nil
+func CheckFencing(ctx context.Context) error {
+	f, ok := ctx.Value(fencingKey{}).(fencing)
+	if !ok {
+		return nil
+	}
+	return f.leader.checkToken(ctx, f.token)
+}
@@ -0,0 +1,104 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code-platform/log"
+	"code-platform/pkg/errorx"
+	"code-platform/pkg/testx"
+	"code-platform/repository"
+	"code-platform/repository/rdb/model"
+	. "code-platform/service/scheduler"
+	"code-platform/storage"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testHelper() (*storage.Storage, *SchedulerService) {
+	testStorage := testx.NewStorage()
+	dao := &repository.Dao{Storage: testStorage}
+	schedulerService := NewSchedulerService(dao, log.Sub("scheduler"))
+	return testStorage, schedulerService
+}
+
+func TestEnqueueAndRetryJobRun(t *testing.T) {
+	testStorage, schedulerService := testHelper()
+	defer testStorage.Close()
+
+	ctx := context.Background()
+	testx.MustTruncateTable(ctx, testStorage.RDB, "job_run")
+
+	schedulerService.Register(Job{
+		Name:        "test.delayed",
+		MaxAttempts: 3,
+		Handler:     func(ctx context.Context, payload []byte) error { return nil },
+	})
+
+	_, err := schedulerService.Enqueue(ctx, testStorage.RDB, "test.unknown", nil, time.Now(), "")
+	require.ErrorIs(t, err, ErrJobNotRegistered)
+
+	runID, err := schedulerService.Enqueue(ctx, testStorage.RDB, "test.delayed", []byte(`{"id":1}`), time.Now(), "test:1")
+	require.NoError(t, err)
+	require.NotZero(t, runID)
+
+	// 同一去重键已有等待执行的记录
+	duplicatedRunID, err := schedulerService.Enqueue(ctx, testStorage.RDB, "test.delayed", []byte(`{"id":1}`), time.Now(), "test:1")
+	require.NoError(t, err)
+	require.Zero(t, duplicatedRunID)
+
+	resp, err := schedulerService.ListJobRuns(ctx, "test.delayed", -1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.PageInfo.Total)
+	run := resp.Records.([]*JobRun)[0]
+	require.Equal(t, runID, run.ID)
+	require.Equal(t, `{"id":1}`, run.Payload)
+	require.Equal(t, uint32(3), run.MaxAttempts)
+	require.Equal(t, model.JobKindDelayed, run.Kind)
+
+	// 等待执行的记录不能重新执行
+	err = schedulerService.RetryJobRun(ctx, runID)
+	require.Equal(t, errorx.ErrJobRunNotRetryable, err)
+	err = schedulerService.RetryJobRun(ctx, runID+1)
+	require.Equal(t, errorx.ErrIsNotFound, err)
+
+	now := time.Now()
+	claimed, err := model.ClaimJobRun(ctx, testStorage.RDB, runID, 1, now)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	finished, err := model.FinishJobRun(ctx, testStorage.RDB, runID, 1, 1, model.JobRunStatusFailed, "boom", now)
+	require.NoError(t, err)
+	require.True(t, finished)
+
+	resp, err = schedulerService.ListJobRuns(ctx, "", model.JobRunStatusFailed, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.PageInfo.Total)
+	require.Equal(t, "boom", resp.Records.([]*JobRun)[0].Error)
+
+	// 结束后释放去重键
+	runID2, err := schedulerService.Enqueue(ctx, testStorage.RDB, "test.delayed", nil, time.Now(), "test:1")
+	require.NoError(t, err)
+	require.NotZero(t, runID2)
+
+	err = schedulerService.RetryJobRun(ctx, runID)
+	require.NoError(t, err)
+	requeued, err := model.QueryJobRunByID(ctx, testStorage.RDB, runID)
+	require.NoError(t, err)
+	require.Equal(t, model.JobRunStatusPending, requeued.Status)
+	require.Zero(t, requeued.Attempt)
+
+	// 旧持有者不能领取已由更大 token 执行过的记录，也不能写入新持有者的执行结果
+	claimed, err = model.ClaimJobRun(ctx, testStorage.RDB, runID, 0, now)
+	require.NoError(t, err)
+	require.False(t, claimed)
+	claimed, err = model.ClaimJobRun(ctx, testStorage.RDB, runID, 2, now)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	finished, err = model.FinishJobRun(ctx, testStorage.RDB, runID, 1, 1, model.JobRunStatusSucceeded, "", now)
+	require.NoError(t, err)
+	require.False(t, finished)
+	finished, err = model.FinishJobRun(ctx, testStorage.RDB, runID, 1, 2, model.JobRunStatusSucceeded, "", now)
+	require.NoError(t, err)
+	require.True(t, finished)
+}
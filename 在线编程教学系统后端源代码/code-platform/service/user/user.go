@@ -642,7 +642,8 @@ func (u *UserService) refreshToken(ctx context.Context, userID uint64, refreshTo
 	return newToken, newRefreshToken, nil
 }
 
-// 回填 token 至缓存。异步任务，无返回值
+// 回填 token 至缓存。异步任务，无返回值；
+// 缓存未命中时会从数据库读取会话，回填失败不影响登录，无需作为调度器的持久任务重试
 func (u *UserService) backFilledToken(token string, userStat *pb.TokenStat, expireAt time.Time, duration time.Duration) {
 	parallelx.DoAsyncWithTimeOut(context.TODO(), duration, u.Logger, func(ctx context.Context) (err error) {
 		// proto marshal
@@ -17,6 +17,8 @@ type MinioClient struct {
 	reportBucketName     string
 	attachmentBucketName string
 	videoBucketName      string
+	submissionBucketName string
+	templateBucketName   string
 	policyReadOnly       string
 	policyWriteOnly      string
 	policyReadWrite      string
@@ -44,6 +46,8 @@ func MustInitMinioClient() MinioClient {
 		reportBucketName:     bucketNames["report"],
 		attachmentBucketName: bucketNames["attachment"],
 		videoBucketName:      bucketNames["video"],
+		submissionBucketName: bucketNames["submissions"],
+		templateBucketName:   bucketNames["template"],
 		policyReadOnly:       newPolicyToJSON(newPolicyReadOnly()),
 		policyWriteOnly:      newPolicyToJSON(newPolicyWriteOnly()),
 		policyReadWrite:      newPolicyToJSON(newPolicyReadWrite()),
@@ -61,6 +65,15 @@ func MustInitMinioClient() MinioClient {
 			panic(err)
 		}
 	}
+	// 工作区归档与代码模板仅由服务端读取，不设置公开策略
+	for _, name := range []string{
+		minioClient.submissionBucketName,
+		minioClient.templateBucketName,
+	} {
+		if err := minioClient.newBucket(ctx, name, ""); err != nil {
+			panic(err)
+		}
+	}
 
 	return minioClient
 }
@@ -78,6 +91,9 @@ func (m *MinioClient) newBucket(ctx context.Context, bucketName string, policy s
 		if err := m.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{Region: region}); err != nil {
 			return err
 		}
+		if policy == "" {
+			return nil
+		}
 		if err := m.SetBucketPolicy(ctx, bucketName, fmt.Sprintf(policy, bucketName, bucketName)); err != nil {
 			return err
 		}
@@ -101,6 +117,14 @@ func (m *MinioClient) VideoBucketName() string {
 	return m.videoBucketName
 }
 
+func (m *MinioClient) SubmissionBucketName() string {
+	return m.submissionBucketName
+}
+
+func (m *MinioClient) TemplateBucketName() string {
+	return m.templateBucketName
+}
+
 func (m *MinioClient) URLFormat() string {
 	// add proto
 	return "http://" + m.urlFormat